@@ -0,0 +1,16 @@
+package all
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewRootCommand() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:   "all",
+		Short: "Run api, notifier, tx-sender and tx-listener within a single process",
+	}
+
+	rootCmd.AddCommand(newRunCommand())
+
+	return rootCmd
+}
@@ -0,0 +1,95 @@
+package all
+
+import (
+	"context"
+	"os"
+
+	"github.com/consensys/orchestrate/cmd/flags"
+	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
+	txlistener "github.com/consensys/orchestrate/src/tx-listener"
+	txsender "github.com/consensys/orchestrate/src/tx-sender"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newRunCommand() *cobra.Command {
+	runCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run application",
+		RunE:  run,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			utils.PreRunBindFlags(viper.GetViper(), cmd.Flags(), "")
+		},
+		PostRun: func(cmd *cobra.Command, args []string) {
+			if err := cmd.Context().Err(); err != nil {
+				os.Exit(1)
+			}
+		},
+	}
+
+	flags.AllFlags(runCmd.Flags())
+
+	return runCmd
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	ctx, cancel := context.WithCancel(cmd.Context())
+	defer cancel()
+
+	vipr := viper.GetViper()
+
+	// Services communicate through an in-memory broker instead of Kafka
+	broker := inmemory.NewBroker()
+
+	apiCfg := flags.NewAPIConfig(vipr)
+	apiCfg.Broker = broker
+	notifierCfg := flags.NewNotifierConfig(vipr)
+	notifierCfg.Broker = broker
+	txSenderCfg := flags.NewTxSenderConfig(vipr)
+	txSenderCfg.Broker = broker
+	txListenerCfg := flags.NewTxListenerConfig(vipr)
+	txListenerCfg.Broker = broker
+
+	// Only the API exposes HTTP entrypoints, tx-sender and tx-listener would otherwise bind the same ports
+	txSenderCfg.App.HTTP = &app.HTTP{}
+	txListenerCfg.App.HTTP = &app.HTTP{}
+
+	// Tracing is configured once for the process, every service sharing the same tracer provider
+	shutdownTracer, err := tracing.ConfigureTracer(ctx, apiCfg.App.Tracing, "orchestrate")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = shutdownTracer(context.Background()) }()
+
+	apiApp, err := api.New(ctx, apiCfg, notifierCfg)
+	if err != nil {
+		return err
+	}
+
+	txSenderApp, err := txsender.New(ctx, txSenderCfg)
+	if err != nil {
+		return err
+	}
+
+	txListenerApp, err := txlistener.New(ctx, txListenerCfg)
+	if err != nil {
+		return err
+	}
+
+	gr := &multierror.Group{}
+	for _, runner := range []interface{ Run(context.Context) error }{apiApp, txSenderApp, txListenerApp} {
+		r := runner
+		gr.Go(func() error {
+			// Stopping one service stops the others
+			defer cancel()
+			return r.Run(ctx)
+		})
+	}
+
+	return gr.Wait().ErrorOrNil()
+}
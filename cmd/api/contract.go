@@ -0,0 +1,142 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ethartifacts "github.com/consensys/orchestrate/pkg/ethereum/artifacts"
+	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
+	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func newContractCmd() *cobra.Command {
+	contractCmd := &cobra.Command{
+		Use:   "contract",
+		Short: "Contract registry management",
+	}
+
+	var tag, chain, tenant string
+	var libraries map[string]string
+	importCmd := &cobra.Command{
+		Use:   "import [paths...]",
+		Short: "import contracts from Hardhat, Foundry or Truffle build artifacts",
+		Args:  cobra.MinimumNArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			utils.PreRunBindFlags(viper.GetViper(), cmd.Flags(), "")
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &api.ImportContractsRequest{
+				Tag:       tag,
+				ChainName: chain,
+				Libraries: map[string]ethcommon.Address{},
+			}
+			for name, address := range libraries {
+				if !ethcommon.IsHexAddress(address) {
+					return fmt.Errorf("invalid address of library %s", name)
+				}
+				req.Libraries[name] = ethcommon.HexToAddress(address)
+			}
+
+			var err error
+			req.Artifacts, err = readArtifacts(args)
+			if err != nil {
+				return err
+			}
+
+			return importContracts(cmd.Context(), viper.GetViper(), req, tenant)
+		},
+	}
+
+	importCmd.Flags().StringVar(&tag, "tag", "", "Tag attached to all the imported contracts, latest by default")
+	importCmd.Flags().StringVar(&chain, "chain", "", "Name of the chain on which the deployments of the libraries to link are searched")
+	importCmd.Flags().StringVar(&tenant, "tenant", "", "Tenant owning the imported contracts, requires an API key")
+	importCmd.Flags().StringToStringVar(&libraries, "library", nil, "Address of a library to link, as Name=0x...")
+	orchestrateclient.Flags(importCmd.Flags())
+	authkey.Flags(importCmd.Flags())
+
+	contractCmd.AddCommand(importCmd)
+
+	return contractCmd
+}
+
+// readArtifacts reads the JSON artifacts of the given files and directories, skipping the Hardhat debug and build info files
+func readArtifacts(paths []string) ([]*api.ContractArtifactParams, error) {
+	var artifacts []*api.ContractArtifactParams
+	for _, root := range paths {
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if info.Name() == "build-info" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if filepath.Ext(path) != ".json" || strings.HasSuffix(path, ".dbg.json") {
+				return nil
+			}
+
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			artifact, err := ethartifacts.Parse(data)
+			if err != nil {
+				return fmt.Errorf("%s: %s", path, err.Error())
+			}
+
+			// Artifacts without contract name are named after their file, as Foundry does
+			params := &api.ContractArtifactParams{Artifact: data}
+			if artifact.Name == "" {
+				params.Name = strings.TrimSuffix(filepath.Base(path), ".json")
+			}
+			artifacts = append(artifacts, params)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(artifacts) == 0 {
+		return nil, fmt.Errorf("no artifact found")
+	}
+
+	return artifacts, nil
+}
+
+func importContracts(ctx context.Context, vipr *viper.Viper, req *api.ImportContractsRequest, tenant string) error {
+	log.WithField("artifacts", len(req.Artifacts)).Debug("Importing contracts...")
+
+	httpClient := http.NewClient(http.NewConfig(vipr))
+	client := orchestrateclient.NewHTTPClient(httpClient, orchestrateclient.NewConfigFromViper(vipr, nil))
+	if tenant != "" {
+		ctx = multitenancy.WithUserInfo(ctx, multitenancy.NewUserInfo(tenant, ""))
+	}
+
+	contracts, err := client.ImportContracts(ctx, req)
+	if err != nil {
+		log.WithError(err).Error("Could not import contracts")
+		return err
+	}
+
+	for _, contract := range contracts {
+		log.WithField("name", contract.Name).WithField("tag", contract.Tag).Info("contract imported")
+	}
+
+	log.WithField("contracts", len(contracts)).Info("contracts imported successfully")
+	return nil
+}
@@ -13,6 +13,7 @@ func NewRootCommand() *cobra.Command {
 	rootCmd.AddCommand(newRunCommand())
 	rootCmd.AddCommand(newMigrateCmd())
 	rootCmd.AddCommand(newAccountCmd())
+	rootCmd.AddCommand(newContractCmd())
 
 	return rootCmd
 }
@@ -1,12 +1,14 @@
 package api
 
 import (
+	"context"
 	"os"
 
 	"github.com/consensys/orchestrate/cmd/flags"
 	"github.com/consensys/orchestrate/src/api"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -39,7 +41,15 @@ func run(cmd *cobra.Command, _ []string) error {
 	ctx := cmd.Context()
 	vipr := viper.GetViper()
 
-	apiApp, err := api.New(ctx, flags.NewAPIConfig(vipr), flags.NewNotifierConfig(vipr))
+	apiCfg := flags.NewAPIConfig(vipr)
+
+	shutdownTracer, err := tracing.ConfigureTracer(ctx, apiCfg.App.Tracing, "orchestrate-api")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = shutdownTracer(context.Background()) }()
+
+	apiApp, err := api.New(ctx, apiCfg, flags.NewNotifierConfig(vipr))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,20 @@
+package flags
+
+import (
+	"github.com/spf13/pflag"
+)
+
+// AllFlags registers the flags of every service once, to run them within a single process
+func AllFlags(f *pflag.FlagSet) {
+	NewAPIFlags(f)
+	NotifierFlags(f)
+
+	// tx-sender specific flags
+	RedisFlags(f)
+	maxRecovery(f)
+	nonceManagerType(f)
+	nonceManagerExpiration(f)
+
+	// tx-listener specific flags
+	providerRefreshInterval(f)
+}
@@ -1,6 +1,10 @@
 package flags
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/ethereum/create2"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authjwt "github.com/consensys/orchestrate/pkg/toolkit/app/auth/jwt/jose"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
@@ -8,16 +12,27 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	metricregistry "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/registry"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	tcpmetrics "github.com/consensys/orchestrate/pkg/toolkit/tcp/metrics"
 	"github.com/consensys/orchestrate/src/api"
 	"github.com/consensys/orchestrate/src/api/metrics"
 	"github.com/consensys/orchestrate/src/api/proxy"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+func init() {
+	viper.SetDefault(outboxRelayIntervalViperKey, outboxRelayIntervalDefault)
+	_ = viper.BindEnv(outboxRelayIntervalViperKey, outboxRelayIntervalEnv)
+	viper.SetDefault(create2FactoryViperKey, create2FactoryDefault)
+	_ = viper.BindEnv(create2FactoryViperKey, create2FactoryEnv)
+}
+
 func NewAPIFlags(f *pflag.FlagSet) {
 	QKMFlags(f)
+	Web3SignerFlags(f)
+	KeystoreFlags(f)
 	PGFlags(f)
 
 	KafkaFlags(f)
@@ -33,17 +48,53 @@ func NewAPIFlags(f *pflag.FlagSet) {
 	app.Flags(f)
 	app.MetricFlags(f)
 	metricregistry.Flags(f, httpmetrics.ModuleName, tcpmetrics.ModuleName, metrics.ModuleName)
+	tracing.Flags(f)
 	proxy.Flags(f)
+	outboxRelayInterval(f)
+	create2Factory(f)
+}
+
+const (
+	outboxRelayIntervalFlag     = "outbox-relay-interval"
+	outboxRelayIntervalViperKey = "outbox.relay.interval"
+	outboxRelayIntervalDefault  = 100 * time.Millisecond
+	outboxRelayIntervalEnv      = "OUTBOX_RELAY_INTERVAL"
+)
+
+func outboxRelayInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval at which messages stored in the outbox are relayed to Kafka.
+Environment variable: %q`, outboxRelayIntervalEnv)
+	f.Duration(outboxRelayIntervalFlag, outboxRelayIntervalDefault, desc)
+	_ = viper.BindPFlag(outboxRelayIntervalViperKey, f.Lookup(outboxRelayIntervalFlag))
+}
+
+const (
+	create2FactoryFlag     = "create2-factory-address"
+	create2FactoryViperKey = "create2.factory.address"
+	create2FactoryEnv      = "CREATE2_FACTORY_ADDRESS"
+)
+
+var create2FactoryDefault = create2.FactoryAddress.Hex()
+
+func create2Factory(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Address of the factory through which contracts are deployed with a salt at deterministic addresses.
+Environment variable: %q`, create2FactoryEnv)
+	f.String(create2FactoryFlag, create2FactoryDefault, desc)
+	_ = viper.BindPFlag(create2FactoryViperKey, f.Lookup(create2FactoryFlag))
 }
 
 func NewAPIConfig(vipr *viper.Viper) *api.Config {
 	return &api.Config{
-		App:          app.NewConfig(vipr),
-		Postgres:     NewPGConfig(vipr),
-		Kafka:        NewKafkaConfig(vipr),
-		Messenger:    NewConsumerConfig(vipr),
-		Multitenancy: vipr.GetBool(multitenancy.EnabledViperKey),
-		Proxy:        proxy.NewConfig(),
-		QKM:          NewQKMConfig(vipr),
+		App:                 app.NewConfig(vipr),
+		Postgres:            NewPGConfig(vipr),
+		Kafka:               NewKafkaConfig(vipr),
+		Messenger:           NewConsumerConfig(vipr),
+		Multitenancy:        vipr.GetBool(multitenancy.EnabledViperKey),
+		Proxy:               proxy.NewConfig(),
+		QKM:                 NewQKMConfig(vipr),
+		Web3Signer:          NewWeb3SignerConfig(vipr),
+		Keystore:            NewKeystoreConfig(vipr),
+		OutboxRelayInterval: vipr.GetDuration(outboxRelayIntervalViperKey),
+		Create2Factory:      ethcommon.HexToAddress(vipr.GetString(create2FactoryViperKey)),
 	}
 }
@@ -0,0 +1,76 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(keystoreTypeViperKey, keystoreTypeDefault)
+	_ = viper.BindEnv(keystoreTypeViperKey, keystoreTypeEnv)
+
+	viper.SetDefault(keystorePathViperKey, keystorePathDefault)
+	_ = viper.BindEnv(keystorePathViperKey, keystorePathEnv)
+
+	viper.SetDefault(keystoreMasterKeyViperKey, keystoreMasterKeyDefault)
+	_ = viper.BindEnv(keystoreMasterKeyViperKey, keystoreMasterKeyEnv)
+}
+
+func KeystoreFlags(f *pflag.FlagSet) {
+	keystoreType(f)
+	keystorePath(f)
+	keystoreMasterKey(f)
+}
+
+const (
+	keystoreTypeFlag     = "keystore-type"
+	keystoreTypeViperKey = "keystore.type"
+	keystoreTypeDefault  = ""
+	keystoreTypeEnv      = "KEYSTORE_TYPE"
+)
+
+func keystoreType(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Type of the local encrypted keystore used instead of the Quorum Key Manager, one of %q or %q. Disabled if empty.
+Environment variable: %q`, keystore.TypePostgres, keystore.TypeFile, keystoreTypeEnv)
+	f.String(keystoreTypeFlag, keystoreTypeDefault, desc)
+	_ = viper.BindPFlag(keystoreTypeViperKey, f.Lookup(keystoreTypeFlag))
+}
+
+const (
+	keystorePathFlag     = "keystore-path"
+	keystorePathViperKey = "keystore.path"
+	keystorePathDefault  = "./keystore"
+	keystorePathEnv      = "KEYSTORE_PATH"
+)
+
+func keystorePath(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Directory of the V3 keystore files of a %q keystore.
+Environment variable: %q`, keystore.TypeFile, keystorePathEnv)
+	f.String(keystorePathFlag, keystorePathDefault, desc)
+	_ = viper.BindPFlag(keystorePathViperKey, f.Lookup(keystorePathFlag))
+}
+
+const (
+	keystoreMasterKeyFlag     = "keystore-master-key"
+	keystoreMasterKeyViperKey = "keystore.master.key"
+	keystoreMasterKeyDefault  = ""
+	keystoreMasterKeyEnv      = "KEYSTORE_MASTER_KEY"
+)
+
+func keystoreMasterKey(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Master key encrypting the keys of the local keystore.
+Environment variable: %q`, keystoreMasterKeyEnv)
+	f.String(keystoreMasterKeyFlag, keystoreMasterKeyDefault, desc)
+	_ = viper.BindPFlag(keystoreMasterKeyViperKey, f.Lookup(keystoreMasterKeyFlag))
+}
+
+func NewKeystoreConfig(vipr *viper.Viper) *keystore.Config {
+	return &keystore.Config{
+		Type:      vipr.GetString(keystoreTypeViperKey),
+		Path:      vipr.GetString(keystorePathViperKey),
+		MasterKey: vipr.GetString(keystoreMasterKeyViperKey),
+	}
+}
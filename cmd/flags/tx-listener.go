@@ -12,6 +12,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	metricregistry "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/registry"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	tcpmetrics "github.com/consensys/orchestrate/pkg/toolkit/tcp/metrics"
 	txlistener "github.com/consensys/orchestrate/src/tx-listener"
 	"github.com/spf13/pflag"
@@ -42,6 +43,7 @@ func TxListenerFlags(f *pflag.FlagSet) {
 	KafkaTopicTxListener(f)
 
 	metricregistry.Flags(f, tcpmetrics.ModuleName)
+	tracing.Flags(f)
 	providerRefreshInterval(f)
 }
 
@@ -6,13 +6,17 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	txsender "github.com/consensys/orchestrate/src/tx-sender"
+	"github.com/consensys/orchestrate/src/tx-sender/service"
+	sendermetrics "github.com/consensys/orchestrate/src/tx-sender/tx-sender/metrics"
 
 	"github.com/cenkalti/backoff/v4"
+	pkgbackoff "github.com/consensys/orchestrate/pkg/backoff"
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	metricregistry "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/registry"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	tcpmetrics "github.com/consensys/orchestrate/pkg/toolkit/tcp/metrics"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -30,6 +34,18 @@ func init() {
 
 	viper.SetDefault(NonceManagerExpirationViperKey, nonceManagerExpirationDefault)
 	_ = viper.BindEnv(NonceManagerExpirationViperKey, nonceManagerExpirationEnv)
+
+	viper.SetDefault(chainConcurrencyViperKey, chainConcurrencyDefault)
+	_ = viper.BindEnv(chainConcurrencyViperKey, chainConcurrencyEnv)
+
+	viper.SetDefault(chainQueueSizeViperKey, chainQueueSizeDefault)
+	_ = viper.BindEnv(chainQueueSizeViperKey, chainQueueSizeEnv)
+
+	viper.SetDefault(jobTimeoutViperKey, jobTimeoutDefault)
+	_ = viper.BindEnv(jobTimeoutViperKey, jobTimeoutEnv)
+
+	viper.SetDefault(chainPipelinesViperKey, chainPipelinesDefault)
+	_ = viper.BindEnv(chainPipelinesViperKey, chainPipelinesEnv)
 }
 
 const (
@@ -59,9 +75,41 @@ const (
 	nonceManagerExpirationEnv      = "NONCE_MANAGER_EXPIRATION"
 )
 
+const (
+	chainConcurrencyFlag     = "tx-sender-chain-concurrency"
+	chainConcurrencyViperKey = "tx-sender.chain.concurrency"
+	chainConcurrencyDefault  = 10
+	chainConcurrencyEnv      = "TX_SENDER_CHAIN_CONCURRENCY"
+)
+
+const (
+	chainQueueSizeFlag     = "tx-sender-chain-queue-size"
+	chainQueueSizeViperKey = "tx-sender.chain.queue-size"
+	chainQueueSizeDefault  = 100
+	chainQueueSizeEnv      = "TX_SENDER_CHAIN_QUEUE_SIZE"
+)
+
+const (
+	jobTimeoutFlag     = "tx-sender-job-timeout"
+	jobTimeoutViperKey = "tx-sender.job.timeout"
+	jobTimeoutDefault  = time.Duration(0)
+	jobTimeoutEnv      = "TX_SENDER_JOB_TIMEOUT"
+)
+
+const (
+	chainPipelinesFlag     = "tx-sender-chain-pipelines"
+	chainPipelinesViperKey = "tx-sender.chain.pipelines"
+	chainPipelinesEnv      = "TX_SENDER_CHAIN_PIPELINES"
+)
+
+var chainPipelinesDefault []string
+
 func TxSenderFlags(f *pflag.FlagSet) {
 	RedisFlags(f)
+	PGFlags(f)
 	QKMFlags(f)
+	Web3SignerFlags(f)
+	KeystoreFlags(f)
 
 	KafkaFlags(f)
 	KafkaConsumerFlags(f)
@@ -71,11 +119,16 @@ func TxSenderFlags(f *pflag.FlagSet) {
 	authkey.Flags(f)
 	orchestrateclient.Flags(f)
 	app.MetricFlags(f)
-	metricregistry.Flags(f, tcpmetrics.ModuleName)
+	metricregistry.Flags(f, tcpmetrics.ModuleName, sendermetrics.ModuleName)
+	tracing.Flags(f)
 
 	maxRecovery(f)
 	nonceManagerType(f)
 	nonceManagerExpiration(f)
+	chainConcurrency(f)
+	chainQueueSize(f)
+	jobTimeout(f)
+	chainPipelines(f)
 }
 
 func maxRecovery(f *pflag.FlagSet) {
@@ -87,29 +140,58 @@ Environment variable: %q`, nonceMaxRecoveryEnv)
 
 func nonceManagerType(f *pflag.FlagSet) {
 	desc := fmt.Sprintf(`Type of Nonce manager cache (one of %q)
-Environment variable: %q`, []string{txsender.NonceManagerTypeInMemory, txsender.NonceManagerTypeRedis}, nonceManagerTypeEnv)
+Environment variable: %q`, []string{txsender.NonceManagerTypeInMemory, txsender.NonceManagerTypeRedis, txsender.NonceManagerTypePostgres}, nonceManagerTypeEnv)
 	f.String(nonceManagerTypeFlag, nonceManagerTypeDefault, desc)
 	_ = viper.BindPFlag(nonceManagerTypeViperKey, f.Lookup(nonceManagerTypeFlag))
 }
 
 func nonceManagerExpiration(f *pflag.FlagSet) {
-	desc := fmt.Sprintf(`Nonce manager cache expiration time (TTL).
+	desc := fmt.Sprintf(`Nonce manager cache expiration time (TTL), after which nonces reserved by jobs neither sent nor released are reused.
 Environment variable: %q`, nonceManagerExpirationEnv)
 	f.Duration(nonceManagerExpirationFlag, nonceManagerExpirationDefault, desc)
 	_ = viper.BindPFlag(NonceManagerExpirationViperKey, f.Lookup(nonceManagerExpirationFlag))
 }
 
-func retryMessageBackOff() backoff.BackOff {
-	bckOff := backoff.NewExponentialBackOff()
-	bckOff.MaxInterval = time.Second * 5
-	bckOff.MaxElapsedTime = time.Second * 30
-	return bckOff
+func chainConcurrency(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum number of jobs of a chain processed concurrently, jobs of the same account being processed in order.
+Environment variable: %q`, chainConcurrencyEnv)
+	f.Int(chainConcurrencyFlag, chainConcurrencyDefault, desc)
+	_ = viper.BindPFlag(chainConcurrencyViperKey, f.Lookup(chainConcurrencyFlag))
+}
+
+func chainQueueSize(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum number of jobs of a chain queued or being processed, consumption being paused once reached.
+Environment variable: %q`, chainQueueSizeEnv)
+	f.Int(chainQueueSizeFlag, chainQueueSizeDefault, desc)
+	_ = viper.BindPFlag(chainQueueSizeViperKey, f.Lookup(chainQueueSizeFlag))
+}
+
+func jobTimeout(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum duration of the processing of a job before it fails (no limit if 0).
+Environment variable: %q`, jobTimeoutEnv)
+	f.Duration(jobTimeoutFlag, jobTimeoutDefault, desc)
+	_ = viper.BindPFlag(jobTimeoutViperKey, f.Lookup(jobTimeoutFlag))
+}
+
+func chainPipelines(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Concurrency and job timeout of specific chains, formatted as <chainUUID>:<concurrency>[:<timeout>] (e.g. "b0c8a7b2-4d3e-4a0b-9f6e-0a7d6c5b4e3f:5:30s").
+Environment variable: %q`, chainPipelinesEnv)
+	f.StringSlice(chainPipelinesFlag, chainPipelinesDefault, desc)
+	_ = viper.BindPFlag(chainPipelinesViperKey, f.Lookup(chainPipelinesFlag))
+}
+
+func retryMessageBackOff() pkgbackoff.BackOff {
+	return pkgbackoff.IncrementalBackOff(backoff.DefaultInitialInterval, time.Second*5, time.Second*30)
 }
 
 func NewTxSenderConfig(vipr *viper.Viper) *txsender.Config {
+	// Messages are committed once their jobs are processed
+	kafkaCfg := NewKafkaConfig(vipr)
+	kafkaCfg.DisableCommitOnRead = true
+
 	return &txsender.Config{
 		App:                    app.NewConfig(vipr),
-		Kafka:                  NewKafkaConfig(vipr),
+		Kafka:                  kafkaCfg,
 		ConsumerTopic:          viper.GetString(TxSenderViperKey),
 		Messenger:              NewConsumerConfig(vipr),
 		ProxyURL:               vipr.GetString(orchestrateclient.URLViperKey),
@@ -118,7 +200,16 @@ func NewTxSenderConfig(vipr *viper.Viper) *txsender.Config {
 		NonceManagerType:       vipr.GetString(nonceManagerTypeViperKey),
 		NonceManagerExpiration: vipr.GetDuration(NonceManagerExpirationViperKey),
 		RedisCfg:               NewRedisConfig(vipr),
+		Postgres:               NewPGConfig(vipr),
 		IsMultiTenancyEnabled:  vipr.GetBool(multitenancy.EnabledViperKey),
 		QKM:                    NewQKMConfig(vipr),
+		Web3Signer:             NewWeb3SignerConfig(vipr),
+		Keystore:               NewKeystoreConfig(vipr),
+		Pipeline: &service.PipelineConfig{
+			Concurrency: vipr.GetInt(chainConcurrencyViperKey),
+			Timeout:     vipr.GetDuration(jobTimeoutViperKey),
+			QueueSize:   vipr.GetInt(chainQueueSizeViperKey),
+			Chains:      vipr.GetStringSlice(chainPipelinesViperKey),
+		},
 	}
 }
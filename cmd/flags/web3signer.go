@@ -0,0 +1,115 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/consensys/orchestrate/src/infra/signer/web3signer"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(web3signerURLViperKey, web3signerURLDefault)
+	_ = viper.BindEnv(web3signerURLViperKey, web3signerURLEnv)
+
+	viper.SetDefault(web3signerStoreIDViperKey, web3signerStoreIDDefault)
+	_ = viper.BindEnv(web3signerStoreIDViperKey, web3signerStoreIDEnv)
+
+	viper.SetDefault(web3signerTenantsViperKey, web3signerTenantsDefault)
+	_ = viper.BindEnv(web3signerTenantsViperKey, web3signerTenantsEnv)
+
+	viper.SetDefault(web3signerAPIKeyViperKey, web3signerAPIKeyDefault)
+	_ = viper.BindEnv(web3signerAPIKeyViperKey, web3signerAPIKeyEnv)
+
+	viper.SetDefault(web3signerTLSSkipVerifyViperKey, web3signerTLSSkipVerifyDefault)
+	_ = viper.BindEnv(web3signerTLSSkipVerifyViperKey, web3signerTLSSkipVerifyEnv)
+}
+
+func Web3SignerFlags(f *pflag.FlagSet) {
+	web3signerURL(f)
+	web3signerStoreID(f)
+	web3signerTenants(f)
+	web3signerAPIKey(f)
+	web3signerTLSSkipVerify(f)
+}
+
+const (
+	web3signerURLFlag     = "web3signer-url"
+	web3signerURLViperKey = "web3signer.url"
+	web3signerURLDefault  = ""
+	web3signerURLEnv      = "WEB3SIGNER_URL"
+)
+
+func web3signerURL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`URL of a Web3Signer, or EthSigner compatible signer, holding the keys of accounts out of the Key Manager.
+Environment variable: %q`, web3signerURLEnv)
+	f.String(web3signerURLFlag, web3signerURLDefault, desc)
+	_ = viper.BindPFlag(web3signerURLViperKey, f.Lookup(web3signerURLFlag))
+}
+
+const (
+	web3signerStoreIDFlag     = "web3signer-store-id"
+	web3signerStoreIDViperKey = "web3signer.store.id"
+	web3signerStoreIDDefault  = "web3signer"
+	web3signerStoreIDEnv      = "WEB3SIGNER_STORE_ID"
+)
+
+func web3signerStoreID(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Store ID of the accounts signing through Web3Signer.
+Environment variable: %q`, web3signerStoreIDEnv)
+	f.String(web3signerStoreIDFlag, web3signerStoreIDDefault, desc)
+	_ = viper.BindPFlag(web3signerStoreIDViperKey, f.Lookup(web3signerStoreIDFlag))
+}
+
+const (
+	web3signerTenantsFlag     = "web3signer-tenants"
+	web3signerTenantsViperKey = "web3signer.tenants"
+	web3signerTenantsEnv      = "WEB3SIGNER_TENANTS"
+)
+
+var web3signerTenantsDefault []string
+
+func web3signerTenants(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Tenants whose accounts are registered in Web3Signer when no store ID is specified.
+Environment variable: %q`, web3signerTenantsEnv)
+	f.StringSlice(web3signerTenantsFlag, web3signerTenantsDefault, desc)
+	_ = viper.BindPFlag(web3signerTenantsViperKey, f.Lookup(web3signerTenantsFlag))
+}
+
+const (
+	web3signerAPIKeyFlag     = "web3signer-api-key"
+	web3signerAPIKeyViperKey = "web3signer.api.key"
+	web3signerAPIKeyDefault  = ""
+	web3signerAPIKeyEnv      = "WEB3SIGNER_API_KEY"
+)
+
+func web3signerAPIKey(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Web3Signer API-KEY authentication.
+Environment variable: %q`, web3signerAPIKeyEnv)
+	f.String(web3signerAPIKeyFlag, web3signerAPIKeyDefault, desc)
+	_ = viper.BindPFlag(web3signerAPIKeyViperKey, f.Lookup(web3signerAPIKeyFlag))
+}
+
+const (
+	web3signerTLSSkipVerifyFlag     = "web3signer-tls-skip-verify"
+	web3signerTLSSkipVerifyViperKey = "web3signer.tls.skip.verify"
+	web3signerTLSSkipVerifyDefault  = false
+	web3signerTLSSkipVerifyEnv      = "WEB3SIGNER_TLS_SKIP_VERIFY"
+)
+
+func web3signerTLSSkipVerify(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Web3Signer, disables SSL certificate verification.
+Environment variable: %q`, web3signerTLSSkipVerifyEnv)
+	f.Bool(web3signerTLSSkipVerifyFlag, web3signerTLSSkipVerifyDefault, desc)
+	_ = viper.BindPFlag(web3signerTLSSkipVerifyViperKey, f.Lookup(web3signerTLSSkipVerifyFlag))
+}
+
+func NewWeb3SignerConfig(vipr *viper.Viper) *web3signer.Config {
+	return &web3signer.Config{
+		URL:           vipr.GetString(web3signerURLViperKey),
+		StoreID:       vipr.GetString(web3signerStoreIDViperKey),
+		Tenants:       vipr.GetStringSlice(web3signerTenantsViperKey),
+		APIKey:        vipr.GetString(web3signerAPIKeyViperKey),
+		TLSSkipVerify: vipr.GetBool(web3signerTLSSkipVerifyViperKey),
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"github.com/consensys/orchestrate/cmd/all"
 	"github.com/consensys/orchestrate/cmd/api"
 	txlistener "github.com/consensys/orchestrate/cmd/tx-listener"
 	txsender "github.com/consensys/orchestrate/cmd/tx-sender"
@@ -19,6 +20,7 @@ func NewCommand() *cobra.Command {
 	rootCmd.AddCommand(txsender.NewRootCommand())
 	rootCmd.AddCommand(txlistener.NewRootCommand())
 	rootCmd.AddCommand(api.NewRootCommand())
+	rootCmd.AddCommand(all.NewRootCommand())
 
 	return rootCmd
 }
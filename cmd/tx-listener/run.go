@@ -1,10 +1,12 @@
 package txlistener
 
 import (
+	"context"
 	"os"
 
 	"github.com/consensys/orchestrate/cmd/flags"
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/pkg/utils"
 	txlistener "github.com/consensys/orchestrate/src/tx-listener"
 	"github.com/spf13/cobra"
@@ -35,7 +37,15 @@ func newRunCommand() *cobra.Command {
 
 func run(cmd *cobra.Command, _ []string) error {
 	ctx := cmd.Context()
-	app, err := txlistener.New(ctx, flags.NewTxListenerConfig(viper.GetViper()))
+	cfg := flags.NewTxListenerConfig(viper.GetViper())
+
+	shutdownTracer, err := tracing.ConfigureTracer(ctx, cfg.App.Tracing, "orchestrate-tx-listener")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = shutdownTracer(context.Background()) }()
+
+	app, err := txlistener.New(ctx, cfg)
 	if err != nil {
 		return err
 	}
@@ -1,10 +1,12 @@
 package txsender
 
 import (
+	"context"
 	"os"
 
 	"github.com/consensys/orchestrate/cmd/flags"
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/pkg/utils"
 	txsender "github.com/consensys/orchestrate/src/tx-sender"
 	"github.com/spf13/cobra"
@@ -38,6 +40,12 @@ func run(cmd *cobra.Command, _ []string) error {
 	ctx := cmd.Context()
 	cfg := flags.NewTxSenderConfig(viper.GetViper())
 
+	shutdownTracer, err := tracing.ConfigureTracer(ctx, cfg.App.Tracing, "orchestrate-tx-sender")
+	if err != nil {
+		return errors.CombineErrors(cmdErr, err)
+	}
+	defer func() { _ = shutdownTracer(context.Background()) }()
+
 	app, err := txsender.New(ctx, cfg)
 	if err != nil {
 		return errors.CombineErrors(cmdErr, err)
@@ -49,10 +49,15 @@ require (
 	github.com/unrolled/secure v1.10.0
 	github.com/vulcand/oxy v1.3.0
 	github.com/yuin/gopher-lua v0.0.0-20191220021717-ab39c6098bdb // indirect
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace v1.0.1 // indirect
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
 	golang.org/x/crypto v0.0.0-20220315160706-3147a52a75dd
 	golang.org/x/net v0.0.0-20220127200216-cd36cc0744dd
 	golang.org/x/time v0.0.0-20210611083556-38a9dc6acbc6
-	google.golang.org/protobuf v1.26.0
+	google.golang.org/protobuf v1.27.1
 	gopkg.in/DATA-DOG/go-sqlmock.v1 v1.3.0 // indirect
 	gopkg.in/h2non/gock.v1 v1.0.15
 	gopkg.in/yaml.v2 v2.4.0
@@ -63,8 +68,8 @@ replace (
 	github.com/containerd/containerd => github.com/containerd/containerd v1.4.12
 	github.com/docker/docker => github.com/docker/engine v1.4.2-0.20200204220554-5f6d6f3f2203
 	github.com/spf13/viper => github.com/spf13/viper v1.8.1
-	google.golang.org/api => google.golang.org/api v0.10.0
-	google.golang.org/grpc => google.golang.org/grpc v1.28.0 // indirect
+	google.golang.org/api => google.golang.org/api v0.44.0
+	google.golang.org/grpc => google.golang.org/grpc v1.43.0 // indirect
 	hashicorp/consul => hashicorp/consul v1.10.1 // indirect
 )
 
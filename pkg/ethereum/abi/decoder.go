@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -198,3 +199,41 @@ func Decode(event *abi.Event, txLog *ethereum.Log) (map[string]string, error) {
 
 	return logMapping, nil
 }
+
+// DecodeInputs decodes the arguments of a method call, without its selector, to string, unnamed inputs being indexed by position
+func DecodeInputs(method *abi.Method, data []byte) (map[string]string, error) {
+	unpackValues, err := method.Inputs.UnpackValues(data)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid input data for method %s", method.Sig)
+	}
+
+	return formatArguments(method.Inputs, unpackValues)
+}
+
+// DecodeOutputs decodes the return data of a method call to string, unnamed outputs being indexed by position
+func DecodeOutputs(method *abi.Method, data []byte) (map[string]string, error) {
+	unpackValues, err := method.Outputs.UnpackValues(data)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid output data for method %s", method.Sig)
+	}
+
+	return formatArguments(method.Outputs, unpackValues)
+}
+
+func formatArguments(args abi.Arguments, values []interface{}) (map[string]string, error) {
+	formatted := make(map[string]string, len(args))
+	for i, arg := range args {
+		decoded, err := FormatNonIndexedArg(&arg.Type, values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		name := arg.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		formatted[name] = decoded
+	}
+
+	return formatted, nil
+}
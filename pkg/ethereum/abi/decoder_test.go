@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -470,3 +471,45 @@ func TestDecode(t *testing.T) {
 
 	}
 }
+
+func TestDecodeOutputs(t *testing.T) {
+	parsedABI, _ := abi.JSON(strings.NewReader(`[{"name":"getReserves","type":"function","stateMutability":"view","inputs":[],
+"outputs":[{"name":"reserve","type":"uint256"},{"name":"","type":"address"}]}]`))
+	method := parsedABI.Methods["getReserves"]
+
+	data, _ := method.Outputs.Pack(big.NewInt(99), ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"))
+	decoded, err := DecodeOutputs(&method, data)
+	if err != nil {
+		t.Fatalf("DecodeOutputs: unexpected error %v", err)
+	}
+
+	expected := map[string]string{"reserve": "99", "1": "0x5Cc634233E4a454d47aACd9fC68801482Fb02610"}
+	if !reflect.DeepEqual(expected, decoded) {
+		t.Errorf("DecodeOutputs: expected mapping %q but got %q", expected, decoded)
+	}
+
+	if _, err = DecodeOutputs(&method, []byte{0x01}); err == nil {
+		t.Errorf("DecodeOutputs: expected an error on invalid data")
+	}
+}
+
+func TestDecodeInputs(t *testing.T) {
+	parsedABI, _ := abi.JSON(strings.NewReader(`[{"name":"transfer","type":"function","stateMutability":"nonpayable",
+"inputs":[{"name":"to","type":"address"},{"name":"","type":"uint256"}],"outputs":[]}]`))
+	method := parsedABI.Methods["transfer"]
+
+	data, _ := method.Inputs.Pack(ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"), big.NewInt(99))
+	decoded, err := DecodeInputs(&method, data)
+	if err != nil {
+		t.Fatalf("DecodeInputs: unexpected error %v", err)
+	}
+
+	expected := map[string]string{"to": "0x5Cc634233E4a454d47aACd9fC68801482Fb02610", "1": "99"}
+	if !reflect.DeepEqual(expected, decoded) {
+		t.Errorf("DecodeInputs: expected mapping %q but got %q", expected, decoded)
+	}
+
+	if _, err = DecodeInputs(&method, []byte{0x01}); err == nil {
+		t.Errorf("DecodeInputs: expected an error on invalid data")
+	}
+}
@@ -0,0 +1,223 @@
+package artifacts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Artifacts are JSON outputs of the following frameworks:
+// - Hardhat (artifacts/): bytecodes as hex strings with `linkReferences` and `deployedLinkReferences`
+// - Foundry (out/): bytecodes as objects holding the hex string and its `linkReferences`, name in the metadata
+// - Truffle (build/contracts/): bytecodes as hex strings with `__Library______` placeholders
+
+// placeholderLength is the length in hex characters of a library address placeholder
+const placeholderLength = 40
+
+const (
+	opPush20  = 0x73
+	opAddress = 0x30
+)
+
+type rawArtifact struct {
+	ContractName           string          `json:"contractName"`
+	ABI                    json.RawMessage `json:"abi"`
+	Bytecode               json.RawMessage `json:"bytecode"`
+	DeployedBytecode       json.RawMessage `json:"deployedBytecode"`
+	LinkReferences         linkReferences  `json:"linkReferences"`
+	DeployedLinkReferences linkReferences  `json:"deployedLinkReferences"`
+	Metadata               json.RawMessage `json:"metadata"`
+}
+
+type rawBytecode struct {
+	Object         string         `json:"object"`
+	LinkReferences linkReferences `json:"linkReferences"`
+}
+
+type rawMetadata struct {
+	Settings struct {
+		CompilationTarget map[string]string `json:"compilationTarget"`
+	} `json:"settings"`
+}
+
+// linkReferences are indexed by source file then by library name
+type linkReferences map[string]map[string][]struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// Parse extracts the contract of an artifact, detecting the framework which generated it
+func Parse(data []byte) (*entities.ContractArtifact, error) {
+	raw := &rawArtifact{}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return nil, fmt.Errorf("invalid artifact: %s", err.Error())
+	}
+
+	if len(raw.ABI) == 0 || string(raw.ABI) == "null" {
+		return nil, fmt.Errorf("artifact has no abi")
+	}
+	abi := new(bytes.Buffer)
+	if err := json.Compact(abi, raw.ABI); err != nil {
+		return nil, fmt.Errorf("invalid artifact abi: %s", err.Error())
+	}
+
+	artifact := &entities.ContractArtifact{
+		Name:   raw.ContractName,
+		RawABI: abi.String(),
+	}
+
+	var err error
+	artifact.Bytecode, artifact.LinkReferences, err = parseBytecode(raw.Bytecode, raw.LinkReferences)
+	if err != nil {
+		return nil, err
+	}
+	artifact.DeployedBytecode, artifact.DeployedLinkReferences, err = parseBytecode(raw.DeployedBytecode, raw.DeployedLinkReferences)
+	if err != nil {
+		return nil, err
+	}
+
+	if artifact.Name == "" {
+		artifact.Name = metadataName(raw.Metadata)
+	}
+
+	return artifact, nil
+}
+
+// Link replaces the placeholders of the libraries in the bytecode by their addresses
+func Link(bytecode string, refs []*entities.LinkReference, libraries map[string]common.Address) (hexutil.Bytes, error) {
+	if bytecode == "" {
+		return nil, nil
+	}
+
+	code := []byte(strings.TrimPrefix(bytecode, "0x"))
+	for _, ref := range refs {
+		address, ok := libraries[ref.Library]
+		if !ok {
+			return nil, fmt.Errorf("missing address of library %s", ref.Library)
+		}
+
+		hexAddress := hex.EncodeToString(address.Bytes())
+		for _, offset := range ref.Offsets {
+			start := offset * 2
+			if start < 0 || start+placeholderLength > len(code) {
+				return nil, fmt.Errorf("invalid link reference of library %s", ref.Library)
+			}
+			copy(code[start:], hexAddress)
+		}
+	}
+
+	linked, err := hexutil.Decode("0x" + string(code))
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytecode: %s", err.Error())
+	}
+
+	return linked, nil
+}
+
+// CodeHash hashes the code deployed at the address. Libraries push their own address at the start of their code
+// to prevent state modifying calls, it is zeroed so that the hash matches the deployed bytecode of their artifact
+func CodeHash(code []byte, address common.Address) common.Hash {
+	if len(code) > common.AddressLength+1 && code[0] == opPush20 && code[common.AddressLength+1] == opAddress &&
+		bytes.Equal(code[1:common.AddressLength+1], address.Bytes()) {
+		normalized := make([]byte, len(code))
+		copy(normalized, code)
+		copy(normalized[1:], common.Address{}.Bytes())
+		return crypto.Keccak256Hash(normalized)
+	}
+
+	return crypto.Keccak256Hash(code)
+}
+
+func parseBytecode(data json.RawMessage, refs linkReferences) (string, []*entities.LinkReference, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return "", nil, nil
+	}
+
+	var bytecode string
+	if data[0] == '{' {
+		raw := &rawBytecode{}
+		if err := json.Unmarshal(data, raw); err != nil {
+			return "", nil, fmt.Errorf("invalid artifact bytecode: %s", err.Error())
+		}
+		bytecode, refs = raw.Object, raw.LinkReferences
+	} else if err := json.Unmarshal(data, &bytecode); err != nil {
+		return "", nil, fmt.Errorf("invalid artifact bytecode: %s", err.Error())
+	}
+
+	bytecode = strings.TrimPrefix(bytecode, "0x")
+	if bytecode == "" {
+		return "", nil, nil
+	}
+
+	if refs == nil {
+		return "0x" + bytecode, placeholderReferences(bytecode), nil
+	}
+
+	return "0x" + bytecode, flattenReferences(refs), nil
+}
+
+func flattenReferences(refs linkReferences) []*entities.LinkReference {
+	var res []*entities.LinkReference
+	for _, libraries := range refs {
+		for library, offsets := range libraries {
+			ref := &entities.LinkReference{Library: library}
+			for _, offset := range offsets {
+				ref.Offsets = append(ref.Offsets, offset.Start)
+			}
+			res = append(res, ref)
+		}
+	}
+
+	return res
+}
+
+// placeholderReferences locates Truffle placeholders, made of the library name padded with underscores
+func placeholderReferences(bytecode string) []*entities.LinkReference {
+	var res []*entities.LinkReference
+	refs := map[string]*entities.LinkReference{}
+	for i := 0; i+placeholderLength <= len(bytecode); i += 2 {
+		if bytecode[i:i+2] != "__" {
+			continue
+		}
+
+		library := strings.Trim(bytecode[i:i+placeholderLength], "_")
+		if _, ok := refs[library]; !ok {
+			refs[library] = &entities.LinkReference{Library: library}
+			res = append(res, refs[library])
+		}
+		refs[library].Offsets = append(refs[library].Offsets, i/2)
+		i += placeholderLength - 2
+	}
+
+	return res
+}
+
+// metadataName reads the contract name from the compilation target of the solc metadata, given as an object or a string
+func metadataName(data json.RawMessage) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	var content string
+	if json.Unmarshal(data, &content) == nil {
+		data = json.RawMessage(content)
+	}
+
+	metadata := &rawMetadata{}
+	if json.Unmarshal(data, metadata) != nil {
+		return ""
+	}
+
+	for _, name := range metadata.Settings.CompilationTarget {
+		return name
+	}
+
+	return ""
+}
@@ -0,0 +1,145 @@
+// +build unit
+
+package artifacts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const abi = `[{"inputs":[],"name":"increment","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+var placeholder = "__$" + strings.Repeat("a", 34) + "$__"
+
+func TestParse(t *testing.T) {
+	t.Run("should parse Hardhat artifact", func(t *testing.T) {
+		artifact, err := Parse([]byte(`{
+	"_format": "hh-sol-artifact-1",
+	"contractName": "Counter",
+	"abi": ` + abi + `,
+	"bytecode": "0x6080` + placeholder + `6080",
+	"deployedBytecode": "0x6080",
+	"linkReferences": {"contracts/Math.sol": {"Math": [{"start": 2, "length": 20}]}},
+	"deployedLinkReferences": {}
+}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Counter", artifact.Name)
+		assert.Equal(t, abi, artifact.RawABI)
+		assert.Equal(t, "0x6080", artifact.DeployedBytecode)
+		assert.Equal(t, []*entities.LinkReference{{Library: "Math", Offsets: []int{2}}}, artifact.LinkReferences)
+		assert.Empty(t, artifact.DeployedLinkReferences)
+		assert.Equal(t, []string{"Math"}, artifact.Libraries())
+	})
+
+	t.Run("should parse Foundry artifact", func(t *testing.T) {
+		artifact, err := Parse([]byte(`{
+	"abi": ` + abi + `,
+	"bytecode": {"object": "0x6080` + placeholder + `", "linkReferences": {"src/Math.sol": {"Math": [{"start": 2, "length": 20}]}}},
+	"deployedBytecode": {"object": "0x6080", "linkReferences": {}},
+	"metadata": {"settings": {"compilationTarget": {"src/Counter.sol": "Counter"}}}
+}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Counter", artifact.Name)
+		assert.Equal(t, "0x6080"+placeholder, artifact.Bytecode)
+		assert.Equal(t, []*entities.LinkReference{{Library: "Math", Offsets: []int{2}}}, artifact.LinkReferences)
+	})
+
+	t.Run("should parse Foundry artifact with metadata as string", func(t *testing.T) {
+		artifact, err := Parse([]byte(`{
+	"abi": ` + abi + `,
+	"bytecode": {"object": "0x6080", "linkReferences": {}},
+	"metadata": "{\"settings\":{\"compilationTarget\":{\"src/Counter.sol\":\"Counter\"}}}"
+}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Counter", artifact.Name)
+		assert.Empty(t, artifact.DeployedBytecode)
+	})
+
+	t.Run("should parse Truffle artifact", func(t *testing.T) {
+		truffle := "__Math" + strings.Repeat("_", 34)
+		artifact, err := Parse([]byte(`{
+	"contractName": "Counter",
+	"abi": ` + abi + `,
+	"bytecode": "0x6080` + truffle + `6080` + truffle + `",
+	"deployedBytecode": "0x6080` + truffle + `"
+}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "Counter", artifact.Name)
+		assert.Equal(t, []*entities.LinkReference{{Library: "Math", Offsets: []int{2, 24}}}, artifact.LinkReferences)
+		assert.Equal(t, []*entities.LinkReference{{Library: "Math", Offsets: []int{2}}}, artifact.DeployedLinkReferences)
+	})
+
+	t.Run("should parse artifact without deployed bytecode", func(t *testing.T) {
+		artifact, err := Parse([]byte(`{"contractName": "ERC20", "abi": [], "bytecode": "0x6080", "deployedBytecode": "0x"}`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "ERC20", artifact.Name)
+		assert.Equal(t, "[]", artifact.RawABI)
+		assert.Empty(t, artifact.DeployedBytecode)
+	})
+
+	t.Run("should fail if artifact has no abi", func(t *testing.T) {
+		_, err := Parse([]byte(`{"contractName": "Counter", "bytecode": "0x6080"}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if artifact is not JSON", func(t *testing.T) {
+		_, err := Parse([]byte(`not json`))
+		assert.Error(t, err)
+	})
+}
+
+func TestLink(t *testing.T) {
+	refs := []*entities.LinkReference{{Library: "Math", Offsets: []int{2}}}
+	address := common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+
+	t.Run("should replace placeholders by library addresses", func(t *testing.T) {
+		code, err := Link("0x6080"+placeholder+"6080", refs, map[string]common.Address{"Math": address})
+
+		require.NoError(t, err)
+		assert.Equal(t, "0x6080"+strings.ToLower(address.Hex()[2:])+"6080", code.String())
+	})
+
+	t.Run("should fail if library address is missing", func(t *testing.T) {
+		_, err := Link("0x6080"+placeholder, refs, map[string]common.Address{})
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if placeholders are not resolved", func(t *testing.T) {
+		_, err := Link("0x6080"+placeholder, nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if link reference is out of bytecode", func(t *testing.T) {
+		_, err := Link("0x6080", refs, map[string]common.Address{"Math": address})
+		assert.Error(t, err)
+	})
+}
+
+func TestCodeHash(t *testing.T) {
+	address := common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+
+	t.Run("should zero the address of libraries", func(t *testing.T) {
+		code := append(append([]byte{opPush20}, address.Bytes()...), opAddress, 0x14)
+		expected := append(append([]byte{opPush20}, make([]byte, common.AddressLength)...), opAddress, 0x14)
+
+		assert.Equal(t, crypto.Keccak256Hash(expected), CodeHash(code, address))
+	})
+
+	t.Run("should hash code of contracts", func(t *testing.T) {
+		code := hexutil.MustDecode("0x608060405234801561001057600080fd5b50")
+
+		assert.Equal(t, crypto.Keccak256Hash(code), CodeHash(code, address))
+	})
+}
@@ -0,0 +1,20 @@
+package create2
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// FactoryAddress is the address of the deterministic deployment proxy, deployed at the same address on most chains
+// See https://github.com/Arachnid/deterministic-deployment-proxy
+var FactoryAddress = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+// FactoryData is the call data deploying the init code with the given salt through the deterministic deployment proxy
+func FactoryData(salt common.Hash, initCode []byte) []byte {
+	return append(salt.Bytes(), initCode...)
+}
+
+// Address computes the address of the contract deployed by the factory with the given salt and init code
+func Address(factory common.Address, salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(factory, salt, crypto.Keccak256(initCode))
+}
@@ -0,0 +1,28 @@
+// +build unit
+
+package create2
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddress(t *testing.T) {
+	// Example 5 of EIP-1014
+	factory := common.HexToAddress("0x00000000000000000000000000000000deadbeef")
+	salt := common.HexToHash("0x00000000000000000000000000000000000000000000000000000000cafebabe")
+	initCode := hexutil.MustDecode("0xdeadbeef")
+
+	assert.Equal(t, common.HexToAddress("0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"), Address(factory, salt, initCode))
+}
+
+func TestFactoryData(t *testing.T) {
+	salt := common.HexToHash("0x01")
+
+	data := FactoryData(salt, hexutil.MustDecode("0xdeadbeef"))
+
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000001deadbeef", hexutil.Encode(data))
+}
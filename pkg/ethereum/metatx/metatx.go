@@ -0,0 +1,158 @@
+package metatx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Hashing and encoding follow the OpenZeppelin MinimalForwarder (ERC-2771) and the ERC-4337 v0.6 EntryPoint.
+// Contract ABIs are provided by the contract registry so that any compatible implementation can be used
+
+const (
+	ExecuteMethod   = "execute"
+	VerifyMethod    = "verify"
+	HandleOpsMethod = "handleOps"
+)
+
+var (
+	domainTypeHash         = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+	forwardRequestTypeHash = crypto.Keccak256Hash([]byte("ForwardRequest(address from,address to,uint256 value,uint256 gas," +
+		"uint256 nonce,bytes data)"))
+)
+
+type forwardRequestArg struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Gas   *big.Int
+	Nonce *big.Int
+	Data  []byte
+}
+
+type userOperationArg struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// ForwardRequestHash computes the EIP-712 hash of a forward request, which is the payload signed by the user
+func ForwardRequestHash(domainName, domainVersion string, chainID *big.Int, forwarder common.Address, req *entities.ForwardRequest) common.Hash {
+	domainSeparator := crypto.Keccak256(
+		domainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(domainName)),
+		crypto.Keccak256([]byte(domainVersion)),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+		common.LeftPadBytes(forwarder.Bytes(), 32),
+	)
+
+	structHash := crypto.Keccak256(
+		forwardRequestTypeHash.Bytes(),
+		common.LeftPadBytes(req.From.Bytes(), 32),
+		common.LeftPadBytes(req.To.Bytes(), 32),
+		common.LeftPadBytes(bigOrZero(req.Value).Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(req.Gas).Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(req.Nonce).Bytes(), 32),
+		crypto.Keccak256(req.Data),
+	)
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+// RecoverSigner returns the address having signed the EIP-712 hash, v being 27 or 28
+func RecoverSigner(hash common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("signature must be exactly %d bytes", crypto.SignatureLength)
+	}
+
+	rsv := make([]byte, crypto.SignatureLength)
+	copy(rsv, sig)
+	if rsv[crypto.RecoveryIDOffset] >= 27 {
+		rsv[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(hash.Bytes(), rsv)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// EncodeExecute encodes the forwarder call relaying a signed forward request
+func EncodeExecute(forwarderABI *abi.ABI, req *entities.ForwardRequest, sig []byte) ([]byte, error) {
+	return forwarderABI.Pack(ExecuteMethod, newForwardRequestArg(req), sig)
+}
+
+// EncodeVerify encodes the forwarder view call checking the signature and nonce of a forward request
+func EncodeVerify(forwarderABI *abi.ABI, req *entities.ForwardRequest, sig []byte) ([]byte, error) {
+	return forwarderABI.Pack(VerifyMethod, newForwardRequestArg(req), sig)
+}
+
+func DecodeVerify(forwarderABI *abi.ABI, output []byte) (bool, error) {
+	res, err := forwarderABI.Unpack(VerifyMethod, output)
+	if err != nil {
+		return false, err
+	}
+
+	valid, ok := res[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("invalid %s output", VerifyMethod)
+	}
+
+	return valid, nil
+}
+
+// EncodeHandleOps encodes the entry point call relaying user operations, the beneficiary receiving the gas refunds
+func EncodeHandleOps(entryPointABI *abi.ABI, ops []*entities.UserOperation, beneficiary common.Address) ([]byte, error) {
+	args := []userOperationArg{}
+	for _, op := range ops {
+		args = append(args, userOperationArg{
+			Sender:               op.Sender,
+			Nonce:                bigOrZero(op.Nonce),
+			InitCode:             op.InitCode,
+			CallData:             op.CallData,
+			CallGasLimit:         bigOrZero(op.CallGasLimit),
+			VerificationGasLimit: bigOrZero(op.VerificationGasLimit),
+			PreVerificationGas:   bigOrZero(op.PreVerificationGas),
+			MaxFeePerGas:         bigOrZero(op.MaxFeePerGas),
+			MaxPriorityFeePerGas: bigOrZero(op.MaxPriorityFeePerGas),
+			PaymasterAndData:     op.PaymasterAndData,
+			Signature:            op.Signature,
+		})
+	}
+
+	return entryPointABI.Pack(HandleOpsMethod, args, beneficiary)
+}
+
+func newForwardRequestArg(req *entities.ForwardRequest) forwardRequestArg {
+	return forwardRequestArg{
+		From:  req.From,
+		To:    req.To,
+		Value: bigOrZero(req.Value),
+		Gas:   new(big.Int).SetUint64(req.Gas),
+		Nonce: new(big.Int).SetUint64(req.Nonce),
+		Data:  req.Data,
+	}
+}
+
+func bigOrZero(v *hexutil.Big) *big.Int {
+	if v == nil {
+		return big.NewInt(0)
+	}
+
+	return v.ToInt()
+}
@@ -0,0 +1,122 @@
+// +build unit
+
+package metatx
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const forwarderABI = `[
+{"name":"execute","type":"function","stateMutability":"payable","inputs":[{"name":"req","type":"tuple","components":[
+	{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},
+	{"name":"gas","type":"uint256"},{"name":"nonce","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"name":"signature","type":"bytes"}],"outputs":[{"name":"","type":"bool"},{"name":"","type":"bytes"}]},
+{"name":"verify","type":"function","stateMutability":"view","inputs":[{"name":"req","type":"tuple","components":[
+	{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},
+	{"name":"gas","type":"uint256"},{"name":"nonce","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"name":"signature","type":"bytes"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+const entryPointABI = `[
+{"name":"handleOps","type":"function","stateMutability":"nonpayable","inputs":[{"name":"ops","type":"tuple[]","components":[
+	{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},
+	{"name":"callData","type":"bytes"},{"name":"callGasLimit","type":"uint256"},{"name":"verificationGasLimit","type":"uint256"},
+	{"name":"preVerificationGas","type":"uint256"},{"name":"maxFeePerGas","type":"uint256"},{"name":"maxPriorityFeePerGas","type":"uint256"},
+	{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+	{"name":"beneficiary","type":"address"}],"outputs":[]}
+]`
+
+func fakeForwardRequest() *entities.ForwardRequest {
+	return &entities.ForwardRequest{
+		From:  common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+		To:    common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"),
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Gas:   100000,
+		Nonce: 2,
+		Data:  hexutil.MustDecode("0xa9059cbb"),
+	}
+}
+
+func TestTypeHashes(t *testing.T) {
+	assert.Equal(t, "0x8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f", domainTypeHash.Hex())
+	assert.Equal(t, "0xdd8f4b70b0f4393e889bd39128a30628a78b61816a9eb8199759e7a349657e48", forwardRequestTypeHash.Hex())
+}
+
+func TestForwardRequestHash(t *testing.T) {
+	forwarder := common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+	req := fakeForwardRequest()
+
+	hash := ForwardRequestHash("MinimalForwarder", "0.0.1", big.NewInt(1), forwarder, req)
+
+	assert.Equal(t, hash, ForwardRequestHash("MinimalForwarder", "0.0.1", big.NewInt(1), forwarder, req))
+	assert.NotEqual(t, hash, ForwardRequestHash("MinimalForwarder", "0.0.2", big.NewInt(1), forwarder, req))
+	assert.NotEqual(t, hash, ForwardRequestHash("MinimalForwarder", "0.0.1", big.NewInt(2), forwarder, req))
+
+	req.Nonce++
+	assert.NotEqual(t, hash, ForwardRequestHash("MinimalForwarder", "0.0.1", big.NewInt(1), forwarder, req))
+}
+
+func TestRecoverSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	hash := ForwardRequestHash("MinimalForwarder", "0.0.1", big.NewInt(1), common.Address{}, fakeForwardRequest())
+
+	sig, err := crypto.Sign(hash.Bytes(), key)
+	require.NoError(t, err)
+	sig[64] += 27
+
+	signer, err := RecoverSigner(hash, sig)
+
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	_, err = RecoverSigner(hash, sig[:64])
+	assert.Error(t, err)
+}
+
+func TestEncodeExecute(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(forwarderABI))
+	require.NoError(t, err)
+	req := fakeForwardRequest()
+	sig := make([]byte, 65)
+
+	data, err := EncodeExecute(&parsed, req, sig)
+	require.NoError(t, err)
+
+	args, err := parsed.Methods[ExecuteMethod].Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	assert.Equal(t, sig, args[1])
+
+	_, err = EncodeExecute(&abi.ABI{}, req, sig)
+	assert.Error(t, err)
+}
+
+func TestEncodeHandleOps(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(entryPointABI))
+	require.NoError(t, err)
+	beneficiary := common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	op := &entities.UserOperation{
+		Sender:       common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+		Nonce:        (*hexutil.Big)(big.NewInt(1)),
+		CallData:     hexutil.MustDecode("0xa9059cbb"),
+		CallGasLimit: (*hexutil.Big)(big.NewInt(50000)),
+		Signature:    hexutil.MustDecode("0x01"),
+	}
+
+	data, err := EncodeHandleOps(&parsed, []*entities.UserOperation{op}, beneficiary)
+	require.NoError(t, err)
+
+	args, err := parsed.Methods[HandleOpsMethod].Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+	assert.Equal(t, beneficiary, args[1])
+}
@@ -0,0 +1,68 @@
+package multicall
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Encoding follows Multicall3, deployed at the same address on most EVM chains (https://github.com/mds1/multicall)
+
+const Aggregate3Method = "aggregate3"
+
+// Address is the address of the Multicall3 contract
+var Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+const aggregate3ABI = `[{"name":"aggregate3","type":"function","stateMutability":"payable",
+"inputs":[{"name":"calls","type":"tuple[]","components":[
+	{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],
+"outputs":[{"name":"returnData","type":"tuple[]","components":[
+	{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`
+
+var multicallABI, _ = abi.JSON(strings.NewReader(aggregate3ABI))
+
+type Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// EncodeAggregate3 encodes the calls to be executed in a single eth_call
+func EncodeAggregate3(calls []*Call) ([]byte, error) {
+	args := []Call{}
+	for _, call := range calls {
+		args = append(args, *call)
+	}
+
+	return multicallABI.Pack(Aggregate3Method, args)
+}
+
+// DecodeAggregate3 decodes the result of each call, in the order of the calls
+func DecodeAggregate3(data []byte) ([]*Result, error) {
+	res, err := multicallABI.Unpack(Aggregate3Method, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 1 {
+		return nil, fmt.Errorf("invalid %s output", Aggregate3Method)
+	}
+
+	results, ok := abi.ConvertType(res[0], new([]Result)).(*[]Result)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s output", Aggregate3Method)
+	}
+
+	var output []*Result
+	for i := range *results {
+		output = append(output, &(*results)[i])
+	}
+
+	return output, nil
+}
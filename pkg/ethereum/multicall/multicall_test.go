@@ -0,0 +1,41 @@
+// +build unit
+
+package multicall
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeAggregate3(t *testing.T) {
+	data, err := EncodeAggregate3([]*Call{
+		{Target: common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"), AllowFailure: true, CallData: hexutil.MustDecode("0x18160ddd")},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "0x82ad56cb", hexutil.Encode(data[:4]))
+}
+
+func TestDecodeAggregate3(t *testing.T) {
+	expected := []Result{
+		{Success: true, ReturnData: common.LeftPadBytes([]byte{1}, 32)},
+		{Success: false, ReturnData: []byte{}},
+	}
+	data, err := multicallABI.Methods[Aggregate3Method].Outputs.Pack(expected)
+	require.NoError(t, err)
+
+	results, err := DecodeAggregate3(data)
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.True(t, results[0].Success)
+	assert.Equal(t, expected[0].ReturnData, results[0].ReturnData)
+	assert.False(t, results[1].Success)
+
+	_, err = DecodeAggregate3([]byte{0x01})
+	assert.Error(t, err)
+}
@@ -0,0 +1,73 @@
+package proxy
+
+import (
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ImplementationSlot is the EIP-1967 slot of the implementation, keccak256("eip1967.proxy.implementation") - 1
+	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// BeaconSlot is the EIP-1967 slot of the beacon, keccak256("eip1967.proxy.beacon") - 1
+	BeaconSlot = common.HexToHash("0xa3f0ad74e5423aebfd80d3ef4346578335a9a72aeaee59ff6cb3582b35133d50")
+	// ProxiableSlot is the EIP-1822 slot of the implementation, keccak256("PROXIABLE")
+	ProxiableSlot = common.HexToHash("0xc5f16f0fcc639fa48a6947836d9850f504798523bf8c9a3a87d5876cf622bcf7")
+)
+
+var (
+	UpgradedTopic       = crypto.Keccak256Hash([]byte("Upgraded(address)"))
+	BeaconUpgradedTopic = crypto.Keccak256Hash([]byte("BeaconUpgraded(address)"))
+	// BeaconImplementationData is the call data of implementation() on a beacon
+	BeaconImplementationData = crypto.Keccak256([]byte("implementation()"))[:4]
+)
+
+// Slots are the storage slots read to detect a proxy, by order of precedence
+var Slots = []struct {
+	Slot common.Hash
+	Kind entities.ProxyKind
+}{
+	{ImplementationSlot, entities.ProxyKindEIP1967},
+	{BeaconSlot, entities.ProxyKindBeacon},
+	{ProxiableSlot, entities.ProxyKindEIP1822},
+}
+
+// AddressFromWord reads the address held by a storage slot or a call result, nil if empty
+func AddressFromWord(word []byte) *common.Address {
+	if len(word) < common.AddressLength {
+		return nil
+	}
+
+	address := common.BytesToAddress(word[len(word)-common.AddressLength:])
+	if address == (common.Address{}) {
+		return nil
+	}
+
+	return &address
+}
+
+// ParseUpgradedLog returns the new implementation, or beacon, of an `Upgraded` or `BeaconUpgraded` log emitted by a proxy
+func ParseUpgradedLog(log *ethereum.Log) (entities.ProxyKind, *common.Address) {
+	topics := log.GetTopics()
+	if len(topics) != 2 {
+		return "", nil
+	}
+
+	var kind entities.ProxyKind
+	switch common.HexToHash(topics[0]) {
+	case UpgradedTopic:
+		kind = entities.ProxyKindEIP1967
+	case BeaconUpgradedTopic:
+		kind = entities.ProxyKindBeacon
+	default:
+		return "", nil
+	}
+
+	address := AddressFromWord(common.HexToHash(topics[1]).Bytes())
+	if address == nil {
+		return "", nil
+	}
+
+	return kind, address
+}
@@ -0,0 +1,64 @@
+// +build unit
+
+package proxy
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlots(t *testing.T) {
+	eip1967Slot := func(name string) common.Hash {
+		hash := new(big.Int).SetBytes(crypto.Keccak256([]byte(name)))
+		return common.BigToHash(hash.Sub(hash, big.NewInt(1)))
+	}
+
+	assert.Equal(t, eip1967Slot("eip1967.proxy.implementation"), ImplementationSlot)
+	assert.Equal(t, eip1967Slot("eip1967.proxy.beacon"), BeaconSlot)
+	assert.Equal(t, crypto.Keccak256Hash([]byte("PROXIABLE")), ProxiableSlot)
+	assert.Equal(t, "0x5c60da1b", hexutil.Encode(BeaconImplementationData))
+}
+
+func TestAddressFromWord(t *testing.T) {
+	address := common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+
+	assert.Equal(t, &address, AddressFromWord(common.BytesToHash(address.Bytes()).Bytes()))
+	assert.Nil(t, AddressFromWord(common.Hash{}.Bytes()))
+	assert.Nil(t, AddressFromWord([]byte{}))
+}
+
+func TestParseUpgradedLog(t *testing.T) {
+	address := common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+	topic := common.BytesToHash(address.Bytes()).Hex()
+
+	t.Run("should parse Upgraded log", func(t *testing.T) {
+		kind, implementation := ParseUpgradedLog(&ethereum.Log{Topics: []string{UpgradedTopic.Hex(), topic}})
+
+		assert.Equal(t, entities.ProxyKindEIP1967, kind)
+		assert.Equal(t, &address, implementation)
+	})
+
+	t.Run("should parse BeaconUpgraded log", func(t *testing.T) {
+		kind, beacon := ParseUpgradedLog(&ethereum.Log{Topics: []string{BeaconUpgradedTopic.Hex(), topic}})
+
+		assert.Equal(t, entities.ProxyKindBeacon, kind)
+		assert.Equal(t, &address, beacon)
+	})
+
+	t.Run("should ignore other logs", func(t *testing.T) {
+		transferTopic := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex()
+
+		_, implementation := ParseUpgradedLog(&ethereum.Log{Topics: []string{transferTopic, topic, topic}})
+		assert.Nil(t, implementation)
+
+		_, implementation = ParseUpgradedLog(&ethereum.Log{Topics: []string{UpgradedTopic.Hex()}})
+		assert.Nil(t, implementation)
+	})
+}
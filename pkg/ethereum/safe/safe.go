@@ -0,0 +1,186 @@
+package safe
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Hashing and encoding follow the Safe contracts v1.3.0 (GnosisSafe.sol)
+
+const contractABI = `[
+{"name":"nonce","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"name":"getThreshold","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+{"name":"getOwners","type":"function","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address[]"}]},
+{"name":"execTransaction","type":"function","stateMutability":"payable","inputs":[
+	{"name":"to","type":"address"},{"name":"value","type":"uint256"},{"name":"data","type":"bytes"},{"name":"operation","type":"uint8"},
+	{"name":"safeTxGas","type":"uint256"},{"name":"baseGas","type":"uint256"},{"name":"gasPrice","type":"uint256"},
+	{"name":"gasToken","type":"address"},{"name":"refundReceiver","type":"address"},{"name":"signatures","type":"bytes"}],
+	"outputs":[{"name":"success","type":"bool"}]}
+]`
+
+const signatureLength = 65
+
+var (
+	safeABI = mustParseABI()
+
+	domainSeparatorTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(uint256 chainId,address verifyingContract)"))
+	safeTxTypeHash          = crypto.Keccak256Hash([]byte("SafeTx(address to,uint256 value,bytes data,uint8 operation," +
+		"uint256 safeTxGas,uint256 baseGas,uint256 gasPrice,address gasToken,address refundReceiver,uint256 nonce)"))
+)
+
+func mustParseABI() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
+
+// TxHash computes the EIP-712 hash of a Safe transaction, which is the payload signed by the Safe owners
+func TxHash(chainID *big.Int, safeAddress common.Address, tx *entities.SafeTransaction) common.Hash {
+	domainSeparator := crypto.Keccak256(
+		domainSeparatorTypeHash.Bytes(),
+		common.LeftPadBytes(chainID.Bytes(), 32),
+		common.LeftPadBytes(safeAddress.Bytes(), 32),
+	)
+
+	structHash := crypto.Keccak256(
+		safeTxTypeHash.Bytes(),
+		common.LeftPadBytes(tx.To.Bytes(), 32),
+		common.LeftPadBytes(bigOrZero(tx.Value).Bytes(), 32),
+		crypto.Keccak256(tx.Data),
+		common.LeftPadBytes([]byte{byte(tx.Operation)}, 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(tx.SafeTxGas).Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(tx.BaseGas).Bytes(), 32),
+		common.LeftPadBytes(bigOrZero(tx.GasPrice).Bytes(), 32),
+		common.LeftPadBytes(tx.GasToken.Bytes(), 32),
+		common.LeftPadBytes(tx.RefundReceiver.Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(tx.Nonce).Bytes(), 32),
+	)
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
+
+// EncodeExecTransaction encodes the execTransaction call of a Safe transaction.
+// Signatures are sorted by signer address as required by the Safe contract
+func EncodeExecTransaction(tx *entities.SafeTransaction, signatures []*entities.SafeSignature) ([]byte, error) {
+	sorted := make([]*entities.SafeSignature, len(signatures))
+	copy(sorted, signatures)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Signer.Bytes(), sorted[j].Signer.Bytes()) < 0
+	})
+
+	var packedSignatures []byte
+	for _, sig := range sorted {
+		packedSignatures = append(packedSignatures, sig.Signature...)
+	}
+
+	return safeABI.Pack("execTransaction",
+		tx.To,
+		bigOrZero(tx.Value),
+		[]byte(tx.Data),
+		uint8(tx.Operation),
+		new(big.Int).SetUint64(tx.SafeTxGas),
+		new(big.Int).SetUint64(tx.BaseGas),
+		bigOrZero(tx.GasPrice),
+		tx.GasToken,
+		tx.RefundReceiver,
+		packedSignatures,
+	)
+}
+
+// EncodeCall encodes a call to one of the Safe view methods: "nonce", "getThreshold" or "getOwners"
+func EncodeCall(method string) ([]byte, error) {
+	return safeABI.Pack(method)
+}
+
+func DecodeUint(method string, output []byte) (uint64, error) {
+	res, err := safeABI.Unpack(method, output)
+	if err != nil {
+		return 0, err
+	}
+
+	value, ok := res[0].(*big.Int)
+	if !ok || !value.IsUint64() {
+		return 0, fmt.Errorf("invalid %s output", method)
+	}
+
+	return value.Uint64(), nil
+}
+
+func DecodeOwners(output []byte) ([]common.Address, error) {
+	res, err := safeABI.Unpack("getOwners", output)
+	if err != nil {
+		return nil, err
+	}
+
+	owners, ok := res[0].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("invalid getOwners output")
+	}
+
+	return owners, nil
+}
+
+// FromEthSign converts an EIP-191 (eth_sign) signature of the Safe transaction hash to the Safe signature format
+func FromEthSign(signature []byte) ([]byte, error) {
+	if len(signature) != signatureLength {
+		return nil, fmt.Errorf("invalid signature length")
+	}
+
+	sig := make([]byte, signatureLength)
+	copy(sig, signature)
+	if sig[64] < 27 {
+		sig[64] += 27
+	}
+	sig[64] += 4
+
+	return sig, nil
+}
+
+// RecoverSigner returns the owner address which produced a Safe signature of the given transaction hash.
+// Both EIP-712 (v = 27 or 28) and eth_sign (v = 31 or 32) signatures are supported
+func RecoverSigner(safeTxHash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != signatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length")
+	}
+
+	sig := make([]byte, signatureLength)
+	copy(sig, signature)
+
+	hash := safeTxHash.Bytes()
+	switch v := sig[64]; {
+	case v == 27 || v == 28:
+		sig[64] = v - 27
+	case v == 31 || v == 32:
+		sig[64] = v - 31
+		hash = crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), hash)
+	default:
+		return common.Address{}, fmt.Errorf("unsupported signature type")
+	}
+
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+func bigOrZero(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return big.NewInt(0)
+	}
+
+	return value.ToInt()
+}
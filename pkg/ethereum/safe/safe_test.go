@@ -0,0 +1,99 @@
+// +build unit
+
+package safe
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakeSafeTransaction() *entities.SafeTransaction {
+	return &entities.SafeTransaction{
+		To:    common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"),
+		Value: (*hexutil.Big)(big.NewInt(1000)),
+		Data:  hexutil.MustDecode("0xa9059cbb"),
+		Nonce: 3,
+	}
+}
+
+func TestTypeHashes(t *testing.T) {
+	assert.Equal(t, "0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218", domainSeparatorTypeHash.Hex())
+	assert.Equal(t, "0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8", safeTxTypeHash.Hex())
+}
+
+func TestTxHash(t *testing.T) {
+	safeAddress := common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+	tx := fakeSafeTransaction()
+
+	hash := TxHash(big.NewInt(1), safeAddress, tx)
+
+	assert.Equal(t, hash, TxHash(big.NewInt(1), safeAddress, tx))
+	assert.NotEqual(t, hash, TxHash(big.NewInt(2), safeAddress, tx))
+
+	tx.Nonce++
+	assert.NotEqual(t, hash, TxHash(big.NewInt(1), safeAddress, tx))
+}
+
+func TestRecoverSigner(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+	hash := TxHash(big.NewInt(1), common.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"), fakeSafeTransaction())
+
+	t.Run("should recover signer of EIP-712 signature", func(t *testing.T) {
+		sig, err := crypto.Sign(hash.Bytes(), key)
+		require.NoError(t, err)
+		sig[64] += 27
+
+		recovered, err := RecoverSigner(hash, sig)
+
+		require.NoError(t, err)
+		assert.Equal(t, signer, recovered)
+	})
+
+	t.Run("should recover signer of eth_sign signature", func(t *testing.T) {
+		sig, err := crypto.Sign(crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n32"), hash.Bytes()), key)
+		require.NoError(t, err)
+
+		safeSig, err := FromEthSign(sig)
+		require.NoError(t, err)
+		recovered, err := RecoverSigner(hash, safeSig)
+
+		require.NoError(t, err)
+		assert.Equal(t, signer, recovered)
+	})
+
+	t.Run("should fail if signature type is not supported", func(t *testing.T) {
+		sig := make([]byte, 65)
+		sig[64] = 1
+
+		_, err := RecoverSigner(hash, sig)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEncodeExecTransaction(t *testing.T) {
+	sig1 := &entities.SafeSignature{Signer: common.HexToAddress("0x2000000000000000000000000000000000000000"), Signature: make([]byte, 65)}
+	sig2 := &entities.SafeSignature{Signer: common.HexToAddress("0x1000000000000000000000000000000000000000"), Signature: make([]byte, 65)}
+	sig1.Signature[0] = 0x02
+	sig2.Signature[0] = 0x01
+
+	data, err := EncodeExecTransaction(fakeSafeTransaction(), []*entities.SafeSignature{sig1, sig2})
+	require.NoError(t, err)
+
+	args, err := safeABI.Methods["execTransaction"].Inputs.Unpack(data[4:])
+	require.NoError(t, err)
+
+	signatures := args[9].([]byte)
+	require.Len(t, signatures, 130)
+	assert.Equal(t, byte(0x01), signatures[0])
+	assert.Equal(t, byte(0x02), signatures[65])
+}
@@ -27,6 +27,10 @@ type OrchestrateClient interface {
 	ContractClient
 	ChainProxyClient
 	EventStreamClient
+	SafeProposalClient
+	RelayerClient
+	SentrySessionClient
+	PrivacyGroupClient
 }
 
 type ChainProxyClient interface {
@@ -39,9 +43,12 @@ type TransactionClient interface {
 	SendDeployTransaction(ctx context.Context, request *types.DeployContractRequest) (*types.TransactionResponse, error)
 	SendRawTransaction(ctx context.Context, request *types.RawTransactionRequest) (*types.TransactionResponse, error)
 	SendTransferTransaction(ctx context.Context, request *types.TransferRequest) (*types.TransactionResponse, error)
+	SendTransactionBatch(ctx context.Context, request *types.SendTransactionBatchRequest) (*types.TransactionBatchResponse, error)
 	GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error)
 	SendCallOffTransaction(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error)
 	SendSpeedUpTransaction(ctx context.Context, txRequestUUID string, increment *float64) (*types.TransactionResponse, error)
+	SearchTransactions(ctx context.Context, filters *entities.TransactionRequestFilters) ([]*types.TransactionResponse, error)
+	SearchTransactionPages(ctx context.Context, filters *entities.TransactionRequestFilters, fn func([]*types.TransactionResponse) error) error
 }
 
 type ScheduleClient interface {
@@ -58,6 +65,7 @@ type JobClient interface {
 	StartJob(ctx context.Context, jobUUID string) error
 	ResendJobTx(ctx context.Context, jobUUID string) error
 	SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error)
+	SearchJobPages(ctx context.Context, filters *entities.JobFilters, fn func([]*types.JobResponse) error) error
 }
 
 type MetricClient interface {
@@ -68,9 +76,11 @@ type MetricClient interface {
 type AccountClient interface {
 	CreateAccount(ctx context.Context, request *types.CreateAccountRequest) (*types.AccountResponse, error)
 	SearchAccounts(ctx context.Context, filters *entities.AccountFilters) ([]*types.AccountResponse, error)
+	SearchAccountPages(ctx context.Context, filters *entities.AccountFilters, fn func([]*types.AccountResponse) error) error
 	GetAccount(ctx context.Context, address ethcommon.Address) (*types.AccountResponse, error)
 	ImportAccount(ctx context.Context, request *types.ImportAccountRequest) (*types.AccountResponse, error)
 	UpdateAccount(ctx context.Context, address ethcommon.Address, request *types.UpdateAccountRequest) (*types.AccountResponse, error)
+	RotateAccount(ctx context.Context, address ethcommon.Address, request *types.RotateAccountRequest) (*types.AccountResponse, error)
 	SignMessage(ctx context.Context, address ethcommon.Address, request *qkmtypes.SignMessageRequest) (string, error)
 	SignTypedData(ctx context.Context, address ethcommon.Address, request *qkmtypes.SignTypedDataRequest) (string, error)
 	VerifyMessageSignature(ctx context.Context, request *utilstypes.VerifyRequest) error
@@ -82,6 +92,7 @@ type FaucetClient interface {
 	UpdateFaucet(ctx context.Context, uuid string, request *types.UpdateFaucetRequest) (*types.FaucetResponse, error)
 	GetFaucet(ctx context.Context, uuid string) (*types.FaucetResponse, error)
 	SearchFaucets(ctx context.Context, filters *entities.FaucetFilters) ([]*types.FaucetResponse, error)
+	SearchFaucetPages(ctx context.Context, filters *entities.FaucetFilters, fn func([]*types.FaucetResponse) error) error
 	DeleteFaucet(ctx context.Context, uuid string) error
 }
 
@@ -90,6 +101,7 @@ type ChainClient interface {
 	UpdateChain(ctx context.Context, uuid string, request *types.UpdateChainRequest) (*types.ChainResponse, error)
 	GetChain(ctx context.Context, uuid string) (*types.ChainResponse, error)
 	SearchChains(ctx context.Context, filters *entities.ChainFilters) ([]*types.ChainResponse, error)
+	SearchChainPages(ctx context.Context, filters *entities.ChainFilters, fn func([]*types.ChainResponse) error) error
 	DeleteChain(ctx context.Context, uuid string) error
 }
 
@@ -102,6 +114,8 @@ type ContractClient interface {
 	GetContractTags(ctx context.Context, name string) ([]string, error)
 	SetContractAddressCodeHash(ctx context.Context, address, chainID string, req *types.SetContractCodeHashRequest) error
 	GetContractEvents(ctx context.Context, address, chainUUID string, req *types.GetContractEventsRequest) (*types.GetContractEventsBySignHashResponse, error)
+	CallContract(ctx context.Context, request *types.CallContractRequest) ([]*types.ContractCallResponse, error)
+	ImportContracts(ctx context.Context, request *types.ImportContractsRequest) ([]*types.ContractResponse, error)
 }
 
 type EventStreamClient interface {
@@ -109,5 +123,41 @@ type EventStreamClient interface {
 	UpdateEventStream(ctx context.Context, uuid string, request *types.UpdateEventStreamRequest) (*types.EventStreamResponse, error)
 	GetEventStream(ctx context.Context, uuid string) (*types.EventStreamResponse, error)
 	SearchEventStreams(ctx context.Context, filters *entities.EventStreamFilters) ([]*types.EventStreamResponse, error)
+	SearchEventStreamPages(ctx context.Context, filters *entities.EventStreamFilters, fn func([]*types.EventStreamResponse) error) error
 	DeleteEventStream(ctx context.Context, uuid string) error
 }
+
+type SafeProposalClient interface {
+	CreateSafeProposal(ctx context.Context, request *types.CreateSafeProposalRequest) (*types.SafeProposalResponse, error)
+	GetSafeProposal(ctx context.Context, uuid string) (*types.SafeProposalResponse, error)
+	SearchSafeProposals(ctx context.Context, filters *entities.SafeProposalFilters) ([]*types.SafeProposalResponse, error)
+	SearchSafeProposalPages(ctx context.Context, filters *entities.SafeProposalFilters, fn func([]*types.SafeProposalResponse) error) error
+	SignSafeProposal(ctx context.Context, uuid string, request *types.SignSafeProposalRequest) (*types.SafeProposalResponse, error)
+}
+
+type RelayerClient interface {
+	RegisterRelayer(ctx context.Context, request *types.RegisterRelayerRequest) (*types.RelayerResponse, error)
+	GetRelayer(ctx context.Context, uuid string) (*types.RelayerResponse, error)
+	SearchRelayers(ctx context.Context, filters *entities.RelayerFilters) ([]*types.RelayerResponse, error)
+	SearchRelayerPages(ctx context.Context, filters *entities.RelayerFilters, fn func([]*types.RelayerResponse) error) error
+	DeleteRelayer(ctx context.Context, uuid string) error
+	SearchRelaySpendings(ctx context.Context, filters *entities.RelaySpendingFilters) ([]*types.RelaySpendingResponse, error)
+	SendRelayTransaction(ctx context.Context, txRequest *types.RelayTransactionRequest) (*types.TransactionResponse, error)
+}
+
+type PrivacyGroupClient interface {
+	CreatePrivacyGroup(ctx context.Context, request *types.CreatePrivacyGroupRequest) (*types.PrivacyGroupResponse, error)
+	GetPrivacyGroup(ctx context.Context, uuid string) (*types.PrivacyGroupResponse, error)
+	SearchPrivacyGroups(ctx context.Context, filters *entities.PrivacyGroupFilters) ([]*types.PrivacyGroupResponse, error)
+	SearchPrivacyGroupPages(ctx context.Context, filters *entities.PrivacyGroupFilters, fn func([]*types.PrivacyGroupResponse) error) error
+	FindPrivacyGroups(ctx context.Context, request *types.FindPrivacyGroupsRequest) ([]*types.OnChainPrivacyGroupResponse, error)
+	DeletePrivacyGroup(ctx context.Context, uuid string) error
+}
+
+type SentrySessionClient interface {
+	CreateSentrySession(ctx context.Context, request *types.CreateSentrySessionRequest) (*types.SentrySessionResponse, error)
+	GetSentrySession(ctx context.Context, jobUUID string) (*types.SentrySessionResponse, error)
+	UpdateSentrySession(ctx context.Context, jobUUID string, request *types.UpdateSentrySessionRequest) (*types.SentrySessionResponse, error)
+	SearchSentrySessions(ctx context.Context, filters *entities.SentrySessionFilters) ([]*types.SentrySessionResponse, error)
+	DeleteSentrySession(ctx context.Context, jobUUID string) error
+}
@@ -83,7 +83,41 @@ func (c *HTTPClient) UpdateAccount(ctx context.Context, address ethcommon.Addres
 	return resp, nil
 }
 
+func (c *HTTPClient) RotateAccount(ctx context.Context, address ethcommon.Address, req *api.RotateAccountRequest) (*api.AccountResponse, error) {
+	reqURL := fmt.Sprintf("%v/accounts/%s/rotate", c.config.URL, address)
+	resp := &api.AccountResponse{}
+
+	response, err := clientutils.PostRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer clientutils.CloseResponse(response)
+	if err := parseResponse(ctx, response, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func (c *HTTPClient) SearchAccounts(ctx context.Context, filters *entities.AccountFilters) ([]*api.AccountResponse, error) {
+	resp, _, err := c.searchAccountsPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchAccountPages(ctx context.Context, filters *entities.AccountFilters, fn func([]*api.AccountResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		accounts, next, err := c.searchAccountsPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(accounts)
+	})
+}
+
+func (c *HTTPClient) searchAccountsPage(ctx context.Context, filters *entities.AccountFilters) ([]*api.AccountResponse, *entities.PageCursor, error) {
 	reqURL := fmt.Sprintf("%v/accounts", c.config.URL)
 	var resp []*api.AccountResponse
 
@@ -92,21 +126,28 @@ func (c *HTTPClient) SearchAccounts(ctx context.Context, filters *entities.Accou
 		qParams = append(qParams, "aliases="+strings.Join(filters.Aliases, ","))
 	}
 
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
 	if len(qParams) > 0 {
 		reqURL = reqURL + "?" + strings.Join(qParams, "&")
 	}
 
 	response, err := clientutils.GetRequest(ctx, c.client, reqURL)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	defer clientutils.CloseResponse(response)
 	if err := parseResponse(ctx, response, &resp); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return resp, nil
+	next, err := parseNextPageCursor(response)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, next, nil
 }
 
 func (c *HTTPClient) SignMessage(ctx context.Context, address ethcommon.Address, req *qkmtypes.SignMessageRequest) (string, error) {
@@ -28,14 +28,34 @@ func (c *HTTPClient) GetChain(ctx context.Context, uuid string) (*types.ChainRes
 }
 
 func (c *HTTPClient) SearchChains(ctx context.Context, filters *entities.ChainFilters) ([]*types.ChainResponse, error) {
+	resp, _, err := c.searchChainsPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchChainPages(ctx context.Context, filters *entities.ChainFilters, fn func([]*types.ChainResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		chains, next, err := c.searchChainsPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(chains)
+	})
+}
+
+func (c *HTTPClient) searchChainsPage(ctx context.Context, filters *entities.ChainFilters) ([]*types.ChainResponse, *entities.PageCursor, error) {
 	reqURL := fmt.Sprintf("%v/chains", c.config.URL)
 	var resp []*types.ChainResponse
+	var next *entities.PageCursor
 
 	var qParams []string
 	if len(filters.Names) > 0 {
 		qParams = append(qParams, "names="+strings.Join(filters.Names, ","))
 	}
 
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
 	if len(qParams) > 0 {
 		reqURL = reqURL + "?" + strings.Join(qParams, "&")
 	}
@@ -46,10 +66,14 @@ func (c *HTTPClient) SearchChains(ctx context.Context, filters *entities.ChainFi
 			return err
 		}
 		defer clientutils.CloseResponse(response)
-		return parseResponse(ctx, response, &resp)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
 	})
 
-	return resp, err
+	return resp, next, err
 }
 
 func (c *HTTPClient) RegisterChain(ctx context.Context, request *types.RegisterChainRequest) (*types.ChainResponse, error) {
@@ -137,3 +137,35 @@ func (c *HTTPClient) GetContractEvents(ctx context.Context, address, chainID str
 
 	return resp, err
 }
+
+func (c *HTTPClient) CallContract(ctx context.Context, request *types.CallContractRequest) ([]*types.ContractCallResponse, error) {
+	reqURL := fmt.Sprintf("%v/contracts/call", c.config.URL)
+	var resp []*types.ContractCallResponse
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) ImportContracts(ctx context.Context, request *types.ImportContractsRequest) ([]*types.ContractResponse, error) {
+	reqURL := fmt.Sprintf("%v/contracts/import", c.config.URL)
+	var resp []*types.ContractResponse
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
@@ -28,8 +28,26 @@ func (c *HTTPClient) GetEventStream(ctx context.Context, uuid string) (*types.Ev
 }
 
 func (c *HTTPClient) SearchEventStreams(ctx context.Context, filters *entities.EventStreamFilters) ([]*types.EventStreamResponse, error) {
+	resp, _, err := c.searchEventStreamsPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchEventStreamPages(ctx context.Context, filters *entities.EventStreamFilters, fn func([]*types.EventStreamResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		eventStreams, next, err := c.searchEventStreamsPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(eventStreams)
+	})
+}
+
+func (c *HTTPClient) searchEventStreamsPage(ctx context.Context, filters *entities.EventStreamFilters) ([]*types.EventStreamResponse, *entities.PageCursor, error) {
 	reqURL := fmt.Sprintf("%v/eventstreams", c.config.URL)
 	var resp []*types.EventStreamResponse
+	var next *entities.PageCursor
 
 	var qParams []string
 	if len(filters.Names) > 0 {
@@ -44,6 +62,8 @@ func (c *HTTPClient) SearchEventStreams(ctx context.Context, filters *entities.E
 		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
 	}
 
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
 	if len(qParams) > 0 {
 		reqURL = reqURL + "?" + strings.Join(qParams, "&")
 	}
@@ -54,10 +74,14 @@ func (c *HTTPClient) SearchEventStreams(ctx context.Context, filters *entities.E
 			return err
 		}
 		defer clientutils.CloseResponse(response)
-		return parseResponse(ctx, response, &resp)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
 	})
 
-	return resp, err
+	return resp, next, err
 }
 
 func (c *HTTPClient) CreateEventStream(ctx context.Context, request *types.CreateEventStreamRequest) (*types.EventStreamResponse, error) {
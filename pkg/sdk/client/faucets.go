@@ -28,8 +28,26 @@ func (c *HTTPClient) GetFaucet(ctx context.Context, uuid string) (*types.FaucetR
 }
 
 func (c *HTTPClient) SearchFaucets(ctx context.Context, filters *entities.FaucetFilters) ([]*types.FaucetResponse, error) {
+	resp, _, err := c.searchFaucetsPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchFaucetPages(ctx context.Context, filters *entities.FaucetFilters, fn func([]*types.FaucetResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		faucets, next, err := c.searchFaucetsPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(faucets)
+	})
+}
+
+func (c *HTTPClient) searchFaucetsPage(ctx context.Context, filters *entities.FaucetFilters) ([]*types.FaucetResponse, *entities.PageCursor, error) {
 	reqURL := fmt.Sprintf("%v/faucets", c.config.URL)
 	var resp []*types.FaucetResponse
+	var next *entities.PageCursor
 
 	var qParams []string
 	if len(filters.Names) > 0 {
@@ -40,6 +58,8 @@ func (c *HTTPClient) SearchFaucets(ctx context.Context, filters *entities.Faucet
 		qParams = append(qParams, "chain_rule="+filters.ChainRule)
 	}
 
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
 	if len(qParams) > 0 {
 		reqURL = reqURL + "?" + strings.Join(qParams, "&")
 	}
@@ -50,10 +70,14 @@ func (c *HTTPClient) SearchFaucets(ctx context.Context, filters *entities.Faucet
 			return err
 		}
 		defer clientutils.CloseResponse(response)
-		return parseResponse(ctx, response, &resp)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
 	})
 
-	return resp, err
+	return resp, next, err
 }
 
 func (c *HTTPClient) RegisterFaucet(ctx context.Context, request *types.RegisterFaucetRequest) (*types.FaucetResponse, error) {
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// jobQueryParams formats the filters on jobs shared by the job and the transaction request searches
+func jobQueryParams(statuses []entities.JobStatus, jobTypes []entities.JobType, labels map[string]string, from, to *ethcommon.Address) []string {
+	var qParams []string
+	if len(statuses) > 0 {
+		var qStatuses []string
+		for _, status := range statuses {
+			qStatuses = append(qStatuses, string(status))
+		}
+		qParams = append(qParams, "statuses="+strings.Join(qStatuses, ","))
+	}
+
+	if len(jobTypes) > 0 {
+		var qTypes []string
+		for _, jobType := range jobTypes {
+			qTypes = append(qTypes, url.QueryEscape(string(jobType)))
+		}
+		qParams = append(qParams, "types="+strings.Join(qTypes, ","))
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		qParams = append(qParams, "label="+url.QueryEscape(key+":"+labels[key]))
+	}
+
+	if from != nil {
+		qParams = append(qParams, "from="+from.Hex())
+	}
+
+	if to != nil {
+		qParams = append(qParams, "to="+to.Hex())
+	}
+
+	return qParams
+}
+
+func timeQueryParam(param string, t time.Time) []string {
+	if t.IsZero() {
+		return nil
+	}
+
+	return []string{param + "=" + url.QueryEscape(t.Format(time.RFC3339))}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/consensys/orchestrate/src/entities"
 
@@ -51,8 +50,26 @@ func (c *HTTPClient) GetJobs(ctx context.Context) ([]*types.JobResponse, error)
 }
 
 func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error) {
+	resp, _, err := c.searchJobPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchJobPages(ctx context.Context, filters *entities.JobFilters, fn func([]*types.JobResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		jobs, next, err := c.searchJobPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(jobs)
+	})
+}
+
+func (c *HTTPClient) searchJobPage(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, *entities.PageCursor, error) {
 	reqURL := fmt.Sprintf("%v/jobs", c.config.URL)
 	var resp []*types.JobResponse
+	var next *entities.PageCursor
 
 	var qParams []string
 	if len(filters.TxHashes) > 0 {
@@ -67,10 +84,21 @@ func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters
 		qParams = append(qParams, "status="+string(filters.Status))
 	}
 
-	if !filters.UpdatedAfter.IsZero() {
-		qParams = append(qParams, "updated_after="+filters.UpdatedAfter.Format(time.RFC3339))
+	qParams = append(qParams, jobQueryParams(filters.Statuses, filters.Types, filters.Labels, filters.From, filters.To)...)
+
+	if filters.ScheduleUUID != "" {
+		qParams = append(qParams, "schedule_uuid="+filters.ScheduleUUID)
 	}
 
+	if len(filters.IdempotencyKeys) > 0 {
+		qParams = append(qParams, "idempotency_keys="+strings.Join(filters.IdempotencyKeys, ","))
+	}
+
+	qParams = append(qParams, timeQueryParam("created_after", filters.CreatedAfter)...)
+	qParams = append(qParams, timeQueryParam("created_before", filters.CreatedBefore)...)
+	qParams = append(qParams, timeQueryParam("updated_after", filters.UpdatedAfter)...)
+	qParams = append(qParams, timeQueryParam("updated_before", filters.UpdatedBefore)...)
+
 	if filters.OnlyParents {
 		qParams = append(qParams, "only_parents=true")
 	}
@@ -83,6 +111,8 @@ func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters
 		qParams = append(qParams, "with_logs=true")
 	}
 
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
 	if len(qParams) > 0 {
 		reqURL = reqURL + "?" + strings.Join(qParams, "&")
 	}
@@ -94,10 +124,14 @@ func (c *HTTPClient) SearchJob(ctx context.Context, filters *entities.JobFilters
 			return errors.FromError(err).SetMessage(errMessage).AppendReason(err.Error()).ExtendComponent(component)
 		}
 		defer clientutils.CloseResponse(response)
-		return parseResponse(ctx, response, &resp)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
 	})
 
-	return resp, err
+	return resp, next, err
 }
 
 func (c *HTTPClient) CreateJob(ctx context.Context, request *types.CreateJobRequest) (*types.JobResponse, error) {
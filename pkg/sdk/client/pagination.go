@@ -0,0 +1,78 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const DefaultPageLimit = 100
+
+func paginationQueryParams(pagination *entities.Pagination) []string {
+	if pagination == nil {
+		return nil
+	}
+
+	var qParams []string
+	if pagination.Limit > 0 {
+		qParams = append(qParams, "limit="+strconv.Itoa(pagination.Limit))
+	}
+
+	if pagination.SortBy != "" {
+		qParams = append(qParams, "sort="+string(pagination.SortBy))
+	}
+
+	if pagination.Order != "" {
+		qParams = append(qParams, "order="+string(pagination.Order))
+	}
+
+	if pagination.After != nil {
+		qParams = append(qParams, "cursor="+pagination.After.String())
+	}
+
+	return qParams
+}
+
+// parseNextPageCursor extracts the cursor of the next page from the Link header of a search response, nil on the last page
+func parseNextPageCursor(response *http.Response) (*entities.PageCursor, error) {
+	link := response.Header.Get("Link")
+	if link == "" {
+		return nil, nil
+	}
+
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end < start {
+		return nil, errors.InvalidFormatError("invalid Link header %s", link)
+	}
+
+	nextURL, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid Link header %s", link)
+	}
+
+	return entities.ParsePageCursor(nextURL.Query().Get("cursor"))
+}
+
+// walkPages requests the pages one after the other, starting from the given pagination, until the last page is reached
+func walkPages(pagination *entities.Pagination, page func(*entities.Pagination) (*entities.PageCursor, error)) error {
+	current := entities.Pagination{Limit: DefaultPageLimit}
+	if pagination != nil {
+		current = *pagination
+		if current.Limit == 0 {
+			current.Limit = DefaultPageLimit
+		}
+	}
+
+	for {
+		pagePagination := current
+		next, err := page(&pagePagination)
+		if err != nil || next == nil {
+			return err
+		}
+		current.After = next
+	}
+}
@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func (c *HTTPClient) CreatePrivacyGroup(ctx context.Context, request *types.CreatePrivacyGroupRequest) (*types.PrivacyGroupResponse, error) {
+	reqURL := fmt.Sprintf("%v/privacy-groups", c.config.URL)
+	resp := &types.PrivacyGroupResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetPrivacyGroup(ctx context.Context, uuid string) (*types.PrivacyGroupResponse, error) {
+	reqURL := fmt.Sprintf("%v/privacy-groups/%s", c.config.URL, uuid)
+	resp := &types.PrivacyGroupResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchPrivacyGroups(ctx context.Context, filters *entities.PrivacyGroupFilters) ([]*types.PrivacyGroupResponse, error) {
+	resp, _, err := c.searchPrivacyGroupsPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchPrivacyGroupPages(ctx context.Context, filters *entities.PrivacyGroupFilters, fn func([]*types.PrivacyGroupResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		groups, next, err := c.searchPrivacyGroupsPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(groups)
+	})
+}
+
+func (c *HTTPClient) searchPrivacyGroupsPage(ctx context.Context, filters *entities.PrivacyGroupFilters) ([]*types.PrivacyGroupResponse, *entities.PageCursor, error) {
+	reqURL := fmt.Sprintf("%v/privacy-groups", c.config.URL)
+	var resp []*types.PrivacyGroupResponse
+	var next *entities.PageCursor
+
+	var qParams []string
+	if len(filters.Names) > 0 {
+		qParams = append(qParams, "names="+strings.Join(filters.Names, ","))
+	}
+
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+
+	if filters.PrivacyGroupID != "" {
+		// Privacy group IDs are base64 encoded
+		qParams = append(qParams, "privacy_group_id="+url.QueryEscape(filters.PrivacyGroupID))
+	}
+
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
+	})
+
+	return resp, next, err
+}
+
+func (c *HTTPClient) FindPrivacyGroups(ctx context.Context, request *types.FindPrivacyGroupsRequest) ([]*types.OnChainPrivacyGroupResponse, error) {
+	reqURL := fmt.Sprintf("%v/privacy-groups/find", c.config.URL)
+	var resp []*types.OnChainPrivacyGroupResponse
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) DeletePrivacyGroup(ctx context.Context, uuid string) error {
+	reqURL := fmt.Sprintf("%v/privacy-groups/%v", c.config.URL, uuid)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return ParseEmptyBodyResponse(ctx, response)
+}
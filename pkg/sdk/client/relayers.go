@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func (c *HTTPClient) RegisterRelayer(ctx context.Context, request *types.RegisterRelayerRequest) (*types.RelayerResponse, error) {
+	reqURL := fmt.Sprintf("%v/relayers", c.config.URL)
+	resp := &types.RelayerResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetRelayer(ctx context.Context, uuid string) (*types.RelayerResponse, error) {
+	reqURL := fmt.Sprintf("%v/relayers/%s", c.config.URL, uuid)
+	resp := &types.RelayerResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchRelayers(ctx context.Context, filters *entities.RelayerFilters) ([]*types.RelayerResponse, error) {
+	resp, _, err := c.searchRelayersPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchRelayerPages(ctx context.Context, filters *entities.RelayerFilters, fn func([]*types.RelayerResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		relayers, next, err := c.searchRelayersPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(relayers)
+	})
+}
+
+func (c *HTTPClient) searchRelayersPage(ctx context.Context, filters *entities.RelayerFilters) ([]*types.RelayerResponse, *entities.PageCursor, error) {
+	reqURL := fmt.Sprintf("%v/relayers", c.config.URL)
+	var resp []*types.RelayerResponse
+	var next *entities.PageCursor
+
+	var qParams []string
+	if len(filters.Names) > 0 {
+		qParams = append(qParams, "names="+strings.Join(filters.Names, ","))
+	}
+
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+
+	if filters.Type != "" {
+		qParams = append(qParams, "type="+string(filters.Type))
+	}
+
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
+	})
+
+	return resp, next, err
+}
+
+func (c *HTTPClient) DeleteRelayer(ctx context.Context, uuid string) error {
+	reqURL := fmt.Sprintf("%v/relayers/%v", c.config.URL, uuid)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return ParseEmptyBodyResponse(ctx, response)
+}
+
+func (c *HTTPClient) SearchRelaySpendings(ctx context.Context, filters *entities.RelaySpendingFilters) ([]*types.RelaySpendingResponse, error) {
+	reqURL := fmt.Sprintf("%v/relayers/spendings", c.config.URL)
+	var resp []*types.RelaySpendingResponse
+
+	var qParams []string
+	if filters.RelayerUUID != "" {
+		qParams = append(qParams, "relayer_uuid="+filters.RelayerUUID)
+	}
+
+	if filters.TenantID != "" {
+		qParams = append(qParams, "tenant_id="+filters.TenantID)
+	}
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SendRelayTransaction(ctx context.Context, txRequest *types.RelayTransactionRequest) (*types.TransactionResponse, error) {
+	reqURL := fmt.Sprintf("%v/transactions/relay", c.config.URL)
+	resp := &types.TransactionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, txRequest)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
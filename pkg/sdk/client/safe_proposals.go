@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func (c *HTTPClient) CreateSafeProposal(ctx context.Context, request *types.CreateSafeProposalRequest) (*types.SafeProposalResponse, error) {
+	reqURL := fmt.Sprintf("%v/safe-proposals", c.config.URL)
+	resp := &types.SafeProposalResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetSafeProposal(ctx context.Context, uuid string) (*types.SafeProposalResponse, error) {
+	reqURL := fmt.Sprintf("%v/safe-proposals/%s", c.config.URL, uuid)
+	resp := &types.SafeProposalResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchSafeProposals(ctx context.Context, filters *entities.SafeProposalFilters) ([]*types.SafeProposalResponse, error) {
+	resp, _, err := c.searchSafeProposalsPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchSafeProposalPages(ctx context.Context, filters *entities.SafeProposalFilters, fn func([]*types.SafeProposalResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		proposals, next, err := c.searchSafeProposalsPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(proposals)
+	})
+}
+
+func (c *HTTPClient) searchSafeProposalsPage(ctx context.Context, filters *entities.SafeProposalFilters) ([]*types.SafeProposalResponse, *entities.PageCursor, error) {
+	reqURL := fmt.Sprintf("%v/safe-proposals", c.config.URL)
+	var resp []*types.SafeProposalResponse
+	var next *entities.PageCursor
+
+	var qParams []string
+	if filters.SafeAddress != nil {
+		qParams = append(qParams, "safe="+filters.SafeAddress.Hex())
+	}
+
+	if filters.ChainUUID != "" {
+		qParams = append(qParams, "chain_uuid="+filters.ChainUUID)
+	}
+
+	if filters.Status != "" {
+		qParams = append(qParams, "status="+string(filters.Status))
+	}
+
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
+	})
+
+	return resp, next, err
+}
+
+func (c *HTTPClient) SignSafeProposal(ctx context.Context, uuid string, request *types.SignSafeProposalRequest) (*types.SafeProposalResponse, error) {
+	reqURL := fmt.Sprintf("%v/safe-proposals/%s/signatures", c.config.URL, uuid)
+	resp := &types.SafeProposalResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func (c *HTTPClient) CreateSentrySession(ctx context.Context, request *types.CreateSentrySessionRequest) (*types.SentrySessionResponse, error) {
+	reqURL := fmt.Sprintf("%v/sentry-sessions", c.config.URL)
+	resp := &types.SentrySessionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) GetSentrySession(ctx context.Context, jobUUID string) (*types.SentrySessionResponse, error) {
+	reqURL := fmt.Sprintf("%v/sentry-sessions/%s", c.config.URL, jobUUID)
+	resp := &types.SentrySessionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) UpdateSentrySession(ctx context.Context, jobUUID string, request *types.UpdateSentrySessionRequest) (*types.SentrySessionResponse, error) {
+	reqURL := fmt.Sprintf("%v/sentry-sessions/%s", c.config.URL, jobUUID)
+	resp := &types.SentrySessionResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PatchRequest(ctx, c.client, reqURL, request)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) SearchSentrySessions(ctx context.Context, filters *entities.SentrySessionFilters) ([]*types.SentrySessionResponse, error) {
+	reqURL := fmt.Sprintf("%v/sentry-sessions", c.config.URL)
+	if filters.ChainUUID != "" {
+		reqURL = reqURL + "?chain_uuid=" + filters.ChainUUID
+	}
+	var resp []*types.SentrySessionResponse
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, &resp)
+	})
+
+	return resp, err
+}
+
+func (c *HTTPClient) DeleteSentrySession(ctx context.Context, jobUUID string) error {
+	reqURL := fmt.Sprintf("%v/sentry-sessions/%v", c.config.URL, jobUUID)
+
+	response, err := clientutils.DeleteRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return err
+	}
+
+	defer clientutils.CloseResponse(response)
+	return ParseEmptyBodyResponse(ctx, response)
+}
@@ -3,8 +3,10 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 
 	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
 )
@@ -77,6 +79,23 @@ func (c *HTTPClient) SendTransferTransaction(ctx context.Context, txRequest *typ
 	return resp, err
 }
 
+func (c *HTTPClient) SendTransactionBatch(ctx context.Context, batchRequest *types.SendTransactionBatchRequest) (*types.TransactionBatchResponse, error) {
+	reqURL := fmt.Sprintf("%v/transactions/batch", c.config.URL)
+	resp := &types.TransactionBatchResponse{}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.PostRequest(ctx, c.client, reqURL, batchRequest)
+		if err != nil {
+			return err
+		}
+
+		defer clientutils.CloseResponse(response)
+		return parseResponse(ctx, response, resp)
+	})
+
+	return resp, err
+}
+
 func (c *HTTPClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
 	reqURL := fmt.Sprintf("%v/transactions/%v", c.config.URL, txRequestUUID)
 	resp := &types.TransactionResponse{}
@@ -131,3 +150,59 @@ func (c *HTTPClient) SendSpeedUpTransaction(ctx context.Context, txRequestUUID s
 
 	return resp, err
 }
+
+func (c *HTTPClient) SearchTransactions(ctx context.Context, filters *entities.TransactionRequestFilters) ([]*types.TransactionResponse, error) {
+	resp, _, err := c.searchTransactionsPage(ctx, filters)
+	return resp, err
+}
+
+func (c *HTTPClient) SearchTransactionPages(ctx context.Context, filters *entities.TransactionRequestFilters, fn func([]*types.TransactionResponse) error) error {
+	pageFilters := *filters
+	return walkPages(filters.Pagination, func(pagination *entities.Pagination) (*entities.PageCursor, error) {
+		pageFilters.Pagination = pagination
+		txRequests, next, err := c.searchTransactionsPage(ctx, &pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		return next, fn(txRequests)
+	})
+}
+
+func (c *HTTPClient) searchTransactionsPage(ctx context.Context, filters *entities.TransactionRequestFilters) ([]*types.TransactionResponse, *entities.PageCursor, error) {
+	reqURL := fmt.Sprintf("%v/transactions", c.config.URL)
+	var resp []*types.TransactionResponse
+	var next *entities.PageCursor
+
+	var qParams []string
+	if len(filters.IdempotencyKeys) > 0 {
+		qParams = append(qParams, "idempotency_keys="+strings.Join(filters.IdempotencyKeys, ","))
+	}
+
+	if filters.ScheduleUUID != "" {
+		qParams = append(qParams, "schedule_uuid="+filters.ScheduleUUID)
+	}
+
+	qParams = append(qParams, jobQueryParams(filters.Statuses, filters.Types, filters.Labels, filters.From, filters.To)...)
+	qParams = append(qParams, timeQueryParam("created_after", filters.CreatedAfter)...)
+	qParams = append(qParams, timeQueryParam("created_before", filters.CreatedBefore)...)
+	qParams = append(qParams, paginationQueryParams(filters.Pagination)...)
+
+	if len(qParams) > 0 {
+		reqURL = reqURL + "?" + strings.Join(qParams, "&")
+	}
+
+	err := callWithBackOff(ctx, c.config.backOff, func() error {
+		response, err := clientutils.GetRequest(ctx, c.client, reqURL)
+		if err != nil {
+			return err
+		}
+		defer clientutils.CloseResponse(response)
+		if err = parseResponse(ctx, response, &resp); err != nil {
+			return err
+		}
+		next, err = parseNextPageCursor(response)
+		return err
+	})
+
+	return resp, next, err
+}
@@ -35,6 +35,7 @@ type MessengerTxListener interface {
 	CreateSubscriptionMessage(ctx context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error
 	UpdateSubscriptionMessage(ctx context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error
 	DeleteSubscriptionMessage(ctx context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error
+	BackfillEventLogsMessage(ctx context.Context, backfill *entities.EventLogsBackfill, userInfo *multitenancy.UserInfo) error
 }
 
 type MessengerTxSender interface {
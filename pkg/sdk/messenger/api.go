@@ -8,22 +8,22 @@ import (
 	"github.com/consensys/orchestrate/src/api/service/types"
 )
 
-func (c *ProducerClient) ContractEventLogsMessage(_ context.Context, req *types.EventLogsMessageRequest, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicAPI, listener.EventLogsMessageType, req, req.ChainUUID, userInfo)
+func (c *ProducerClient) ContractEventLogsMessage(ctx context.Context, req *types.EventLogsMessageRequest, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicAPI, listener.EventLogsMessageType, req, req.ChainUUID, userInfo)
 }
 
-func (c *ProducerClient) JobUpdateMessage(_ context.Context, req *types.JobUpdateMessageRequest, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicAPI, listener.UpdateJobMessageType, req, req.JobUUID, userInfo)
+func (c *ProducerClient) JobUpdateMessage(ctx context.Context, req *types.JobUpdateMessageRequest, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicAPI, listener.UpdateJobMessageType, req, req.JobUUID, userInfo)
 }
 
-func (c *ProducerClient) EventStreamSuspendMessage(_ context.Context, eventStreamUUID string, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicAPI, listener.SuspendEventStreamMessageType, &types.SuspendEventStreamRequestMessage{
+func (c *ProducerClient) EventStreamSuspendMessage(ctx context.Context, eventStreamUUID string, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicAPI, listener.SuspendEventStreamMessageType, &types.SuspendEventStreamRequestMessage{
 		UUID: eventStreamUUID,
 	}, eventStreamUUID, userInfo)
 }
 
-func (c *ProducerClient) NotificationAckMessage(_ context.Context, notifUUID string, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicAPI, listener.AckNotificationMessageType, &types.AckNotificationRequestMessage{
+func (c *ProducerClient) NotificationAckMessage(ctx context.Context, notifUUID string, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicAPI, listener.AckNotificationMessageType, &types.AckNotificationRequestMessage{
 		UUID: notifUUID,
 	}, notifUUID, userInfo)
 }
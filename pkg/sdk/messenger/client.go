@@ -1,14 +1,19 @@
 package messenger
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/kafka"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ProducerClient struct {
@@ -25,11 +30,21 @@ func NewProducerClient(cfg *Config, client kafka.Producer) *ProducerClient {
 	}
 }
 
-func (c *ProducerClient) sendMessage(topic string, msgType entities.RequestMessageType, msgBody interface{}, partitionKey string, userInfo *multitenancy.UserInfo) error {
+func (c *ProducerClient) sendMessage(ctx context.Context, topic string, msgType entities.RequestMessageType, msgBody interface{}, partitionKey string, userInfo *multitenancy.UserInfo) (err error) {
 	if topic == "" {
 		return errors.InvalidParameterError("topic not defined")
 	}
 
+	ctx, span := tracing.StartSpan(ctx, fmt.Sprintf("%s send", topic),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination", topic),
+			attribute.String("messaging.message_type", string(msgType)),
+		),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	bBody, err := json.Marshal(msgBody)
 	if err != nil {
 		return errors.EncodingError("failed to marshall consumer message body")
@@ -39,6 +54,7 @@ func (c *ProducerClient) sendMessage(topic string, msgType entities.RequestMessa
 	if userInfo.AuthMode == multitenancy.AuthMethodJWT || userInfo.AuthMode == multitenancy.AuthMethodAPIKey {
 		headers[utils.UserInfoHeader] = userInfo
 	}
+	tracing.InjectMessageHeaders(ctx, headers)
 
 	err = c.client.Send(&entities.Message{
 		Type: msgType,
@@ -9,15 +9,15 @@ import (
 	"github.com/consensys/orchestrate/src/notifier/service/types"
 )
 
-func (c *ProducerClient) TransactionNotificationMessage(_ context.Context, eventStream *entities.EventStream, notif *entities.Notification, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicNotifier, service.TransactionMessageType, &types.TransactionMessageRequest{
+func (c *ProducerClient) TransactionNotificationMessage(ctx context.Context, eventStream *entities.EventStream, notif *entities.Notification, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicNotifier, service.TransactionMessageType, &types.TransactionMessageRequest{
 		EventStream:  eventStream,
 		Notification: notif,
 	}, notif.SourceUUID, userInfo)
 }
 
-func (c *ProducerClient) ContractEventNotificationMessage(_ context.Context, eventStream *entities.EventStream, notif *entities.Notification, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicNotifier, service.ContractEventMessageType, &types.ContractEventMessageRequest{
+func (c *ProducerClient) ContractEventNotificationMessage(ctx context.Context, eventStream *entities.EventStream, notif *entities.Notification, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicNotifier, service.ContractEventMessageType, &types.ContractEventMessageRequest{
 		EventStream:  eventStream,
 		Notification: notif,
 	}, notif.SourceUUID, userInfo)
@@ -9,29 +9,35 @@ import (
 	"github.com/consensys/orchestrate/src/tx-listener/service/types"
 )
 
-func (c *ProducerClient) PendingJobMessage(_ context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicTxListener, service.PendingJobMessageType, &types.PendingJobMessageRequest{
+func (c *ProducerClient) PendingJobMessage(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicTxListener, service.PendingJobMessageType, &types.PendingJobMessageRequest{
 		Job: job,
 	}, job.ChainUUID, userInfo)
 }
 
-func (c *ProducerClient) CreateSubscriptionMessage(_ context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicTxListener, service.SubscriptionMessageType, &types.SubscriptionMessageRequest{
+func (c *ProducerClient) CreateSubscriptionMessage(ctx context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicTxListener, service.SubscriptionMessageType, &types.SubscriptionMessageRequest{
 		Action:       types.CreateSubscriptionAction,
 		Subscription: sub,
 	}, sub.ChainUUID, userInfo)
 }
 
-func (c *ProducerClient) UpdateSubscriptionMessage(_ context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicTxListener, service.SubscriptionMessageType, &types.SubscriptionMessageRequest{
+func (c *ProducerClient) UpdateSubscriptionMessage(ctx context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicTxListener, service.SubscriptionMessageType, &types.SubscriptionMessageRequest{
 		Action:       types.UpdateSubscriptionAction,
 		Subscription: sub,
 	}, sub.ChainUUID, userInfo)
 }
 
-func (c *ProducerClient) DeleteSubscriptionMessage(_ context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicTxListener, service.SubscriptionMessageType, &types.SubscriptionMessageRequest{
+func (c *ProducerClient) DeleteSubscriptionMessage(ctx context.Context, sub *entities.Subscription, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicTxListener, service.SubscriptionMessageType, &types.SubscriptionMessageRequest{
 		Action:       types.DeleteSubscriptionAction,
 		Subscription: sub,
 	}, sub.ChainUUID, userInfo)
 }
+
+func (c *ProducerClient) BackfillEventLogsMessage(ctx context.Context, backfill *entities.EventLogsBackfill, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicTxListener, service.EventLogsBackfillMessageType, &types.EventLogsBackfillMessageRequest{
+		Backfill: backfill,
+	}, backfill.ChainUUID, userInfo)
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/consensys/orchestrate/src/tx-sender/service/types"
 )
 
-func (c *ProducerClient) StartedJobMessage(_ context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
-	return c.sendMessage(c.cfg.TopicTxSender, service.StartedJobMessageType, &types.StartedJobReq{
+func (c *ProducerClient) StartedJobMessage(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	return c.sendMessage(ctx, c.cfg.TopicTxSender, service.StartedJobMessageType, &types.StartedJobReq{
 		Job: job,
 	}, job.PartitionKey(), userInfo)
 }
@@ -100,6 +100,21 @@ func (mr *MockOrchestrateClientMockRecorder) SendTransferTransaction(ctx, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SendTransferTransaction), ctx, request)
 }
 
+// SendTransactionBatch mocks base method
+func (m *MockOrchestrateClient) SendTransactionBatch(ctx context.Context, request *types.SendTransactionBatchRequest) (*types.TransactionBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionBatch", ctx, request)
+	ret0, _ := ret[0].(*types.TransactionBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionBatch indicates an expected call of SendTransactionBatch
+func (mr *MockOrchestrateClientMockRecorder) SendTransactionBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionBatch", reflect.TypeOf((*MockOrchestrateClient)(nil).SendTransactionBatch), ctx, request)
+}
+
 // GetTxRequest mocks base method
 func (m *MockOrchestrateClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +160,35 @@ func (mr *MockOrchestrateClientMockRecorder) SendSpeedUpTransaction(ctx, txReque
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSpeedUpTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SendSpeedUpTransaction), ctx, txRequestUUID, increment)
 }
 
+// SearchTransactions mocks base method
+func (m *MockOrchestrateClient) SearchTransactions(ctx context.Context, filters *entities.TransactionRequestFilters) ([]*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactions", ctx, filters)
+	ret0, _ := ret[0].([]*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTransactions indicates an expected call of SearchTransactions
+func (mr *MockOrchestrateClientMockRecorder) SearchTransactions(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactions", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchTransactions), ctx, filters)
+}
+
+// SearchTransactionPages mocks base method
+func (m *MockOrchestrateClient) SearchTransactionPages(ctx context.Context, filters *entities.TransactionRequestFilters, fn func([]*types.TransactionResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactionPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchTransactionPages indicates an expected call of SearchTransactionPages
+func (mr *MockOrchestrateClientMockRecorder) SearchTransactionPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactionPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchTransactionPages), ctx, filters, fn)
+}
+
 // GetSchedule mocks base method
 func (m *MockOrchestrateClient) GetSchedule(ctx context.Context, scheduleUUID string) (*types.ScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -293,6 +337,20 @@ func (mr *MockOrchestrateClientMockRecorder) SearchJob(ctx, filters interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJob", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchJob), ctx, filters)
 }
 
+// SearchJobPages mocks base method
+func (m *MockOrchestrateClient) SearchJobPages(ctx context.Context, filters *entities.JobFilters, fn func([]*types.JobResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJobPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchJobPages indicates an expected call of SearchJobPages
+func (mr *MockOrchestrateClientMockRecorder) SearchJobPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchJobPages), ctx, filters, fn)
+}
+
 // Checker mocks base method
 func (m *MockOrchestrateClient) Checker() healthcheck.Check {
 	m.ctrl.T.Helper()
@@ -352,6 +410,20 @@ func (mr *MockOrchestrateClientMockRecorder) SearchAccounts(ctx, filters interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchAccounts), ctx, filters)
 }
 
+// SearchAccountPages mocks base method
+func (m *MockOrchestrateClient) SearchAccountPages(ctx context.Context, filters *entities.AccountFilters, fn func([]*types.AccountResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchAccountPages indicates an expected call of SearchAccountPages
+func (mr *MockOrchestrateClientMockRecorder) SearchAccountPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchAccountPages), ctx, filters, fn)
+}
+
 // GetAccount mocks base method
 func (m *MockOrchestrateClient) GetAccount(ctx context.Context, address common.Address) (*types.AccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +469,21 @@ func (mr *MockOrchestrateClientMockRecorder) UpdateAccount(ctx, address, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockOrchestrateClient)(nil).UpdateAccount), ctx, address, request)
 }
 
+// RotateAccount mocks base method
+func (m *MockOrchestrateClient) RotateAccount(ctx context.Context, address common.Address, request *types.RotateAccountRequest) (*types.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAccount", ctx, address, request)
+	ret0, _ := ret[0].(*types.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAccount indicates an expected call of RotateAccount
+func (mr *MockOrchestrateClientMockRecorder) RotateAccount(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAccount", reflect.TypeOf((*MockOrchestrateClient)(nil).RotateAccount), ctx, address, request)
+}
+
 // SignMessage mocks base method
 func (m *MockOrchestrateClient) SignMessage(ctx context.Context, address common.Address, request *types0.SignMessageRequest) (string, error) {
 	m.ctrl.T.Helper()
@@ -515,6 +602,20 @@ func (mr *MockOrchestrateClientMockRecorder) SearchFaucets(ctx, filters interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFaucets", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchFaucets), ctx, filters)
 }
 
+// SearchFaucetPages mocks base method
+func (m *MockOrchestrateClient) SearchFaucetPages(ctx context.Context, filters *entities.FaucetFilters, fn func([]*types.FaucetResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFaucetPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchFaucetPages indicates an expected call of SearchFaucetPages
+func (mr *MockOrchestrateClientMockRecorder) SearchFaucetPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFaucetPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchFaucetPages), ctx, filters, fn)
+}
+
 // DeleteFaucet mocks base method
 func (m *MockOrchestrateClient) DeleteFaucet(ctx context.Context, uuid string) error {
 	m.ctrl.T.Helper()
@@ -589,6 +690,20 @@ func (mr *MockOrchestrateClientMockRecorder) SearchChains(ctx, filters interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChains", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchChains), ctx, filters)
 }
 
+// SearchChainPages mocks base method
+func (m *MockOrchestrateClient) SearchChainPages(ctx context.Context, filters *entities.ChainFilters, fn func([]*types.ChainResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchChainPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchChainPages indicates an expected call of SearchChainPages
+func (mr *MockOrchestrateClientMockRecorder) SearchChainPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChainPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchChainPages), ctx, filters, fn)
+}
+
 // DeleteChain mocks base method
 func (m *MockOrchestrateClient) DeleteChain(ctx context.Context, uuid string) error {
 	m.ctrl.T.Helper()
@@ -721,6 +836,36 @@ func (mr *MockOrchestrateClientMockRecorder) GetContractEvents(ctx, address, cha
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockOrchestrateClient)(nil).GetContractEvents), ctx, address, chainUUID, req)
 }
 
+// CallContract mocks base method
+func (m *MockOrchestrateClient) CallContract(ctx context.Context, request *types.CallContractRequest) ([]*types.ContractCallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, request)
+	ret0, _ := ret[0].([]*types.ContractCallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract
+func (mr *MockOrchestrateClientMockRecorder) CallContract(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockOrchestrateClient)(nil).CallContract), ctx, request)
+}
+
+// ImportContracts mocks base method
+func (m *MockOrchestrateClient) ImportContracts(ctx context.Context, request *types.ImportContractsRequest) ([]*types.ContractResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportContracts", ctx, request)
+	ret0, _ := ret[0].([]*types.ContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportContracts indicates an expected call of ImportContracts
+func (mr *MockOrchestrateClientMockRecorder) ImportContracts(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportContracts", reflect.TypeOf((*MockOrchestrateClient)(nil).ImportContracts), ctx, request)
+}
+
 // ChainProxyURL mocks base method
 func (m *MockOrchestrateClient) ChainProxyURL(chainUUID string) string {
 	m.ctrl.T.Helper()
@@ -809,6 +954,20 @@ func (mr *MockOrchestrateClientMockRecorder) SearchEventStreams(ctx, filters int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEventStreams", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchEventStreams), ctx, filters)
 }
 
+// SearchEventStreamPages mocks base method
+func (m *MockOrchestrateClient) SearchEventStreamPages(ctx context.Context, filters *entities.EventStreamFilters, fn func([]*types.EventStreamResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEventStreamPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchEventStreamPages indicates an expected call of SearchEventStreamPages
+func (mr *MockOrchestrateClientMockRecorder) SearchEventStreamPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEventStreamPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchEventStreamPages), ctx, filters, fn)
+}
+
 // DeleteEventStream mocks base method
 func (m *MockOrchestrateClient) DeleteEventStream(ctx context.Context, uuid string) error {
 	m.ctrl.T.Helper()
@@ -823,240 +982,623 @@ func (mr *MockOrchestrateClientMockRecorder) DeleteEventStream(ctx, uuid interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventStream", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteEventStream), ctx, uuid)
 }
 
-// MockChainProxyClient is a mock of ChainProxyClient interface
-type MockChainProxyClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockChainProxyClientMockRecorder
+// CreateSafeProposal mocks base method
+func (m *MockOrchestrateClient) CreateSafeProposal(ctx context.Context, request *types.CreateSafeProposalRequest) (*types.SafeProposalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSafeProposal", ctx, request)
+	ret0, _ := ret[0].(*types.SafeProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockChainProxyClientMockRecorder is the mock recorder for MockChainProxyClient
-type MockChainProxyClientMockRecorder struct {
-	mock *MockChainProxyClient
+// CreateSafeProposal indicates an expected call of CreateSafeProposal
+func (mr *MockOrchestrateClientMockRecorder) CreateSafeProposal(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSafeProposal", reflect.TypeOf((*MockOrchestrateClient)(nil).CreateSafeProposal), ctx, request)
 }
 
-// NewMockChainProxyClient creates a new mock instance
-func NewMockChainProxyClient(ctrl *gomock.Controller) *MockChainProxyClient {
-	mock := &MockChainProxyClient{ctrl: ctrl}
-	mock.recorder = &MockChainProxyClientMockRecorder{mock}
-	return mock
+// GetSafeProposal mocks base method
+func (m *MockOrchestrateClient) GetSafeProposal(ctx context.Context, uuid string) (*types.SafeProposalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSafeProposal", ctx, uuid)
+	ret0, _ := ret[0].(*types.SafeProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockChainProxyClient) EXPECT() *MockChainProxyClientMockRecorder {
-	return m.recorder
+// GetSafeProposal indicates an expected call of GetSafeProposal
+func (mr *MockOrchestrateClientMockRecorder) GetSafeProposal(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSafeProposal", reflect.TypeOf((*MockOrchestrateClient)(nil).GetSafeProposal), ctx, uuid)
 }
 
-// ChainProxyURL mocks base method
-func (m *MockChainProxyClient) ChainProxyURL(chainUUID string) string {
+// SearchSafeProposals mocks base method
+func (m *MockOrchestrateClient) SearchSafeProposals(ctx context.Context, filters *entities.SafeProposalFilters) ([]*types.SafeProposalResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChainProxyURL", chainUUID)
-	ret0, _ := ret[0].(string)
-	return ret0
+	ret := m.ctrl.Call(m, "SearchSafeProposals", ctx, filters)
+	ret0, _ := ret[0].([]*types.SafeProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ChainProxyURL indicates an expected call of ChainProxyURL
-func (mr *MockChainProxyClientMockRecorder) ChainProxyURL(chainUUID interface{}) *gomock.Call {
+// SearchSafeProposals indicates an expected call of SearchSafeProposals
+func (mr *MockOrchestrateClientMockRecorder) SearchSafeProposals(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainProxyURL", reflect.TypeOf((*MockChainProxyClient)(nil).ChainProxyURL), chainUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSafeProposals", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchSafeProposals), ctx, filters)
 }
 
-// ChainTesseraProxyURL mocks base method
-func (m *MockChainProxyClient) ChainTesseraProxyURL(chainUUID string) string {
+// SearchSafeProposalPages mocks base method
+func (m *MockOrchestrateClient) SearchSafeProposalPages(ctx context.Context, filters *entities.SafeProposalFilters, fn func([]*types.SafeProposalResponse) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChainTesseraProxyURL", chainUUID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "SearchSafeProposalPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ChainTesseraProxyURL indicates an expected call of ChainTesseraProxyURL
-func (mr *MockChainProxyClientMockRecorder) ChainTesseraProxyURL(chainUUID interface{}) *gomock.Call {
+// SearchSafeProposalPages indicates an expected call of SearchSafeProposalPages
+func (mr *MockOrchestrateClientMockRecorder) SearchSafeProposalPages(ctx, filters, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainTesseraProxyURL", reflect.TypeOf((*MockChainProxyClient)(nil).ChainTesseraProxyURL), chainUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSafeProposalPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchSafeProposalPages), ctx, filters, fn)
 }
 
-// MockTransactionClient is a mock of TransactionClient interface
-type MockTransactionClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockTransactionClientMockRecorder
+// SignSafeProposal mocks base method
+func (m *MockOrchestrateClient) SignSafeProposal(ctx context.Context, uuid string, request *types.SignSafeProposalRequest) (*types.SafeProposalResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignSafeProposal", ctx, uuid, request)
+	ret0, _ := ret[0].(*types.SafeProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockTransactionClientMockRecorder is the mock recorder for MockTransactionClient
-type MockTransactionClientMockRecorder struct {
-	mock *MockTransactionClient
+// SignSafeProposal indicates an expected call of SignSafeProposal
+func (mr *MockOrchestrateClientMockRecorder) SignSafeProposal(ctx, uuid, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignSafeProposal", reflect.TypeOf((*MockOrchestrateClient)(nil).SignSafeProposal), ctx, uuid, request)
 }
 
-// NewMockTransactionClient creates a new mock instance
-func NewMockTransactionClient(ctrl *gomock.Controller) *MockTransactionClient {
-	mock := &MockTransactionClient{ctrl: ctrl}
-	mock.recorder = &MockTransactionClientMockRecorder{mock}
-	return mock
+// RegisterRelayer mocks base method
+func (m *MockOrchestrateClient) RegisterRelayer(ctx context.Context, request *types.RegisterRelayerRequest) (*types.RelayerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterRelayer", ctx, request)
+	ret0, _ := ret[0].(*types.RelayerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockTransactionClient) EXPECT() *MockTransactionClientMockRecorder {
-	return m.recorder
+// RegisterRelayer indicates an expected call of RegisterRelayer
+func (mr *MockOrchestrateClientMockRecorder) RegisterRelayer(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRelayer", reflect.TypeOf((*MockOrchestrateClient)(nil).RegisterRelayer), ctx, request)
 }
 
-// SendContractTransaction mocks base method
-func (m *MockTransactionClient) SendContractTransaction(ctx context.Context, request *types.SendTransactionRequest) (*types.TransactionResponse, error) {
+// GetRelayer mocks base method
+func (m *MockOrchestrateClient) GetRelayer(ctx context.Context, uuid string) (*types.RelayerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendContractTransaction", ctx, request)
-	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret := m.ctrl.Call(m, "GetRelayer", ctx, uuid)
+	ret0, _ := ret[0].(*types.RelayerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendContractTransaction indicates an expected call of SendContractTransaction
-func (mr *MockTransactionClientMockRecorder) SendContractTransaction(ctx, request interface{}) *gomock.Call {
+// GetRelayer indicates an expected call of GetRelayer
+func (mr *MockOrchestrateClientMockRecorder) GetRelayer(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendContractTransaction), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelayer", reflect.TypeOf((*MockOrchestrateClient)(nil).GetRelayer), ctx, uuid)
 }
 
-// SendDeployTransaction mocks base method
-func (m *MockTransactionClient) SendDeployTransaction(ctx context.Context, request *types.DeployContractRequest) (*types.TransactionResponse, error) {
+// SearchRelayers mocks base method
+func (m *MockOrchestrateClient) SearchRelayers(ctx context.Context, filters *entities.RelayerFilters) ([]*types.RelayerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendDeployTransaction", ctx, request)
-	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret := m.ctrl.Call(m, "SearchRelayers", ctx, filters)
+	ret0, _ := ret[0].([]*types.RelayerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendDeployTransaction indicates an expected call of SendDeployTransaction
-func (mr *MockTransactionClientMockRecorder) SendDeployTransaction(ctx, request interface{}) *gomock.Call {
+// SearchRelayers indicates an expected call of SearchRelayers
+func (mr *MockOrchestrateClientMockRecorder) SearchRelayers(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDeployTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendDeployTransaction), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRelayers", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchRelayers), ctx, filters)
 }
 
-// SendRawTransaction mocks base method
-func (m *MockTransactionClient) SendRawTransaction(ctx context.Context, request *types.RawTransactionRequest) (*types.TransactionResponse, error) {
+// SearchRelayerPages mocks base method
+func (m *MockOrchestrateClient) SearchRelayerPages(ctx context.Context, filters *entities.RelayerFilters, fn func([]*types.RelayerResponse) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendRawTransaction", ctx, request)
-	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret := m.ctrl.Call(m, "SearchRelayerPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchRelayerPages indicates an expected call of SearchRelayerPages
+func (mr *MockOrchestrateClientMockRecorder) SearchRelayerPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRelayerPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchRelayerPages), ctx, filters, fn)
+}
+
+// DeleteRelayer mocks base method
+func (m *MockOrchestrateClient) DeleteRelayer(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRelayer", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRelayer indicates an expected call of DeleteRelayer
+func (mr *MockOrchestrateClientMockRecorder) DeleteRelayer(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRelayer", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteRelayer), ctx, uuid)
+}
+
+// SearchRelaySpendings mocks base method
+func (m *MockOrchestrateClient) SearchRelaySpendings(ctx context.Context, filters *entities.RelaySpendingFilters) ([]*types.RelaySpendingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRelaySpendings", ctx, filters)
+	ret0, _ := ret[0].([]*types.RelaySpendingResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendRawTransaction indicates an expected call of SendRawTransaction
-func (mr *MockTransactionClientMockRecorder) SendRawTransaction(ctx, request interface{}) *gomock.Call {
+// SearchRelaySpendings indicates an expected call of SearchRelaySpendings
+func (mr *MockOrchestrateClientMockRecorder) SearchRelaySpendings(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendRawTransaction), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRelaySpendings", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchRelaySpendings), ctx, filters)
 }
 
-// SendTransferTransaction mocks base method
-func (m *MockTransactionClient) SendTransferTransaction(ctx context.Context, request *types.TransferRequest) (*types.TransactionResponse, error) {
+// SendRelayTransaction mocks base method
+func (m *MockOrchestrateClient) SendRelayTransaction(ctx context.Context, txRequest *types.RelayTransactionRequest) (*types.TransactionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendTransferTransaction", ctx, request)
+	ret := m.ctrl.Call(m, "SendRelayTransaction", ctx, txRequest)
 	ret0, _ := ret[0].(*types.TransactionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendTransferTransaction indicates an expected call of SendTransferTransaction
-func (mr *MockTransactionClientMockRecorder) SendTransferTransaction(ctx, request interface{}) *gomock.Call {
+// SendRelayTransaction indicates an expected call of SendRelayTransaction
+func (mr *MockOrchestrateClientMockRecorder) SendRelayTransaction(ctx, txRequest interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendTransferTransaction), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRelayTransaction", reflect.TypeOf((*MockOrchestrateClient)(nil).SendRelayTransaction), ctx, txRequest)
 }
 
-// GetTxRequest mocks base method
-func (m *MockTransactionClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
+// CreateSentrySession mocks base method
+func (m *MockOrchestrateClient) CreateSentrySession(ctx context.Context, request *types.CreateSentrySessionRequest) (*types.SentrySessionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTxRequest", ctx, txRequestUUID)
-	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret := m.ctrl.Call(m, "CreateSentrySession", ctx, request)
+	ret0, _ := ret[0].(*types.SentrySessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTxRequest indicates an expected call of GetTxRequest
-func (mr *MockTransactionClientMockRecorder) GetTxRequest(ctx, txRequestUUID interface{}) *gomock.Call {
+// CreateSentrySession indicates an expected call of CreateSentrySession
+func (mr *MockOrchestrateClientMockRecorder) CreateSentrySession(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxRequest", reflect.TypeOf((*MockTransactionClient)(nil).GetTxRequest), ctx, txRequestUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSentrySession", reflect.TypeOf((*MockOrchestrateClient)(nil).CreateSentrySession), ctx, request)
 }
 
-// SendCallOffTransaction mocks base method
-func (m *MockTransactionClient) SendCallOffTransaction(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
+// GetSentrySession mocks base method
+func (m *MockOrchestrateClient) GetSentrySession(ctx context.Context, jobUUID string) (*types.SentrySessionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendCallOffTransaction", ctx, txRequestUUID)
-	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret := m.ctrl.Call(m, "GetSentrySession", ctx, jobUUID)
+	ret0, _ := ret[0].(*types.SentrySessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendCallOffTransaction indicates an expected call of SendCallOffTransaction
-func (mr *MockTransactionClientMockRecorder) SendCallOffTransaction(ctx, txRequestUUID interface{}) *gomock.Call {
+// GetSentrySession indicates an expected call of GetSentrySession
+func (mr *MockOrchestrateClientMockRecorder) GetSentrySession(ctx, jobUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCallOffTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendCallOffTransaction), ctx, txRequestUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentrySession", reflect.TypeOf((*MockOrchestrateClient)(nil).GetSentrySession), ctx, jobUUID)
 }
 
-// SendSpeedUpTransaction mocks base method
-func (m *MockTransactionClient) SendSpeedUpTransaction(ctx context.Context, txRequestUUID string, increment *float64) (*types.TransactionResponse, error) {
+// UpdateSentrySession mocks base method
+func (m *MockOrchestrateClient) UpdateSentrySession(ctx context.Context, jobUUID string, request *types.UpdateSentrySessionRequest) (*types.SentrySessionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendSpeedUpTransaction", ctx, txRequestUUID, increment)
-	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret := m.ctrl.Call(m, "UpdateSentrySession", ctx, jobUUID, request)
+	ret0, _ := ret[0].(*types.SentrySessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendSpeedUpTransaction indicates an expected call of SendSpeedUpTransaction
-func (mr *MockTransactionClientMockRecorder) SendSpeedUpTransaction(ctx, txRequestUUID, increment interface{}) *gomock.Call {
+// UpdateSentrySession indicates an expected call of UpdateSentrySession
+func (mr *MockOrchestrateClientMockRecorder) UpdateSentrySession(ctx, jobUUID, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSpeedUpTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendSpeedUpTransaction), ctx, txRequestUUID, increment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSentrySession", reflect.TypeOf((*MockOrchestrateClient)(nil).UpdateSentrySession), ctx, jobUUID, request)
 }
 
-// MockScheduleClient is a mock of ScheduleClient interface
-type MockScheduleClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockScheduleClientMockRecorder
+// SearchSentrySessions mocks base method
+func (m *MockOrchestrateClient) SearchSentrySessions(ctx context.Context, filters *entities.SentrySessionFilters) ([]*types.SentrySessionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSentrySessions", ctx, filters)
+	ret0, _ := ret[0].([]*types.SentrySessionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockScheduleClientMockRecorder is the mock recorder for MockScheduleClient
-type MockScheduleClientMockRecorder struct {
-	mock *MockScheduleClient
+// SearchSentrySessions indicates an expected call of SearchSentrySessions
+func (mr *MockOrchestrateClientMockRecorder) SearchSentrySessions(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSentrySessions", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchSentrySessions), ctx, filters)
 }
 
-// NewMockScheduleClient creates a new mock instance
-func NewMockScheduleClient(ctrl *gomock.Controller) *MockScheduleClient {
-	mock := &MockScheduleClient{ctrl: ctrl}
-	mock.recorder = &MockScheduleClientMockRecorder{mock}
-	return mock
+// DeleteSentrySession mocks base method
+func (m *MockOrchestrateClient) DeleteSentrySession(ctx context.Context, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSentrySession", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockScheduleClient) EXPECT() *MockScheduleClientMockRecorder {
-	return m.recorder
+// DeleteSentrySession indicates an expected call of DeleteSentrySession
+func (mr *MockOrchestrateClientMockRecorder) DeleteSentrySession(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSentrySession", reflect.TypeOf((*MockOrchestrateClient)(nil).DeleteSentrySession), ctx, jobUUID)
 }
 
-// GetSchedule mocks base method
-func (m *MockScheduleClient) GetSchedule(ctx context.Context, scheduleUUID string) (*types.ScheduleResponse, error) {
+// CreatePrivacyGroup mocks base method
+func (m *MockOrchestrateClient) CreatePrivacyGroup(ctx context.Context, request *types.CreatePrivacyGroupRequest) (*types.PrivacyGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSchedule", ctx, scheduleUUID)
-	ret0, _ := ret[0].(*types.ScheduleResponse)
+	ret := m.ctrl.Call(m, "CreatePrivacyGroup", ctx, request)
+	ret0, _ := ret[0].(*types.PrivacyGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSchedule indicates an expected call of GetSchedule
-func (mr *MockScheduleClientMockRecorder) GetSchedule(ctx, scheduleUUID interface{}) *gomock.Call {
+// CreatePrivacyGroup indicates an expected call of CreatePrivacyGroup
+func (mr *MockOrchestrateClientMockRecorder) CreatePrivacyGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockScheduleClient)(nil).GetSchedule), ctx, scheduleUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivacyGroup", reflect.TypeOf((*MockOrchestrateClient)(nil).CreatePrivacyGroup), ctx, request)
 }
 
-// GetSchedules mocks base method
-func (m *MockScheduleClient) GetSchedules(ctx context.Context) ([]*types.ScheduleResponse, error) {
+// GetPrivacyGroup mocks base method
+func (m *MockOrchestrateClient) GetPrivacyGroup(ctx context.Context, uuid string) (*types.PrivacyGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSchedules", ctx)
-	ret0, _ := ret[0].([]*types.ScheduleResponse)
+	ret := m.ctrl.Call(m, "GetPrivacyGroup", ctx, uuid)
+	ret0, _ := ret[0].(*types.PrivacyGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSchedules indicates an expected call of GetSchedules
-func (mr *MockScheduleClientMockRecorder) GetSchedules(ctx interface{}) *gomock.Call {
+// GetPrivacyGroup indicates an expected call of GetPrivacyGroup
+func (mr *MockOrchestrateClientMockRecorder) GetPrivacyGroup(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockScheduleClient)(nil).GetSchedules), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacyGroup", reflect.TypeOf((*MockOrchestrateClient)(nil).GetPrivacyGroup), ctx, uuid)
 }
 
-// CreateSchedule mocks base method
-func (m *MockScheduleClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest) (*types.ScheduleResponse, error) {
+// SearchPrivacyGroups mocks base method
+func (m *MockOrchestrateClient) SearchPrivacyGroups(ctx context.Context, filters *entities.PrivacyGroupFilters) ([]*types.PrivacyGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPrivacyGroups", ctx, filters)
+	ret0, _ := ret[0].([]*types.PrivacyGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPrivacyGroups indicates an expected call of SearchPrivacyGroups
+func (mr *MockOrchestrateClientMockRecorder) SearchPrivacyGroups(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPrivacyGroups", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchPrivacyGroups), ctx, filters)
+}
+
+// SearchPrivacyGroupPages mocks base method
+func (m *MockOrchestrateClient) SearchPrivacyGroupPages(ctx context.Context, filters *entities.PrivacyGroupFilters, fn func([]*types.PrivacyGroupResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPrivacyGroupPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchPrivacyGroupPages indicates an expected call of SearchPrivacyGroupPages
+func (mr *MockOrchestrateClientMockRecorder) SearchPrivacyGroupPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPrivacyGroupPages", reflect.TypeOf((*MockOrchestrateClient)(nil).SearchPrivacyGroupPages), ctx, filters, fn)
+}
+
+// FindPrivacyGroups mocks base method
+func (m *MockOrchestrateClient) FindPrivacyGroups(ctx context.Context, request *types.FindPrivacyGroupsRequest) ([]*types.OnChainPrivacyGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrivacyGroups", ctx, request)
+	ret0, _ := ret[0].([]*types.OnChainPrivacyGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrivacyGroups indicates an expected call of FindPrivacyGroups
+func (mr *MockOrchestrateClientMockRecorder) FindPrivacyGroups(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrivacyGroups", reflect.TypeOf((*MockOrchestrateClient)(nil).FindPrivacyGroups), ctx, request)
+}
+
+// DeletePrivacyGroup mocks base method
+func (m *MockOrchestrateClient) DeletePrivacyGroup(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivacyGroup", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivacyGroup indicates an expected call of DeletePrivacyGroup
+func (mr *MockOrchestrateClientMockRecorder) DeletePrivacyGroup(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivacyGroup", reflect.TypeOf((*MockOrchestrateClient)(nil).DeletePrivacyGroup), ctx, uuid)
+}
+
+// MockChainProxyClient is a mock of ChainProxyClient interface
+type MockChainProxyClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainProxyClientMockRecorder
+}
+
+// MockChainProxyClientMockRecorder is the mock recorder for MockChainProxyClient
+type MockChainProxyClientMockRecorder struct {
+	mock *MockChainProxyClient
+}
+
+// NewMockChainProxyClient creates a new mock instance
+func NewMockChainProxyClient(ctrl *gomock.Controller) *MockChainProxyClient {
+	mock := &MockChainProxyClient{ctrl: ctrl}
+	mock.recorder = &MockChainProxyClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChainProxyClient) EXPECT() *MockChainProxyClientMockRecorder {
+	return m.recorder
+}
+
+// ChainProxyURL mocks base method
+func (m *MockChainProxyClient) ChainProxyURL(chainUUID string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainProxyURL", chainUUID)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ChainProxyURL indicates an expected call of ChainProxyURL
+func (mr *MockChainProxyClientMockRecorder) ChainProxyURL(chainUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainProxyURL", reflect.TypeOf((*MockChainProxyClient)(nil).ChainProxyURL), chainUUID)
+}
+
+// ChainTesseraProxyURL mocks base method
+func (m *MockChainProxyClient) ChainTesseraProxyURL(chainUUID string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainTesseraProxyURL", chainUUID)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ChainTesseraProxyURL indicates an expected call of ChainTesseraProxyURL
+func (mr *MockChainProxyClientMockRecorder) ChainTesseraProxyURL(chainUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainTesseraProxyURL", reflect.TypeOf((*MockChainProxyClient)(nil).ChainTesseraProxyURL), chainUUID)
+}
+
+// MockTransactionClient is a mock of TransactionClient interface
+type MockTransactionClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionClientMockRecorder
+}
+
+// MockTransactionClientMockRecorder is the mock recorder for MockTransactionClient
+type MockTransactionClientMockRecorder struct {
+	mock *MockTransactionClient
+}
+
+// NewMockTransactionClient creates a new mock instance
+func NewMockTransactionClient(ctrl *gomock.Controller) *MockTransactionClient {
+	mock := &MockTransactionClient{ctrl: ctrl}
+	mock.recorder = &MockTransactionClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactionClient) EXPECT() *MockTransactionClientMockRecorder {
+	return m.recorder
+}
+
+// SendContractTransaction mocks base method
+func (m *MockTransactionClient) SendContractTransaction(ctx context.Context, request *types.SendTransactionRequest) (*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendContractTransaction", ctx, request)
+	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendContractTransaction indicates an expected call of SendContractTransaction
+func (mr *MockTransactionClientMockRecorder) SendContractTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendContractTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendContractTransaction), ctx, request)
+}
+
+// SendDeployTransaction mocks base method
+func (m *MockTransactionClient) SendDeployTransaction(ctx context.Context, request *types.DeployContractRequest) (*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDeployTransaction", ctx, request)
+	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendDeployTransaction indicates an expected call of SendDeployTransaction
+func (mr *MockTransactionClientMockRecorder) SendDeployTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDeployTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendDeployTransaction), ctx, request)
+}
+
+// SendRawTransaction mocks base method
+func (m *MockTransactionClient) SendRawTransaction(ctx context.Context, request *types.RawTransactionRequest) (*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRawTransaction", ctx, request)
+	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendRawTransaction indicates an expected call of SendRawTransaction
+func (mr *MockTransactionClientMockRecorder) SendRawTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendRawTransaction), ctx, request)
+}
+
+// SendTransferTransaction mocks base method
+func (m *MockTransactionClient) SendTransferTransaction(ctx context.Context, request *types.TransferRequest) (*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransferTransaction", ctx, request)
+	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransferTransaction indicates an expected call of SendTransferTransaction
+func (mr *MockTransactionClientMockRecorder) SendTransferTransaction(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransferTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendTransferTransaction), ctx, request)
+}
+
+// SendTransactionBatch mocks base method
+func (m *MockTransactionClient) SendTransactionBatch(ctx context.Context, request *types.SendTransactionBatchRequest) (*types.TransactionBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTransactionBatch", ctx, request)
+	ret0, _ := ret[0].(*types.TransactionBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTransactionBatch indicates an expected call of SendTransactionBatch
+func (mr *MockTransactionClientMockRecorder) SendTransactionBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTransactionBatch", reflect.TypeOf((*MockTransactionClient)(nil).SendTransactionBatch), ctx, request)
+}
+
+// GetTxRequest mocks base method
+func (m *MockTransactionClient) GetTxRequest(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTxRequest", ctx, txRequestUUID)
+	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxRequest indicates an expected call of GetTxRequest
+func (mr *MockTransactionClientMockRecorder) GetTxRequest(ctx, txRequestUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxRequest", reflect.TypeOf((*MockTransactionClient)(nil).GetTxRequest), ctx, txRequestUUID)
+}
+
+// SendCallOffTransaction mocks base method
+func (m *MockTransactionClient) SendCallOffTransaction(ctx context.Context, txRequestUUID string) (*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCallOffTransaction", ctx, txRequestUUID)
+	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendCallOffTransaction indicates an expected call of SendCallOffTransaction
+func (mr *MockTransactionClientMockRecorder) SendCallOffTransaction(ctx, txRequestUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCallOffTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendCallOffTransaction), ctx, txRequestUUID)
+}
+
+// SendSpeedUpTransaction mocks base method
+func (m *MockTransactionClient) SendSpeedUpTransaction(ctx context.Context, txRequestUUID string, increment *float64) (*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSpeedUpTransaction", ctx, txRequestUUID, increment)
+	ret0, _ := ret[0].(*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendSpeedUpTransaction indicates an expected call of SendSpeedUpTransaction
+func (mr *MockTransactionClientMockRecorder) SendSpeedUpTransaction(ctx, txRequestUUID, increment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSpeedUpTransaction", reflect.TypeOf((*MockTransactionClient)(nil).SendSpeedUpTransaction), ctx, txRequestUUID, increment)
+}
+
+// SearchTransactions mocks base method
+func (m *MockTransactionClient) SearchTransactions(ctx context.Context, filters *entities.TransactionRequestFilters) ([]*types.TransactionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactions", ctx, filters)
+	ret0, _ := ret[0].([]*types.TransactionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTransactions indicates an expected call of SearchTransactions
+func (mr *MockTransactionClientMockRecorder) SearchTransactions(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactions", reflect.TypeOf((*MockTransactionClient)(nil).SearchTransactions), ctx, filters)
+}
+
+// SearchTransactionPages mocks base method
+func (m *MockTransactionClient) SearchTransactionPages(ctx context.Context, filters *entities.TransactionRequestFilters, fn func([]*types.TransactionResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTransactionPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchTransactionPages indicates an expected call of SearchTransactionPages
+func (mr *MockTransactionClientMockRecorder) SearchTransactionPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTransactionPages", reflect.TypeOf((*MockTransactionClient)(nil).SearchTransactionPages), ctx, filters, fn)
+}
+
+// MockScheduleClient is a mock of ScheduleClient interface
+type MockScheduleClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleClientMockRecorder
+}
+
+// MockScheduleClientMockRecorder is the mock recorder for MockScheduleClient
+type MockScheduleClientMockRecorder struct {
+	mock *MockScheduleClient
+}
+
+// NewMockScheduleClient creates a new mock instance
+func NewMockScheduleClient(ctrl *gomock.Controller) *MockScheduleClient {
+	mock := &MockScheduleClient{ctrl: ctrl}
+	mock.recorder = &MockScheduleClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockScheduleClient) EXPECT() *MockScheduleClientMockRecorder {
+	return m.recorder
+}
+
+// GetSchedule mocks base method
+func (m *MockScheduleClient) GetSchedule(ctx context.Context, scheduleUUID string) (*types.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedule", ctx, scheduleUUID)
+	ret0, _ := ret[0].(*types.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedule indicates an expected call of GetSchedule
+func (mr *MockScheduleClientMockRecorder) GetSchedule(ctx, scheduleUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedule", reflect.TypeOf((*MockScheduleClient)(nil).GetSchedule), ctx, scheduleUUID)
+}
+
+// GetSchedules mocks base method
+func (m *MockScheduleClient) GetSchedules(ctx context.Context) ([]*types.ScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedules", ctx)
+	ret0, _ := ret[0].([]*types.ScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedules indicates an expected call of GetSchedules
+func (mr *MockScheduleClientMockRecorder) GetSchedules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockScheduleClient)(nil).GetSchedules), ctx)
+}
+
+// CreateSchedule mocks base method
+func (m *MockScheduleClient) CreateSchedule(ctx context.Context, request *types.CreateScheduleRequest) (*types.ScheduleResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSchedule", ctx, request)
 	ret0, _ := ret[0].(*types.ScheduleResponse)
@@ -1064,774 +1606,1320 @@ func (m *MockScheduleClient) CreateSchedule(ctx context.Context, request *types.
 	return ret0, ret1
 }
 
-// CreateSchedule indicates an expected call of CreateSchedule
-func (mr *MockScheduleClientMockRecorder) CreateSchedule(ctx, request interface{}) *gomock.Call {
+// CreateSchedule indicates an expected call of CreateSchedule
+func (mr *MockScheduleClientMockRecorder) CreateSchedule(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleClient)(nil).CreateSchedule), ctx, request)
+}
+
+// MockJobClient is a mock of JobClient interface
+type MockJobClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobClientMockRecorder
+}
+
+// MockJobClientMockRecorder is the mock recorder for MockJobClient
+type MockJobClientMockRecorder struct {
+	mock *MockJobClient
+}
+
+// NewMockJobClient creates a new mock instance
+func NewMockJobClient(ctrl *gomock.Controller) *MockJobClient {
+	mock := &MockJobClient{ctrl: ctrl}
+	mock.recorder = &MockJobClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockJobClient) EXPECT() *MockJobClientMockRecorder {
+	return m.recorder
+}
+
+// GetJob mocks base method
+func (m *MockJobClient) GetJob(ctx context.Context, jobUUID string) (*types.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobUUID)
+	ret0, _ := ret[0].(*types.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob
+func (mr *MockJobClientMockRecorder) GetJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobClient)(nil).GetJob), ctx, jobUUID)
+}
+
+// GetJobs mocks base method
+func (m *MockJobClient) GetJobs(ctx context.Context) ([]*types.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", ctx)
+	ret0, _ := ret[0].([]*types.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs
+func (mr *MockJobClientMockRecorder) GetJobs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockJobClient)(nil).GetJobs), ctx)
+}
+
+// CreateJob mocks base method
+func (m *MockJobClient) CreateJob(ctx context.Context, request *types.CreateJobRequest) (*types.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, request)
+	ret0, _ := ret[0].(*types.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob
+func (mr *MockJobClientMockRecorder) CreateJob(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockJobClient)(nil).CreateJob), ctx, request)
+}
+
+// UpdateJob mocks base method
+func (m *MockJobClient) UpdateJob(ctx context.Context, jobUUID string, request *types.UpdateJobRequest) (*types.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", ctx, jobUUID, request)
+	ret0, _ := ret[0].(*types.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateJob indicates an expected call of UpdateJob
+func (mr *MockJobClientMockRecorder) UpdateJob(ctx, jobUUID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockJobClient)(nil).UpdateJob), ctx, jobUUID, request)
+}
+
+// StartJob mocks base method
+func (m *MockJobClient) StartJob(ctx context.Context, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartJob", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartJob indicates an expected call of StartJob
+func (mr *MockJobClientMockRecorder) StartJob(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJob", reflect.TypeOf((*MockJobClient)(nil).StartJob), ctx, jobUUID)
+}
+
+// ResendJobTx mocks base method
+func (m *MockJobClient) ResendJobTx(ctx context.Context, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendJobTx", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendJobTx indicates an expected call of ResendJobTx
+func (mr *MockJobClientMockRecorder) ResendJobTx(ctx, jobUUID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendJobTx", reflect.TypeOf((*MockJobClient)(nil).ResendJobTx), ctx, jobUUID)
+}
+
+// SearchJob mocks base method
+func (m *MockJobClient) SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJob", ctx, filters)
+	ret0, _ := ret[0].([]*types.JobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchJob indicates an expected call of SearchJob
+func (mr *MockJobClientMockRecorder) SearchJob(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJob", reflect.TypeOf((*MockJobClient)(nil).SearchJob), ctx, filters)
+}
+
+// SearchJobPages mocks base method
+func (m *MockJobClient) SearchJobPages(ctx context.Context, filters *entities.JobFilters, fn func([]*types.JobResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchJobPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchJobPages indicates an expected call of SearchJobPages
+func (mr *MockJobClientMockRecorder) SearchJobPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJobPages", reflect.TypeOf((*MockJobClient)(nil).SearchJobPages), ctx, filters, fn)
+}
+
+// MockMetricClient is a mock of MetricClient interface
+type MockMetricClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricClientMockRecorder
+}
+
+// MockMetricClientMockRecorder is the mock recorder for MockMetricClient
+type MockMetricClientMockRecorder struct {
+	mock *MockMetricClient
+}
+
+// NewMockMetricClient creates a new mock instance
+func NewMockMetricClient(ctrl *gomock.Controller) *MockMetricClient {
+	mock := &MockMetricClient{ctrl: ctrl}
+	mock.recorder = &MockMetricClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockMetricClient) EXPECT() *MockMetricClientMockRecorder {
+	return m.recorder
+}
+
+// Checker mocks base method
+func (m *MockMetricClient) Checker() healthcheck.Check {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checker")
+	ret0, _ := ret[0].(healthcheck.Check)
+	return ret0
+}
+
+// Checker indicates an expected call of Checker
+func (mr *MockMetricClientMockRecorder) Checker() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checker", reflect.TypeOf((*MockMetricClient)(nil).Checker))
+}
+
+// Prometheus mocks base method
+func (m *MockMetricClient) Prometheus(arg0 context.Context) (map[string]*io_prometheus_client.MetricFamily, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prometheus", arg0)
+	ret0, _ := ret[0].(map[string]*io_prometheus_client.MetricFamily)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prometheus indicates an expected call of Prometheus
+func (mr *MockMetricClientMockRecorder) Prometheus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prometheus", reflect.TypeOf((*MockMetricClient)(nil).Prometheus), arg0)
+}
+
+// MockAccountClient is a mock of AccountClient interface
+type MockAccountClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountClientMockRecorder
+}
+
+// MockAccountClientMockRecorder is the mock recorder for MockAccountClient
+type MockAccountClientMockRecorder struct {
+	mock *MockAccountClient
+}
+
+// NewMockAccountClient creates a new mock instance
+func NewMockAccountClient(ctrl *gomock.Controller) *MockAccountClient {
+	mock := &MockAccountClient{ctrl: ctrl}
+	mock.recorder = &MockAccountClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAccountClient) EXPECT() *MockAccountClientMockRecorder {
+	return m.recorder
+}
+
+// CreateAccount mocks base method
+func (m *MockAccountClient) CreateAccount(ctx context.Context, request *types.CreateAccountRequest) (*types.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, request)
+	ret0, _ := ret[0].(*types.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccount indicates an expected call of CreateAccount
+func (mr *MockAccountClientMockRecorder) CreateAccount(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountClient)(nil).CreateAccount), ctx, request)
+}
+
+// SearchAccounts mocks base method
+func (m *MockAccountClient) SearchAccounts(ctx context.Context, filters *entities.AccountFilters) ([]*types.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccounts", ctx, filters)
+	ret0, _ := ret[0].([]*types.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAccounts indicates an expected call of SearchAccounts
+func (mr *MockAccountClientMockRecorder) SearchAccounts(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockAccountClient)(nil).SearchAccounts), ctx, filters)
+}
+
+// SearchAccountPages mocks base method
+func (m *MockAccountClient) SearchAccountPages(ctx context.Context, filters *entities.AccountFilters, fn func([]*types.AccountResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAccountPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchAccountPages indicates an expected call of SearchAccountPages
+func (mr *MockAccountClientMockRecorder) SearchAccountPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccountPages", reflect.TypeOf((*MockAccountClient)(nil).SearchAccountPages), ctx, filters, fn)
+}
+
+// GetAccount mocks base method
+func (m *MockAccountClient) GetAccount(ctx context.Context, address common.Address) (*types.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, address)
+	ret0, _ := ret[0].(*types.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount
+func (mr *MockAccountClientMockRecorder) GetAccount(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountClient)(nil).GetAccount), ctx, address)
+}
+
+// ImportAccount mocks base method
+func (m *MockAccountClient) ImportAccount(ctx context.Context, request *types.ImportAccountRequest) (*types.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAccount", ctx, request)
+	ret0, _ := ret[0].(*types.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAccount indicates an expected call of ImportAccount
+func (mr *MockAccountClientMockRecorder) ImportAccount(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccount", reflect.TypeOf((*MockAccountClient)(nil).ImportAccount), ctx, request)
+}
+
+// UpdateAccount mocks base method
+func (m *MockAccountClient) UpdateAccount(ctx context.Context, address common.Address, request *types.UpdateAccountRequest) (*types.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccount", ctx, address, request)
+	ret0, _ := ret[0].(*types.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccount indicates an expected call of UpdateAccount
+func (mr *MockAccountClientMockRecorder) UpdateAccount(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountClient)(nil).UpdateAccount), ctx, address, request)
+}
+
+// RotateAccount mocks base method
+func (m *MockAccountClient) RotateAccount(ctx context.Context, address common.Address, request *types.RotateAccountRequest) (*types.AccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateAccount", ctx, address, request)
+	ret0, _ := ret[0].(*types.AccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateAccount indicates an expected call of RotateAccount
+func (mr *MockAccountClientMockRecorder) RotateAccount(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateAccount", reflect.TypeOf((*MockAccountClient)(nil).RotateAccount), ctx, address, request)
+}
+
+// SignMessage mocks base method
+func (m *MockAccountClient) SignMessage(ctx context.Context, address common.Address, request *types0.SignMessageRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMessage", ctx, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignMessage indicates an expected call of SignMessage
+func (mr *MockAccountClientMockRecorder) SignMessage(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessage", reflect.TypeOf((*MockAccountClient)(nil).SignMessage), ctx, address, request)
+}
+
+// SignTypedData mocks base method
+func (m *MockAccountClient) SignTypedData(ctx context.Context, address common.Address, request *types0.SignTypedDataRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTypedData", ctx, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTypedData indicates an expected call of SignTypedData
+func (mr *MockAccountClientMockRecorder) SignTypedData(ctx, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTypedData", reflect.TypeOf((*MockAccountClient)(nil).SignTypedData), ctx, address, request)
+}
+
+// VerifyMessageSignature mocks base method
+func (m *MockAccountClient) VerifyMessageSignature(ctx context.Context, request *types1.VerifyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMessageSignature", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyMessageSignature indicates an expected call of VerifyMessageSignature
+func (mr *MockAccountClientMockRecorder) VerifyMessageSignature(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMessageSignature", reflect.TypeOf((*MockAccountClient)(nil).VerifyMessageSignature), ctx, request)
+}
+
+// VerifyTypedDataSignature mocks base method
+func (m *MockAccountClient) VerifyTypedDataSignature(ctx context.Context, request *types1.VerifyTypedDataRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyTypedDataSignature", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyTypedDataSignature indicates an expected call of VerifyTypedDataSignature
+func (mr *MockAccountClientMockRecorder) VerifyTypedDataSignature(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTypedDataSignature", reflect.TypeOf((*MockAccountClient)(nil).VerifyTypedDataSignature), ctx, request)
+}
+
+// MockFaucetClient is a mock of FaucetClient interface
+type MockFaucetClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFaucetClientMockRecorder
+}
+
+// MockFaucetClientMockRecorder is the mock recorder for MockFaucetClient
+type MockFaucetClientMockRecorder struct {
+	mock *MockFaucetClient
+}
+
+// NewMockFaucetClient creates a new mock instance
+func NewMockFaucetClient(ctrl *gomock.Controller) *MockFaucetClient {
+	mock := &MockFaucetClient{ctrl: ctrl}
+	mock.recorder = &MockFaucetClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFaucetClient) EXPECT() *MockFaucetClientMockRecorder {
+	return m.recorder
+}
+
+// RegisterFaucet mocks base method
+func (m *MockFaucetClient) RegisterFaucet(ctx context.Context, request *types.RegisterFaucetRequest) (*types.FaucetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFaucet", ctx, request)
+	ret0, _ := ret[0].(*types.FaucetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFaucet indicates an expected call of RegisterFaucet
+func (mr *MockFaucetClientMockRecorder) RegisterFaucet(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockScheduleClient)(nil).CreateSchedule), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFaucet", reflect.TypeOf((*MockFaucetClient)(nil).RegisterFaucet), ctx, request)
 }
 
-// MockJobClient is a mock of JobClient interface
-type MockJobClient struct {
+// UpdateFaucet mocks base method
+func (m *MockFaucetClient) UpdateFaucet(ctx context.Context, uuid string, request *types.UpdateFaucetRequest) (*types.FaucetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFaucet", ctx, uuid, request)
+	ret0, _ := ret[0].(*types.FaucetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFaucet indicates an expected call of UpdateFaucet
+func (mr *MockFaucetClientMockRecorder) UpdateFaucet(ctx, uuid, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaucet", reflect.TypeOf((*MockFaucetClient)(nil).UpdateFaucet), ctx, uuid, request)
+}
+
+// GetFaucet mocks base method
+func (m *MockFaucetClient) GetFaucet(ctx context.Context, uuid string) (*types.FaucetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFaucet", ctx, uuid)
+	ret0, _ := ret[0].(*types.FaucetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFaucet indicates an expected call of GetFaucet
+func (mr *MockFaucetClientMockRecorder) GetFaucet(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFaucet", reflect.TypeOf((*MockFaucetClient)(nil).GetFaucet), ctx, uuid)
+}
+
+// SearchFaucets mocks base method
+func (m *MockFaucetClient) SearchFaucets(ctx context.Context, filters *entities.FaucetFilters) ([]*types.FaucetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFaucets", ctx, filters)
+	ret0, _ := ret[0].([]*types.FaucetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchFaucets indicates an expected call of SearchFaucets
+func (mr *MockFaucetClientMockRecorder) SearchFaucets(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFaucets", reflect.TypeOf((*MockFaucetClient)(nil).SearchFaucets), ctx, filters)
+}
+
+// SearchFaucetPages mocks base method
+func (m *MockFaucetClient) SearchFaucetPages(ctx context.Context, filters *entities.FaucetFilters, fn func([]*types.FaucetResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchFaucetPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchFaucetPages indicates an expected call of SearchFaucetPages
+func (mr *MockFaucetClientMockRecorder) SearchFaucetPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFaucetPages", reflect.TypeOf((*MockFaucetClient)(nil).SearchFaucetPages), ctx, filters, fn)
+}
+
+// DeleteFaucet mocks base method
+func (m *MockFaucetClient) DeleteFaucet(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFaucet", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFaucet indicates an expected call of DeleteFaucet
+func (mr *MockFaucetClientMockRecorder) DeleteFaucet(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFaucet", reflect.TypeOf((*MockFaucetClient)(nil).DeleteFaucet), ctx, uuid)
+}
+
+// MockChainClient is a mock of ChainClient interface
+type MockChainClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockJobClientMockRecorder
+	recorder *MockChainClientMockRecorder
 }
 
-// MockJobClientMockRecorder is the mock recorder for MockJobClient
-type MockJobClientMockRecorder struct {
-	mock *MockJobClient
+// MockChainClientMockRecorder is the mock recorder for MockChainClient
+type MockChainClientMockRecorder struct {
+	mock *MockChainClient
 }
 
-// NewMockJobClient creates a new mock instance
-func NewMockJobClient(ctrl *gomock.Controller) *MockJobClient {
-	mock := &MockJobClient{ctrl: ctrl}
-	mock.recorder = &MockJobClientMockRecorder{mock}
+// NewMockChainClient creates a new mock instance
+func NewMockChainClient(ctrl *gomock.Controller) *MockChainClient {
+	mock := &MockChainClient{ctrl: ctrl}
+	mock.recorder = &MockChainClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockJobClient) EXPECT() *MockJobClientMockRecorder {
+func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
 	return m.recorder
 }
 
-// GetJob mocks base method
-func (m *MockJobClient) GetJob(ctx context.Context, jobUUID string) (*types.JobResponse, error) {
+// RegisterChain mocks base method
+func (m *MockChainClient) RegisterChain(ctx context.Context, request *types.RegisterChainRequest) (*types.ChainResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetJob", ctx, jobUUID)
-	ret0, _ := ret[0].(*types.JobResponse)
+	ret := m.ctrl.Call(m, "RegisterChain", ctx, request)
+	ret0, _ := ret[0].(*types.ChainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetJob indicates an expected call of GetJob
-func (mr *MockJobClientMockRecorder) GetJob(ctx, jobUUID interface{}) *gomock.Call {
+// RegisterChain indicates an expected call of RegisterChain
+func (mr *MockChainClientMockRecorder) RegisterChain(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobClient)(nil).GetJob), ctx, jobUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterChain", reflect.TypeOf((*MockChainClient)(nil).RegisterChain), ctx, request)
 }
 
-// GetJobs mocks base method
-func (m *MockJobClient) GetJobs(ctx context.Context) ([]*types.JobResponse, error) {
+// UpdateChain mocks base method
+func (m *MockChainClient) UpdateChain(ctx context.Context, uuid string, request *types.UpdateChainRequest) (*types.ChainResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetJobs", ctx)
-	ret0, _ := ret[0].([]*types.JobResponse)
+	ret := m.ctrl.Call(m, "UpdateChain", ctx, uuid, request)
+	ret0, _ := ret[0].(*types.ChainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetJobs indicates an expected call of GetJobs
-func (mr *MockJobClientMockRecorder) GetJobs(ctx interface{}) *gomock.Call {
+// UpdateChain indicates an expected call of UpdateChain
+func (mr *MockChainClientMockRecorder) UpdateChain(ctx, uuid, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockJobClient)(nil).GetJobs), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChain", reflect.TypeOf((*MockChainClient)(nil).UpdateChain), ctx, uuid, request)
 }
 
-// CreateJob mocks base method
-func (m *MockJobClient) CreateJob(ctx context.Context, request *types.CreateJobRequest) (*types.JobResponse, error) {
+// GetChain mocks base method
+func (m *MockChainClient) GetChain(ctx context.Context, uuid string) (*types.ChainResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateJob", ctx, request)
-	ret0, _ := ret[0].(*types.JobResponse)
+	ret := m.ctrl.Call(m, "GetChain", ctx, uuid)
+	ret0, _ := ret[0].(*types.ChainResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateJob indicates an expected call of CreateJob
-func (mr *MockJobClientMockRecorder) CreateJob(ctx, request interface{}) *gomock.Call {
+// GetChain indicates an expected call of GetChain
+func (mr *MockChainClientMockRecorder) GetChain(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockJobClient)(nil).CreateJob), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChain", reflect.TypeOf((*MockChainClient)(nil).GetChain), ctx, uuid)
+}
+
+// SearchChains mocks base method
+func (m *MockChainClient) SearchChains(ctx context.Context, filters *entities.ChainFilters) ([]*types.ChainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchChains", ctx, filters)
+	ret0, _ := ret[0].([]*types.ChainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchChains indicates an expected call of SearchChains
+func (mr *MockChainClientMockRecorder) SearchChains(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChains", reflect.TypeOf((*MockChainClient)(nil).SearchChains), ctx, filters)
+}
+
+// SearchChainPages mocks base method
+func (m *MockChainClient) SearchChainPages(ctx context.Context, filters *entities.ChainFilters, fn func([]*types.ChainResponse) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchChainPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SearchChainPages indicates an expected call of SearchChainPages
+func (mr *MockChainClientMockRecorder) SearchChainPages(ctx, filters, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChainPages", reflect.TypeOf((*MockChainClient)(nil).SearchChainPages), ctx, filters, fn)
+}
+
+// DeleteChain mocks base method
+func (m *MockChainClient) DeleteChain(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChain", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChain indicates an expected call of DeleteChain
+func (mr *MockChainClientMockRecorder) DeleteChain(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChain", reflect.TypeOf((*MockChainClient)(nil).DeleteChain), ctx, uuid)
+}
+
+// MockContractClient is a mock of ContractClient interface
+type MockContractClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContractClientMockRecorder
+}
+
+// MockContractClientMockRecorder is the mock recorder for MockContractClient
+type MockContractClientMockRecorder struct {
+	mock *MockContractClient
+}
+
+// NewMockContractClient creates a new mock instance
+func NewMockContractClient(ctrl *gomock.Controller) *MockContractClient {
+	mock := &MockContractClient{ctrl: ctrl}
+	mock.recorder = &MockContractClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockContractClient) EXPECT() *MockContractClientMockRecorder {
+	return m.recorder
 }
 
-// UpdateJob mocks base method
-func (m *MockJobClient) UpdateJob(ctx context.Context, jobUUID string, request *types.UpdateJobRequest) (*types.JobResponse, error) {
+// RegisterContract mocks base method
+func (m *MockContractClient) RegisterContract(ctx context.Context, req *types.RegisterContractRequest) (*types.ContractResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateJob", ctx, jobUUID, request)
-	ret0, _ := ret[0].(*types.JobResponse)
+	ret := m.ctrl.Call(m, "RegisterContract", ctx, req)
+	ret0, _ := ret[0].(*types.ContractResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateJob indicates an expected call of UpdateJob
-func (mr *MockJobClientMockRecorder) UpdateJob(ctx, jobUUID, request interface{}) *gomock.Call {
+// RegisterContract indicates an expected call of RegisterContract
+func (mr *MockContractClientMockRecorder) RegisterContract(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockJobClient)(nil).UpdateJob), ctx, jobUUID, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterContract", reflect.TypeOf((*MockContractClient)(nil).RegisterContract), ctx, req)
 }
 
-// StartJob mocks base method
-func (m *MockJobClient) StartJob(ctx context.Context, jobUUID string) error {
+// DeregisterContract mocks base method
+func (m *MockContractClient) DeregisterContract(ctx context.Context, name, tag string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartJob", ctx, jobUUID)
+	ret := m.ctrl.Call(m, "DeregisterContract", ctx, name, tag)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StartJob indicates an expected call of StartJob
-func (mr *MockJobClientMockRecorder) StartJob(ctx, jobUUID interface{}) *gomock.Call {
+// DeregisterContract indicates an expected call of DeregisterContract
+func (mr *MockContractClientMockRecorder) DeregisterContract(ctx, name, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartJob", reflect.TypeOf((*MockJobClient)(nil).StartJob), ctx, jobUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterContract", reflect.TypeOf((*MockContractClient)(nil).DeregisterContract), ctx, name, tag)
 }
 
-// ResendJobTx mocks base method
-func (m *MockJobClient) ResendJobTx(ctx context.Context, jobUUID string) error {
+// GetContract mocks base method
+func (m *MockContractClient) GetContract(ctx context.Context, name, tag string) (*types.ContractResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResendJobTx", ctx, jobUUID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetContract", ctx, name, tag)
+	ret0, _ := ret[0].(*types.ContractResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ResendJobTx indicates an expected call of ResendJobTx
-func (mr *MockJobClientMockRecorder) ResendJobTx(ctx, jobUUID interface{}) *gomock.Call {
+// GetContract indicates an expected call of GetContract
+func (mr *MockContractClientMockRecorder) GetContract(ctx, name, tag interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendJobTx", reflect.TypeOf((*MockJobClient)(nil).ResendJobTx), ctx, jobUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContract", reflect.TypeOf((*MockContractClient)(nil).GetContract), ctx, name, tag)
 }
 
-// SearchJob mocks base method
-func (m *MockJobClient) SearchJob(ctx context.Context, filters *entities.JobFilters) ([]*types.JobResponse, error) {
+// SearchContract mocks base method
+func (m *MockContractClient) SearchContract(ctx context.Context, req *types.SearchContractRequest) (*types.ContractResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchJob", ctx, filters)
-	ret0, _ := ret[0].([]*types.JobResponse)
+	ret := m.ctrl.Call(m, "SearchContract", ctx, req)
+	ret0, _ := ret[0].(*types.ContractResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchJob indicates an expected call of SearchJob
-func (mr *MockJobClientMockRecorder) SearchJob(ctx, filters interface{}) *gomock.Call {
+// SearchContract indicates an expected call of SearchContract
+func (mr *MockContractClientMockRecorder) SearchContract(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchJob", reflect.TypeOf((*MockJobClient)(nil).SearchJob), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContract", reflect.TypeOf((*MockContractClient)(nil).SearchContract), ctx, req)
 }
 
-// MockMetricClient is a mock of MetricClient interface
-type MockMetricClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockMetricClientMockRecorder
+// GetContractsCatalog mocks base method
+func (m *MockContractClient) GetContractsCatalog(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractsCatalog", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockMetricClientMockRecorder is the mock recorder for MockMetricClient
-type MockMetricClientMockRecorder struct {
-	mock *MockMetricClient
+// GetContractsCatalog indicates an expected call of GetContractsCatalog
+func (mr *MockContractClientMockRecorder) GetContractsCatalog(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractsCatalog", reflect.TypeOf((*MockContractClient)(nil).GetContractsCatalog), ctx)
 }
 
-// NewMockMetricClient creates a new mock instance
-func NewMockMetricClient(ctrl *gomock.Controller) *MockMetricClient {
-	mock := &MockMetricClient{ctrl: ctrl}
-	mock.recorder = &MockMetricClientMockRecorder{mock}
-	return mock
+// GetContractTags mocks base method
+func (m *MockContractClient) GetContractTags(ctx context.Context, name string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContractTags", ctx, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockMetricClient) EXPECT() *MockMetricClientMockRecorder {
-	return m.recorder
+// GetContractTags indicates an expected call of GetContractTags
+func (mr *MockContractClientMockRecorder) GetContractTags(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractTags", reflect.TypeOf((*MockContractClient)(nil).GetContractTags), ctx, name)
 }
 
-// Checker mocks base method
-func (m *MockMetricClient) Checker() healthcheck.Check {
+// SetContractAddressCodeHash mocks base method
+func (m *MockContractClient) SetContractAddressCodeHash(ctx context.Context, address, chainID string, req *types.SetContractCodeHashRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Checker")
-	ret0, _ := ret[0].(healthcheck.Check)
+	ret := m.ctrl.Call(m, "SetContractAddressCodeHash", ctx, address, chainID, req)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Checker indicates an expected call of Checker
-func (mr *MockMetricClientMockRecorder) Checker() *gomock.Call {
+// SetContractAddressCodeHash indicates an expected call of SetContractAddressCodeHash
+func (mr *MockContractClientMockRecorder) SetContractAddressCodeHash(ctx, address, chainID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checker", reflect.TypeOf((*MockMetricClient)(nil).Checker))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractAddressCodeHash", reflect.TypeOf((*MockContractClient)(nil).SetContractAddressCodeHash), ctx, address, chainID, req)
 }
 
-// Prometheus mocks base method
-func (m *MockMetricClient) Prometheus(arg0 context.Context) (map[string]*io_prometheus_client.MetricFamily, error) {
+// GetContractEvents mocks base method
+func (m *MockContractClient) GetContractEvents(ctx context.Context, address, chainUUID string, req *types.GetContractEventsRequest) (*types.GetContractEventsBySignHashResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Prometheus", arg0)
-	ret0, _ := ret[0].(map[string]*io_prometheus_client.MetricFamily)
+	ret := m.ctrl.Call(m, "GetContractEvents", ctx, address, chainUUID, req)
+	ret0, _ := ret[0].(*types.GetContractEventsBySignHashResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Prometheus indicates an expected call of Prometheus
-func (mr *MockMetricClientMockRecorder) Prometheus(arg0 interface{}) *gomock.Call {
+// GetContractEvents indicates an expected call of GetContractEvents
+func (mr *MockContractClientMockRecorder) GetContractEvents(ctx, address, chainUUID, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prometheus", reflect.TypeOf((*MockMetricClient)(nil).Prometheus), arg0)
-}
-
-// MockAccountClient is a mock of AccountClient interface
-type MockAccountClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockAccountClientMockRecorder
-}
-
-// MockAccountClientMockRecorder is the mock recorder for MockAccountClient
-type MockAccountClientMockRecorder struct {
-	mock *MockAccountClient
-}
-
-// NewMockAccountClient creates a new mock instance
-func NewMockAccountClient(ctrl *gomock.Controller) *MockAccountClient {
-	mock := &MockAccountClient{ctrl: ctrl}
-	mock.recorder = &MockAccountClientMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockAccountClient) EXPECT() *MockAccountClientMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockContractClient)(nil).GetContractEvents), ctx, address, chainUUID, req)
 }
 
-// CreateAccount mocks base method
-func (m *MockAccountClient) CreateAccount(ctx context.Context, request *types.CreateAccountRequest) (*types.AccountResponse, error) {
+// CallContract mocks base method
+func (m *MockContractClient) CallContract(ctx context.Context, request *types.CallContractRequest) ([]*types.ContractCallResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", ctx, request)
-	ret0, _ := ret[0].(*types.AccountResponse)
+	ret := m.ctrl.Call(m, "CallContract", ctx, request)
+	ret0, _ := ret[0].([]*types.ContractCallResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount
-func (mr *MockAccountClientMockRecorder) CreateAccount(ctx, request interface{}) *gomock.Call {
+// CallContract indicates an expected call of CallContract
+func (mr *MockContractClientMockRecorder) CallContract(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountClient)(nil).CreateAccount), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockContractClient)(nil).CallContract), ctx, request)
 }
 
-// SearchAccounts mocks base method
-func (m *MockAccountClient) SearchAccounts(ctx context.Context, filters *entities.AccountFilters) ([]*types.AccountResponse, error) {
+// ImportContracts mocks base method
+func (m *MockContractClient) ImportContracts(ctx context.Context, request *types.ImportContractsRequest) ([]*types.ContractResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchAccounts", ctx, filters)
-	ret0, _ := ret[0].([]*types.AccountResponse)
+	ret := m.ctrl.Call(m, "ImportContracts", ctx, request)
+	ret0, _ := ret[0].([]*types.ContractResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchAccounts indicates an expected call of SearchAccounts
-func (mr *MockAccountClientMockRecorder) SearchAccounts(ctx, filters interface{}) *gomock.Call {
+// ImportContracts indicates an expected call of ImportContracts
+func (mr *MockContractClientMockRecorder) ImportContracts(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAccounts", reflect.TypeOf((*MockAccountClient)(nil).SearchAccounts), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportContracts", reflect.TypeOf((*MockContractClient)(nil).ImportContracts), ctx, request)
 }
 
-// GetAccount mocks base method
-func (m *MockAccountClient) GetAccount(ctx context.Context, address common.Address) (*types.AccountResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", ctx, address)
-	ret0, _ := ret[0].(*types.AccountResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockEventStreamClient is a mock of EventStreamClient interface
+type MockEventStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventStreamClientMockRecorder
 }
 
-// GetAccount indicates an expected call of GetAccount
-func (mr *MockAccountClientMockRecorder) GetAccount(ctx, address interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountClient)(nil).GetAccount), ctx, address)
+// MockEventStreamClientMockRecorder is the mock recorder for MockEventStreamClient
+type MockEventStreamClientMockRecorder struct {
+	mock *MockEventStreamClient
 }
 
-// ImportAccount mocks base method
-func (m *MockAccountClient) ImportAccount(ctx context.Context, request *types.ImportAccountRequest) (*types.AccountResponse, error) {
+// NewMockEventStreamClient creates a new mock instance
+func NewMockEventStreamClient(ctrl *gomock.Controller) *MockEventStreamClient {
+	mock := &MockEventStreamClient{ctrl: ctrl}
+	mock.recorder = &MockEventStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventStreamClient) EXPECT() *MockEventStreamClientMockRecorder {
+	return m.recorder
+}
+
+// CreateEventStream mocks base method
+func (m *MockEventStreamClient) CreateEventStream(ctx context.Context, request *types.CreateEventStreamRequest) (*types.EventStreamResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImportAccount", ctx, request)
-	ret0, _ := ret[0].(*types.AccountResponse)
+	ret := m.ctrl.Call(m, "CreateEventStream", ctx, request)
+	ret0, _ := ret[0].(*types.EventStreamResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ImportAccount indicates an expected call of ImportAccount
-func (mr *MockAccountClientMockRecorder) ImportAccount(ctx, request interface{}) *gomock.Call {
+// CreateEventStream indicates an expected call of CreateEventStream
+func (mr *MockEventStreamClientMockRecorder) CreateEventStream(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAccount", reflect.TypeOf((*MockAccountClient)(nil).ImportAccount), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).CreateEventStream), ctx, request)
 }
 
-// UpdateAccount mocks base method
-func (m *MockAccountClient) UpdateAccount(ctx context.Context, address common.Address, request *types.UpdateAccountRequest) (*types.AccountResponse, error) {
+// UpdateEventStream mocks base method
+func (m *MockEventStreamClient) UpdateEventStream(ctx context.Context, uuid string, request *types.UpdateEventStreamRequest) (*types.EventStreamResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAccount", ctx, address, request)
-	ret0, _ := ret[0].(*types.AccountResponse)
+	ret := m.ctrl.Call(m, "UpdateEventStream", ctx, uuid, request)
+	ret0, _ := ret[0].(*types.EventStreamResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateAccount indicates an expected call of UpdateAccount
-func (mr *MockAccountClientMockRecorder) UpdateAccount(ctx, address, request interface{}) *gomock.Call {
+// UpdateEventStream indicates an expected call of UpdateEventStream
+func (mr *MockEventStreamClientMockRecorder) UpdateEventStream(ctx, uuid, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountClient)(nil).UpdateAccount), ctx, address, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).UpdateEventStream), ctx, uuid, request)
 }
 
-// SignMessage mocks base method
-func (m *MockAccountClient) SignMessage(ctx context.Context, address common.Address, request *types0.SignMessageRequest) (string, error) {
+// GetEventStream mocks base method
+func (m *MockEventStreamClient) GetEventStream(ctx context.Context, uuid string) (*types.EventStreamResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignMessage", ctx, address, request)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetEventStream", ctx, uuid)
+	ret0, _ := ret[0].(*types.EventStreamResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SignMessage indicates an expected call of SignMessage
-func (mr *MockAccountClientMockRecorder) SignMessage(ctx, address, request interface{}) *gomock.Call {
+// GetEventStream indicates an expected call of GetEventStream
+func (mr *MockEventStreamClientMockRecorder) GetEventStream(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessage", reflect.TypeOf((*MockAccountClient)(nil).SignMessage), ctx, address, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).GetEventStream), ctx, uuid)
 }
 
-// SignTypedData mocks base method
-func (m *MockAccountClient) SignTypedData(ctx context.Context, address common.Address, request *types0.SignTypedDataRequest) (string, error) {
+// SearchEventStreams mocks base method
+func (m *MockEventStreamClient) SearchEventStreams(ctx context.Context, filters *entities.EventStreamFilters) ([]*types.EventStreamResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SignTypedData", ctx, address, request)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "SearchEventStreams", ctx, filters)
+	ret0, _ := ret[0].([]*types.EventStreamResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SignTypedData indicates an expected call of SignTypedData
-func (mr *MockAccountClientMockRecorder) SignTypedData(ctx, address, request interface{}) *gomock.Call {
+// SearchEventStreams indicates an expected call of SearchEventStreams
+func (mr *MockEventStreamClientMockRecorder) SearchEventStreams(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTypedData", reflect.TypeOf((*MockAccountClient)(nil).SignTypedData), ctx, address, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEventStreams", reflect.TypeOf((*MockEventStreamClient)(nil).SearchEventStreams), ctx, filters)
 }
 
-// VerifyMessageSignature mocks base method
-func (m *MockAccountClient) VerifyMessageSignature(ctx context.Context, request *types1.VerifyRequest) error {
+// SearchEventStreamPages mocks base method
+func (m *MockEventStreamClient) SearchEventStreamPages(ctx context.Context, filters *entities.EventStreamFilters, fn func([]*types.EventStreamResponse) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifyMessageSignature", ctx, request)
+	ret := m.ctrl.Call(m, "SearchEventStreamPages", ctx, filters, fn)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// VerifyMessageSignature indicates an expected call of VerifyMessageSignature
-func (mr *MockAccountClientMockRecorder) VerifyMessageSignature(ctx, request interface{}) *gomock.Call {
+// SearchEventStreamPages indicates an expected call of SearchEventStreamPages
+func (mr *MockEventStreamClientMockRecorder) SearchEventStreamPages(ctx, filters, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMessageSignature", reflect.TypeOf((*MockAccountClient)(nil).VerifyMessageSignature), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEventStreamPages", reflect.TypeOf((*MockEventStreamClient)(nil).SearchEventStreamPages), ctx, filters, fn)
 }
-
-// VerifyTypedDataSignature mocks base method
-func (m *MockAccountClient) VerifyTypedDataSignature(ctx context.Context, request *types1.VerifyTypedDataRequest) error {
+
+// DeleteEventStream mocks base method
+func (m *MockEventStreamClient) DeleteEventStream(ctx context.Context, uuid string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifyTypedDataSignature", ctx, request)
+	ret := m.ctrl.Call(m, "DeleteEventStream", ctx, uuid)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// VerifyTypedDataSignature indicates an expected call of VerifyTypedDataSignature
-func (mr *MockAccountClientMockRecorder) VerifyTypedDataSignature(ctx, request interface{}) *gomock.Call {
+// DeleteEventStream indicates an expected call of DeleteEventStream
+func (mr *MockEventStreamClientMockRecorder) DeleteEventStream(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyTypedDataSignature", reflect.TypeOf((*MockAccountClient)(nil).VerifyTypedDataSignature), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).DeleteEventStream), ctx, uuid)
 }
 
-// MockFaucetClient is a mock of FaucetClient interface
-type MockFaucetClient struct {
+// MockSafeProposalClient is a mock of SafeProposalClient interface
+type MockSafeProposalClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockFaucetClientMockRecorder
+	recorder *MockSafeProposalClientMockRecorder
 }
 
-// MockFaucetClientMockRecorder is the mock recorder for MockFaucetClient
-type MockFaucetClientMockRecorder struct {
-	mock *MockFaucetClient
+// MockSafeProposalClientMockRecorder is the mock recorder for MockSafeProposalClient
+type MockSafeProposalClientMockRecorder struct {
+	mock *MockSafeProposalClient
 }
 
-// NewMockFaucetClient creates a new mock instance
-func NewMockFaucetClient(ctrl *gomock.Controller) *MockFaucetClient {
-	mock := &MockFaucetClient{ctrl: ctrl}
-	mock.recorder = &MockFaucetClientMockRecorder{mock}
+// NewMockSafeProposalClient creates a new mock instance
+func NewMockSafeProposalClient(ctrl *gomock.Controller) *MockSafeProposalClient {
+	mock := &MockSafeProposalClient{ctrl: ctrl}
+	mock.recorder = &MockSafeProposalClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockFaucetClient) EXPECT() *MockFaucetClientMockRecorder {
+func (m *MockSafeProposalClient) EXPECT() *MockSafeProposalClientMockRecorder {
 	return m.recorder
 }
 
-// RegisterFaucet mocks base method
-func (m *MockFaucetClient) RegisterFaucet(ctx context.Context, request *types.RegisterFaucetRequest) (*types.FaucetResponse, error) {
+// CreateSafeProposal mocks base method
+func (m *MockSafeProposalClient) CreateSafeProposal(ctx context.Context, request *types.CreateSafeProposalRequest) (*types.SafeProposalResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterFaucet", ctx, request)
-	ret0, _ := ret[0].(*types.FaucetResponse)
+	ret := m.ctrl.Call(m, "CreateSafeProposal", ctx, request)
+	ret0, _ := ret[0].(*types.SafeProposalResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RegisterFaucet indicates an expected call of RegisterFaucet
-func (mr *MockFaucetClientMockRecorder) RegisterFaucet(ctx, request interface{}) *gomock.Call {
+// CreateSafeProposal indicates an expected call of CreateSafeProposal
+func (mr *MockSafeProposalClientMockRecorder) CreateSafeProposal(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFaucet", reflect.TypeOf((*MockFaucetClient)(nil).RegisterFaucet), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSafeProposal", reflect.TypeOf((*MockSafeProposalClient)(nil).CreateSafeProposal), ctx, request)
 }
 
-// UpdateFaucet mocks base method
-func (m *MockFaucetClient) UpdateFaucet(ctx context.Context, uuid string, request *types.UpdateFaucetRequest) (*types.FaucetResponse, error) {
+// GetSafeProposal mocks base method
+func (m *MockSafeProposalClient) GetSafeProposal(ctx context.Context, uuid string) (*types.SafeProposalResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateFaucet", ctx, uuid, request)
-	ret0, _ := ret[0].(*types.FaucetResponse)
+	ret := m.ctrl.Call(m, "GetSafeProposal", ctx, uuid)
+	ret0, _ := ret[0].(*types.SafeProposalResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateFaucet indicates an expected call of UpdateFaucet
-func (mr *MockFaucetClientMockRecorder) UpdateFaucet(ctx, uuid, request interface{}) *gomock.Call {
+// GetSafeProposal indicates an expected call of GetSafeProposal
+func (mr *MockSafeProposalClientMockRecorder) GetSafeProposal(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFaucet", reflect.TypeOf((*MockFaucetClient)(nil).UpdateFaucet), ctx, uuid, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSafeProposal", reflect.TypeOf((*MockSafeProposalClient)(nil).GetSafeProposal), ctx, uuid)
 }
 
-// GetFaucet mocks base method
-func (m *MockFaucetClient) GetFaucet(ctx context.Context, uuid string) (*types.FaucetResponse, error) {
+// SearchSafeProposals mocks base method
+func (m *MockSafeProposalClient) SearchSafeProposals(ctx context.Context, filters *entities.SafeProposalFilters) ([]*types.SafeProposalResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFaucet", ctx, uuid)
-	ret0, _ := ret[0].(*types.FaucetResponse)
+	ret := m.ctrl.Call(m, "SearchSafeProposals", ctx, filters)
+	ret0, _ := ret[0].([]*types.SafeProposalResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFaucet indicates an expected call of GetFaucet
-func (mr *MockFaucetClientMockRecorder) GetFaucet(ctx, uuid interface{}) *gomock.Call {
+// SearchSafeProposals indicates an expected call of SearchSafeProposals
+func (mr *MockSafeProposalClientMockRecorder) SearchSafeProposals(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFaucet", reflect.TypeOf((*MockFaucetClient)(nil).GetFaucet), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSafeProposals", reflect.TypeOf((*MockSafeProposalClient)(nil).SearchSafeProposals), ctx, filters)
 }
 
-// SearchFaucets mocks base method
-func (m *MockFaucetClient) SearchFaucets(ctx context.Context, filters *entities.FaucetFilters) ([]*types.FaucetResponse, error) {
+// SearchSafeProposalPages mocks base method
+func (m *MockSafeProposalClient) SearchSafeProposalPages(ctx context.Context, filters *entities.SafeProposalFilters, fn func([]*types.SafeProposalResponse) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchFaucets", ctx, filters)
-	ret0, _ := ret[0].([]*types.FaucetResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SearchSafeProposalPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SearchFaucets indicates an expected call of SearchFaucets
-func (mr *MockFaucetClientMockRecorder) SearchFaucets(ctx, filters interface{}) *gomock.Call {
+// SearchSafeProposalPages indicates an expected call of SearchSafeProposalPages
+func (mr *MockSafeProposalClientMockRecorder) SearchSafeProposalPages(ctx, filters, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchFaucets", reflect.TypeOf((*MockFaucetClient)(nil).SearchFaucets), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSafeProposalPages", reflect.TypeOf((*MockSafeProposalClient)(nil).SearchSafeProposalPages), ctx, filters, fn)
 }
 
-// DeleteFaucet mocks base method
-func (m *MockFaucetClient) DeleteFaucet(ctx context.Context, uuid string) error {
+// SignSafeProposal mocks base method
+func (m *MockSafeProposalClient) SignSafeProposal(ctx context.Context, uuid string, request *types.SignSafeProposalRequest) (*types.SafeProposalResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFaucet", ctx, uuid)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SignSafeProposal", ctx, uuid, request)
+	ret0, _ := ret[0].(*types.SafeProposalResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteFaucet indicates an expected call of DeleteFaucet
-func (mr *MockFaucetClientMockRecorder) DeleteFaucet(ctx, uuid interface{}) *gomock.Call {
+// SignSafeProposal indicates an expected call of SignSafeProposal
+func (mr *MockSafeProposalClientMockRecorder) SignSafeProposal(ctx, uuid, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFaucet", reflect.TypeOf((*MockFaucetClient)(nil).DeleteFaucet), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignSafeProposal", reflect.TypeOf((*MockSafeProposalClient)(nil).SignSafeProposal), ctx, uuid, request)
 }
 
-// MockChainClient is a mock of ChainClient interface
-type MockChainClient struct {
+// MockRelayerClient is a mock of RelayerClient interface
+type MockRelayerClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockChainClientMockRecorder
+	recorder *MockRelayerClientMockRecorder
 }
 
-// MockChainClientMockRecorder is the mock recorder for MockChainClient
-type MockChainClientMockRecorder struct {
-	mock *MockChainClient
+// MockRelayerClientMockRecorder is the mock recorder for MockRelayerClient
+type MockRelayerClientMockRecorder struct {
+	mock *MockRelayerClient
 }
 
-// NewMockChainClient creates a new mock instance
-func NewMockChainClient(ctrl *gomock.Controller) *MockChainClient {
-	mock := &MockChainClient{ctrl: ctrl}
-	mock.recorder = &MockChainClientMockRecorder{mock}
+// NewMockRelayerClient creates a new mock instance
+func NewMockRelayerClient(ctrl *gomock.Controller) *MockRelayerClient {
+	mock := &MockRelayerClient{ctrl: ctrl}
+	mock.recorder = &MockRelayerClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockChainClient) EXPECT() *MockChainClientMockRecorder {
+func (m *MockRelayerClient) EXPECT() *MockRelayerClientMockRecorder {
 	return m.recorder
 }
 
-// RegisterChain mocks base method
-func (m *MockChainClient) RegisterChain(ctx context.Context, request *types.RegisterChainRequest) (*types.ChainResponse, error) {
+// RegisterRelayer mocks base method
+func (m *MockRelayerClient) RegisterRelayer(ctx context.Context, request *types.RegisterRelayerRequest) (*types.RelayerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterChain", ctx, request)
-	ret0, _ := ret[0].(*types.ChainResponse)
+	ret := m.ctrl.Call(m, "RegisterRelayer", ctx, request)
+	ret0, _ := ret[0].(*types.RelayerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RegisterChain indicates an expected call of RegisterChain
-func (mr *MockChainClientMockRecorder) RegisterChain(ctx, request interface{}) *gomock.Call {
+// RegisterRelayer indicates an expected call of RegisterRelayer
+func (mr *MockRelayerClientMockRecorder) RegisterRelayer(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterChain", reflect.TypeOf((*MockChainClient)(nil).RegisterChain), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterRelayer", reflect.TypeOf((*MockRelayerClient)(nil).RegisterRelayer), ctx, request)
 }
 
-// UpdateChain mocks base method
-func (m *MockChainClient) UpdateChain(ctx context.Context, uuid string, request *types.UpdateChainRequest) (*types.ChainResponse, error) {
+// GetRelayer mocks base method
+func (m *MockRelayerClient) GetRelayer(ctx context.Context, uuid string) (*types.RelayerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateChain", ctx, uuid, request)
-	ret0, _ := ret[0].(*types.ChainResponse)
+	ret := m.ctrl.Call(m, "GetRelayer", ctx, uuid)
+	ret0, _ := ret[0].(*types.RelayerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateChain indicates an expected call of UpdateChain
-func (mr *MockChainClientMockRecorder) UpdateChain(ctx, uuid, request interface{}) *gomock.Call {
+// GetRelayer indicates an expected call of GetRelayer
+func (mr *MockRelayerClientMockRecorder) GetRelayer(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChain", reflect.TypeOf((*MockChainClient)(nil).UpdateChain), ctx, uuid, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelayer", reflect.TypeOf((*MockRelayerClient)(nil).GetRelayer), ctx, uuid)
 }
 
-// GetChain mocks base method
-func (m *MockChainClient) GetChain(ctx context.Context, uuid string) (*types.ChainResponse, error) {
+// SearchRelayers mocks base method
+func (m *MockRelayerClient) SearchRelayers(ctx context.Context, filters *entities.RelayerFilters) ([]*types.RelayerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetChain", ctx, uuid)
-	ret0, _ := ret[0].(*types.ChainResponse)
+	ret := m.ctrl.Call(m, "SearchRelayers", ctx, filters)
+	ret0, _ := ret[0].([]*types.RelayerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetChain indicates an expected call of GetChain
-func (mr *MockChainClientMockRecorder) GetChain(ctx, uuid interface{}) *gomock.Call {
+// SearchRelayers indicates an expected call of SearchRelayers
+func (mr *MockRelayerClientMockRecorder) SearchRelayers(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChain", reflect.TypeOf((*MockChainClient)(nil).GetChain), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRelayers", reflect.TypeOf((*MockRelayerClient)(nil).SearchRelayers), ctx, filters)
 }
 
-// SearchChains mocks base method
-func (m *MockChainClient) SearchChains(ctx context.Context, filters *entities.ChainFilters) ([]*types.ChainResponse, error) {
+// SearchRelayerPages mocks base method
+func (m *MockRelayerClient) SearchRelayerPages(ctx context.Context, filters *entities.RelayerFilters, fn func([]*types.RelayerResponse) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchChains", ctx, filters)
-	ret0, _ := ret[0].([]*types.ChainResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SearchRelayerPages", ctx, filters, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SearchChains indicates an expected call of SearchChains
-func (mr *MockChainClientMockRecorder) SearchChains(ctx, filters interface{}) *gomock.Call {
+// SearchRelayerPages indicates an expected call of SearchRelayerPages
+func (mr *MockRelayerClientMockRecorder) SearchRelayerPages(ctx, filters, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchChains", reflect.TypeOf((*MockChainClient)(nil).SearchChains), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRelayerPages", reflect.TypeOf((*MockRelayerClient)(nil).SearchRelayerPages), ctx, filters, fn)
 }
 
-// DeleteChain mocks base method
-func (m *MockChainClient) DeleteChain(ctx context.Context, uuid string) error {
+// DeleteRelayer mocks base method
+func (m *MockRelayerClient) DeleteRelayer(ctx context.Context, uuid string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteChain", ctx, uuid)
+	ret := m.ctrl.Call(m, "DeleteRelayer", ctx, uuid)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteChain indicates an expected call of DeleteChain
-func (mr *MockChainClientMockRecorder) DeleteChain(ctx, uuid interface{}) *gomock.Call {
+// DeleteRelayer indicates an expected call of DeleteRelayer
+func (mr *MockRelayerClientMockRecorder) DeleteRelayer(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChain", reflect.TypeOf((*MockChainClient)(nil).DeleteChain), ctx, uuid)
-}
-
-// MockContractClient is a mock of ContractClient interface
-type MockContractClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockContractClientMockRecorder
-}
-
-// MockContractClientMockRecorder is the mock recorder for MockContractClient
-type MockContractClientMockRecorder struct {
-	mock *MockContractClient
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRelayer", reflect.TypeOf((*MockRelayerClient)(nil).DeleteRelayer), ctx, uuid)
 }
 
-// NewMockContractClient creates a new mock instance
-func NewMockContractClient(ctrl *gomock.Controller) *MockContractClient {
-	mock := &MockContractClient{ctrl: ctrl}
-	mock.recorder = &MockContractClientMockRecorder{mock}
-	return mock
+// SearchRelaySpendings mocks base method
+func (m *MockRelayerClient) SearchRelaySpendings(ctx context.Context, filters *entities.RelaySpendingFilters) ([]*types.RelaySpendingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchRelaySpendings", ctx, filters)
+	ret0, _ := ret[0].([]*types.RelaySpendingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockContractClient) EXPECT() *MockContractClientMockRecorder {
-	return m.recorder
+// SearchRelaySpendings indicates an expected call of SearchRelaySpendings
+func (mr *MockRelayerClientMockRecorder) SearchRelaySpendings(ctx, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchRelaySpendings", reflect.TypeOf((*MockRelayerClient)(nil).SearchRelaySpendings), ctx, filters)
 }
 
-// RegisterContract mocks base method
-func (m *MockContractClient) RegisterContract(ctx context.Context, req *types.RegisterContractRequest) (*types.ContractResponse, error) {
+// SendRelayTransaction mocks base method
+func (m *MockRelayerClient) SendRelayTransaction(ctx context.Context, txRequest *types.RelayTransactionRequest) (*types.TransactionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterContract", ctx, req)
-	ret0, _ := ret[0].(*types.ContractResponse)
+	ret := m.ctrl.Call(m, "SendRelayTransaction", ctx, txRequest)
+	ret0, _ := ret[0].(*types.TransactionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RegisterContract indicates an expected call of RegisterContract
-func (mr *MockContractClientMockRecorder) RegisterContract(ctx, req interface{}) *gomock.Call {
+// SendRelayTransaction indicates an expected call of SendRelayTransaction
+func (mr *MockRelayerClientMockRecorder) SendRelayTransaction(ctx, txRequest interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterContract", reflect.TypeOf((*MockContractClient)(nil).RegisterContract), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRelayTransaction", reflect.TypeOf((*MockRelayerClient)(nil).SendRelayTransaction), ctx, txRequest)
 }
 
-// DeregisterContract mocks base method
-func (m *MockContractClient) DeregisterContract(ctx context.Context, name, tag string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeregisterContract", ctx, name, tag)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockPrivacyGroupClient is a mock of PrivacyGroupClient interface
+type MockPrivacyGroupClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyGroupClientMockRecorder
 }
 
-// DeregisterContract indicates an expected call of DeregisterContract
-func (mr *MockContractClientMockRecorder) DeregisterContract(ctx, name, tag interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterContract", reflect.TypeOf((*MockContractClient)(nil).DeregisterContract), ctx, name, tag)
+// MockPrivacyGroupClientMockRecorder is the mock recorder for MockPrivacyGroupClient
+type MockPrivacyGroupClientMockRecorder struct {
+	mock *MockPrivacyGroupClient
 }
 
-// GetContract mocks base method
-func (m *MockContractClient) GetContract(ctx context.Context, name, tag string) (*types.ContractResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContract", ctx, name, tag)
-	ret0, _ := ret[0].(*types.ContractResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockPrivacyGroupClient creates a new mock instance
+func NewMockPrivacyGroupClient(ctrl *gomock.Controller) *MockPrivacyGroupClient {
+	mock := &MockPrivacyGroupClient{ctrl: ctrl}
+	mock.recorder = &MockPrivacyGroupClientMockRecorder{mock}
+	return mock
 }
 
-// GetContract indicates an expected call of GetContract
-func (mr *MockContractClientMockRecorder) GetContract(ctx, name, tag interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContract", reflect.TypeOf((*MockContractClient)(nil).GetContract), ctx, name, tag)
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPrivacyGroupClient) EXPECT() *MockPrivacyGroupClientMockRecorder {
+	return m.recorder
 }
 
-// SearchContract mocks base method
-func (m *MockContractClient) SearchContract(ctx context.Context, req *types.SearchContractRequest) (*types.ContractResponse, error) {
+// CreatePrivacyGroup mocks base method
+func (m *MockPrivacyGroupClient) CreatePrivacyGroup(ctx context.Context, request *types.CreatePrivacyGroupRequest) (*types.PrivacyGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchContract", ctx, req)
-	ret0, _ := ret[0].(*types.ContractResponse)
+	ret := m.ctrl.Call(m, "CreatePrivacyGroup", ctx, request)
+	ret0, _ := ret[0].(*types.PrivacyGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchContract indicates an expected call of SearchContract
-func (mr *MockContractClientMockRecorder) SearchContract(ctx, req interface{}) *gomock.Call {
+// CreatePrivacyGroup indicates an expected call of CreatePrivacyGroup
+func (mr *MockPrivacyGroupClientMockRecorder) CreatePrivacyGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContract", reflect.TypeOf((*MockContractClient)(nil).SearchContract), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivacyGroup", reflect.TypeOf((*MockPrivacyGroupClient)(nil).CreatePrivacyGroup), ctx, request)
 }
 
-// GetContractsCatalog mocks base method
-func (m *MockContractClient) GetContractsCatalog(ctx context.Context) ([]string, error) {
+// GetPrivacyGroup mocks base method
+func (m *MockPrivacyGroupClient) GetPrivacyGroup(ctx context.Context, uuid string) (*types.PrivacyGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContractsCatalog", ctx)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetPrivacyGroup", ctx, uuid)
+	ret0, _ := ret[0].(*types.PrivacyGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetContractsCatalog indicates an expected call of GetContractsCatalog
-func (mr *MockContractClientMockRecorder) GetContractsCatalog(ctx interface{}) *gomock.Call {
+// GetPrivacyGroup indicates an expected call of GetPrivacyGroup
+func (mr *MockPrivacyGroupClientMockRecorder) GetPrivacyGroup(ctx, uuid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractsCatalog", reflect.TypeOf((*MockContractClient)(nil).GetContractsCatalog), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacyGroup", reflect.TypeOf((*MockPrivacyGroupClient)(nil).GetPrivacyGroup), ctx, uuid)
 }
 
-// GetContractTags mocks base method
-func (m *MockContractClient) GetContractTags(ctx context.Context, name string) ([]string, error) {
+// SearchPrivacyGroups mocks base method
+func (m *MockPrivacyGroupClient) SearchPrivacyGroups(ctx context.Context, filters *entities.PrivacyGroupFilters) ([]*types.PrivacyGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContractTags", ctx, name)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "SearchPrivacyGroups", ctx, filters)
+	ret0, _ := ret[0].([]*types.PrivacyGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetContractTags indicates an expected call of GetContractTags
-func (mr *MockContractClientMockRecorder) GetContractTags(ctx, name interface{}) *gomock.Call {
+// SearchPrivacyGroups indicates an expected call of SearchPrivacyGroups
+func (mr *MockPrivacyGroupClientMockRecorder) SearchPrivacyGroups(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractTags", reflect.TypeOf((*MockContractClient)(nil).GetContractTags), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPrivacyGroups", reflect.TypeOf((*MockPrivacyGroupClient)(nil).SearchPrivacyGroups), ctx, filters)
 }
 
-// SetContractAddressCodeHash mocks base method
-func (m *MockContractClient) SetContractAddressCodeHash(ctx context.Context, address, chainID string, req *types.SetContractCodeHashRequest) error {
+// SearchPrivacyGroupPages mocks base method
+func (m *MockPrivacyGroupClient) SearchPrivacyGroupPages(ctx context.Context, filters *entities.PrivacyGroupFilters, fn func([]*types.PrivacyGroupResponse) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetContractAddressCodeHash", ctx, address, chainID, req)
+	ret := m.ctrl.Call(m, "SearchPrivacyGroupPages", ctx, filters, fn)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetContractAddressCodeHash indicates an expected call of SetContractAddressCodeHash
-func (mr *MockContractClientMockRecorder) SetContractAddressCodeHash(ctx, address, chainID, req interface{}) *gomock.Call {
+// SearchPrivacyGroupPages indicates an expected call of SearchPrivacyGroupPages
+func (mr *MockPrivacyGroupClientMockRecorder) SearchPrivacyGroupPages(ctx, filters, fn interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContractAddressCodeHash", reflect.TypeOf((*MockContractClient)(nil).SetContractAddressCodeHash), ctx, address, chainID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPrivacyGroupPages", reflect.TypeOf((*MockPrivacyGroupClient)(nil).SearchPrivacyGroupPages), ctx, filters, fn)
 }
 
-// GetContractEvents mocks base method
-func (m *MockContractClient) GetContractEvents(ctx context.Context, address, chainUUID string, req *types.GetContractEventsRequest) (*types.GetContractEventsBySignHashResponse, error) {
+// FindPrivacyGroups mocks base method
+func (m *MockPrivacyGroupClient) FindPrivacyGroups(ctx context.Context, request *types.FindPrivacyGroupsRequest) ([]*types.OnChainPrivacyGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContractEvents", ctx, address, chainUUID, req)
-	ret0, _ := ret[0].(*types.GetContractEventsBySignHashResponse)
+	ret := m.ctrl.Call(m, "FindPrivacyGroups", ctx, request)
+	ret0, _ := ret[0].([]*types.OnChainPrivacyGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetContractEvents indicates an expected call of GetContractEvents
-func (mr *MockContractClientMockRecorder) GetContractEvents(ctx, address, chainUUID, req interface{}) *gomock.Call {
+// FindPrivacyGroups indicates an expected call of FindPrivacyGroups
+func (mr *MockPrivacyGroupClientMockRecorder) FindPrivacyGroups(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractEvents", reflect.TypeOf((*MockContractClient)(nil).GetContractEvents), ctx, address, chainUUID, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrivacyGroups", reflect.TypeOf((*MockPrivacyGroupClient)(nil).FindPrivacyGroups), ctx, request)
 }
 
-// MockEventStreamClient is a mock of EventStreamClient interface
-type MockEventStreamClient struct {
+// DeletePrivacyGroup mocks base method
+func (m *MockPrivacyGroupClient) DeletePrivacyGroup(ctx context.Context, uuid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivacyGroup", ctx, uuid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrivacyGroup indicates an expected call of DeletePrivacyGroup
+func (mr *MockPrivacyGroupClientMockRecorder) DeletePrivacyGroup(ctx, uuid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivacyGroup", reflect.TypeOf((*MockPrivacyGroupClient)(nil).DeletePrivacyGroup), ctx, uuid)
+}
+
+// MockSentrySessionClient is a mock of SentrySessionClient interface
+type MockSentrySessionClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockEventStreamClientMockRecorder
+	recorder *MockSentrySessionClientMockRecorder
 }
 
-// MockEventStreamClientMockRecorder is the mock recorder for MockEventStreamClient
-type MockEventStreamClientMockRecorder struct {
-	mock *MockEventStreamClient
+// MockSentrySessionClientMockRecorder is the mock recorder for MockSentrySessionClient
+type MockSentrySessionClientMockRecorder struct {
+	mock *MockSentrySessionClient
 }
 
-// NewMockEventStreamClient creates a new mock instance
-func NewMockEventStreamClient(ctrl *gomock.Controller) *MockEventStreamClient {
-	mock := &MockEventStreamClient{ctrl: ctrl}
-	mock.recorder = &MockEventStreamClientMockRecorder{mock}
+// NewMockSentrySessionClient creates a new mock instance
+func NewMockSentrySessionClient(ctrl *gomock.Controller) *MockSentrySessionClient {
+	mock := &MockSentrySessionClient{ctrl: ctrl}
+	mock.recorder = &MockSentrySessionClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockEventStreamClient) EXPECT() *MockEventStreamClientMockRecorder {
+func (m *MockSentrySessionClient) EXPECT() *MockSentrySessionClientMockRecorder {
 	return m.recorder
 }
 
-// CreateEventStream mocks base method
-func (m *MockEventStreamClient) CreateEventStream(ctx context.Context, request *types.CreateEventStreamRequest) (*types.EventStreamResponse, error) {
+// CreateSentrySession mocks base method
+func (m *MockSentrySessionClient) CreateSentrySession(ctx context.Context, request *types.CreateSentrySessionRequest) (*types.SentrySessionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateEventStream", ctx, request)
-	ret0, _ := ret[0].(*types.EventStreamResponse)
+	ret := m.ctrl.Call(m, "CreateSentrySession", ctx, request)
+	ret0, _ := ret[0].(*types.SentrySessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateEventStream indicates an expected call of CreateEventStream
-func (mr *MockEventStreamClientMockRecorder) CreateEventStream(ctx, request interface{}) *gomock.Call {
+// CreateSentrySession indicates an expected call of CreateSentrySession
+func (mr *MockSentrySessionClientMockRecorder) CreateSentrySession(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).CreateEventStream), ctx, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSentrySession", reflect.TypeOf((*MockSentrySessionClient)(nil).CreateSentrySession), ctx, request)
 }
 
-// UpdateEventStream mocks base method
-func (m *MockEventStreamClient) UpdateEventStream(ctx context.Context, uuid string, request *types.UpdateEventStreamRequest) (*types.EventStreamResponse, error) {
+// GetSentrySession mocks base method
+func (m *MockSentrySessionClient) GetSentrySession(ctx context.Context, jobUUID string) (*types.SentrySessionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateEventStream", ctx, uuid, request)
-	ret0, _ := ret[0].(*types.EventStreamResponse)
+	ret := m.ctrl.Call(m, "GetSentrySession", ctx, jobUUID)
+	ret0, _ := ret[0].(*types.SentrySessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UpdateEventStream indicates an expected call of UpdateEventStream
-func (mr *MockEventStreamClientMockRecorder) UpdateEventStream(ctx, uuid, request interface{}) *gomock.Call {
+// GetSentrySession indicates an expected call of GetSentrySession
+func (mr *MockSentrySessionClientMockRecorder) GetSentrySession(ctx, jobUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).UpdateEventStream), ctx, uuid, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSentrySession", reflect.TypeOf((*MockSentrySessionClient)(nil).GetSentrySession), ctx, jobUUID)
 }
 
-// GetEventStream mocks base method
-func (m *MockEventStreamClient) GetEventStream(ctx context.Context, uuid string) (*types.EventStreamResponse, error) {
+// UpdateSentrySession mocks base method
+func (m *MockSentrySessionClient) UpdateSentrySession(ctx context.Context, jobUUID string, request *types.UpdateSentrySessionRequest) (*types.SentrySessionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventStream", ctx, uuid)
-	ret0, _ := ret[0].(*types.EventStreamResponse)
+	ret := m.ctrl.Call(m, "UpdateSentrySession", ctx, jobUUID, request)
+	ret0, _ := ret[0].(*types.SentrySessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEventStream indicates an expected call of GetEventStream
-func (mr *MockEventStreamClientMockRecorder) GetEventStream(ctx, uuid interface{}) *gomock.Call {
+// UpdateSentrySession indicates an expected call of UpdateSentrySession
+func (mr *MockSentrySessionClientMockRecorder) UpdateSentrySession(ctx, jobUUID, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).GetEventStream), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSentrySession", reflect.TypeOf((*MockSentrySessionClient)(nil).UpdateSentrySession), ctx, jobUUID, request)
 }
 
-// SearchEventStreams mocks base method
-func (m *MockEventStreamClient) SearchEventStreams(ctx context.Context, filters *entities.EventStreamFilters) ([]*types.EventStreamResponse, error) {
+// SearchSentrySessions mocks base method
+func (m *MockSentrySessionClient) SearchSentrySessions(ctx context.Context, filters *entities.SentrySessionFilters) ([]*types.SentrySessionResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchEventStreams", ctx, filters)
-	ret0, _ := ret[0].([]*types.EventStreamResponse)
+	ret := m.ctrl.Call(m, "SearchSentrySessions", ctx, filters)
+	ret0, _ := ret[0].([]*types.SentrySessionResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchEventStreams indicates an expected call of SearchEventStreams
-func (mr *MockEventStreamClientMockRecorder) SearchEventStreams(ctx, filters interface{}) *gomock.Call {
+// SearchSentrySessions indicates an expected call of SearchSentrySessions
+func (mr *MockSentrySessionClientMockRecorder) SearchSentrySessions(ctx, filters interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEventStreams", reflect.TypeOf((*MockEventStreamClient)(nil).SearchEventStreams), ctx, filters)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSentrySessions", reflect.TypeOf((*MockSentrySessionClient)(nil).SearchSentrySessions), ctx, filters)
 }
 
-// DeleteEventStream mocks base method
-func (m *MockEventStreamClient) DeleteEventStream(ctx context.Context, uuid string) error {
+// DeleteSentrySession mocks base method
+func (m *MockSentrySessionClient) DeleteSentrySession(ctx context.Context, jobUUID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteEventStream", ctx, uuid)
+	ret := m.ctrl.Call(m, "DeleteSentrySession", ctx, jobUUID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteEventStream indicates an expected call of DeleteEventStream
-func (mr *MockEventStreamClientMockRecorder) DeleteEventStream(ctx, uuid interface{}) *gomock.Call {
+// DeleteSentrySession indicates an expected call of DeleteSentrySession
+func (mr *MockSentrySessionClientMockRecorder) DeleteSentrySession(ctx, jobUUID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventStream", reflect.TypeOf((*MockEventStreamClient)(nil).DeleteEventStream), ctx, uuid)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSentrySession", reflect.TypeOf((*MockSentrySessionClient)(nil).DeleteSentrySession), ctx, jobUUID)
 }
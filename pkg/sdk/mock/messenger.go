@@ -176,6 +176,20 @@ func (mr *MockOrchestrateMessengerMockRecorder) DeleteSubscriptionMessage(ctx, s
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionMessage", reflect.TypeOf((*MockOrchestrateMessenger)(nil).DeleteSubscriptionMessage), ctx, sub, userInfo)
 }
 
+// BackfillEventLogsMessage mocks base method
+func (m *MockOrchestrateMessenger) BackfillEventLogsMessage(ctx context.Context, backfill *entities.EventLogsBackfill, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillEventLogsMessage", ctx, backfill, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillEventLogsMessage indicates an expected call of BackfillEventLogsMessage
+func (mr *MockOrchestrateMessengerMockRecorder) BackfillEventLogsMessage(ctx, backfill, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillEventLogsMessage", reflect.TypeOf((*MockOrchestrateMessenger)(nil).BackfillEventLogsMessage), ctx, backfill, userInfo)
+}
+
 // StartedJobMessage mocks base method
 func (m *MockOrchestrateMessenger) StartedJobMessage(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
@@ -399,6 +413,20 @@ func (mr *MockMessengerTxListenerMockRecorder) DeleteSubscriptionMessage(ctx, su
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionMessage", reflect.TypeOf((*MockMessengerTxListener)(nil).DeleteSubscriptionMessage), ctx, sub, userInfo)
 }
 
+// BackfillEventLogsMessage mocks base method
+func (m *MockMessengerTxListener) BackfillEventLogsMessage(ctx context.Context, backfill *entities.EventLogsBackfill, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillEventLogsMessage", ctx, backfill, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BackfillEventLogsMessage indicates an expected call of BackfillEventLogsMessage
+func (mr *MockMessengerTxListenerMockRecorder) BackfillEventLogsMessage(ctx, backfill, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillEventLogsMessage", reflect.TypeOf((*MockMessengerTxListener)(nil).BackfillEventLogsMessage), ctx, backfill, userInfo)
+}
+
 // MockMessengerTxSender is a mock of MessengerTxSender interface
 type MockMessengerTxSender struct {
 	ctrl     *gomock.Controller
@@ -19,6 +19,7 @@ import (
 	httpmetrics "github.com/consensys/orchestrate/pkg/toolkit/app/http/metrics"
 	httpmid "github.com/consensys/orchestrate/pkg/toolkit/app/http/middleware/dynamic"
 	metricsmid "github.com/consensys/orchestrate/pkg/toolkit/app/http/middleware/metrics"
+	tracingmid "github.com/consensys/orchestrate/pkg/toolkit/app/http/middleware/tracing"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/router"
 	httprouter "github.com/consensys/orchestrate/pkg/toolkit/app/http/router/dynamic"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
@@ -103,6 +104,10 @@ func New(cfg *Config, opts ...Option) (*App, error) {
 	reg.Add(httpMidMetrics)
 	httpBuilder.Metrics = metricsmid.NewBuilder(httpMidMetrics)
 
+	if cfg.Tracing != nil && cfg.Tracing.Enabled {
+		httpBuilder.Tracing = tracingmid.NewBuilder()
+	}
+
 	// Create watcher
 	prvdr := aggregator.New()
 	watcher := configwatcher.New(
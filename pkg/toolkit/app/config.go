@@ -5,6 +5,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/configwatcher"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	metricsregister "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/registry"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/spf13/viper"
 	traefikstatic "github.com/traefik/traefik/v2/pkg/config/static"
 	traefiktypes "github.com/traefik/traefik/v2/pkg/types"
@@ -15,6 +16,7 @@ type Config struct {
 	Watcher *configwatcher.Config
 	Log     *log.Config
 	Metrics *metricsregister.Config
+	Tracing *tracing.Config
 }
 
 type HTTP struct {
@@ -42,6 +44,7 @@ func NewConfig(vipr *viper.Viper) *Config {
 		Watcher: configwatcher.NewConfig(vipr),
 		Log:     log.NewConfig(vipr),
 		Metrics: metricsregister.NewConfig(vipr),
+		Tracing: tracing.NewConfig(vipr),
 	}
 }
 
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Builder struct{}
+
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+func (b *Builder) Build(ctx context.Context, _ string, _ interface{}) (mid func(http.Handler) http.Handler, respModifier func(resp *http.Response) error, err error) {
+	return New(httputil.ServiceFromContext(ctx)).Handler, nil, nil
+}
+
+// Tracing starts a server span for every request, continuing the trace propagated by the caller if any
+type Tracing struct {
+	service string
+}
+
+func New(service string) *Tracing {
+	return &Tracing{
+		service: service,
+	}
+}
+
+func (t *Tracing) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		t.ServeHTTP(rw, req, h)
+	})
+}
+
+func (t *Tracing) ServeHTTP(rw http.ResponseWriter, req *http.Request, next http.Handler) {
+	ctx := tracing.ExtractHTTPHeaders(req.Context(), req.Header)
+	ctx, span := tracing.StartSpan(ctx, fmt.Sprintf("HTTP %s %s", httputil.GetMethod(req), t.service),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest(t.service, "", req)...),
+	)
+	defer span.End()
+
+	recorder := httputil.NewResponseWriterRecorder(rw)
+	next.ServeHTTP(recorder, req.WithContext(ctx))
+
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(recorder.GetCode())...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(recorder.GetCode()))
+}
@@ -0,0 +1,72 @@
+// +build unit
+
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/http/httputil"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), 1, "test"))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	ctx := httputil.WithService(context.Background(), "service-test")
+	mid, _, err := NewBuilder().Build(ctx, "", nil)
+	require.NoError(t, err)
+
+	t.Run("should start a server span continuing the trace of the caller", func(t *testing.T) {
+		exporter.Reset()
+
+		callerCtx, callerSpan := tracing.StartSpan(context.Background(), "caller")
+		callerSpan.End()
+
+		var handlerSpanCtx trace.SpanContext
+		h := mid(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			handlerSpanCtx = trace.SpanContextFromContext(req.Context())
+			rw.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "http://localhost/transactions", nil)
+		tracing.InjectHTTPHeaders(callerCtx, req.Header)
+		h.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		span := spans[1]
+		assert.Equal(t, "HTTP GET service-test", span.Name)
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+		assert.Equal(t, callerSpan.SpanContext().TraceID(), span.SpanContext.TraceID())
+		assert.Equal(t, callerSpan.SpanContext().SpanID(), span.Parent.SpanID())
+		assert.Equal(t, span.SpanContext.SpanID(), handlerSpanCtx.SpanID())
+		assert.Equal(t, codes.Unset, span.Status.Code)
+	})
+
+	t.Run("should mark span as failed on server errors", func(t *testing.T) {
+		exporter.Reset()
+
+		h := mid(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			rw.WriteHeader(http.StatusInternalServerError)
+		}))
+
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "http://localhost/transactions", nil))
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+	})
+}
@@ -29,6 +29,7 @@ type Builder struct {
 	Handler    handler.Builder
 	TLS        tlsmanager.Manager
 	Metrics    middleware.Builder
+	Tracing    middleware.Builder
 
 	dashboard handler.Builder
 
@@ -189,6 +190,17 @@ func (b *Builder) buildMiddleware(ctx context.Context, routerName string, rtInfo
 	var respModifiers []func(resp *http.Response) error
 	var rvErr error
 
+	// Add tracing middleware first so that the span covers the whole chain
+	if b.Tracing != nil {
+		mid, _, err := b.Tracing.Build(ctx, fmt.Sprintf("%v:%v", routerName, "tracing"), nil)
+		if err != nil {
+			b.logger.WithError(err).Error("could not build tracing middleware")
+			rvErr = err
+		} else if mid != nil {
+			chain = chain.Append(mid)
+		}
+	}
+
 	// Add metrics middleware
 	if b.Metrics != nil {
 		mid, respModifier, err := b.Metrics.Build(
@@ -0,0 +1,98 @@
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(EnabledViperKey, enabledDefault)
+	_ = viper.BindEnv(EnabledViperKey, enabledEnv)
+	viper.SetDefault(EndpointViperKey, endpointDefault)
+	_ = viper.BindEnv(EndpointViperKey, endpointEnv)
+	viper.SetDefault(InsecureViperKey, insecureDefault)
+	_ = viper.BindEnv(InsecureViperKey, insecureEnv)
+	viper.SetDefault(SampleRatioViperKey, sampleRatioDefault)
+	_ = viper.BindEnv(SampleRatioViperKey, sampleRatioEnv)
+}
+
+const (
+	enabledFlag     = "tracing-enabled"
+	EnabledViperKey = "tracing.enabled"
+	enabledDefault  = false
+	enabledEnv      = "TRACING_ENABLED"
+)
+
+const (
+	endpointFlag     = "tracing-otlp-endpoint"
+	EndpointViperKey = "tracing.otlp.endpoint"
+	endpointDefault  = "localhost:4317"
+	endpointEnv      = "TRACING_OTLP_ENDPOINT"
+)
+
+const (
+	insecureFlag     = "tracing-otlp-insecure"
+	InsecureViperKey = "tracing.otlp.insecure"
+	insecureDefault  = false
+	insecureEnv      = "TRACING_OTLP_INSECURE"
+)
+
+const (
+	sampleRatioFlag     = "tracing-sample-ratio"
+	SampleRatioViperKey = "tracing.sample.ratio"
+	sampleRatioDefault  = 1.0
+	sampleRatioEnv      = "TRACING_SAMPLE_RATIO"
+)
+
+func Flags(f *pflag.FlagSet) {
+	enabled(f)
+	endpoint(f)
+	insecure(f)
+	sampleRatio(f)
+}
+
+func enabled(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Enable OpenTelemetry tracing.
+Environment variable: %q`, enabledEnv)
+	f.Bool(enabledFlag, enabledDefault, desc)
+	_ = viper.BindPFlag(EnabledViperKey, f.Lookup(enabledFlag))
+}
+
+func endpoint(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Address of the OTLP gRPC collector spans are exported to.
+Environment variable: %q`, endpointEnv)
+	f.String(endpointFlag, endpointDefault, desc)
+	_ = viper.BindPFlag(EndpointViperKey, f.Lookup(endpointFlag))
+}
+
+func insecure(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Disable TLS on the connection to the OTLP collector.
+Environment variable: %q`, insecureEnv)
+	f.Bool(insecureFlag, insecureDefault, desc)
+	_ = viper.BindPFlag(InsecureViperKey, f.Lookup(insecureFlag))
+}
+
+func sampleRatio(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Ratio of traces to sample, between 0 and 1. Traces started upstream keep the sampling decision of their parent.
+Environment variable: %q`, sampleRatioEnv)
+	f.Float64(sampleRatioFlag, sampleRatioDefault, desc)
+	_ = viper.BindPFlag(SampleRatioViperKey, f.Lookup(sampleRatioFlag))
+}
+
+func NewConfig(vipr *viper.Viper) *Config {
+	return &Config{
+		Enabled:     vipr.GetBool(EnabledViperKey),
+		Endpoint:    vipr.GetString(EndpointViperKey),
+		Insecure:    vipr.GetBool(InsecureViperKey),
+		SampleRatio: vipr.GetFloat64(SampleRatioViperKey),
+	}
+}
+
+type Config struct {
+	Enabled     bool
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+}
@@ -0,0 +1,129 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/consensys/orchestrate"
+
+// ConfigureTracer registers the global tracer provider, exporting spans to an OTLP collector, and the W3C trace context
+// propagator. It modifies global variables so it should be called only once per process.
+// The returned function flushes the pending spans and should be called before exiting.
+func ConfigureTracer(ctx context.Context, cfg *Config, serviceName string) (func(context.Context) error, error) {
+	if cfg == nil || !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewTracerProvider(sdktrace.NewBatchSpanProcessor(exporter), cfg.SampleRatio, serviceName)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// NewTracerProvider creates a tracer provider sampling root spans at the given ratio, child spans following the
+// decision of their parent
+func NewTracerProvider(processor sdktrace.SpanProcessor, sampleRatio float64, serviceName string) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName))),
+	)
+}
+
+// StartSpan starts a span with the global tracer provider, a no-op span being returned if tracing is not enabled
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// EndSpan ends the span, marking it as failed if err is not nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// InjectHTTPHeaders writes the trace context of ctx in the headers of an outgoing HTTP request
+func InjectHTTPHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// ExtractHTTPHeaders returns a copy of ctx holding the trace context found in the headers of an incoming HTTP request
+func ExtractHTTPHeaders(ctx context.Context, header http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectMessageHeaders writes the trace context of ctx in the headers of a message to be published
+func InjectMessageHeaders(ctx context.Context, headers map[string]interface{}) {
+	carrier := mapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	for key, value := range carrier {
+		headers[key] = value
+	}
+}
+
+// ExtractMessageHeaders returns a copy of ctx holding the trace context found in the JSON encoded headers of a
+// consumed message
+func ExtractMessageHeaders(ctx context.Context, headers map[string][]byte) context.Context {
+	propagator := otel.GetTextMapPropagator()
+
+	carrier := mapCarrier{}
+	for _, key := range propagator.Fields() {
+		bValue, ok := headers[key]
+		if !ok {
+			continue
+		}
+
+		var value string
+		if err := json.Unmarshal(bValue, &value); err == nil {
+			carrier[key] = value
+		}
+	}
+
+	return propagator.Extract(ctx, carrier)
+}
+
+// mapCarrier is a propagation.TextMapCarrier backed by a map
+type mapCarrier map[string]string
+
+var _ propagation.TextMapCarrier = mapCarrier{}
+
+func (c mapCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c mapCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+func (c mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,104 @@
+// +build unit
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTracer() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), 1, "test"))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return exporter
+}
+
+func TestConfigureTracer(t *testing.T) {
+	t.Run("should not register any tracer provider if tracing is disabled", func(t *testing.T) {
+		shutdown, err := ConfigureTracer(context.Background(), &Config{Enabled: false}, "test")
+
+		require.NoError(t, err)
+		assert.NoError(t, shutdown(context.Background()))
+	})
+}
+
+func TestEndSpan(t *testing.T) {
+	exporter := setupTracer()
+
+	t.Run("should export span successfully", func(t *testing.T) {
+		exporter.Reset()
+
+		_, span := StartSpan(context.Background(), "test.span")
+		EndSpan(span, nil)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "test.span", spans[0].Name)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	})
+
+	t.Run("should export span marked as failed", func(t *testing.T) {
+		exporter.Reset()
+
+		_, span := StartSpan(context.Background(), "test.span")
+		EndSpan(span, fmt.Errorf("error"))
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+		assert.Equal(t, "error", spans[0].Status.Description)
+	})
+}
+
+func TestMessageHeaders(t *testing.T) {
+	_ = setupTracer()
+
+	t.Run("should propagate trace context through JSON encoded message headers", func(t *testing.T) {
+		ctx, span := StartSpan(context.Background(), "test.producer")
+		defer span.End()
+
+		headers := map[string]interface{}{}
+		InjectMessageHeaders(ctx, headers)
+
+		bHeaders := map[string][]byte{}
+		for key, value := range headers {
+			bHeaders[key], _ = json.Marshal(value)
+		}
+
+		consumerCtx := ExtractMessageHeaders(context.Background(), bHeaders)
+		spanCtx := trace.SpanContextFromContext(consumerCtx)
+		assert.True(t, spanCtx.IsRemote())
+		assert.Equal(t, span.SpanContext().TraceID(), spanCtx.TraceID())
+	})
+
+	t.Run("should ignore missing trace context", func(t *testing.T) {
+		consumerCtx := ExtractMessageHeaders(context.Background(), map[string][]byte{})
+		assert.False(t, trace.SpanContextFromContext(consumerCtx).IsValid())
+	})
+}
+
+func TestHTTPHeaders(t *testing.T) {
+	_ = setupTracer()
+
+	ctx, span := StartSpan(context.Background(), "test.client")
+	defer span.End()
+
+	header := http.Header{}
+	InjectHTTPHeaders(ctx, header)
+
+	serverCtx := ExtractHTTPHeaders(context.Background(), header)
+	assert.Equal(t, span.SpanContext().TraceID(), trace.SpanContextFromContext(serverCtx).TraceID())
+}
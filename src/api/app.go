@@ -17,6 +17,7 @@ import (
 
 	postgresstore "github.com/consensys/orchestrate/src/api/store/postgres"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/signer/web3signer"
 
 	qkmclient "github.com/consensys/quorum-key-manager/pkg/client"
 
@@ -25,6 +26,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
 	pkgproxy "github.com/consensys/orchestrate/pkg/toolkit/app/http/handler/proxy"
 	"github.com/consensys/orchestrate/src/api/business/builder"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/metrics"
 	"github.com/consensys/orchestrate/src/api/service/controllers"
 )
@@ -35,9 +37,10 @@ func NewAPI(
 	jwt, key auth.Checker,
 	keyManagerClient qkmclient.KeyManagerClient,
 	qkmStoreID string,
-	ec ethclient.Client,
+	ec ethclient.MultiClient,
 	messengerClient sdk.OrchestrateMessenger,
-	notifierDaemon app.Daemon,
+	outboxMessenger usecases.OutboxMessenger,
+	daemons ...app.Daemon,
 ) (*app.App, error) {
 	// Metrics
 	var appMetrics metrics.TransactionSchedulerMetrics
@@ -47,17 +50,25 @@ func NewAPI(
 		appMetrics = metrics.NewTransactionSchedulerNopMetrics()
 	}
 
+	signers, err := web3signer.NewRouter(keyManagerClient, cfg.Web3Signer)
+	if err != nil {
+		return nil, err
+	}
+
 	ucs := builder.NewUseCases(
 		postgresstore.New(db),
 		appMetrics,
 		keyManagerClient,
+		signers,
 		qkmStoreID,
 		ec,
 		messengerClient,
+		outboxMessenger,
+		cfg.Create2Factory,
 	)
 
 	// Option of the API
-	apiHandlerOpt := app.HandlerOpt(reflect.TypeOf(&dynamic.API{}), controllers.NewBuilder(ucs, keyManagerClient, qkmStoreID))
+	apiHandlerOpt := app.HandlerOpt(reflect.TypeOf(&dynamic.API{}), controllers.NewBuilder(ucs, keyManagerClient, signers, qkmStoreID))
 
 	// ReverseProxy Handler
 	proxyBuilder, err := pkgproxy.NewBuilder(cfg.Proxy.ServersTransport, nil)
@@ -93,16 +104,23 @@ func NewAPI(
 		accessLogMid = app.NonOpt()
 	}
 
-	subscriptionRouter := service.NewSubscriptionRouter(ucs.EventStreams().NotifyContractEvents())
+	subscriptionRouter := service.NewSubscriptionRouter(ucs.EventStreams().NotifyContractEvents(), ucs.EventLogs().Index())
 	jobRouter := service.NewJobHandler(ucs.Jobs().Update())
 	notificationRouter := service.NewNotificationHandler(ucs.Notifications().Ack())
 	eventStreamRouter := service.NewEventStreamHandler(ucs.EventStreams().Update())
 
-	msgConsumer, err := service.NewMessageConsumer(
-		cfg.Kafka, []string{cfg.Messenger.TopicAPI},
-		jobRouter, subscriptionRouter, notificationRouter, eventStreamRouter)
-	if err != nil {
-		return nil, err
+	var msgConsumer messenger.Consumer
+	if cfg.Broker != nil {
+		msgConsumer = service.NewInMemoryMessageConsumer(
+			cfg.Broker, []string{cfg.Messenger.TopicAPI},
+			jobRouter, subscriptionRouter, notificationRouter, eventStreamRouter)
+	} else {
+		msgConsumer, err = service.NewMessageConsumer(
+			cfg.Kafka, []string{cfg.Messenger.TopicAPI},
+			jobRouter, subscriptionRouter, notificationRouter, eventStreamRouter)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Create app
@@ -123,7 +141,9 @@ func NewAPI(
 	}
 
 	appli.RegisterDaemon(NewConsumerService(msgConsumer))
-	appli.RegisterDaemon(notifierDaemon)
+	for _, daemon := range daemons {
+		appli.RegisterDaemon(daemon)
+	}
 
 	return appli, nil
 }
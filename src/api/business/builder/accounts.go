@@ -4,6 +4,8 @@ import (
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/accounts"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/signer"
 	qkmclient "github.com/consensys/quorum-key-manager/pkg/client"
 )
 
@@ -12,23 +14,28 @@ type accountUseCases struct {
 	get    usecases.GetAccountUseCase
 	search usecases.SearchAccountsUseCase
 	update usecases.UpdateAccountUseCase
+	rotate usecases.RotateAccountUseCase
 }
 
 func newAccountUseCases(
 	db store.DB,
 	keyManagerClient qkmclient.EthClient,
+	signers *signer.Router,
 	searchChainsUC usecases.SearchChainsUseCase,
 	sendTxUC usecases.SendTxUseCase,
 	getFaucetCandidateUC usecases.GetFaucetCandidateUseCase,
+	ec ethclient.Client,
 ) *accountUseCases {
 	searchAccountsUC := accounts.NewSearchAccountsUseCase(db)
 	fundAccountUC := accounts.NewFundAccountUseCase(searchChainsUC, sendTxUC, getFaucetCandidateUC)
+	createAccountUC := accounts.NewCreateAccountUseCase(db, searchAccountsUC, fundAccountUC, keyManagerClient, signers)
 
 	return &accountUseCases{
-		create: accounts.NewCreateAccountUseCase(db, searchAccountsUC, fundAccountUC, keyManagerClient),
+		create: createAccountUC,
 		get:    accounts.NewGetAccountUseCase(db),
 		search: searchAccountsUC,
 		update: accounts.NewUpdateAccountUseCase(db),
+		rotate: accounts.NewRotateAccountUseCase(db, createAccountUC, searchChainsUC, sendTxUC, ec),
 	}
 }
 
@@ -47,3 +54,7 @@ func (u *accountUseCases) Create() usecases.CreateAccountUseCase {
 func (u *accountUseCases) Update() usecases.UpdateAccountUseCase {
 	return u.update
 }
+
+func (u *accountUseCases) Rotate() usecases.RotateAccountUseCase {
+	return u.rotate
+}
@@ -4,6 +4,7 @@ import (
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/contracts"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
 )
 
 type contractUseCases struct {
@@ -15,13 +16,19 @@ type contractUseCases struct {
 	get                usecases.GetContractUseCase
 	search             usecases.SearchContractUseCase
 	decodeLog          usecases.DecodeEventLogUseCase
+	call               usecases.CallContractUseCase
+	importContracts    usecases.ImportContractsUseCase
+	resolveProxy       usecases.ResolveProxyContractUseCase
+	decodeCall         usecases.DecodeCallUseCase
 }
 
 var _ usecases.ContractUseCases = &contractUseCases{}
 
-func newContractUseCases(db store.DB) *contractUseCases {
+func newContractUseCases(db store.DB, ec ethclient.MultiClient, searchChainsUC usecases.SearchChainsUseCase) *contractUseCases {
 	getContractUC := contracts.NewGetContractUseCase(db.Contract())
-	getContractEventUC := contracts.NewGetEventsUseCase(db.ContractEvent())
+	searchContractUC := contracts.NewSearchContractUseCase(db.Contract())
+	getContractEventUC := contracts.NewGetEventsUseCase(db, ec)
+	resolveProxyUC := contracts.NewResolveProxyContractUseCase(db, searchChainsUC, searchContractUC, ec)
 
 	return &contractUseCases{
 		register:           contracts.NewRegisterContractUseCase(db),
@@ -31,7 +38,11 @@ func newContractUseCases(db store.DB) *contractUseCases {
 		getContractEvents:  getContractEventUC,
 		getTags:            contracts.NewGetTagsUseCase(db.Contract()),
 		registerDeployment: contracts.NewRegisterDeploymentUseCase(db.Contract()),
-		search:             contracts.NewSearchContractUseCase(db.Contract()),
+		search:             searchContractUC,
+		call:               contracts.NewCallContractUseCase(db, searchChainsUC, getContractUC, searchContractUC, resolveProxyUC, ec),
+		importContracts:    contracts.NewImportContractsUseCase(db, searchChainsUC, getContractUC),
+		resolveProxy:       resolveProxyUC,
+		decodeCall:         contracts.NewDecodeCallUseCase(getContractUC, searchContractUC),
 	}
 }
 
@@ -66,3 +77,19 @@ func (u *contractUseCases) Search() usecases.SearchContractUseCase {
 func (u *contractUseCases) DecodeLog() usecases.DecodeEventLogUseCase {
 	return u.decodeLog
 }
+
+func (u *contractUseCases) Call() usecases.CallContractUseCase {
+	return u.call
+}
+
+func (u *contractUseCases) Import() usecases.ImportContractsUseCase {
+	return u.importContracts
+}
+
+func (u *contractUseCases) ResolveProxy() usecases.ResolveProxyContractUseCase {
+	return u.resolveProxy
+}
+
+func (u *contractUseCases) DecodeCall() usecases.DecodeCallUseCase {
+	return u.decodeCall
+}
@@ -0,0 +1,39 @@
+package builder
+
+import (
+	"github.com/consensys/orchestrate/pkg/sdk"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	eventlogs "github.com/consensys/orchestrate/src/api/business/use-cases/event_logs"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+type eventLogUseCases struct {
+	index    usecases.IndexEventLogsUseCase
+	search   usecases.SearchEventLogsUseCase
+	backfill usecases.BackfillEventLogsUseCase
+}
+
+func newEventLogUseCases(
+	db store.DB,
+	decodeLogUC usecases.DecodeEventLogUseCase,
+	searchChainsUC usecases.SearchChainsUseCase,
+	messenger sdk.MessengerTxListener,
+) *eventLogUseCases {
+	return &eventLogUseCases{
+		index:    eventlogs.NewIndexUseCase(db, decodeLogUC),
+		search:   eventlogs.NewSearchUseCase(db.EventLog(), searchChainsUC),
+		backfill: eventlogs.NewBackfillUseCase(searchChainsUC, messenger),
+	}
+}
+
+func (u *eventLogUseCases) Index() usecases.IndexEventLogsUseCase {
+	return u.index
+}
+
+func (u *eventLogUseCases) Search() usecases.SearchEventLogsUseCase {
+	return u.search
+}
+
+func (u *eventLogUseCases) Backfill() usecases.BackfillEventLogsUseCase {
+	return u.backfill
+}
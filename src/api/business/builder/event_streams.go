@@ -12,6 +12,7 @@ type eventStreamUseCases struct {
 	search               usecases.SearchEventStreamsUseCase
 	notifyTx             usecases.NotifyTransactionUseCase
 	notifyContractEvents usecases.NotifyContractEventsUseCase
+	notifySafeProposal   usecases.NotifySafeProposalUseCase
 	get                  usecases.GetEventStreamUseCase
 	update               usecases.UpdateEventStreamUseCase
 	delete               usecases.DeleteEventStreamUseCase
@@ -24,13 +25,15 @@ func newEventStreamUseCases(
 	contracts usecases.ContractUseCases,
 	chains usecases.ChainUseCases,
 	txNotifierMessenger sdk.MessengerNotifier,
+	outboxMessenger usecases.OutboxMessenger,
 ) *eventStreamUseCases {
 	return &eventStreamUseCases{
 		get:                  streams.NewGetUseCase(db.EventStream()),
 		create:               streams.NewCreateUseCase(db.EventStream(), chains.Search()),
 		search:               streams.NewSearchUseCase(db.EventStream()),
-		notifyTx:             streams.NewNotifyTransactionUseCase(db, contracts.Search(), contracts.DecodeLog(), txNotifierMessenger),
+		notifyTx:             streams.NewNotifyTransactionUseCase(db, contracts.Search(), contracts.DecodeLog(), outboxMessenger),
 		notifyContractEvents: streams.NewNotifyContractEventsUseCase(db, contracts.Search(), contracts.DecodeLog(), txNotifierMessenger),
+		notifySafeProposal:   streams.NewNotifySafeProposalUseCase(db, outboxMessenger),
 		update:               streams.NewUpdateUseCase(db.EventStream()),
 		delete:               streams.NewDeleteUseCase(db.EventStream()),
 	}
@@ -52,6 +55,10 @@ func (u *eventStreamUseCases) NotifyContractEvents() usecases.NotifyContractEven
 	return u.notifyContractEvents
 }
 
+func (u *eventStreamUseCases) NotifySafeProposal() usecases.NotifySafeProposalUseCase {
+	return u.notifySafeProposal
+}
+
 func (u *eventStreamUseCases) Get() usecases.GetEventStreamUseCase {
 	return u.get
 }
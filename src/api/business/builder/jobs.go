@@ -1,7 +1,6 @@
 package builder
 
 import (
-	"github.com/consensys/orchestrate/pkg/sdk"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/jobs"
 	"github.com/consensys/orchestrate/src/api/metrics"
@@ -10,6 +9,7 @@ import (
 
 type jobUseCases struct {
 	create   usecases.CreateJobUseCase
+	prepare  usecases.PrepareJobUseCase
 	get      usecases.GetJobUseCase
 	start    usecases.StartJobUseCase
 	resendTx usecases.ResendJobTxUseCase
@@ -21,22 +21,28 @@ type jobUseCases struct {
 func newJobUseCases(
 	db store.DB,
 	appMetrics metrics.TransactionSchedulerMetrics,
-	messengerClient sdk.OrchestrateMessenger,
+	outboxMessenger usecases.OutboxMessenger,
 	eventStreams usecases.EventStreamsUseCases,
 	chains usecases.ChainUseCases,
 	qkmStoreID string,
+	updateSafeProposalUC usecases.UpdateSafeProposalExecutionUseCase,
+	updateRelaySpendingUC usecases.UpdateRelaySpendingUseCase,
+	decodeCallUC usecases.DecodeCallUseCase,
 ) *jobUseCases {
-	startJobUC := jobs.NewStartJobUseCase(db, messengerClient, appMetrics)
+	startJobUC := jobs.NewStartJobUseCase(db, outboxMessenger, appMetrics)
 	startNextJobUC := jobs.NewStartNextJobUseCase(db, startJobUC)
-	createJobUC := jobs.NewCreateJobUseCase(db, chains.Get(), qkmStoreID)
+	prepareJobUC := jobs.NewPrepareJobUseCase(db, qkmStoreID)
+	createJobUC := jobs.NewCreateJobUseCase(db, chains.Get(), prepareJobUC)
 
 	return &jobUseCases{
-		create:   createJobUC,
-		get:      jobs.NewGetJobUseCase(db),
-		search:   jobs.NewSearchJobsUseCase(db),
-		update:   jobs.NewUpdateJobUseCase(db, startNextJobUC, appMetrics, eventStreams.NotifyTransaction(), messengerClient),
+		create:  createJobUC,
+		prepare: prepareJobUC,
+		get:     jobs.NewGetJobUseCase(db, decodeCallUC),
+		search:  jobs.NewSearchJobsUseCase(db, decodeCallUC),
+		update: jobs.NewUpdateJobUseCase(db, startNextJobUC, appMetrics, eventStreams.NotifyTransaction(), outboxMessenger,
+			updateSafeProposalUC, updateRelaySpendingUC),
 		start:    startJobUC,
-		resendTx: jobs.NewResendJobTxUseCase(db, messengerClient),
+		resendTx: jobs.NewResendJobTxUseCase(db, outboxMessenger),
 		retryTx:  jobs.NewRetryJobTxUseCase(db, createJobUC, startJobUC),
 	}
 }
@@ -0,0 +1,51 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	privacygroups "github.com/consensys/orchestrate/src/api/business/use-cases/privacy_groups"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+)
+
+type privacyGroupUseCases struct {
+	create usecases.CreatePrivacyGroupUseCase
+	get    usecases.GetPrivacyGroupUseCase
+	search usecases.SearchPrivacyGroupsUseCase
+	delete usecases.DeletePrivacyGroupUseCase
+	find   usecases.FindPrivacyGroupsUseCase
+}
+
+func newPrivacyGroupUseCases(
+	db store.DB,
+	ec ethclient.EEAClient,
+	searchChainsUC usecases.SearchChainsUseCase,
+	getChainUC usecases.GetChainUseCase,
+) *privacyGroupUseCases {
+	return &privacyGroupUseCases{
+		create: privacygroups.NewCreateUseCase(db, searchChainsUC, ec),
+		get:    privacygroups.NewGetUseCase(db.PrivacyGroup()),
+		search: privacygroups.NewSearchUseCase(db.PrivacyGroup()),
+		delete: privacygroups.NewDeleteUseCase(db, getChainUC, ec),
+		find:   privacygroups.NewFindUseCase(searchChainsUC, ec),
+	}
+}
+
+func (u *privacyGroupUseCases) Create() usecases.CreatePrivacyGroupUseCase {
+	return u.create
+}
+
+func (u *privacyGroupUseCases) Get() usecases.GetPrivacyGroupUseCase {
+	return u.get
+}
+
+func (u *privacyGroupUseCases) Search() usecases.SearchPrivacyGroupsUseCase {
+	return u.search
+}
+
+func (u *privacyGroupUseCases) Delete() usecases.DeletePrivacyGroupUseCase {
+	return u.delete
+}
+
+func (u *privacyGroupUseCases) Find() usecases.FindPrivacyGroupsUseCase {
+	return u.find
+}
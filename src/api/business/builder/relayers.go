@@ -0,0 +1,58 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/relayers"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+)
+
+type relayerUseCases struct {
+	register        usecases.RegisterRelayerUseCase
+	get             usecases.GetRelayerUseCase
+	search          usecases.SearchRelayersUseCase
+	delete          usecases.DeleteRelayerUseCase
+	relay           usecases.RelayTxUseCase
+	searchSpendings usecases.SearchRelaySpendingsUseCase
+}
+
+func newRelayerUseCases(
+	db store.DB,
+	ec ethclient.Client,
+	searchChainsUC usecases.SearchChainsUseCase,
+	getContractUC usecases.GetContractUseCase,
+	sendTxUC usecases.SendTxUseCase,
+) *relayerUseCases {
+	return &relayerUseCases{
+		register:        relayers.NewRegisterUseCase(db, searchChainsUC, getContractUC),
+		get:             relayers.NewGetUseCase(db.Relayer()),
+		search:          relayers.NewSearchUseCase(db.Relayer()),
+		delete:          relayers.NewDeleteUseCase(db.Relayer()),
+		relay:           relayers.NewRelayUseCase(db, searchChainsUC, getContractUC, sendTxUC, ec),
+		searchSpendings: relayers.NewSearchSpendingsUseCase(db.Relayer()),
+	}
+}
+
+func (u *relayerUseCases) Register() usecases.RegisterRelayerUseCase {
+	return u.register
+}
+
+func (u *relayerUseCases) Get() usecases.GetRelayerUseCase {
+	return u.get
+}
+
+func (u *relayerUseCases) Search() usecases.SearchRelayersUseCase {
+	return u.search
+}
+
+func (u *relayerUseCases) Delete() usecases.DeleteRelayerUseCase {
+	return u.delete
+}
+
+func (u *relayerUseCases) Relay() usecases.RelayTxUseCase {
+	return u.relay
+}
+
+func (u *relayerUseCases) SearchSpendings() usecases.SearchRelaySpendingsUseCase {
+	return u.searchSpendings
+}
@@ -0,0 +1,49 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	safeproposals "github.com/consensys/orchestrate/src/api/business/use-cases/safe_proposals"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/signer"
+)
+
+type safeProposalUseCases struct {
+	create usecases.CreateSafeProposalUseCase
+	get    usecases.GetSafeProposalUseCase
+	search usecases.SearchSafeProposalsUseCase
+	sign   usecases.SignSafeProposalUseCase
+}
+
+func newSafeProposalUseCases(
+	db store.DB,
+	signer signer.Signer,
+	qkmStoreID string,
+	ec ethclient.Client,
+	searchChainsUC usecases.SearchChainsUseCase,
+	sendTxUC usecases.SendTxUseCase,
+	notifyUC usecases.NotifySafeProposalUseCase,
+) *safeProposalUseCases {
+	return &safeProposalUseCases{
+		create: safeproposals.NewCreateUseCase(db, searchChainsUC, ec),
+		get:    safeproposals.NewGetUseCase(db.SafeProposal()),
+		search: safeproposals.NewSearchUseCase(db.SafeProposal()),
+		sign:   safeproposals.NewSignUseCase(db, signer, qkmStoreID, sendTxUC, notifyUC),
+	}
+}
+
+func (u *safeProposalUseCases) Create() usecases.CreateSafeProposalUseCase {
+	return u.create
+}
+
+func (u *safeProposalUseCases) Get() usecases.GetSafeProposalUseCase {
+	return u.get
+}
+
+func (u *safeProposalUseCases) Search() usecases.SearchSafeProposalsUseCase {
+	return u.search
+}
+
+func (u *safeProposalUseCases) Sign() usecases.SignSafeProposalUseCase {
+	return u.sign
+}
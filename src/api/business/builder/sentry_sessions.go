@@ -0,0 +1,45 @@
+package builder
+
+import (
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	sentrysessions "github.com/consensys/orchestrate/src/api/business/use-cases/sentry_sessions"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+type sentrySessionUseCases struct {
+	create usecases.CreateSentrySessionUseCase
+	update usecases.UpdateSentrySessionUseCase
+	get    usecases.GetSentrySessionUseCase
+	search usecases.SearchSentrySessionsUseCase
+	delete usecases.DeleteSentrySessionUseCase
+}
+
+func newSentrySessionUseCases(db store.DB) *sentrySessionUseCases {
+	return &sentrySessionUseCases{
+		create: sentrysessions.NewCreateUseCase(db),
+		update: sentrysessions.NewUpdateUseCase(db.SentrySession()),
+		get:    sentrysessions.NewGetUseCase(db.SentrySession()),
+		search: sentrysessions.NewSearchUseCase(db.SentrySession()),
+		delete: sentrysessions.NewDeleteUseCase(db.SentrySession()),
+	}
+}
+
+func (u *sentrySessionUseCases) Create() usecases.CreateSentrySessionUseCase {
+	return u.create
+}
+
+func (u *sentrySessionUseCases) Update() usecases.UpdateSentrySessionUseCase {
+	return u.update
+}
+
+func (u *sentrySessionUseCases) Get() usecases.GetSentrySessionUseCase {
+	return u.get
+}
+
+func (u *sentrySessionUseCases) Search() usecases.SearchSentrySessionsUseCase {
+	return u.search
+}
+
+func (u *sentrySessionUseCases) Delete() usecases.DeleteSentrySessionUseCase {
+	return u.delete
+}
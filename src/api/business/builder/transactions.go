@@ -4,12 +4,15 @@ import (
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/transactions"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 type transactionUseCases struct {
 	sendContract usecases.SendContractTxUseCase
 	sendDeploy   usecases.SendDeployTxUseCase
 	send         usecases.SendTxUseCase
+	sendBatch    usecases.SendTxBatchUseCase
 	get          usecases.GetTxUseCase
 	search       usecases.SearchTransactionsUseCase
 	speedUp      usecases.SpeedUpTxUseCase
@@ -23,19 +26,25 @@ func newTransactionUseCases(
 	schedulesUCs *scheduleUseCases,
 	jobUCs *jobUseCases,
 	getContractUC usecases.GetContractUseCase,
+	resolveProxyUC usecases.ResolveProxyContractUseCase,
+	decodeCallUC usecases.DecodeCallUseCase,
+	ec ethclient.ChainStateReader,
+	create2Factory ethcommon.Address,
 ) *transactionUseCases {
-	getTransactionUC := transactions.NewGetTxUseCase(db, schedulesUCs.GetSchedule())
+	getTransactionUC := transactions.NewGetTxUseCase(db, schedulesUCs.GetSchedule(), decodeCallUC)
 	sendTxUC := transactions.NewSendTxUseCase(db, searchChainsUC, jobUCs.Start(), jobUCs.Create(), getTransactionUC,
 		getFaucetCandidateUC)
 
 	return &transactionUseCases{
-		sendContract: transactions.NewSendContractTxUseCase(sendTxUC, getContractUC),
-		sendDeploy:   transactions.NewSendDeployTxUseCase(sendTxUC, getContractUC),
+		sendContract: transactions.NewSendContractTxUseCase(sendTxUC, getContractUC, resolveProxyUC),
+		sendDeploy:   transactions.NewSendDeployTxUseCase(sendTxUC, getContractUC, searchChainsUC, ec, create2Factory),
 		send:         sendTxUC,
-		get:          getTransactionUC,
-		search:       transactions.NewSearchTransactionsUseCase(db, getTransactionUC),
-		speedUp:      transactions.NewSpeedUpTxUseCase(getTransactionUC, jobUCs.retryTx),
-		callOff:      transactions.NewCallOffTxUseCase(getTransactionUC, jobUCs.retryTx),
+		sendBatch: transactions.NewSendTxBatchUseCase(db, searchChainsUC, jobUCs.Start(), jobUCs.Create(), jobUCs.prepare,
+			getTransactionUC, getFaucetCandidateUC, getContractUC, resolveProxyUC, ec, create2Factory),
+		get:     getTransactionUC,
+		search:  transactions.NewSearchTransactionsUseCase(db, getTransactionUC),
+		speedUp: transactions.NewSpeedUpTxUseCase(getTransactionUC, jobUCs.retryTx),
+		callOff: transactions.NewCallOffTxUseCase(getTransactionUC, jobUCs.retryTx),
 	}
 }
 
@@ -51,6 +60,10 @@ func (u *transactionUseCases) Send() usecases.SendTxUseCase {
 	return u.send
 }
 
+func (u *transactionUseCases) SendBatch() usecases.SendTxBatchUseCase {
+	return u.sendBatch
+}
+
 func (u *transactionUseCases) Get() usecases.GetTxUseCase {
 	return u.get
 }
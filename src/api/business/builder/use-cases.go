@@ -4,58 +4,83 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/faucets"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/relayers"
+	safeproposals "github.com/consensys/orchestrate/src/api/business/use-cases/safe_proposals"
 	"github.com/consensys/orchestrate/src/api/metrics"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/signer"
 	qkmclient "github.com/consensys/quorum-key-manager/pkg/client"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 type useCases struct {
-	jobUseCases          usecases.JobUseCases
-	scheduleUseCases     usecases.ScheduleUseCases
-	transactionUseCases  usecases.TransactionUseCases
-	faucetUseCases       usecases.FaucetUseCases
-	chainUseCases        usecases.ChainUseCases
-	contractUseCases     usecases.ContractUseCases
-	accountUseCases      usecases.AccountUseCases
-	eventStreamUseCases  usecases.EventStreamsUseCases
-	subscriptionUseCases usecases.SubscriptionUseCases
-	notificationUseCases usecases.NotificationsUseCases
+	jobUseCases           usecases.JobUseCases
+	scheduleUseCases      usecases.ScheduleUseCases
+	transactionUseCases   usecases.TransactionUseCases
+	faucetUseCases        usecases.FaucetUseCases
+	chainUseCases         usecases.ChainUseCases
+	contractUseCases      usecases.ContractUseCases
+	accountUseCases       usecases.AccountUseCases
+	eventStreamUseCases   usecases.EventStreamsUseCases
+	subscriptionUseCases  usecases.SubscriptionUseCases
+	notificationUseCases  usecases.NotificationsUseCases
+	safeProposalUseCases  usecases.SafeProposalUseCases
+	relayerUseCases       usecases.RelayerUseCases
+	eventLogUseCases      usecases.EventLogUseCases
+	sentrySessionUseCases usecases.SentrySessionUseCases
+	privacyGroupUseCases  usecases.PrivacyGroupUseCases
 }
 
 func NewUseCases(
 	db store.DB,
 	appMetrics metrics.TransactionSchedulerMetrics,
 	keyManagerClient qkmclient.EthClient,
+	signers *signer.Router,
 	qkmStoreID string,
-	ec ethclient.Client,
+	ec ethclient.MultiClient,
 	messengerClient sdk.OrchestrateMessenger,
+	outboxMessenger usecases.OutboxMessenger,
+	create2Factory ethcommon.Address,
 ) usecases.UseCases {
 	chainUseCases := newChainUseCases(db, ec)
-	contractUseCases := newContractUseCases(db)
+	contractUseCases := newContractUseCases(db, ec, chainUseCases.Search())
 	faucetUseCases := newFaucetUseCases(db)
 	getFaucetCandidateUC := faucets.NewGetFaucetCandidateUseCase(faucetUseCases.Search(), ec)
 	scheduleUseCases := newScheduleUseCases(db)
-	eventStreamUseCases := newEventStreamUseCases(db, contractUseCases, chainUseCases, messengerClient)
+	eventStreamUseCases := newEventStreamUseCases(db, contractUseCases, chainUseCases, messengerClient, outboxMessenger)
 	subscriptionsUseCases := NewSubscriptionUseCases(db, contractUseCases, chainUseCases, eventStreamUseCases.Search(),
 		messengerClient)
-	jobUseCases := newJobUseCases(db, appMetrics, messengerClient, eventStreamUseCases, chainUseCases, qkmStoreID)
+	updateSafeProposalUC := safeproposals.NewUpdateExecutionUseCase(db, eventStreamUseCases.NotifySafeProposal())
+	updateRelaySpendingUC := relayers.NewUpdateSpendingUseCase(db.Relayer())
+	jobUseCases := newJobUseCases(db, appMetrics, outboxMessenger, eventStreamUseCases, chainUseCases, qkmStoreID,
+		updateSafeProposalUC, updateRelaySpendingUC, contractUseCases.DecodeCall())
 	transactionUseCases := newTransactionUseCases(db, chainUseCases.Search(), getFaucetCandidateUC,
-		scheduleUseCases, jobUseCases, contractUseCases.Get())
-	accountUseCases := newAccountUseCases(db, keyManagerClient, chainUseCases.Search(),
-		transactionUseCases.Send(), getFaucetCandidateUC)
+		scheduleUseCases, jobUseCases, contractUseCases.Get(), contractUseCases.ResolveProxy(), contractUseCases.DecodeCall(),
+		ec, create2Factory)
+	accountUseCases := newAccountUseCases(db, keyManagerClient, signers, chainUseCases.Search(),
+		transactionUseCases.Send(), getFaucetCandidateUC, ec)
+	safeProposalUseCases := newSafeProposalUseCases(db, signers, qkmStoreID, ec, chainUseCases.Search(),
+		transactionUseCases.Send(), eventStreamUseCases.NotifySafeProposal())
+	relayerUseCases := newRelayerUseCases(db, ec, chainUseCases.Search(), contractUseCases.Get(), transactionUseCases.Send())
+	eventLogUseCases := newEventLogUseCases(db, contractUseCases.DecodeLog(), chainUseCases.Search(), messengerClient)
 
 	return &useCases{
-		jobUseCases:          jobUseCases,
-		scheduleUseCases:     scheduleUseCases,
-		transactionUseCases:  transactionUseCases,
-		faucetUseCases:       faucetUseCases,
-		chainUseCases:        chainUseCases,
-		contractUseCases:     contractUseCases,
-		accountUseCases:      accountUseCases,
-		eventStreamUseCases:  eventStreamUseCases,
-		subscriptionUseCases: subscriptionsUseCases,
-		notificationUseCases: NewNotificationUseCases(db.Notification()),
+		jobUseCases:           jobUseCases,
+		scheduleUseCases:      scheduleUseCases,
+		transactionUseCases:   transactionUseCases,
+		faucetUseCases:        faucetUseCases,
+		chainUseCases:         chainUseCases,
+		contractUseCases:      contractUseCases,
+		accountUseCases:       accountUseCases,
+		eventStreamUseCases:   eventStreamUseCases,
+		subscriptionUseCases:  subscriptionsUseCases,
+		notificationUseCases:  NewNotificationUseCases(db.Notification()),
+		safeProposalUseCases:  safeProposalUseCases,
+		relayerUseCases:       relayerUseCases,
+		eventLogUseCases:      eventLogUseCases,
+		sentrySessionUseCases: newSentrySessionUseCases(db),
+		privacyGroupUseCases:  newPrivacyGroupUseCases(db, ec, chainUseCases.Search(), chainUseCases.Get()),
 	}
 }
 
@@ -98,3 +123,23 @@ func (ucs *useCases) Subscriptions() usecases.SubscriptionUseCases {
 func (ucs *useCases) Notifications() usecases.NotificationsUseCases {
 	return ucs.notificationUseCases
 }
+
+func (ucs *useCases) SafeProposals() usecases.SafeProposalUseCases {
+	return ucs.safeProposalUseCases
+}
+
+func (ucs *useCases) Relayers() usecases.RelayerUseCases {
+	return ucs.relayerUseCases
+}
+
+func (ucs *useCases) EventLogs() usecases.EventLogUseCases {
+	return ucs.eventLogUseCases
+}
+
+func (ucs *useCases) SentrySessions() usecases.SentrySessionUseCases {
+	return ucs.sentrySessionUseCases
+}
+
+func (ucs *useCases) PrivacyGroups() usecases.PrivacyGroupUseCases {
+	return ucs.privacyGroupUseCases
+}
@@ -16,6 +16,7 @@ type AccountUseCases interface {
 	Create() CreateAccountUseCase
 	Update() UpdateAccountUseCase
 	Search() SearchAccountsUseCase
+	Rotate() RotateAccountUseCase
 }
 
 type GetAccountUseCase interface {
@@ -34,6 +35,10 @@ type UpdateAccountUseCase interface {
 	Execute(ctx context.Context, identity *entities.Account, userInfo *multitenancy.UserInfo) (*entities.Account, error)
 }
 
+type RotateAccountUseCase interface {
+	Execute(ctx context.Context, address ethcommon.Address, newAcc *entities.Account, chainNames []string, userInfo *multitenancy.UserInfo) (*entities.Account, error)
+}
+
 type FundAccountUseCase interface {
 	Execute(ctx context.Context, identity *entities.Account, chainName string, userInfo *multitenancy.UserInfo) error
 }
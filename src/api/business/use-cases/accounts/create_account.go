@@ -15,9 +15,12 @@ import (
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/signer"
 	"github.com/consensys/quorum-key-manager/pkg/client"
 	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const createAccountComponent = "use-cases.create-account"
@@ -27,6 +30,7 @@ type createAccountUseCase struct {
 	searchUC         usecases.SearchAccountsUseCase
 	fundAccountUC    usecases.FundAccountUseCase
 	keyManagerClient client.EthClient
+	signers          *signer.Router
 	logger           *log.Logger
 }
 
@@ -35,11 +39,13 @@ func NewCreateAccountUseCase(
 	searchUC usecases.SearchAccountsUseCase,
 	fundAccountUC usecases.FundAccountUseCase,
 	keyManagerClient client.EthClient,
+	signers *signer.Router,
 ) usecases.CreateAccountUseCase {
 	return &createAccountUseCase{
 		db:               db,
 		searchUC:         searchUC,
 		keyManagerClient: keyManagerClient,
+		signers:          signers,
 		fundAccountUC:    fundAccountUC,
 		logger:           log.NewLogger().SetComponent(createAccountComponent),
 	}
@@ -65,6 +71,15 @@ func (uc *createAccountUseCase) Execute(ctx context.Context, acc *entities.Accou
 		return nil, errors.AlreadyExistsError(errMsg).ExtendComponent(createAccountComponent)
 	}
 
+	if remoteSigner, ok := uc.signers.RemoteSigner(acc.StoreID); ok {
+		err = uc.registerRemoteAccount(ctx, remoteSigner, acc, privateKey, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(createAccountComponent)
+		}
+
+		return uc.insert(ctx, acc, chainName, userInfo)
+	}
+
 	var accountID = generateKeyID(userInfo.TenantID, acc.Alias)
 	var resp *qkmtypes.EthAccountResponse
 	if privateKey != nil {
@@ -114,10 +129,66 @@ func (uc *createAccountUseCase) Execute(ctx context.Context, acc *entities.Accou
 	acc.Address = resp.Address
 	acc.PublicKey = resp.PublicKey
 	acc.CompressedPublicKey = resp.CompressedPublicKey
+
+	return uc.insert(ctx, acc, chainName, userInfo)
+}
+
+// registerRemoteAccount registers an account whose key is held by a remote signer, which neither generates nor imports keys
+func (uc *createAccountUseCase) registerRemoteAccount(ctx context.Context, remoteSigner signer.RemoteSigner, acc *entities.Account,
+	privateKey hexutil.Bytes, userInfo *multitenancy.UserInfo) error {
+	logger := uc.logger.WithContext(ctx).WithField("store_id", acc.StoreID)
+
+	if privateKey != nil {
+		errMsg := "private keys cannot be imported in a remote signer store"
+		logger.Error(errMsg)
+		return errors.InvalidParameterError(errMsg)
+	}
+
+	if acc.Address == (ethcommon.Address{}) {
+		errMsg := "address of the account is required for a remote signer store"
+		logger.Error(errMsg)
+		return errors.InvalidParameterError(errMsg)
+	}
+
+	existingAcc, err := uc.db.Account().FindOneByAddress(ctx, acc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if existingAcc != nil {
+		errMsg := "account already exists"
+		logger.Error(errMsg)
+		return errors.AlreadyExistsError(errMsg)
+	}
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to get account"
+		logger.WithError(err).Error(errMsg)
+		return err
+	}
+
+	publicKey, err := remoteSigner.GetPublicKey(ctx, acc.Address.Hex())
+	if err != nil {
+		errMsg := "failed to get public key from remote signer"
+		logger.WithError(err).Error(errMsg)
+		return err
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(publicKey)
+	if err != nil || crypto.PubkeyToAddress(*pubKey) != acc.Address {
+		errMsg := "remote signer returned an invalid public key"
+		logger.Error(errMsg)
+		return errors.DependencyFailureError(errMsg)
+	}
+
+	acc.PublicKey = publicKey
+	acc.CompressedPublicKey = crypto.CompressPubkey(pubKey)
+
+	return nil
+}
+
+func (uc *createAccountUseCase) insert(ctx context.Context, acc *entities.Account, chainName string,
+	userInfo *multitenancy.UserInfo) (*entities.Account, error) {
 	acc.TenantID = userInfo.TenantID
 	acc.OwnerID = userInfo.Username
+	acc.Status = entities.AccountStatusActive
 
-	acc, err = uc.db.Account().Insert(ctx, acc)
+	acc, err := uc.db.Account().Insert(ctx, acc)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(createAccountComponent)
 	}
@@ -129,7 +200,7 @@ func (uc *createAccountUseCase) Execute(ctx context.Context, acc *entities.Accou
 		}
 	}
 
-	logger.WithField("address", acc.Address).Info("ethereum account created successfully")
+	uc.logger.WithContext(ctx).WithField("address", acc.Address).Info("ethereum account created successfully")
 	return acc, nil
 }
 
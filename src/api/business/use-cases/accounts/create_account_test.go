@@ -15,8 +15,12 @@ import (
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/signer"
+	signermocks "github.com/consensys/orchestrate/src/infra/signer/mocks"
 	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
@@ -33,11 +37,14 @@ func TestCreateAccount_Execute(t *testing.T) {
 	mockSearchUC := mocks2.NewMockSearchAccountsUseCase(ctrl)
 	mockFundAccountUC := mocks2.NewMockFundAccountUseCase(ctrl)
 	mockClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockRemoteSigner := signermocks.NewMockRemoteSigner(ctrl)
+	remoteStoreID := "remote-store"
 
 	mockDB.EXPECT().Account().Return(accountAgent).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewCreateAccountUseCase(mockDB, mockSearchUC, mockFundAccountUC, mockClient)
+	usecase := NewCreateAccountUseCase(mockDB, mockSearchUC, mockFundAccountUC, mockClient,
+		signer.NewRouter(mockClient).Mount(remoteStoreID, mockRemoteSigner))
 
 	t.Run("should create new account account successfully", func(t *testing.T) {
 		accEntity := testdata.FakeAccount()
@@ -252,4 +259,63 @@ func TestCreateAccount_Execute(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createAccountComponent), err)
 	})
+	t.Run("should register account of a remote signer store successfully", func(t *testing.T) {
+		privKey, _ := crypto.GenerateKey()
+		accEntity := testdata.FakeAccount()
+		accEntity.StoreID = remoteStoreID
+		accEntity.Address = crypto.PubkeyToAddress(privKey.PublicKey)
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), &entities.AccountFilters{Aliases: []string{accEntity.Alias}, TenantID: userInfo.TenantID}, userInfo)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), accEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+		mockRemoteSigner.EXPECT().GetPublicKey(gomock.Any(), accEntity.Address.Hex()).Return(crypto.FromECDSAPub(&privKey.PublicKey), nil)
+		accountAgent.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, acc *entities.Account) (*entities.Account, error) {
+			assert.Equal(t, hexutil.Bytes(crypto.FromECDSAPub(&privKey.PublicKey)), acc.PublicKey)
+			assert.Equal(t, hexutil.Bytes(crypto.CompressPubkey(&privKey.PublicKey)), acc.CompressedPublicKey)
+			return acc, nil
+		})
+
+		resp, err := usecase.Execute(ctx, accEntity, nil, "", userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.AccountStatusActive, resp.Status)
+	})
+
+	t.Run("should fail with InvalidParameterError if address is missing for a remote signer store", func(t *testing.T) {
+		accEntity := testdata.FakeAccount()
+		accEntity.StoreID = remoteStoreID
+		accEntity.Address = ethcommon.Address{}
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), &entities.AccountFilters{Aliases: []string{accEntity.Alias}, TenantID: userInfo.TenantID}, userInfo)
+
+		_, err := usecase.Execute(ctx, accEntity, nil, "", userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if importing a private key in a remote signer store", func(t *testing.T) {
+		accEntity := testdata.FakeAccount()
+		accEntity.StoreID = remoteStoreID
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), &entities.AccountFilters{Aliases: []string{accEntity.Alias}, TenantID: userInfo.TenantID}, userInfo)
+
+		_, err := usecase.Execute(ctx, accEntity, hexutil.MustDecode("0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E"), "", userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with DependencyFailureError if the remote signer returns the key of another account", func(t *testing.T) {
+		privKey, _ := crypto.GenerateKey()
+		accEntity := testdata.FakeAccount()
+		accEntity.StoreID = remoteStoreID
+
+		mockSearchUC.EXPECT().Execute(gomock.Any(), &entities.AccountFilters{Aliases: []string{accEntity.Alias}, TenantID: userInfo.TenantID}, userInfo)
+		accountAgent.EXPECT().FindOneByAddress(gomock.Any(), accEntity.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+		mockRemoteSigner.EXPECT().GetPublicKey(gomock.Any(), accEntity.Address.Hex()).Return(crypto.FromECDSAPub(&privKey.PublicKey), nil)
+
+		_, err := usecase.Execute(ctx, accEntity, nil, "", userInfo)
+
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
 }
@@ -0,0 +1,204 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const rotateAccountComponent = "use-cases.rotate-account"
+
+type rotateAccountUseCase struct {
+	db              store.DB
+	createAccountUC usecases.CreateAccountUseCase
+	searchChainsUC  usecases.SearchChainsUseCase
+	sendTxUC        usecases.SendTxUseCase
+	ec              ethclient.Client
+	logger          *log.Logger
+}
+
+func NewRotateAccountUseCase(
+	db store.DB,
+	createAccountUC usecases.CreateAccountUseCase,
+	searchChainsUC usecases.SearchChainsUseCase,
+	sendTxUC usecases.SendTxUseCase,
+	ec ethclient.Client,
+) usecases.RotateAccountUseCase {
+	return &rotateAccountUseCase{
+		db:              db,
+		createAccountUC: createAccountUC,
+		searchChainsUC:  searchChainsUC,
+		sendTxUC:        sendTxUC,
+		ec:              ec,
+		logger:          log.NewLogger().SetComponent(rotateAccountComponent),
+	}
+}
+
+// Execute creates a new key replacing the account, transfers its remaining balance on the given chains to the new
+// account, then archives the account once every transfer is sent. A rotation interrupted by a failed transfer is
+// resumed by the next one with the account it created
+func (uc *rotateAccountUseCase) Execute(ctx context.Context, address ethcommon.Address, newAcc *entities.Account, chainNames []string,
+	userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	ctx = log.WithFields(ctx, log.Field("address", address))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("rotating account")
+
+	curAcc, err := uc.db.Account().FindOneByAddress(ctx, address.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+	}
+
+	if curAcc.Status == entities.AccountStatusArchived {
+		errMsg := "account is archived and cannot be rotated"
+		logger.Error(errMsg)
+		return nil, errors.InvalidStateError(errMsg).ExtendComponent(rotateAccountComponent)
+	}
+
+	var chains []*entities.Chain
+	if len(chainNames) > 0 {
+		chains, err = uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: chainNames}, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+		}
+
+		if len(chains) != len(chainNames) {
+			errMsg := "chain does not exist"
+			logger.WithField("chains", chainNames).Error(errMsg)
+			return nil, errors.InvalidParameterError(errMsg).ExtendComponent(rotateAccountComponent)
+		}
+	}
+
+	if curAcc.RotatedTo != nil {
+		logger.WithField("new_address", curAcc.RotatedTo).Debug("resuming account rotation")
+		newAcc, err = uc.db.Account().FindOneByAddress(ctx, curAcc.RotatedTo.Hex(), userInfo.AllowedTenants, userInfo.Username)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+		}
+	} else {
+		newAcc, err = uc.createAccount(ctx, curAcc, newAcc, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+		}
+	}
+
+	for _, chain := range chains {
+		err = uc.transferBalance(ctx, curAcc, newAcc, chain, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+		}
+	}
+
+	curAcc.Status = entities.AccountStatusArchived
+	_, err = uc.db.Account().Update(ctx, curAcc)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(rotateAccountComponent)
+	}
+
+	logger.WithField("new_address", newAcc.Address).Info("account rotated successfully")
+	return newAcc, nil
+}
+
+// createAccount creates the new account and records it on the rotated account, so that a failed rotation is resumed
+func (uc *rotateAccountUseCase) createAccount(ctx context.Context, curAcc, newAcc *entities.Account,
+	userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	if newAcc.StoreID == "" {
+		newAcc.StoreID = curAcc.StoreID
+	}
+	newAcc.Attributes = curAcc.Attributes
+	newAcc.RotatedFrom = &curAcc.Address
+
+	newAcc, err := uc.createAccountUC.Execute(ctx, newAcc, nil, "", userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	curAcc.RotatedTo = &newAcc.Address
+	_, err = uc.db.Account().Update(ctx, curAcc)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAcc, nil
+}
+
+func (uc *rotateAccountUseCase) transferBalance(ctx context.Context, from, to *entities.Account, chain *entities.Chain,
+	userInfo *multitenancy.UserInfo) error {
+	logger := uc.logger.WithContext(ctx).WithField("chain", chain.Name)
+
+	balance, gasPrice, err := uc.getBalanceAndGasPrice(ctx, chain.URLs, from.Address)
+	if err != nil {
+		logger.WithError(err).Error("failed to fetch balance and gas price")
+		return err
+	}
+
+	// Legacy transactions with a fixed gas price are used so that the whole balance minus fees can be transferred
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(params.TxGas))
+	if balance.Cmp(fee) <= 0 {
+		logger.WithField("balance", balance).Debug("insufficient balance to transfer, skipping")
+		return nil
+	}
+
+	// The idempotency key is bound to the rotation and chain, so that a resumed rotation does not send a second transfer
+	txRequest := &entities.TxRequest{
+		IdempotencyKey: fmt.Sprintf("rotate-%s-%s-%s", from.Address.Hex(), to.Address.Hex(), chain.UUID),
+		ChainName:      chain.Name,
+		Params: &entities.TxRequestParams{
+			ETHTransaction: &entities.ETHTransaction{
+				From:            &from.Address,
+				To:              &to.Address,
+				Value:           (*hexutil.Big)(new(big.Int).Sub(balance, fee)),
+				Gas:             utils.ToPtr(params.TxGas).(*uint64),
+				GasPrice:        (*hexutil.Big)(gasPrice),
+				TransactionType: entities.LegacyTxType,
+			},
+		},
+		Labels: map[string]string{
+			"rotatedFrom": from.Address.Hex(),
+			"rotatedTo":   to.Address.Hex(),
+		},
+		InternalData: &entities.InternalData{},
+	}
+
+	_, err = uc.sendTxUC.Execute(ctx, txRequest, nil, userInfo)
+	if errors.IsAlreadyExistsError(err) {
+		// The transfer of the interrupted rotation is still pending, the balance and gas price it was sent with differing
+		logger.Debug("remaining balance transfer already sent, skipping")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.WithField("value", txRequest.Params.Value).Debug("remaining balance transfer sent")
+	return nil
+}
+
+func (uc *rotateAccountUseCase) getBalanceAndGasPrice(ctx context.Context, uris []string, address ethcommon.Address) (balance, gasPrice *big.Int, err error) {
+	for _, uri := range uris {
+		balance, err = uc.ec.BalanceAt(ctx, uri, address, nil)
+		if err != nil {
+			continue
+		}
+
+		gasPrice, err = uc.ec.SuggestGasPrice(ctx, uri)
+		if err != nil {
+			continue
+		}
+
+		return balance, gasPrice, nil
+	}
+
+	return nil, nil, errors.EthConnectionError("all URLs in the list are unreachable")
+}
@@ -0,0 +1,231 @@
+// +build unit
+
+package accounts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateAccount_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockAccountDA := mocks2.NewMockAccountAgent(ctrl)
+	mockCreateAccountUC := mocks.NewMockCreateAccountUseCase(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockSendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+	mockEthClient := mock.NewMockClient(ctrl)
+
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewRotateAccountUseCase(mockDB, mockCreateAccountUC, mockSearchChainsUC, mockSendTxUC, mockEthClient)
+
+	newAddress := ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+
+	t.Run("should rotate account and transfer remaining balance successfully", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		chain := testdata.FakeChain()
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).
+			DoAndReturn(func(ctx context.Context, acc *entities.Account, _ interface{}, _ string, _ *multitenancy.UserInfo) (*entities.Account, error) {
+				assert.Equal(t, curAcc.Address, *acc.RotatedFrom)
+				assert.Equal(t, curAcc.StoreID, acc.StoreID)
+				acc.Address = newAddress
+				return acc, nil
+			})
+		recorded := mockAccountDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, acc *entities.Account) (*entities.Account, error) {
+			assert.Equal(t, entities.AccountStatusActive, acc.Status)
+			assert.Equal(t, newAddress, *acc.RotatedTo)
+			return acc, nil
+		})
+		mockEthClient.EXPECT().BalanceAt(gomock.Any(), chain.URLs[0], curAcc.Address, nil).Return(big.NewInt(1000000), nil)
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), chain.URLs[0]).Return(big.NewInt(10), nil)
+		transferred := mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ interface{}, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, curAcc.Address, *txRequest.Params.From)
+				assert.Equal(t, newAddress, *txRequest.Params.To)
+				assert.Equal(t, "1000000", new(big.Int).Add(txRequest.Params.Value.ToInt(), big.NewInt(210000)).String())
+				return txRequest, nil
+			}).After(recorded)
+		mockAccountDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, acc *entities.Account) (*entities.Account, error) {
+			assert.Equal(t, entities.AccountStatusArchived, acc.Status)
+			return acc, nil
+		}).After(transferred)
+
+		newAcc, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, []string{chain.Name}, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, newAddress, newAcc.Address)
+	})
+
+	t.Run("should skip transfer if balance does not cover fees", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		chain := testdata.FakeChain()
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).Return(&entities.Account{Address: newAddress}, nil)
+		mockAccountDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(curAcc, nil).Times(2)
+		mockEthClient.EXPECT().BalanceAt(gomock.Any(), chain.URLs[0], curAcc.Address, nil).Return(big.NewInt(100), nil)
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), chain.URLs[0]).Return(big.NewInt(10), nil)
+
+		_, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, []string{chain.Name}, userInfo)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should not archive account if transfer fails", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		chain := testdata.FakeChain()
+		expectedErr := errors.InvalidParameterError("error")
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).Return(&entities.Account{Address: newAddress}, nil)
+		mockAccountDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, acc *entities.Account) (*entities.Account, error) {
+			assert.Equal(t, entities.AccountStatusActive, acc.Status)
+			return acc, nil
+		})
+		mockEthClient.EXPECT().BalanceAt(gomock.Any(), chain.URLs[0], curAcc.Address, nil).Return(big.NewInt(1000000), nil)
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), chain.URLs[0]).Return(big.NewInt(10), nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, []string{chain.Name}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(rotateAccountComponent), err)
+		assert.Equal(t, entities.AccountStatusActive, curAcc.Status)
+	})
+
+	t.Run("should resume rotation to the account created by the failed rotation", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		curAcc.RotatedTo = &newAddress
+		chain := testdata.FakeChain()
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), newAddress.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(&entities.Account{Address: newAddress}, nil)
+		mockEthClient.EXPECT().BalanceAt(gomock.Any(), chain.URLs[0], curAcc.Address, nil).Return(big.NewInt(1000000), nil)
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), chain.URLs[0]).Return(big.NewInt(10), nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).Return(&entities.TxRequest{}, nil)
+		mockAccountDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, acc *entities.Account) (*entities.Account, error) {
+			assert.Equal(t, entities.AccountStatusArchived, acc.Status)
+			return acc, nil
+		})
+
+		newAcc, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, []string{chain.Name}, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, newAddress, newAcc.Address)
+	})
+
+	t.Run("should not send a second transfer when resuming a rotation", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		sweptChain := testdata.FakeChain()
+		failedChain := testdata.FakeChain()
+		failedChain.Name = "failedChain"
+		failedChain.URLs = []string{"http://failed-chain-node:8545"}
+		chains := []*entities.Chain{sweptChain, failedChain}
+		var sweepKey string
+
+		// First rotation, interrupted by the failed transfer on the second chain
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(chains, nil)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).Return(&entities.Account{Address: newAddress}, nil)
+		mockAccountDA.EXPECT().Update(gomock.Any(), gomock.Any()).Return(curAcc, nil)
+		mockEthClient.EXPECT().BalanceAt(gomock.Any(), sweptChain.URLs[0], curAcc.Address, nil).Return(big.NewInt(1000000), nil).Times(2)
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), sweptChain.URLs[0]).Return(big.NewInt(10), nil)
+		mockEthClient.EXPECT().BalanceAt(gomock.Any(), failedChain.URLs[0], curAcc.Address, nil).Return(big.NewInt(1000000), nil).Times(2)
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), failedChain.URLs[0]).Return(big.NewInt(10), nil).Times(2)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ interface{}, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				sweepKey = txRequest.IdempotencyKey
+				return txRequest, nil
+			})
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).Return(nil, errors.InvalidParameterError("error"))
+
+		_, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, []string{sweptChain.Name, failedChain.Name}, userInfo)
+		require.Error(t, err)
+		require.Equal(t, newAddress, *curAcc.RotatedTo)
+
+		// Resumed rotation, the pending transfer of the first chain being sent again with a different gas price
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return(chains, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), newAddress.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(&entities.Account{Address: newAddress}, nil)
+		mockEthClient.EXPECT().SuggestGasPrice(gomock.Any(), sweptChain.URLs[0]).Return(big.NewInt(20), nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ interface{}, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, sweepKey, txRequest.IdempotencyKey)
+				return nil, errors.AlreadyExistsError("transaction request with the same idempotency key and different params already exists")
+			})
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, _ interface{}, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.NotEqual(t, sweepKey, txRequest.IdempotencyKey)
+				return txRequest, nil
+			})
+		mockAccountDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, acc *entities.Account) (*entities.Account, error) {
+			assert.Equal(t, entities.AccountStatusArchived, acc.Status)
+			return acc, nil
+		})
+
+		newAcc, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, []string{sweptChain.Name, failedChain.Name}, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, newAddress, newAcc.Address)
+	})
+
+	t.Run("should fail with InvalidStateError if account is archived", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		curAcc.Status = entities.AccountStatusArchived
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+
+		_, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, nil, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		_, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, []string{"unknown"}, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if create account fails", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		expectedErr := errors.DependencyFailureError("error")
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		mockCreateAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, "", userInfo).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, curAcc.Address, &entities.Account{}, nil, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(rotateAccountComponent), err)
+	})
+}
@@ -43,6 +43,15 @@ func (uc *updateAccountUseCase) Execute(ctx context.Context, acc *entities.Accou
 	if acc.StoreID != "" {
 		curAcc.StoreID = acc.StoreID
 	}
+	if acc.Status != "" && acc.Status != curAcc.Status {
+		// Archived accounts have been rotated or retired and cannot be reactivated
+		if curAcc.Status == entities.AccountStatusArchived {
+			errMsg := "archived accounts cannot change status"
+			logger.WithField("status", acc.Status).Error(errMsg)
+			return nil, errors.InvalidStateError(errMsg).ExtendComponent(updateAccountComponent)
+		}
+		curAcc.Status = acc.Status
+	}
 
 	updatedAcc, err := uc.db.Account().Update(ctx, curAcc)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -55,6 +56,30 @@ func TestUpdateAccount_Execute(t *testing.T) {
 		assert.Equal(t, resp.Alias, idenEntity.Alias)
 	})
 
+	t.Run("should update account status successfully", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		identityAgent.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+		identityAgent.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, acc *entities.Account) (*entities.Account, error) {
+			assert.Equal(t, entities.AccountStatusDisabled, acc.Status)
+			return acc, nil
+		})
+
+		resp, err := usecase.Execute(ctx, &entities.Account{Address: curAcc.Address, Status: entities.AccountStatusDisabled}, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.AccountStatusDisabled, resp.Status)
+	})
+
+	t.Run("should fail with InvalidStateError if account is archived", func(t *testing.T) {
+		curAcc := testdata.FakeAccount()
+		curAcc.Status = entities.AccountStatusArchived
+		identityAgent.EXPECT().FindOneByAddress(gomock.Any(), curAcc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(curAcc, nil)
+
+		_, err := usecase.Execute(ctx, &entities.Account{Address: curAcc.Address, Status: entities.AccountStatusActive}, userInfo)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
 	t.Run("should fail with same error if get identity fails", func(t *testing.T) {
 		expectedErr := errors.NotFoundError("error")
 		idenEntity := testdata.FakeAccount()
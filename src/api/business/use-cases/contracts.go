@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/types/ethereum"
 	"github.com/consensys/orchestrate/src/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -20,18 +21,22 @@ type ContractUseCases interface {
 	Register() RegisterContractUseCase
 	Search() SearchContractUseCase
 	DecodeLog() DecodeEventLogUseCase
+	Call() CallContractUseCase
+	Import() ImportContractsUseCase
+	ResolveProxy() ResolveProxyContractUseCase
+	DecodeCall() DecodeCallUseCase
 }
 
 type GetContractsCatalogUseCase interface {
-	Execute(ctx context.Context) ([]string, error)
+	Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error)
 }
 
 type GetContractUseCase interface {
-	Execute(ctx context.Context, name, tag string) (*entities.Contract, error)
+	Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error)
 }
 
 type SearchContractUseCase interface {
-	Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address) (*entities.Contract, error)
+	Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error)
 }
 
 type GetContractEventsUseCase interface {
@@ -39,17 +44,38 @@ type GetContractEventsUseCase interface {
 }
 
 type GetContractTagsUseCase interface {
-	Execute(ctx context.Context, name string) ([]string, error)
+	Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) ([]string, error)
 }
 
 type RegisterContractUseCase interface {
-	Execute(ctx context.Context, contract *entities.Contract) error
+	Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error
 }
 
 type RegisterContractDeploymentUseCase interface {
-	Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes) error
+	Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes, userInfo *multitenancy.UserInfo) error
 }
 
 type DecodeEventLogUseCase interface {
 	Execute(ctx context.Context, chainUUID string, eventLog *ethereum.Log) (*ethereum.Log, error)
 }
+
+// CallContractUseCase executes read-only contract calls and decodes their outputs with the ABI of the contract registry
+type CallContractUseCase interface {
+	Execute(ctx context.Context, req *entities.ContractCallRequest, userInfo *multitenancy.UserInfo) ([]*entities.ContractCallResult, error)
+}
+
+// ImportContractsUseCase links and registers the contracts of build artifacts under the same tag
+type ImportContractsUseCase interface {
+	Execute(ctx context.Context, req *entities.ContractImport, userInfo *multitenancy.UserInfo) ([]*entities.Contract, error)
+}
+
+// ResolveProxyContractUseCase returns the registered contract of the implementation behind a proxy, nil if the address is not a proxy
+type ResolveProxyContractUseCase interface {
+	Execute(ctx context.Context, chainName string, address ethcommon.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error)
+}
+
+// DecodeCallUseCase decodes the method calls of the transactions of jobs, with the ABI of the contract name and tag if
+// given, of the contract deployed at the recipient address otherwise
+type DecodeCallUseCase interface {
+	Execute(ctx context.Context, jobs []*entities.Job, contractName, contractTag string, userInfo *multitenancy.UserInfo) error
+}
@@ -0,0 +1,283 @@
+package contracts
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	orchabi "github.com/consensys/orchestrate/pkg/ethereum/abi"
+	"github.com/consensys/orchestrate/pkg/ethereum/multicall"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	eth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	web3abi "github.com/umbracle/go-web3/abi"
+)
+
+const callContractComponent = "use-cases.call-contract"
+
+type callContractUseCase struct {
+	db               store.DB
+	searchChainsUC   usecases.SearchChainsUseCase
+	getContractUC    usecases.GetContractUseCase
+	searchContractUC usecases.SearchContractUseCase
+	resolveProxyUC   usecases.ResolveProxyContractUseCase
+	ec               ethclient.MultiClient
+	logger           *log.Logger
+}
+
+type encodedCall struct {
+	call   *entities.ContractCall
+	method *abi.Method
+	data   []byte
+}
+
+func NewCallContractUseCase(db store.DB, searchChainsUC usecases.SearchChainsUseCase, getContractUC usecases.GetContractUseCase,
+	searchContractUC usecases.SearchContractUseCase, resolveProxyUC usecases.ResolveProxyContractUseCase,
+	ec ethclient.MultiClient) usecases.CallContractUseCase {
+	return &callContractUseCase{
+		db:               db,
+		searchChainsUC:   searchChainsUC,
+		getContractUC:    getContractUC,
+		searchContractUC: searchContractUC,
+		resolveProxyUC:   resolveProxyUC,
+		ec:               ec,
+		logger:           log.NewLogger().SetComponent(callContractComponent),
+	}
+}
+
+// Execute encodes the calls with the contract registry ABIs and runs them, through Multicall3 if requested and deployed on the chain
+func (uc *callContractUseCase) Execute(ctx context.Context, req *entities.ContractCallRequest, userInfo *multitenancy.UserInfo) ([]*entities.ContractCallResult, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", req.ChainName), log.Field("calls", len(req.Calls)))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("calling contracts")
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{req.ChainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(callContractComponent)
+	}
+
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(callContractComponent)
+	}
+
+	// Private calls of EEA are executed on the state of a privacy group, possibly named in Orchestrate
+	if req.PrivacyGroupName != "" {
+		req.PrivacyGroupID, err = uc.getPrivacyGroupID(ctx, req.PrivacyGroupName, chains[0].UUID, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(callContractComponent)
+		}
+	}
+
+	var calls []*encodedCall
+	for i, call := range req.Calls {
+		encoded, der := uc.encode(ctx, req.ChainName, call, userInfo)
+		if der != nil {
+			logger.WithError(der).WithField("index", i).Error("failed to encode contract call")
+			return nil, errors.FromError(der).SetMessage("call %d: %s", i, errors.FromError(der).GetMessage()).
+				ExtendComponent(callContractComponent)
+		}
+		calls = append(calls, encoded)
+	}
+
+	for _, uri := range chains[0].URLs {
+		results, der := uc.execute(ctx, uri, req, calls)
+		if der != nil {
+			logger.WithError(der).WithField("url", uri).Warn("failed to call contracts")
+			continue
+		}
+
+		logger.Debug("contracts called successfully")
+		return results, nil
+	}
+
+	return nil, errors.EthConnectionError("failed to call contracts on all chain URLs").ExtendComponent(callContractComponent)
+}
+
+func (uc *callContractUseCase) encode(ctx context.Context, chainName string, call *entities.ContractCall,
+	userInfo *multitenancy.UserInfo) (*encodedCall, error) {
+	var contract *entities.Contract
+	var err error
+	if call.ContractName != "" {
+		contract, err = uc.getContractUC.Execute(ctx, call.ContractName, call.ContractTag, userInfo)
+	} else {
+		contract, err = uc.searchContractUC.Execute(ctx, nil, &call.To, userInfo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Calls to a proxy are encoded with the ABI of its implementation when the method is not declared by the proxy
+	if contract == nil || !hasMethod(contract, call.MethodSignature) {
+		implementation, der := uc.resolveProxyUC.Execute(ctx, chainName, call.To, userInfo)
+		if der != nil {
+			return nil, der
+		}
+		if implementation != nil {
+			contract = implementation
+		}
+	}
+	if contract == nil {
+		return nil, errors.InvalidParameterError("contract not found")
+	}
+
+	// The method is encoded as for contract transactions, the go-ethereum ABI being used to decode outputs as for events
+	web3ABI, err := web3abi.NewABI(contract.RawABI)
+	if err != nil {
+		return nil, errors.DataCorruptedError("failed to parse contract ABI")
+	}
+
+	web3Method := web3ABI.GetMethodBySignature(call.MethodSignature)
+	if web3Method == nil {
+		return nil, errors.InvalidParameterError("method not found")
+	}
+
+	data, err := web3Method.Encode(call.Args)
+	if err != nil {
+		return nil, errors.InvalidParameterError(err.Error())
+	}
+
+	for _, method := range contract.ABI.Methods {
+		if bytes.Equal(method.ID, web3Method.ID()) {
+			m := method
+			return &encodedCall{call: call, method: &m, data: data}, nil
+		}
+	}
+
+	return nil, errors.InvalidParameterError("method not found")
+}
+
+func (uc *callContractUseCase) execute(ctx context.Context, uri string, req *entities.ContractCallRequest,
+	calls []*encodedCall) ([]*entities.ContractCallResult, error) {
+	// Multicall3 is only deployed on the public state
+	if req.Multicall && len(calls) > 1 && req.Protocol == "" {
+		code, err := uc.codeAt(ctx, uri, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(code) > 0 {
+			return uc.executeMulticall(ctx, uri, req, calls)
+		}
+		uc.logger.WithContext(ctx).Debug("multicall is not deployed on chain, calling contracts one by one")
+	}
+
+	var results []*entities.ContractCallResult
+	for _, call := range calls {
+		output, err := uc.call(ctx, uri, req, &eth.CallMsg{To: &call.call.To, Data: call.data})
+		if errors.IsConnectionError(err) {
+			return nil, err
+		}
+
+		results = append(results, newResult(call, err == nil, output, err))
+	}
+
+	return results, nil
+}
+
+func (uc *callContractUseCase) executeMulticall(ctx context.Context, uri string, req *entities.ContractCallRequest,
+	calls []*encodedCall) ([]*entities.ContractCallResult, error) {
+	var mCalls []*multicall.Call
+	for _, call := range calls {
+		mCalls = append(mCalls, &multicall.Call{Target: call.call.To, AllowFailure: true, CallData: call.data})
+	}
+
+	data, err := multicall.EncodeAggregate3(mCalls)
+	if err != nil {
+		return nil, errors.InvalidParameterError("failed to encode multicall")
+	}
+
+	output, err := uc.call(ctx, uri, req, &eth.CallMsg{To: &multicall.Address, Data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	mResults, err := multicall.DecodeAggregate3(output)
+	if err != nil || len(mResults) != len(calls) {
+		return nil, errors.EncodingError("invalid multicall output")
+	}
+
+	var results []*entities.ContractCallResult
+	for i, call := range calls {
+		results = append(results, newResult(call, mResults[i].Success, mResults[i].ReturnData, nil))
+	}
+
+	return results, nil
+}
+
+func (uc *callContractUseCase) call(ctx context.Context, uri string, req *entities.ContractCallRequest, msg *eth.CallMsg) ([]byte, error) {
+	switch req.Protocol {
+	case entities.EEAChainType:
+		return uc.ec.PrivCallContract(ctx, uri, req.PrivacyGroupID, msg, req.BlockNumber)
+	case entities.GoQuorumChainType:
+		return uc.ec.QuorumCallContract(ctx, uri, msg, req.PrivateFrom, req.BlockNumber)
+	}
+
+	if req.Pending {
+		return uc.ec.PendingCallContract(ctx, uri, msg)
+	}
+
+	return uc.ec.CallContract(ctx, uri, msg, req.BlockNumber)
+}
+
+func (uc *callContractUseCase) getPrivacyGroupID(ctx context.Context, name, chainUUID string, userInfo *multitenancy.UserInfo) (string, error) {
+	groups, err := uc.db.PrivacyGroup().Search(ctx, &entities.PrivacyGroupFilters{
+		Names:     []string{name},
+		ChainUUID: chainUUID,
+	}, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return "", err
+	}
+
+	if len(groups) == 0 {
+		errMessage := fmt.Sprintf("privacy group '%s' does not exist on chain", name)
+		uc.logger.WithContext(ctx).Error(errMessage)
+		return "", errors.InvalidParameterError(errMessage)
+	}
+
+	return groups[0].PrivacyGroupID, nil
+}
+
+func (uc *callContractUseCase) codeAt(ctx context.Context, uri string, req *entities.ContractCallRequest) ([]byte, error) {
+	if req.Pending {
+		return uc.ec.PendingCodeAt(ctx, uri, multicall.Address)
+	}
+
+	return uc.ec.CodeAt(ctx, uri, multicall.Address, req.BlockNumber)
+}
+
+func newResult(call *encodedCall, success bool, output []byte, callErr error) *entities.ContractCallResult {
+	result := &entities.ContractCallResult{Success: success, Data: output}
+	switch {
+	case callErr != nil:
+		result.Error = errors.FromError(callErr).GetMessage()
+	case !success:
+		result.Error = "call reverted"
+	default:
+		outputs, err := orchabi.DecodeOutputs(call.method, output)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Sprintf("failed to decode outputs: %s", errors.FromError(err).GetMessage())
+			return result
+		}
+		result.Outputs = outputs
+	}
+
+	return result
+}
+
+func hasMethod(contract *entities.Contract, signature string) bool {
+	web3ABI, err := web3abi.NewABI(contract.RawABI)
+	if err != nil {
+		return false
+	}
+
+	return web3ABI.GetMethodBySignature(signature) != nil
+}
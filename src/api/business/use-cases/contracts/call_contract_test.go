@@ -0,0 +1,221 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/multicall"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	eth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallContract_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockSearchContractUC := mocks.NewMockSearchContractUseCase(ctrl)
+	mockResolveProxyUC := mocks.NewMockResolveProxyContractUseCase(ctrl)
+	mockEthClient := mock.NewMockMultiClient(ctrl)
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockPrivacyGroupDA := mocks2.NewMockPrivacyGroupAgent(ctrl)
+	mockDB.EXPECT().PrivacyGroup().Return(mockPrivacyGroupDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCallContractUseCase(mockDB, mockSearchChainsUC, mockGetContractUC, mockSearchContractUC, mockResolveProxyUC, mockEthClient)
+
+	chain := testdata.FakeChain()
+	contract := testdata.FakeContract()
+	token := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+	uintType, _ := abi.NewType("uint256", "", nil)
+	balanceOutput, _ := abi.Arguments{{Type: uintType}}.Pack(big.NewInt(1000))
+
+	newRequest := func() *entities.ContractCallRequest {
+		return &entities.ContractCallRequest{
+			ChainName:   chain.Name,
+			BlockNumber: big.NewInt(10),
+			Calls: []*entities.ContractCall{
+				{
+					To:              token,
+					ContractName:    contract.Name,
+					ContractTag:     contract.Tag,
+					MethodSignature: "balanceOf(address)",
+					Args:            []interface{}{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"},
+				},
+				{To: token, MethodSignature: "totalSupply()"},
+			},
+		}
+	}
+
+	t.Run("should call contracts one by one and decode outputs", func(t *testing.T) {
+		req := newRequest()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &token, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), req.BlockNumber).Return(balanceOutput, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), req.BlockNumber).
+			Return(nil, errors.InvalidParameterError("execution reverted"))
+
+		results, err := usecase.Execute(ctx, req, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.True(t, results[0].Success)
+		assert.Equal(t, map[string]string{"0": "1000"}, results[0].Outputs)
+		assert.False(t, results[1].Success)
+		assert.Equal(t, "execution reverted", results[1].Error)
+	})
+
+	t.Run("should batch calls through multicall if deployed", func(t *testing.T) {
+		req := newRequest()
+		req.Multicall = true
+		aggregateOutput, err := abi.Arguments{{Type: mustNewAggregate3OutputType(t)}}.Pack([]multicall.Result{
+			{Success: true, ReturnData: balanceOutput},
+			{Success: true, ReturnData: balanceOutput},
+		})
+		require.NoError(t, err)
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &token, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CodeAt(gomock.Any(), chain.URLs[0], multicall.Address, req.BlockNumber).Return([]byte{0x60}, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), req.BlockNumber).
+			DoAndReturn(func(ctx context.Context, url string, msg *eth.CallMsg, _ *big.Int) ([]byte, error) {
+				assert.Equal(t, multicall.Address, *msg.To)
+				return aggregateOutput, nil
+			})
+
+		results, err := usecase.Execute(ctx, req, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, map[string]string{"0": "1000"}, results[1].Outputs)
+	})
+
+	t.Run("should call contracts on the private state of a named privacy group", func(t *testing.T) {
+		req := newRequest()
+		req.Calls = req.Calls[:1]
+		req.Multicall = true
+		req.Protocol = entities.EEAChainType
+		req.PrivacyGroupName = "my-group"
+		group := testdata.FakePrivacyGroup()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockPrivacyGroupDA.EXPECT().Search(gomock.Any(), &entities.PrivacyGroupFilters{
+			Names:     []string{req.PrivacyGroupName},
+			ChainUUID: chain.UUID,
+		}, userInfo.AllowedTenants, userInfo.Username).Return([]*entities.PrivacyGroup{group}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().PrivCallContract(gomock.Any(), chain.URLs[0], group.PrivacyGroupID, gomock.Any(), req.BlockNumber).
+			Return(balanceOutput, nil)
+
+		results, err := usecase.Execute(ctx, req, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, map[string]string{"0": "1000"}, results[0].Outputs)
+	})
+
+	t.Run("should call contracts on the private state of a GoQuorum node", func(t *testing.T) {
+		req := newRequest()
+		req.Calls = req.Calls[:1]
+		req.Protocol = entities.GoQuorumChainType
+		req.PrivateFrom = "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().QuorumCallContract(gomock.Any(), chain.URLs[0], gomock.Any(), req.PrivateFrom, req.BlockNumber).
+			Return(balanceOutput, nil)
+
+		results, err := usecase.Execute(ctx, req, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Success)
+	})
+
+	t.Run("should fail with InvalidParameterError if privacy group does not exist", func(t *testing.T) {
+		req := newRequest()
+		req.Protocol = entities.EEAChainType
+		req.PrivacyGroupName = "my-group"
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockPrivacyGroupDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return([]*entities.PrivacyGroup{}, nil)
+
+		_, err := usecase.Execute(ctx, req, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should encode calls to a proxy with the ABI of its implementation", func(t *testing.T) {
+		req := newRequest()
+		req.Calls = req.Calls[1:]
+		proxyContract := testdata.FakeContract()
+		proxyContract.RawABI = "[]"
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &token, userInfo).Return(proxyContract, nil)
+		mockResolveProxyUC.EXPECT().Execute(gomock.Any(), chain.Name, token, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), req.BlockNumber).Return(balanceOutput, nil)
+
+		results, err := usecase.Execute(ctx, req, userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, map[string]string{"0": "1000"}, results[0].Outputs)
+	})
+
+	t.Run("should fail with InvalidParameterError if method does not exist", func(t *testing.T) {
+		req := newRequest()
+		req.Calls[0].MethodSignature = "unknown()"
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockResolveProxyUC.EXPECT().Execute(gomock.Any(), chain.Name, token, userInfo).Return(nil, nil)
+
+		_, err := usecase.Execute(ctx, req, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with EthConnectionError if chain cannot be reached", func(t *testing.T) {
+		req := newRequest()
+		req.Calls = req.Calls[:1]
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), req.BlockNumber).
+			Return(nil, errors.EthConnectionError("error"))
+
+		_, err := usecase.Execute(ctx, req, userInfo)
+
+		assert.True(t, errors.IsConnectionError(err))
+	})
+}
+
+func mustNewAggregate3OutputType(t *testing.T) abi.Type {
+	typ, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "success", Type: "bool"},
+		{Name: "returnData", Type: "bytes"},
+	})
+	require.NoError(t, err)
+	return typ
+}
@@ -0,0 +1,109 @@
+package contracts
+
+import (
+	"context"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	orchabi "github.com/consensys/orchestrate/pkg/ethereum/abi"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const decodeCallComponent = "use-cases.decode-call"
+
+type decodeCallUseCase struct {
+	getContractUC    usecases.GetContractUseCase
+	searchContractUC usecases.SearchContractUseCase
+	logger           *log.Logger
+}
+
+func NewDecodeCallUseCase(getContractUC usecases.GetContractUseCase, searchContractUC usecases.SearchContractUseCase) usecases.DecodeCallUseCase {
+	return &decodeCallUseCase{
+		getContractUC:    getContractUC,
+		searchContractUC: searchContractUC,
+		logger:           log.NewLogger().SetComponent(decodeCallComponent),
+	}
+}
+
+// Execute attaches the decoded call to the jobs, leaving as is transactions to unknown contracts or methods
+func (uc *decodeCallUseCase) Execute(ctx context.Context, jobs []*entities.Job, contractName, contractTag string,
+	userInfo *multitenancy.UserInfo) error {
+	// Contracts are looked up once per recipient, unknown ones included
+	contracts := make(map[ethcommon.Address]*entities.Contract)
+	for _, job := range jobs {
+		if job.Transaction == nil || job.Transaction.To == nil || len(job.Transaction.Data) < 4 {
+			continue
+		}
+
+		to := *job.Transaction.To
+		contract, ok := contracts[to]
+		if !ok {
+			var err error
+			contract, err = uc.contract(ctx, to, contractName, contractTag, userInfo)
+			if err != nil {
+				return errors.FromError(err).ExtendComponent(decodeCallComponent)
+			}
+			contracts[to] = contract
+		}
+
+		if contract != nil {
+			job.DecodedCall = uc.decode(ctx, contract, job.Transaction.Data)
+		}
+	}
+
+	return nil
+}
+
+func (uc *decodeCallUseCase) contract(ctx context.Context, to ethcommon.Address, name, tag string,
+	userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
+	var contract *entities.Contract
+	var err error
+	if name != "" {
+		contract, err = uc.getContractUC.Execute(ctx, name, tag, userInfo)
+	} else {
+		contract, err = uc.searchContractUC.Execute(ctx, nil, &to, userInfo)
+	}
+	if errors.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil || contract == nil {
+		return nil, err
+	}
+
+	contract.ABI, err = abi.JSON(strings.NewReader(contract.RawABI))
+	if err != nil {
+		uc.logger.WithContext(ctx).WithError(err).WithField("name", contract.Name).Warn("failed to parse contract abi")
+		return nil, nil
+	}
+
+	return contract, nil
+}
+
+func (uc *decodeCallUseCase) decode(ctx context.Context, contract *entities.Contract, data []byte) *entities.DecodedCall {
+	logger := uc.logger.WithContext(ctx).WithField("name", contract.Name).WithField("tag", contract.Tag)
+
+	method, err := contract.ABI.MethodById(data[:4])
+	if err != nil {
+		logger.WithField("selector", hexutil.Encode(data[:4])).Debug("method not found in contract abi")
+		return nil
+	}
+
+	inputs, err := orchabi.DecodeInputs(method, data[4:])
+	if err != nil {
+		logger.WithError(err).Warn("failed to decode call inputs")
+		return nil
+	}
+
+	return &entities.DecodedCall{
+		ContractName:    contract.Name,
+		ContractTag:     contract.Tag,
+		MethodSignature: method.Sig,
+		Inputs:          inputs,
+	}
+}
@@ -0,0 +1,121 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCall_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockSearchContractUC := mocks.NewMockSearchContractUseCase(ctrl)
+
+	usecase := NewDecodeCallUseCase(mockGetContractUC, mockSearchContractUC)
+
+	contract := testdata.FakeContract()
+	contractABI, _ := abi.JSON(strings.NewReader(contract.RawABI))
+	recipient := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	data, _ := contractABI.Pack("transfer", recipient, big.NewInt(10))
+
+	newJob := func() *entities.Job {
+		job := testdata.FakeJob()
+		to := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+		job.Transaction.To = &to
+		job.Transaction.Data = data
+		return job
+	}
+
+	expectedInputs := map[string]string{"recipient": recipient.Hex(), "amount": "10"}
+
+	t.Run("should decode calls with the contract at the recipient address once", func(t *testing.T) {
+		jobs := []*entities.Job{newJob(), newJob()}
+
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, jobs[0].Transaction.To, userInfo).Return(contract, nil)
+
+		err := usecase.Execute(ctx, jobs, "", "", userInfo)
+
+		require.NoError(t, err)
+		for _, job := range jobs {
+			require.NotNil(t, job.DecodedCall)
+			assert.Equal(t, contract.Name, job.DecodedCall.ContractName)
+			assert.Equal(t, contract.Tag, job.DecodedCall.ContractTag)
+			assert.Equal(t, "transfer(address,uint256)", job.DecodedCall.MethodSignature)
+			assert.Equal(t, expectedInputs, job.DecodedCall.Inputs)
+		}
+	})
+
+	t.Run("should decode call with the given contract", func(t *testing.T) {
+		job := newJob()
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, userInfo).Return(contract, nil)
+
+		err := usecase.Execute(ctx, []*entities.Job{job}, contract.Name, contract.Tag, userInfo)
+
+		require.NoError(t, err)
+		require.NotNil(t, job.DecodedCall)
+		assert.Equal(t, expectedInputs, job.DecodedCall.Inputs)
+	})
+
+	t.Run("should leave calls to unknown contracts and methods undecoded", func(t *testing.T) {
+		unknownContractJob := newJob()
+		unknownMethodJob := newJob()
+		to := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+		unknownMethodJob.Transaction.To = &to
+		unknownMethodJob.Transaction.Data = hexutil.MustDecode("0xdeadbeef")
+		transferJob := testdata.FakeJob()
+		transferJob.Transaction.Data = nil
+
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, unknownContractJob.Transaction.To, userInfo).Return(nil, nil)
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &to, userInfo).Return(contract, nil)
+
+		err := usecase.Execute(ctx, []*entities.Job{unknownContractJob, unknownMethodJob, transferJob}, "", "", userInfo)
+
+		require.NoError(t, err)
+		assert.Nil(t, unknownContractJob.DecodedCall)
+		assert.Nil(t, unknownMethodJob.DecodedCall)
+		assert.Nil(t, transferJob.DecodedCall)
+	})
+
+	t.Run("should leave calls undecoded if the given contract does not exist", func(t *testing.T) {
+		job := newJob()
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), "Unknown", "latest", userInfo).Return(nil, errors.NotFoundError("error"))
+
+		err := usecase.Execute(ctx, []*entities.Job{job}, "Unknown", "latest", userInfo)
+
+		require.NoError(t, err)
+		assert.Nil(t, job.DecodedCall)
+	})
+
+	t.Run("should fail with same error if search contract fails", func(t *testing.T) {
+		job := newJob()
+		expectedErr := fmt.Errorf("error")
+
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, job.Transaction.To, userInfo).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, []*entities.Job{job}, "", "", userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(decodeCallComponent), err)
+	})
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
 
 	"github.com/consensys/orchestrate/pkg/ethereum/abi"
+	ethproxy "github.com/consensys/orchestrate/pkg/ethereum/proxy"
 	"github.com/consensys/orchestrate/pkg/types/ethereum"
 	ethAbi "github.com/ethereum/go-ethereum/accounts/abi"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -56,6 +58,10 @@ func (uc *decodeEventLogUseCase) Execute(ctx context.Context, chainUUID string,
 		WithField("indexed", uint32(len(eventLog.Topics)-1))
 
 	logger.Debug("decoding receipt logs")
+	if kind, implementation := ethproxy.ParseUpgradedLog(eventLog); implementation != nil {
+		uc.registerUpgrade(ctx, chain.ChainID.String(), ethcommon.HexToAddress(eventLog.GetAddress()), kind, *implementation)
+	}
+
 	sigHash := hexutil.MustDecode(eventLog.Topics[0])
 	contractEvent, contractDefaultEvents, err := uc.getContractEventsUC.Execute(
 		ctx,
@@ -120,6 +126,23 @@ func (uc *decodeEventLogUseCase) Execute(ctx context.Context, chainUUID string,
 	return eventLog, nil
 }
 
+// registerUpgrade tracks the implementation of a proxy, a failure not preventing the log from being decoded
+func (uc *decodeEventLogUseCase) registerUpgrade(ctx context.Context, chainID string, address ethcommon.Address,
+	kind entities.ProxyKind, implementation ethcommon.Address) {
+	err := uc.db.Contract().RegisterProxy(ctx, &entities.ContractProxy{
+		ChainID:        chainID,
+		Address:        address,
+		Implementation: implementation,
+		Kind:           kind,
+	})
+	if err != nil {
+		uc.logger.WithContext(ctx).WithError(err).Warn("failed to register proxy upgrade")
+		return
+	}
+
+	uc.logger.WithContext(ctx).WithField("implementation", implementation.Hex()).Info("proxy upgrade registered")
+}
+
 // GetAbi creates a string ABI (format EventName(argType1, argType2)) from an event
 func getAbi(e *ethAbi.Event) string {
 	inputs := make([]string, len(e.Inputs))
@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 )
@@ -25,8 +26,8 @@ func NewGetCatalogUseCase(agent store.ContractAgent) usecases.GetContractsCatalo
 
 // TODO: Modify to get all contracts and then only return necessary fields instead of getting only names
 // Execute gets all contract names from Postgres
-func (uc *getCatalogUseCase) Execute(ctx context.Context) ([]string, error) {
-	names, err := uc.agent.ListNames(ctx)
+func (uc *getCatalogUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error) {
+	names, err := uc.agent.ListNames(ctx, userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(getCatalogComponent)
 	}
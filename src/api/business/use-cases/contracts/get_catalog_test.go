@@ -10,21 +10,23 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 )
 
 func TestGetCatalog_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	repositoryAgent := mocks.NewMockContractAgent(ctrl)
 	usecase := NewGetCatalogUseCase(repositoryAgent)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		names := []string{"Contract0", "Contract1"}
-		repositoryAgent.EXPECT().ListNames(gomock.Any()).Return(names, nil)
+		repositoryAgent.EXPECT().ListNames(gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(names, nil)
 
-		response, err := usecase.Execute(context.Background())
+		response, err := usecase.Execute(context.Background(), userInfo)
 
 		assert.Equal(t, response, names)
 		assert.NoError(t, err)
@@ -32,9 +34,9 @@ func TestGetCatalog_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		repositoryAgent.EXPECT().ListNames(gomock.Any()).Return(nil, dataAgentError)
+		repositoryAgent.EXPECT().ListNames(gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(context.Background())
+		response, err := usecase.Execute(context.Background(), userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getCatalogComponent), err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
@@ -28,11 +29,11 @@ func NewGetContractUseCase(agent store.ContractAgent) usecases.GetContractUseCas
 }
 
 // Execute gets a contract from Postgres
-func (uc *getContractUseCase) Execute(ctx context.Context, name, tag string) (*entities.Contract, error) {
+func (uc *getContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	ctx = log.WithFields(ctx, log.Field("contract_name", name), log.Field("contract_tag", name))
 	logger := uc.logger.WithContext(ctx)
 
-	contract, err := uc.agent.FindOneByNameAndTag(ctx, name, tag)
+	contract, err := uc.agent.FindOneByNameAndTag(ctx, name, tag, userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(getContractComponent)
 	}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/golang/mock/gomock"
@@ -18,6 +19,7 @@ func TestGetContract_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	contract := testdata.FakeContract()
 	artifactAgent := mocks.NewMockContractAgent(ctrl)
@@ -25,10 +27,10 @@ func TestGetContract_Execute(t *testing.T) {
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		artifactAgent.EXPECT().
-			FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag).
+			FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag, userInfo.AllowedTenants, userInfo.Username).
 			Return(contract, nil)
 
-		response, err := usecase.Execute(ctx, contract.Name, contract.Tag)
+		response, err := usecase.Execute(ctx, contract.Name, contract.Tag, userInfo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, contract.Bytecode, response.Bytecode)
@@ -40,9 +42,9 @@ func TestGetContract_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		artifactAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag).Return(nil, dataAgentError)
+		artifactAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag, userInfo.AllowedTenants, userInfo.Username).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(ctx, contract.Name, contract.Tag)
+		response, err := usecase.Execute(ctx, contract.Name, contract.Tag, userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getContractComponent), err)
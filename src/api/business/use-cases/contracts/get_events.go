@@ -5,8 +5,11 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -14,14 +17,17 @@ import (
 const getEventsComponent = "use-cases.get-events"
 
 type getEventsUseCase struct {
-	contractAgent store.ContractEventAgent
-	logger        *log.Logger
+	db      store.DB
+	proxies *proxyResolver
+	logger  *log.Logger
 }
 
-func NewGetEventsUseCase(contractAgent store.ContractEventAgent) usecases.GetContractEventsUseCase {
+func NewGetEventsUseCase(db store.DB, ec ethclient.Client) usecases.GetContractEventsUseCase {
+	logger := log.NewLogger().SetComponent(getEventsComponent)
 	return &getEventsUseCase{
-		contractAgent: contractAgent,
-		logger:        log.NewLogger().SetComponent(getEventsComponent),
+		db:      db,
+		proxies: &proxyResolver{db: db, ec: ec, logger: logger},
+		logger:  logger,
 	}
 }
 
@@ -30,16 +36,32 @@ func (uc *getEventsUseCase) Execute(ctx context.Context, chainID string, address
 	ctx = log.WithFields(ctx, log.Field("chain_id", chainID), log.Field("address", address))
 	logger := uc.logger.WithContext(ctx)
 
-	contractEvent, err := uc.contractAgent.FindOneByAccountAndSigHash(ctx, chainID, address.Hex(), sigHash.String(), indexedInputCount)
+	contractEvent, err := uc.db.ContractEvent().FindOneByAccountAndSigHash(ctx, chainID, address.Hex(), sigHash.String(), indexedInputCount)
 	if err != nil {
 		return "", nil, errors.FromError(err).ExtendComponent(getEventsComponent)
 	}
+
+	// Events emitted by a proxy are declared by the ABI of its implementation
+	if contractEvent == nil {
+		implementation, der := uc.implementation(ctx, chainID, address)
+		if der != nil {
+			return "", nil, errors.FromError(der).ExtendComponent(getEventsComponent)
+		}
+
+		if implementation != nil {
+			contractEvent, err = uc.db.ContractEvent().FindOneByAccountAndSigHash(ctx, chainID, implementation.Hex(), sigHash.String(), indexedInputCount)
+			if err != nil {
+				return "", nil, errors.FromError(err).ExtendComponent(getEventsComponent)
+			}
+		}
+	}
+
 	if contractEvent != nil {
 		logger.Trace("events were fetched successfully")
 		return contractEvent.ABI, nil, nil
 	}
 
-	defaultEventModels, err := uc.contractAgent.FindDefaultBySigHash(ctx, sigHash.String(), indexedInputCount)
+	defaultEventModels, err := uc.db.ContractEvent().FindDefaultBySigHash(ctx, sigHash.String(), indexedInputCount)
 	if err != nil {
 		return "", nil, errors.FromError(err).ExtendComponent(getEventsComponent)
 	}
@@ -54,3 +76,23 @@ func (uc *getEventsUseCase) Execute(ctx context.Context, chainID string, address
 	logger.Trace("default events were fetched successfully")
 	return "", eventsABI, nil
 }
+
+// implementation follows a registered proxy to its implementation, addresses not registered as proxies not being read
+// from the chain
+func (uc *getEventsUseCase) implementation(ctx context.Context, chainID string, address ethcommon.Address) (*ethcommon.Address, error) {
+	proxy, err := uc.db.Contract().FindProxy(ctx, chainID, address)
+	if err != nil || proxy == nil {
+		return nil, err
+	}
+
+	chains, err := uc.db.Chain().Search(ctx, &entities.ChainFilters{ChainID: chainID}, []string{multitenancy.WildcardTenant},
+		multitenancy.WildcardOwner)
+	if err != nil {
+		return nil, err
+	}
+	if len(chains) == 0 {
+		return nil, nil
+	}
+
+	return uc.proxies.resolve(ctx, chains[0], address, proxy)
+}
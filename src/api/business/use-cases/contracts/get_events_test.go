@@ -5,12 +5,17 @@ package contracts
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	ethproxy "github.com/consensys/orchestrate/pkg/ethereum/proxy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,8 +28,19 @@ func TestGetEvents_Execute(t *testing.T) {
 	sigHash := utils.StringToHexBytes("0x0123")
 	indexedInputCount := uint32(1)
 	contractEvent := testdata.FakeContractEvent()
+	mockDB := mocks.NewMockDB(ctrl)
 	eventAgent := mocks.NewMockContractEventAgent(ctrl)
-	usecase := NewGetEventsUseCase(eventAgent)
+	contractAgent := mocks.NewMockContractAgent(ctrl)
+	chainAgent := mocks.NewMockChainAgent(ctrl)
+	mockEthClient := mock.NewMockClient(ctrl)
+	mockDB.EXPECT().ContractEvent().Return(eventAgent).AnyTimes()
+	mockDB.EXPECT().Contract().Return(contractAgent).AnyTimes()
+	mockDB.EXPECT().Chain().Return(chainAgent).AnyTimes()
+	usecase := NewGetEventsUseCase(mockDB, mockEthClient)
+
+	chain := testdata.FakeChain()
+	chainAgent.EXPECT().Search(gomock.Any(), &entities.ChainFilters{ChainID: chainID}, []string{multitenancy.WildcardTenant}, multitenancy.WildcardOwner).
+		Return([]*entities.Chain{chain}, nil).AnyTimes()
 
 	t.Run("should execute use case successfully if event is found", func(t *testing.T) {
 		eventAgent.EXPECT().
@@ -51,11 +67,76 @@ func TestGetEvents_Execute(t *testing.T) {
 		assert.Nil(t, eventsABI)
 	})
 
+	t.Run("should execute use case successfully if event is found on proxy implementation", func(t *testing.T) {
+		implementation := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+		eventAgent.EXPECT().
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
+			Return(nil, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, contractAddress).
+			Return(&entities.ContractProxy{Implementation: implementation, Kind: entities.ProxyKindEIP1967, UpdatedAt: time.Now()}, nil)
+		eventAgent.EXPECT().
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, implementation.Hex(), sigHash.String(), indexedInputCount).
+			Return(contractEvent, nil)
+
+		responseABI, eventsABI, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount)
+
+		assert.Equal(t, contractEvent.ABI, responseABI)
+		assert.Nil(t, eventsABI)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should execute use case successfully if event is found on implementation of beacon proxy", func(t *testing.T) {
+		beacon := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+		implementation := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+		eventAgent.EXPECT().
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
+			Return(nil, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, contractAddress).
+			Return(&entities.ContractProxy{Implementation: beacon, Kind: entities.ProxyKindBeacon, UpdatedAt: time.Now()}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chain.ChainID.String(), beacon).
+			Return(&entities.ContractProxy{Implementation: implementation, Kind: entities.ProxyKindEIP1967, UpdatedAt: time.Now()}, nil)
+		eventAgent.EXPECT().
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, implementation.Hex(), sigHash.String(), indexedInputCount).
+			Return(contractEvent, nil)
+
+		responseABI, _, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount)
+
+		assert.Equal(t, contractEvent.ABI, responseABI)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should read again outdated implementation of proxy", func(t *testing.T) {
+		outdated := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+		implementation := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+		eventAgent.EXPECT().
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
+			Return(nil, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, contractAddress).
+			Return(&entities.ContractProxy{Implementation: outdated, Kind: entities.ProxyKindEIP1967}, nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), chain.URLs[0], contractAddress, ethproxy.ImplementationSlot, nil).
+			Return(ethcommon.BytesToHash(implementation.Bytes()).Bytes(), nil)
+		contractAgent.EXPECT().RegisterProxy(gomock.Any(), &entities.ContractProxy{
+			ChainID:        chain.ChainID.String(),
+			Address:        contractAddress,
+			Implementation: implementation,
+			Kind:           entities.ProxyKindEIP1967,
+		}).Return(nil)
+		eventAgent.EXPECT().
+			FindOneByAccountAndSigHash(gomock.Any(), chainID, implementation.Hex(), sigHash.String(), indexedInputCount).
+			Return(contractEvent, nil)
+
+		responseABI, _, err := usecase.Execute(ctx, chainID, contractAddress, sigHash, indexedInputCount)
+
+		assert.Equal(t, contractEvent.ABI, responseABI)
+		assert.NoError(t, err)
+	})
+
 	t.Run("should execute use case successfully if event is not found", func(t *testing.T) {
 		defaultEvent := testdata.FakeContractEvent()
 		eventAgent.EXPECT().
 			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
 			Return(nil, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, contractAddress).Return(nil, nil)
 
 		eventAgent.EXPECT().
 			FindDefaultBySigHash(gomock.Any(), sigHash.String(), indexedInputCount).
@@ -73,6 +154,7 @@ func TestGetEvents_Execute(t *testing.T) {
 		eventAgent.EXPECT().
 			FindOneByAccountAndSigHash(gomock.Any(), chainID, contractAddress.Hex(), sigHash.String(), indexedInputCount).
 			Return(nil, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, contractAddress).Return(nil, nil)
 		eventAgent.EXPECT().FindDefaultBySigHash(gomock.Any(), sigHash.String(), indexedInputCount).
 			Return(nil, pgError)
 
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 )
 
@@ -17,6 +18,7 @@ func TestGetTags_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	contractName := "myContract"
 	tagAgent := mocks.NewMockContractAgent(ctrl)
@@ -24,9 +26,9 @@ func TestGetTags_Execute(t *testing.T) {
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		tags := []string{"latest", "v1.0.0"}
-		tagAgent.EXPECT().ListTags(gomock.Any(), contractName).Return(tags, nil)
+		tagAgent.EXPECT().ListTags(gomock.Any(), contractName, userInfo.AllowedTenants, userInfo.Username).Return(tags, nil)
 
-		response, err := usecase.Execute(ctx, contractName)
+		response, err := usecase.Execute(ctx, contractName, userInfo)
 
 		assert.Equal(t, response, tags)
 		assert.NoError(t, err)
@@ -34,9 +36,9 @@ func TestGetTags_Execute(t *testing.T) {
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		tagAgent.EXPECT().ListTags(gomock.Any(), contractName).Return(nil, dataAgentError)
+		tagAgent.EXPECT().ListTags(gomock.Any(), contractName, userInfo.AllowedTenants, userInfo.Username).Return(nil, dataAgentError)
 
-		response, err := usecase.Execute(ctx, contractName)
+		response, err := usecase.Execute(ctx, contractName, userInfo)
 
 		assert.Nil(t, response)
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(getTagsComponent), err)
@@ -0,0 +1,166 @@
+package contracts
+
+import (
+	"context"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/artifacts"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const importContractsComponent = "use-cases.import-contracts"
+
+type importContractsUseCase struct {
+	db             store.DB
+	searchChainsUC usecases.SearchChainsUseCase
+	getContractUC  usecases.GetContractUseCase
+	logger         *log.Logger
+}
+
+func NewImportContractsUseCase(db store.DB, searchChainsUC usecases.SearchChainsUseCase,
+	getContractUC usecases.GetContractUseCase) usecases.ImportContractsUseCase {
+	return &importContractsUseCase{
+		db:             db,
+		searchChainsUC: searchChainsUC,
+		getContractUC:  getContractUC,
+		logger:         log.NewLogger().SetComponent(importContractsComponent),
+	}
+}
+
+// Execute links the artifacts with the given library addresses, or the registered deployments of the libraries on the
+// chain, then registers all contracts in the same transaction
+func (uc *importContractsUseCase) Execute(ctx context.Context, req *entities.ContractImport, userInfo *multitenancy.UserInfo) ([]*entities.Contract, error) {
+	ctx = log.WithFields(ctx, log.Field("tag", req.Tag), log.Field("artifacts", len(req.Artifacts)))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("importing contracts")
+
+	libraries, err := uc.resolveLibraries(ctx, req, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(importContractsComponent)
+	}
+
+	var contracts []*entities.Contract
+	for _, artifact := range req.Artifacts {
+		contract, der := link(artifact, req.Tag, libraries)
+		if der != nil {
+			logger.WithError(der).WithField("contract", artifact.Name).Error("failed to link contract")
+			return nil, errors.FromError(der).ExtendComponent(importContractsComponent)
+		}
+		contracts = append(contracts, contract)
+	}
+
+	err = uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		registerUC := NewRegisterContractUseCase(dbtx)
+		for _, contract := range contracts {
+			if der := registerUC.Execute(ctx, contract, userInfo); der != nil {
+				return errors.FromError(der).SetMessage("contract %s: %s", contract.Name, errors.FromError(der).GetMessage())
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(importContractsComponent)
+	}
+
+	var res []*entities.Contract
+	for _, contract := range contracts {
+		registered, der := uc.getContractUC.Execute(ctx, contract.Name, contract.Tag, userInfo)
+		if der != nil {
+			return nil, errors.FromError(der).ExtendComponent(importContractsComponent)
+		}
+		res = append(res, registered)
+	}
+
+	logger.Info("contracts imported successfully")
+	return res, nil
+}
+
+func (uc *importContractsUseCase) resolveLibraries(ctx context.Context, req *entities.ContractImport,
+	userInfo *multitenancy.UserInfo) (map[string]ethcommon.Address, error) {
+	libraries := map[string]ethcommon.Address{}
+	for name, address := range req.Libraries {
+		libraries[name] = address
+	}
+
+	var missing []string
+	for _, artifact := range req.Artifacts {
+		for _, library := range artifact.Libraries() {
+			if _, ok := libraries[library]; !ok {
+				missing = append(missing, library)
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return libraries, nil
+	}
+
+	if req.ChainName == "" {
+		errMessage := "missing addresses of libraries " + strings.Join(missing, ", ")
+		uc.logger.WithContext(ctx).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{req.ChainName}}, userInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(chains) == 0 {
+		errMessage := "chain does not exist"
+		uc.logger.WithContext(ctx).WithField("chain", req.ChainName).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	for _, library := range missing {
+		if _, ok := libraries[library]; ok {
+			continue
+		}
+
+		address, der := uc.db.Contract().FindDeploymentAddress(ctx, chains[0].ChainID.String(), library,
+			userInfo.AllowedTenants, userInfo.Username)
+		if der != nil {
+			return nil, der
+		}
+		if address == nil {
+			errMessage := "library " + library + " is not deployed on chain " + req.ChainName
+			uc.logger.WithContext(ctx).Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+		libraries[library] = *address
+	}
+
+	return libraries, nil
+}
+
+func link(artifact *entities.ContractArtifact, tag string, libraries map[string]ethcommon.Address) (*entities.Contract, error) {
+	parsedABI, err := abi.JSON(strings.NewReader(artifact.RawABI))
+	if err != nil {
+		return nil, errors.InvalidParameterError("contract %s: invalid abi", artifact.Name)
+	}
+
+	bytecode, err := artifacts.Link(artifact.Bytecode, artifact.LinkReferences, libraries)
+	if err != nil {
+		return nil, errors.InvalidParameterError("contract %s: %s", artifact.Name, err.Error())
+	}
+
+	deployedBytecode, err := artifacts.Link(artifact.DeployedBytecode, artifact.DeployedLinkReferences, libraries)
+	if err != nil {
+		return nil, errors.InvalidParameterError("contract %s: %s", artifact.Name, err.Error())
+	}
+
+	return &entities.Contract{
+		Name:             artifact.Name,
+		Tag:              tag,
+		RawABI:           artifact.RawABI,
+		ABI:              parsedABI,
+		Bytecode:         bytecode,
+		DeployedBytecode: deployedBytecode,
+	}, nil
+}
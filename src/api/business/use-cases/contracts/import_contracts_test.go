@@ -0,0 +1,150 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportContracts_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	mockDB := mocks.NewMockDB(ctrl)
+	contractAgent := mocks.NewMockContractAgent(ctrl)
+	contractEventAgent := mocks.NewMockContractEventAgent(ctrl)
+	searchChainsUC := mocks2.NewMockSearchChainsUseCase(ctrl)
+	getContractUC := mocks2.NewMockGetContractUseCase(ctrl)
+
+	mockDB.EXPECT().Contract().Return(contractAgent).AnyTimes()
+	mockDB.EXPECT().ContractEvent().Return(contractEventAgent).AnyTimes()
+	mockDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persistFunc func(dbtx store.DB) error) error {
+			return persistFunc(mockDB)
+		}).AnyTimes()
+
+	usecase := NewImportContractsUseCase(mockDB, searchChainsUC, getContractUC)
+
+	library := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+	fakeImport := func() *entities.ContractImport {
+		contract := testdata.FakeContract()
+		return &entities.ContractImport{
+			Tag: "v1.0.0",
+			Artifacts: []*entities.ContractArtifact{
+				{
+					Name:             contract.Name,
+					RawABI:           contract.RawABI,
+					Bytecode:         "0x6080__$" + strings.Repeat("a", 34) + "$__",
+					DeployedBytecode: "0x6080",
+					LinkReferences:   []*entities.LinkReference{{Library: "SafeMath", Offsets: []int{2}}},
+				},
+			},
+		}
+	}
+
+	t.Run("should link and register contracts with given library addresses", func(t *testing.T) {
+		req := fakeImport()
+		req.Libraries = map[string]ethcommon.Address{"SafeMath": library}
+		registered := testdata.FakeContract()
+
+		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), req.Artifacts[0].Name, req.Tag, []string{userInfo.TenantID}, userInfo.Username).
+			Return(nil, nil)
+		contractAgent.EXPECT().Register(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, contract *entities.Contract) error {
+			assert.Equal(t, "0x6080"+strings.ToLower(library.Hex()[2:]), contract.Bytecode.String())
+			assert.Equal(t, req.Tag, contract.Tag)
+			assert.Equal(t, userInfo.TenantID, contract.TenantID)
+			return nil
+		})
+		contractEventAgent.EXPECT().RegisterMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		getContractUC.EXPECT().Execute(gomock.Any(), req.Artifacts[0].Name, req.Tag, userInfo).Return(registered, nil)
+
+		contracts, err := usecase.Execute(ctx, req, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.Contract{registered}, contracts)
+	})
+
+	t.Run("should link libraries with their deployments registered on the chain", func(t *testing.T) {
+		req := fakeImport()
+		req.ChainName = "mainnet"
+		chain := testdata.FakeChain()
+
+		searchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{req.ChainName}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindDeploymentAddress(gomock.Any(), chain.ChainID.String(), "SafeMath", userInfo.AllowedTenants, userInfo.Username).
+			Return(&library, nil)
+		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), req.Artifacts[0].Name, req.Tag, []string{userInfo.TenantID}, userInfo.Username).
+			Return(nil, nil)
+		contractAgent.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil)
+		contractEventAgent.EXPECT().RegisterMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		getContractUC.EXPECT().Execute(gomock.Any(), req.Artifacts[0].Name, req.Tag, userInfo).Return(testdata.FakeContract(), nil)
+
+		contracts, err := usecase.Execute(ctx, req, userInfo)
+
+		require.NoError(t, err)
+		assert.Len(t, contracts, 1)
+	})
+
+	t.Run("should fail with InvalidParameterError if library address is missing and no chain is given", func(t *testing.T) {
+		req := fakeImport()
+
+		_, err := usecase.Execute(ctx, req, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if library is not deployed on the chain", func(t *testing.T) {
+		req := fakeImport()
+		req.ChainName = "mainnet"
+		chain := testdata.FakeChain()
+
+		searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindDeploymentAddress(gomock.Any(), chain.ChainID.String(), "SafeMath", userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, nil)
+
+		_, err := usecase.Execute(ctx, req, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		req := fakeImport()
+		req.ChainName = "mainnet"
+
+		searchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		_, err := usecase.Execute(ctx, req, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if register fails", func(t *testing.T) {
+		req := fakeImport()
+		req.Libraries = map[string]ethcommon.Address{"SafeMath": library}
+		expectedErr := errors.PostgresConnectionError("error")
+
+		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+		contractAgent.EXPECT().Register(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, req, userInfo)
+
+		assert.True(t, errors.IsPostgresConnectionError(err))
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
@@ -30,12 +31,17 @@ func NewRegisterContractUseCase(agent store.DB) usecases.RegisterContractUseCase
 	}
 }
 
-func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entities.Contract) error {
-	ctx = log.WithFields(ctx, log.Field("contract_id", contract))
+func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("contract_id", contract), log.Field("tenant", userInfo.TenantID))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("registering contract starting...")
 
-	retrievedContract, err := uc.db.Contract().FindOneByNameAndTag(ctx, contract.Name, contract.Tag)
+	contract.TenantID = userInfo.TenantID
+	contract.OwnerID = userInfo.Username
+
+	// Only the contracts of the tenant can be overwritten, a contract of the shared catalog is shadowed instead
+	retrievedContract, err := uc.db.Contract().FindOneByNameAndTag(ctx, contract.Name, contract.Tag,
+		[]string{userInfo.TenantID}, userInfo.Username)
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(registerContractComponent)
 	}
@@ -55,7 +61,7 @@ func (uc *registerContractUseCase) Execute(ctx context.Context, contract *entiti
 		return errors.InvalidParameterError(errMessage).ExtendComponent(registerContractComponent)
 	}
 
-	if retrievedContract == nil {
+	if retrievedContract == nil || retrievedContract.OwnerID != contract.OwnerID {
 		err = uc.db.Contract().Register(ctx, contract)
 	} else {
 		err = uc.db.Contract().Update(ctx, contract)
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/entities"
@@ -25,6 +26,7 @@ func TestRegisterContract_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	mockDB := mocks.NewMockDB(ctrl)
 	contractAgent := mocks.NewMockContractAgent(ctrl)
@@ -39,10 +41,27 @@ func TestRegisterContract_Execute(t *testing.T) {
 	t.Run("should execute use case successfully by registering new contract", func(t *testing.T) {
 		contract := testdata.FakeContract()
 
-		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag).Return(nil, nil)
+		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag, []string{userInfo.TenantID}, userInfo.Username).
+			Return(nil, nil)
 		contractAgent.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil)
 		contractEventAgent.EXPECT().RegisterMultiple(gomock.Any(), gomock.Any()).Return(nil)
-		err := usecase.Execute(ctx, contract)
+		err := usecase.Execute(ctx, contract, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, userInfo.TenantID, contract.TenantID)
+		assert.Equal(t, userInfo.Username, contract.OwnerID)
+	})
+
+	t.Run("should execute use case successfully by registering contract of the user shadowing the one of the tenant", func(t *testing.T) {
+		contract := testdata.FakeContract()
+		tenantContract := testdata.FakeContract()
+		tenantContract.TenantID = userInfo.TenantID
+
+		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag, []string{userInfo.TenantID}, userInfo.Username).
+			Return(tenantContract, nil)
+		contractAgent.EXPECT().Register(gomock.Any(), gomock.Any()).Return(nil)
+		contractEventAgent.EXPECT().RegisterMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		err := usecase.Execute(ctx, contract, userInfo)
 
 		assert.NoError(t, err)
 	})
@@ -50,11 +69,14 @@ func TestRegisterContract_Execute(t *testing.T) {
 	t.Run("should execute use case successfully by updating existing contract", func(t *testing.T) {
 		contract := testdata.FakeContract()
 
-		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag).Return(contract, nil)
+		contract.OwnerID = userInfo.Username
+
+		contractAgent.EXPECT().FindOneByNameAndTag(gomock.Any(), contract.Name, contract.Tag, []string{userInfo.TenantID}, userInfo.Username).
+			Return(contract, nil)
 		contractAgent.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
 
 		contractEventAgent.EXPECT().RegisterMultiple(gomock.Any(), gomock.Any()).Return(nil)
-		err := usecase.Execute(ctx, contract)
+		err := usecase.Execute(ctx, contract, userInfo)
 
 		assert.NoError(t, err)
 	})
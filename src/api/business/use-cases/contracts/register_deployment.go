@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -25,12 +26,13 @@ func NewRegisterDeploymentUseCase(agent store.ContractAgent) usecases.RegisterCo
 	}
 }
 
-func (uc *registerDeploymentUseCase) Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes) error {
+func (uc *registerDeploymentUseCase) Execute(ctx context.Context, chainID string, address ethcommon.Address, codeHash hexutil.Bytes,
+	userInfo *multitenancy.UserInfo) error {
 	ctx = log.WithFields(ctx, log.Field("chain_id", chainID), log.Field("address", address.String()))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("registering contract deployment hash is starting ...")
 
-	err := uc.agent.RegisterDeployment(ctx, chainID, address, codeHash)
+	err := uc.agent.RegisterDeployment(ctx, chainID, address, codeHash, userInfo.TenantID, userInfo.Username)
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(registerDeploymentComponent)
 	}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/golang/mock/gomock"
@@ -18,24 +19,25 @@ func TestSetCodeHash_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	codeHash := utils.StringToHexBytes("0xAB")
 	contractAgent := mocks.NewMockContractAgent(ctrl)
 	usecase := NewRegisterDeploymentUseCase(contractAgent)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
-		contractAgent.EXPECT().RegisterDeployment(gomock.Any(), chainID, contractAddress, codeHash).Return(nil)
+		contractAgent.EXPECT().RegisterDeployment(gomock.Any(), chainID, contractAddress, codeHash, userInfo.TenantID, userInfo.Username).Return(nil)
 
-		err := usecase.Execute(ctx, chainID, contractAddress, codeHash)
+		err := usecase.Execute(ctx, chainID, contractAddress, codeHash, userInfo)
 
 		assert.NoError(t, err)
 	})
 
 	t.Run("should fail if data agent fails", func(t *testing.T) {
 		dataAgentError := fmt.Errorf("error")
-		contractAgent.EXPECT().RegisterDeployment(gomock.Any(), chainID, contractAddress, codeHash).Return(dataAgentError)
+		contractAgent.EXPECT().RegisterDeployment(gomock.Any(), chainID, contractAddress, codeHash, userInfo.TenantID, userInfo.Username).Return(dataAgentError)
 
-		err := usecase.Execute(ctx, chainID, contractAddress, codeHash)
+		err := usecase.Execute(ctx, chainID, contractAddress, codeHash, userInfo)
 
 		assert.Equal(t, errors.FromError(dataAgentError).ExtendComponent(registerDeploymentComponent), err)
 	})
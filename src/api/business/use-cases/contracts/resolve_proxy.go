@@ -0,0 +1,206 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	ethproxy "github.com/consensys/orchestrate/pkg/ethereum/proxy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	eth "github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const resolveProxyComponent = "use-cases.resolve-proxy"
+
+type resolveProxyContractUseCase struct {
+	searchChainsUC   usecases.SearchChainsUseCase
+	searchContractUC usecases.SearchContractUseCase
+	proxies          *proxyResolver
+	logger           *log.Logger
+}
+
+func NewResolveProxyContractUseCase(db store.DB, searchChainsUC usecases.SearchChainsUseCase,
+	searchContractUC usecases.SearchContractUseCase, ec ethclient.Client) usecases.ResolveProxyContractUseCase {
+	logger := log.NewLogger().SetComponent(resolveProxyComponent)
+	return &resolveProxyContractUseCase{
+		searchChainsUC:   searchChainsUC,
+		searchContractUC: searchContractUC,
+		proxies:          &proxyResolver{db: db, ec: ec, logger: logger},
+		logger:           logger,
+	}
+}
+
+// Execute follows the proxy to its implementation, detecting it from the EIP-1967 and EIP-1822 storage slots of the
+// proxy when no implementation was registered yet, or when the registered one is older than the refresh interval
+func (uc *resolveProxyContractUseCase) Execute(ctx context.Context, chainName string, address ethcommon.Address,
+	userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", chainName), log.Field("address", address.Hex()))
+	logger := uc.logger.WithContext(ctx)
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(resolveProxyComponent)
+	}
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(resolveProxyComponent)
+	}
+
+	implementation, err := uc.proxies.implementation(ctx, chains[0], address)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(resolveProxyComponent)
+	}
+	if implementation == nil {
+		logger.Debug("address is not a proxy")
+		return nil, nil
+	}
+
+	contract, err := uc.searchContractUC.Execute(ctx, nil, implementation, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(resolveProxyComponent)
+	}
+
+	logger.WithField("implementation", implementation.Hex()).Debug("proxy resolved successfully")
+	return contract, nil
+}
+
+// proxyRefreshInterval is the age after which the registered implementation of a proxy is read again from the chain,
+// proxies being upgraded without the registry necessarily decoding their Upgraded events
+const proxyRefreshInterval = time.Minute
+
+// proxyResolver follows proxies to their implementation, registering the implementations read from the chain
+type proxyResolver struct {
+	db     store.DB
+	ec     ethclient.Client
+	logger *log.Logger
+}
+
+// implementation follows the proxy to its implementation, detecting it from the storage slots of the proxy when not
+// registered
+func (r *proxyResolver) implementation(ctx context.Context, chain *entities.Chain, address ethcommon.Address) (*ethcommon.Address, error) {
+	proxy, err := r.db.Contract().FindProxy(ctx, chain.ChainID.String(), address)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.resolve(ctx, chain, address, proxy)
+}
+
+// resolve follows the registered proxy, if any, to its implementation, reading it again from the chain when not
+// registered or once the registration is older than the refresh interval
+func (r *proxyResolver) resolve(ctx context.Context, chain *entities.Chain, address ethcommon.Address,
+	proxy *entities.ContractProxy) (*ethcommon.Address, error) {
+	var err error
+	if proxy == nil || time.Since(proxy.UpdatedAt) > proxyRefreshInterval {
+		proxy, err = r.refresh(ctx, chain, address, proxy)
+		if err != nil || proxy == nil {
+			return nil, err
+		}
+	}
+
+	if proxy.Kind != entities.ProxyKindBeacon {
+		return &proxy.Implementation, nil
+	}
+
+	beacon, err := r.db.Contract().FindProxy(ctx, chain.ChainID.String(), proxy.Implementation)
+	if err != nil {
+		return nil, err
+	}
+	if beacon != nil && time.Since(beacon.UpdatedAt) <= proxyRefreshInterval {
+		return &beacon.Implementation, nil
+	}
+
+	implementation, err := r.beaconImplementation(ctx, chain, proxy.Implementation)
+	if err != nil && beacon != nil {
+		r.logger.WithContext(ctx).WithError(err).Warn("failed to call beacon, using registered implementation")
+		return &beacon.Implementation, nil
+	}
+
+	return implementation, err
+}
+
+// refresh reads the implementation from the storage slots of the proxy and registers it. The registered proxy is kept
+// when the chain cannot be read or when its implementation is not held by the standard slots
+func (r *proxyResolver) refresh(ctx context.Context, chain *entities.Chain, address ethcommon.Address,
+	registered *entities.ContractProxy) (*entities.ContractProxy, error) {
+	proxy, err := r.detect(ctx, chain, address)
+	if err != nil {
+		if registered == nil {
+			return nil, err
+		}
+
+		r.logger.WithContext(ctx).WithError(err).Warn("failed to refresh proxy, using registered implementation")
+		return registered, nil
+	}
+
+	if proxy == nil {
+		if registered == nil {
+			return nil, nil
+		}
+		proxy = registered
+	}
+
+	// Registering again resets the age of the registration
+	err = r.db.Contract().RegisterProxy(ctx, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return proxy, nil
+}
+
+func (r *proxyResolver) detect(ctx context.Context, chain *entities.Chain, address ethcommon.Address) (*entities.ContractProxy, error) {
+	for _, uri := range chain.URLs {
+		proxy, err := r.readSlots(ctx, uri, chain, address)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).WithField("url", uri).Warn("failed to read proxy storage")
+			continue
+		}
+
+		return proxy, nil
+	}
+
+	return nil, errors.EthConnectionError("failed to read proxy storage on all chain URLs")
+}
+
+func (r *proxyResolver) readSlots(ctx context.Context, uri string, chain *entities.Chain, address ethcommon.Address) (*entities.ContractProxy, error) {
+	for _, slot := range ethproxy.Slots {
+		word, err := r.ec.StorageAt(ctx, uri, address, slot.Slot, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if implementation := ethproxy.AddressFromWord(word); implementation != nil {
+			return &entities.ContractProxy{
+				ChainID:        chain.ChainID.String(),
+				Address:        address,
+				Implementation: *implementation,
+				Kind:           slot.Kind,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *proxyResolver) beaconImplementation(ctx context.Context, chain *entities.Chain, beacon ethcommon.Address) (*ethcommon.Address, error) {
+	msg := &eth.CallMsg{To: &beacon, Data: ethproxy.BeaconImplementationData}
+	for _, uri := range chain.URLs {
+		output, err := r.ec.CallContract(ctx, uri, msg, nil)
+		if err != nil {
+			r.logger.WithContext(ctx).WithError(err).WithField("url", uri).Warn("failed to call beacon")
+			continue
+		}
+
+		return ethproxy.AddressFromWord(output), nil
+	}
+
+	return nil, errors.EthConnectionError("failed to call beacon on all chain URLs")
+}
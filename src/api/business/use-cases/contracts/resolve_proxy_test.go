@@ -0,0 +1,170 @@
+// +build unit
+
+package contracts
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	ethproxy "github.com/consensys/orchestrate/pkg/ethereum/proxy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	eth "github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveProxyContract_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	mockDB := mocks.NewMockDB(ctrl)
+	contractAgent := mocks.NewMockContractAgent(ctrl)
+	mockSearchChainsUC := mocks2.NewMockSearchChainsUseCase(ctrl)
+	mockSearchContractUC := mocks2.NewMockSearchContractUseCase(ctrl)
+	mockEthClient := mock.NewMockClient(ctrl)
+
+	mockDB.EXPECT().Contract().Return(contractAgent).AnyTimes()
+
+	usecase := NewResolveProxyContractUseCase(mockDB, mockSearchChainsUC, mockSearchContractUC, mockEthClient)
+
+	chain := testdata.FakeChain()
+	chainID := chain.ChainID.String()
+	proxy := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	implementation := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+	beacon := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	contract := testdata.FakeContract()
+
+	t.Run("should resolve registered implementation", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, proxy).
+			Return(&entities.ContractProxy{Implementation: implementation, Kind: entities.ProxyKindEIP1967, UpdatedAt: time.Now()}, nil)
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &implementation, userInfo).Return(contract, nil)
+
+		result, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, contract, result)
+	})
+
+	t.Run("should read again outdated registered implementation", func(t *testing.T) {
+		upgraded := ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, proxy).
+			Return(&entities.ContractProxy{Implementation: implementation, Kind: entities.ProxyKindEIP1967,
+				UpdatedAt: time.Now().Add(-2 * proxyRefreshInterval)}, nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), chain.URLs[0], proxy, ethproxy.ImplementationSlot, nil).
+			Return(ethcommon.BytesToHash(upgraded.Bytes()).Bytes(), nil)
+		contractAgent.EXPECT().RegisterProxy(gomock.Any(), &entities.ContractProxy{
+			ChainID:        chainID,
+			Address:        proxy,
+			Implementation: upgraded,
+			Kind:           entities.ProxyKindEIP1967,
+		}).Return(nil)
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &upgraded, userInfo).Return(contract, nil)
+
+		result, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, contract, result)
+	})
+
+	t.Run("should use outdated registered implementation if storage cannot be read", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, proxy).
+			Return(&entities.ContractProxy{Implementation: implementation, Kind: entities.ProxyKindEIP1967}, nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), gomock.Any(), proxy, gomock.Any(), nil).
+			Return(nil, errors.EthConnectionError("error")).Times(len(chain.URLs))
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &implementation, userInfo).Return(contract, nil)
+
+		result, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, contract, result)
+	})
+
+	t.Run("should detect and register implementation from storage slots", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, proxy).Return(nil, nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), chain.URLs[0], proxy, ethproxy.ImplementationSlot, nil).
+			Return(ethcommon.Hash{}.Bytes(), nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), chain.URLs[0], proxy, ethproxy.BeaconSlot, nil).
+			Return(ethcommon.Hash{}.Bytes(), nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), chain.URLs[0], proxy, ethproxy.ProxiableSlot, nil).
+			Return(ethcommon.BytesToHash(implementation.Bytes()).Bytes(), nil)
+		contractAgent.EXPECT().RegisterProxy(gomock.Any(), &entities.ContractProxy{
+			ChainID:        chainID,
+			Address:        proxy,
+			Implementation: implementation,
+			Kind:           entities.ProxyKindEIP1822,
+		}).Return(nil)
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &implementation, userInfo).Return(contract, nil)
+
+		result, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, contract, result)
+	})
+
+	t.Run("should resolve implementation of beacon proxy by calling the beacon", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, proxy).
+			Return(&entities.ContractProxy{Implementation: beacon, Kind: entities.ProxyKindBeacon, UpdatedAt: time.Now()}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, beacon).Return(nil, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), nil).
+			DoAndReturn(func(ctx context.Context, url string, msg *eth.CallMsg, _ *big.Int) ([]byte, error) {
+				assert.Equal(t, beacon, *msg.To)
+				assert.Equal(t, ethproxy.BeaconImplementationData, msg.Data)
+				return ethcommon.BytesToHash(implementation.Bytes()).Bytes(), nil
+			})
+		mockSearchContractUC.EXPECT().Execute(gomock.Any(), nil, &implementation, userInfo).Return(contract, nil)
+
+		result, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, contract, result)
+	})
+
+	t.Run("should return nil if address is not a proxy", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, proxy).Return(nil, nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), chain.URLs[0], proxy, gomock.Any(), nil).
+			Return(ethcommon.Hash{}.Bytes(), nil).Times(len(ethproxy.Slots))
+
+		result, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		_, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with EthConnectionError if storage cannot be read", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		contractAgent.EXPECT().FindProxy(gomock.Any(), chainID, proxy).Return(nil, nil)
+		mockEthClient.EXPECT().StorageAt(gomock.Any(), gomock.Any(), proxy, gomock.Any(), nil).
+			Return(nil, errors.EthConnectionError("error")).Times(len(chain.URLs))
+
+		_, err := usecase.Execute(ctx, chain.Name, proxy, userInfo)
+
+		assert.True(t, errors.IsConnectionError(err))
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
@@ -26,16 +27,17 @@ func NewSearchContractUseCase(agent store.ContractAgent) usecases.SearchContract
 	}
 }
 
-func (uc *searchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address) (*entities.Contract, error) {
+func (uc *searchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *ethcommon.Address,
+	userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	logger := uc.logger.WithContext(ctx)
 
 	var contract *entities.Contract
 	var err error
 	switch {
 	case address != nil:
-		contract, err = uc.agent.FindOneByAddress(ctx, address.String())
+		contract, err = uc.agent.FindOneByAddress(ctx, address.String(), userInfo.AllowedTenants, userInfo.Username)
 	case codehash != nil:
-		contract, err = uc.agent.FindOneByCodeHash(ctx, codehash.String())
+		contract, err = uc.agent.FindOneByCodeHash(ctx, codehash.String(), userInfo.AllowedTenants, userInfo.Username)
 	}
 
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/golang/mock/gomock"
@@ -16,6 +17,7 @@ func TestSearchContract_Execute(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 
 	contract := testdata.FakeContract()
 	address := testdata.FakeAddress()
@@ -24,10 +26,10 @@ func TestSearchContract_Execute(t *testing.T) {
 
 	t.Run("should execute use case by address successfully", func(t *testing.T) {
 		contractAgent.EXPECT().
-			FindOneByAddress(gomock.Any(), address.String()).
+			FindOneByAddress(gomock.Any(), address.String(), userInfo.AllowedTenants, userInfo.Username).
 			Return(contract, nil)
 
-		response, err := usecase.Execute(ctx, nil, address)
+		response, err := usecase.Execute(ctx, nil, address, userInfo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, contract.ABI, response.ABI)
@@ -35,10 +37,10 @@ func TestSearchContract_Execute(t *testing.T) {
 
 	t.Run("should execute use case by code_hash successfully", func(t *testing.T) {
 		contractAgent.EXPECT().
-			FindOneByCodeHash(gomock.Any(), contract.Bytecode.String()).
+			FindOneByCodeHash(gomock.Any(), contract.Bytecode.String(), userInfo.AllowedTenants, userInfo.Username).
 			Return(contract, nil)
 
-		response, err := usecase.Execute(ctx, contract.Bytecode, nil)
+		response, err := usecase.Execute(ctx, contract.Bytecode, nil, userInfo)
 
 		assert.NoError(t, err)
 		assert.Equal(t, contract.ABI, response.ABI)
@@ -0,0 +1,30 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+//go:generate mockgen -source=event_logs.go -destination=mocks/event_logs.go -package=mocks
+
+type EventLogUseCases interface {
+	Index() IndexEventLogsUseCase
+	Search() SearchEventLogsUseCase
+	Backfill() BackfillEventLogsUseCase
+}
+
+type IndexEventLogsUseCase interface {
+	Execute(ctx context.Context, chainUUID string, eventLogs []ethtypes.Log) error
+}
+
+type SearchEventLogsUseCase interface {
+	Execute(ctx context.Context, chainName string, filters *entities.EventLogFilters, userInfo *multitenancy.UserInfo) ([]*entities.EventLog, error)
+}
+
+type BackfillEventLogsUseCase interface {
+	Execute(ctx context.Context, chainName string, addresses []ethcommon.Address, fromBlock, toBlock uint64, userInfo *multitenancy.UserInfo) error
+}
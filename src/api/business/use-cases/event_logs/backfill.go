@@ -0,0 +1,86 @@
+package eventlogs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const backfillEventLogsComponent = "use-cases.backfill-event-logs"
+
+type backfillUseCase struct {
+	searchChainsUC usecases.SearchChainsUseCase
+	messenger      sdk.MessengerTxListener
+	logger         *log.Logger
+}
+
+func NewBackfillUseCase(searchChainsUC usecases.SearchChainsUseCase, messenger sdk.MessengerTxListener) usecases.BackfillEventLogsUseCase {
+	return &backfillUseCase{
+		searchChainsUC: searchChainsUC,
+		messenger:      messenger,
+		logger:         log.NewLogger().SetComponent(backfillEventLogsComponent),
+	}
+}
+
+// Execute requests the tx-listener to scan a block range of a chain, the event logs found being indexed asynchronously
+func (uc *backfillUseCase) Execute(ctx context.Context, chainName string, addresses []ethcommon.Address, fromBlock, toBlock uint64,
+	userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("chain", chainName))
+	logger := uc.logger.WithContext(ctx).WithField("from_block", fromBlock).WithField("to_block", toBlock)
+
+	if toBlock < fromBlock {
+		errMsg := "toBlock must be greater than or equal to fromBlock"
+		logger.Error(errMsg)
+		return errors.InvalidParameterError(errMsg).ExtendComponent(backfillEventLogsComponent)
+	}
+
+	if toBlock-fromBlock >= entities.MaxEventLogsBackfillRange {
+		errMsg := "block range is too large"
+		logger.Error(errMsg)
+		return errors.InvalidParameterError(errMsg).ExtendComponent(backfillEventLogsComponent)
+	}
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(backfillEventLogsComponent)
+	}
+
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Error(errMsg)
+		return errors.InvalidParameterError(errMsg).ExtendComponent(backfillEventLogsComponent)
+	}
+
+	// The range is split so that each message is scanned in a bounded time by the tx-listener
+	for from := fromBlock; from <= toBlock; from += entities.EventLogsBackfillMessageRange {
+		to := from + entities.EventLogsBackfillMessageRange - 1
+		if to > toBlock {
+			to = toBlock
+		}
+
+		err = uc.messenger.BackfillEventLogsMessage(ctx, &entities.EventLogsBackfill{
+			ChainUUID: chains[0].UUID,
+			Addresses: addresses,
+			FromBlock: from,
+			ToBlock:   to,
+		}, userInfo)
+		if err != nil {
+			errMsg := "failed to send event logs backfill message"
+			logger.WithError(err).WithField("block", from).Error(errMsg)
+			return errors.DependencyFailureError(errMsg).ExtendComponent(backfillEventLogsComponent)
+		}
+
+		if to == toBlock {
+			break
+		}
+	}
+
+	logger.Info("event logs backfill requested successfully")
+	return nil
+}
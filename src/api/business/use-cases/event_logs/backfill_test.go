@@ -0,0 +1,107 @@
+// +build unit
+
+package eventlogs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackfillEventLogs_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockMessenger := mock.NewMockMessengerTxListener(ctrl)
+
+	usecase := NewBackfillUseCase(mockSearchChainsUC, mockMessenger)
+
+	chain := testdata.FakeChain()
+	addresses := []ethcommon.Address{ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")}
+
+	t.Run("should request backfill to the tx-listener successfully", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockMessenger.EXPECT().BackfillEventLogsMessage(gomock.Any(), &entities.EventLogsBackfill{
+			ChainUUID: chain.UUID,
+			Addresses: addresses,
+			FromBlock: 100,
+			ToBlock:   200,
+		}, userInfo).Return(nil)
+
+		err := usecase.Execute(ctx, chain.Name, addresses, 100, 200, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should split large block range in several backfill messages", func(t *testing.T) {
+		toBlock := uint64(100 + 2*entities.EventLogsBackfillMessageRange)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		gomock.InOrder(
+			mockMessenger.EXPECT().BackfillEventLogsMessage(gomock.Any(), &entities.EventLogsBackfill{
+				ChainUUID: chain.UUID,
+				Addresses: addresses,
+				FromBlock: 100,
+				ToBlock:   99 + entities.EventLogsBackfillMessageRange,
+			}, userInfo).Return(nil),
+			mockMessenger.EXPECT().BackfillEventLogsMessage(gomock.Any(), &entities.EventLogsBackfill{
+				ChainUUID: chain.UUID,
+				Addresses: addresses,
+				FromBlock: 100 + entities.EventLogsBackfillMessageRange,
+				ToBlock:   99 + 2*entities.EventLogsBackfillMessageRange,
+			}, userInfo).Return(nil),
+			mockMessenger.EXPECT().BackfillEventLogsMessage(gomock.Any(), &entities.EventLogsBackfill{
+				ChainUUID: chain.UUID,
+				Addresses: addresses,
+				FromBlock: toBlock,
+				ToBlock:   toBlock,
+			}, userInfo).Return(nil),
+		)
+
+		err := usecase.Execute(ctx, chain.Name, addresses, 100, toBlock, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if block range is too large", func(t *testing.T) {
+		err := usecase.Execute(ctx, chain.Name, addresses, 100, 100+entities.MaxEventLogsBackfillRange, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if block range is invalid", func(t *testing.T) {
+		err := usecase.Execute(ctx, chain.Name, addresses, 200, 100, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		err := usecase.Execute(ctx, chain.Name, addresses, 100, 200, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with DependencyFailureError if message cannot be sent", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockMessenger.EXPECT().BackfillEventLogsMessage(gomock.Any(), gomock.Any(), userInfo).Return(fmt.Errorf("error"))
+
+		err := usecase.Execute(ctx, chain.Name, addresses, 100, 200, userInfo)
+
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
+}
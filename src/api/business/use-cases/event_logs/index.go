@@ -0,0 +1,62 @@
+package eventlogs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const indexEventLogsComponent = "use-cases.index-event-logs"
+
+type indexUseCase struct {
+	db          store.DB
+	decodeLogUC usecases.DecodeEventLogUseCase
+	logger      *log.Logger
+}
+
+func NewIndexUseCase(db store.DB, decodeLogUC usecases.DecodeEventLogUseCase) usecases.IndexEventLogsUseCase {
+	return &indexUseCase{
+		db:          db,
+		decodeLogUC: decodeLogUC,
+		logger:      log.NewLogger().SetComponent(indexEventLogsComponent),
+	}
+}
+
+// Execute persists the event logs of a chain, decoded when the ABI of the event is known
+func (uc *indexUseCase) Execute(ctx context.Context, chainUUID string, eventLogs []ethtypes.Log) error {
+	logger := uc.logger.WithContext(ctx).WithField("chain", chainUUID)
+
+	var indexed []*entities.EventLog
+	for idx := range eventLogs {
+		if eventLogs[idx].Removed {
+			continue
+		}
+
+		eventLog := entities.NewEventLog(chainUUID, &eventLogs[idx])
+		if len(eventLog.Topics) > 0 {
+			decodedLog, err := uc.decodeLogUC.Execute(ctx, chainUUID, eventLog.ToLog())
+			if err != nil {
+				return errors.FromError(err).ExtendComponent(indexEventLogsComponent)
+			}
+			if decodedLog != nil {
+				eventLog.Event = decodedLog.Event
+				eventLog.DecodedData = decodedLog.DecodedData
+			}
+		}
+
+		indexed = append(indexed, eventLog)
+	}
+
+	err := uc.db.EventLog().InsertMultiple(ctx, indexed)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(indexEventLogsComponent)
+	}
+
+	logger.WithField("event_logs", len(indexed)).Debug("event logs indexed successfully")
+	return nil
+}
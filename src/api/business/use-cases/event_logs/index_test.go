@@ -0,0 +1,100 @@
+// +build unit
+
+package eventlogs
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexEventLogs_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockEventLogDA := mocks2.NewMockEventLogAgent(ctrl)
+	mockDecodeLogUC := mocks.NewMockDecodeEventLogUseCase(ctrl)
+
+	mockDB.EXPECT().EventLog().Return(mockEventLogDA).AnyTimes()
+
+	usecase := NewIndexUseCase(mockDB, mockDecodeLogUC)
+
+	chainUUID := "chainUUID"
+	eventLogs := []ethtypes.Log{
+		{
+			Address:     ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+			Topics:      []ethcommon.Hash{ethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")},
+			BlockNumber: 10,
+			TxHash:      ethcommon.HexToHash("0x3b198bfd5d2907285af009e9ae84a0ecd63677110d89d7e030251acb87f6487e"),
+			Index:       1,
+		},
+		{
+			Address:     ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+			BlockNumber: 10,
+			Index:       2,
+		},
+		{
+			Address: ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+			Topics:  []ethcommon.Hash{ethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")},
+			Removed: true,
+		},
+	}
+
+	t.Run("should index decoded and undecoded event logs", func(t *testing.T) {
+		decodedData := map[string]string{"value": "1"}
+		mockDecodeLogUC.EXPECT().Execute(gomock.Any(), chainUUID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, l *ethereum.Log) (*ethereum.Log, error) {
+				assert.Equal(t, eventLogs[0].TxHash.Hex(), l.TxHash)
+				assert.Equal(t, uint64(10), l.BlockNumber)
+				l.Event = "Transfer(address,address,uint256)"
+				l.DecodedData = decodedData
+				return l, nil
+			})
+		mockEventLogDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, indexed []*entities.EventLog) error {
+				require.Len(t, indexed, 2)
+				assert.Equal(t, chainUUID, indexed[0].ChainUUID)
+				assert.Equal(t, "Transfer(address,address,uint256)", indexed[0].Event)
+				assert.Equal(t, decodedData, indexed[0].DecodedData)
+				assert.Equal(t, uint(2), indexed[1].LogIndex)
+				assert.Empty(t, indexed[1].Event)
+				return nil
+			})
+
+		err := usecase.Execute(ctx, chainUUID, eventLogs)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if decoding fails", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		mockDecodeLogUC.EXPECT().Execute(gomock.Any(), chainUUID, gomock.Any()).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, chainUUID, eventLogs)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(indexEventLogsComponent), err)
+	})
+
+	t.Run("should fail with same error if insert fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		mockDecodeLogUC.EXPECT().Execute(gomock.Any(), chainUUID, gomock.Any()).Return(nil, nil)
+		mockEventLogDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		err := usecase.Execute(ctx, chainUUID, eventLogs)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(indexEventLogsComponent), err)
+	})
+}
@@ -0,0 +1,55 @@
+package eventlogs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchEventLogsComponent = "use-cases.search-event-logs"
+
+type searchUseCase struct {
+	db             store.EventLogAgent
+	searchChainsUC usecases.SearchChainsUseCase
+	logger         *log.Logger
+}
+
+func NewSearchUseCase(db store.EventLogAgent, searchChainsUC usecases.SearchChainsUseCase) usecases.SearchEventLogsUseCase {
+	return &searchUseCase{
+		db:             db,
+		searchChainsUC: searchChainsUC,
+		logger:         log.NewLogger().SetComponent(searchEventLogsComponent),
+	}
+}
+
+// Execute searches the event logs indexed on a chain accessible to the user
+func (uc *searchUseCase) Execute(ctx context.Context, chainName string, filters *entities.EventLogFilters,
+	userInfo *multitenancy.UserInfo) ([]*entities.EventLog, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", chainName))
+	logger := uc.logger.WithContext(ctx)
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchEventLogsComponent)
+	}
+
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(searchEventLogsComponent)
+	}
+
+	filters.ChainUUID = chains[0].UUID
+	eventLogs, err := uc.db.Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchEventLogsComponent)
+	}
+
+	logger.Debug("event logs found successfully")
+	return eventLogs, nil
+}
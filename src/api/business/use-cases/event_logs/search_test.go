@@ -0,0 +1,65 @@
+// +build unit
+
+package eventlogs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	storemocks "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchEventLogs_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+
+	mockDB := storemocks.NewMockEventLogAgent(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+
+	usecase := NewSearchUseCase(mockDB, mockSearchChainsUC)
+
+	chain := testdata.FakeChain()
+
+	t.Run("should search event logs of the chain accessible to the user", func(t *testing.T) {
+		eventLog := testdata.FakeEventLog()
+		filters := &entities.EventLogFilters{Limit: 10}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockDB.EXPECT().Search(gomock.Any(), &entities.EventLogFilters{ChainUUID: chain.UUID, Limit: 10}, userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.EventLog{eventLog}, nil)
+
+		resp, err := usecase.Execute(ctx, chain.Name, filters, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*entities.EventLog{eventLog}, resp)
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		_, err := usecase.Execute(ctx, chain.Name, &entities.EventLogFilters{}, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if search fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockDB.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, chain.Name, &entities.EventLogFilters{}, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(searchEventLogsComponent), err)
+	})
+}
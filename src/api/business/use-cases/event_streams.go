@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -18,6 +19,7 @@ type EventStreamsUseCases interface {
 	Search() SearchEventStreamsUseCase
 	NotifyTransaction() NotifyTransactionUseCase
 	NotifyContractEvents() NotifyContractEventsUseCase
+	NotifySafeProposal() NotifySafeProposalUseCase
 	Delete() DeleteEventStreamUseCase
 }
 
@@ -39,6 +41,13 @@ type SearchEventStreamsUseCase interface {
 
 type NotifyTransactionUseCase interface {
 	Execute(ctx context.Context, job *entities.Job, errStr string, userInfo *multitenancy.UserInfo) error
+	// WithDB returns the use case running on the given DB, so that the notification is inserted within a DB transaction
+	WithDB(db store.DB) NotifyTransactionUseCase
+}
+
+type NotifySafeProposalUseCase interface {
+	Execute(ctx context.Context, proposal *entities.SafeProposal, notifType entities.NotificationType, userInfo *multitenancy.UserInfo) error
+	WithDB(db store.DB) NotifySafeProposalUseCase
 }
 
 type NotifyContractEventsUseCase interface {
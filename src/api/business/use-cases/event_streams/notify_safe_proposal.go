@@ -0,0 +1,83 @@
+package streams
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const notifySafeProposalComponent = "use-cases.notify_safe_proposal"
+
+type notifySafeProposalUseCase struct {
+	db              store.DB
+	outboxMessenger usecases.OutboxMessenger
+	logger          *log.Logger
+}
+
+func NewNotifySafeProposalUseCase(db store.DB, outboxMessenger usecases.OutboxMessenger) usecases.NotifySafeProposalUseCase {
+	return &notifySafeProposalUseCase{
+		db:              db,
+		outboxMessenger: outboxMessenger,
+		logger:          log.NewLogger().SetComponent(notifySafeProposalComponent),
+	}
+}
+
+func (uc *notifySafeProposalUseCase) Execute(ctx context.Context, proposal *entities.SafeProposal, notifType entities.NotificationType,
+	userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("safe_proposal", proposal.UUID))
+
+	eventStream, err := uc.db.EventStream().FindOneByTenantAndChain(ctx, proposal.TenantID, proposal.ChainUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(notifySafeProposalComponent)
+	}
+
+	if eventStream == nil {
+		return nil
+	}
+
+	logger := uc.logger.WithContext(ctx).WithField("event_stream", eventStream.Name).WithField("channel", eventStream.Channel)
+	var notif *entities.Notification
+	err = uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		notif, err = dbtx.Notification().Insert(ctx, &entities.Notification{
+			SourceUUID: proposal.UUID,
+			SourceType: entities.NotificationSourceTypeSafeProposal,
+			Status:     entities.NotificationStatusPending,
+			Type:       notifType,
+			APIVersion: "v1",
+		})
+		if err != nil {
+			return err
+		}
+		notif.SafeProposal = proposal
+
+		if eventStream.Status != entities.EventStreamStatusLive {
+			return nil
+		}
+
+		// Safe proposal notifications are delivered by the notifier as any other transaction notification
+		err = uc.outboxMessenger.WithDB(ctx, dbtx).TransactionNotificationMessage(ctx, eventStream, notif, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to send safe proposal notification")
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(notifySafeProposalComponent)
+	}
+
+	logger.WithField("notification", notif.UUID).Debug("safe proposal notification sent successfully to notifier service")
+	return nil
+}
+
+func (uc *notifySafeProposalUseCase) WithDB(db store.DB) usecases.NotifySafeProposalUseCase {
+	dbUC := *uc
+	dbUC.db = db
+	return &dbUC
+}
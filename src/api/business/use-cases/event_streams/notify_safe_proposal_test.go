@@ -0,0 +1,92 @@
+// +build unit
+
+package streams
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks3 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifySafeProposal(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockEventStream := mocks.NewMockEventStreamAgent(ctrl)
+	mockNotification := mocks.NewMockNotificationAgent(ctrl)
+	messenger := mock.NewMockOrchestrateMessenger(ctrl)
+	outboxMessenger := mocks3.NewMockOutboxMessenger(ctrl)
+	outboxMessenger.EXPECT().WithDB(gomock.Any(), gomock.Any()).Return(messenger).AnyTimes()
+
+	mockDB.EXPECT().EventStream().Return(mockEventStream).AnyTimes()
+	mockDB.EXPECT().Notification().Return(mockNotification).AnyTimes()
+	mockDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persistFunc func(dbtx store.DB) error) error {
+			return persistFunc(mockDB)
+		}).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewNotifySafeProposalUseCase(mockDB, outboxMessenger)
+
+	t.Run("should execute use case successfully", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+		eventStream := testdata.FakeWebhookEventStream()
+		expectedNotif := &entities.Notification{
+			SourceUUID: proposal.UUID,
+			SourceType: entities.NotificationSourceTypeSafeProposal,
+			Status:     entities.NotificationStatusPending,
+			Type:       entities.NotificationTypeSafeProposalSigned,
+			APIVersion: "v1",
+		}
+
+		mockEventStream.EXPECT().FindOneByTenantAndChain(gomock.Any(), proposal.TenantID, proposal.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(eventStream, nil)
+		mockNotification.EXPECT().Insert(gomock.Any(), expectedNotif).Return(expectedNotif, nil)
+		messenger.EXPECT().TransactionNotificationMessage(gomock.Any(), eventStream, gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, _ *entities.EventStream, notif *entities.Notification, _ *multitenancy.UserInfo) error {
+				assert.Equal(t, proposal, notif.SafeProposal)
+				return nil
+			})
+
+		err := usecase.Execute(ctx, proposal, entities.NotificationTypeSafeProposalSigned, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should do nothing if no event stream is found", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+
+		mockEventStream.EXPECT().FindOneByTenantAndChain(gomock.Any(), proposal.TenantID, proposal.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, nil)
+
+		err := usecase.Execute(ctx, proposal, entities.NotificationTypeSafeProposalSigned, userInfo)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if sending the message fails", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+		eventStream := testdata.FakeWebhookEventStream()
+
+		mockEventStream.EXPECT().FindOneByTenantAndChain(gomock.Any(), proposal.TenantID, proposal.ChainUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(eventStream, nil)
+		mockNotification.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(&entities.Notification{}, nil)
+		messenger.EXPECT().TransactionNotificationMessage(gomock.Any(), eventStream, gomock.Any(), userInfo).Return(errors.PostgresConnectionError("error"))
+
+		err := usecase.Execute(ctx, proposal, entities.NotificationTypeSafeProposalExecuted, userInfo)
+
+		assert.True(t, errors.IsPostgresConnectionError(err))
+	})
+}
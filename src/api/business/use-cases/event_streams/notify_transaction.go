@@ -4,9 +4,9 @@ import (
 	"context"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/pkg/types/ethereum"
 	"github.com/consensys/orchestrate/pkg/utils"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
@@ -18,29 +18,32 @@ import (
 const notifyTransactionComponent = "use-cases.notify_transaction"
 
 type notifyTransactionUseCase struct {
-	db                  store.DB
-	searchContractUC    usecases.SearchContractUseCase
-	decodeLogUC         usecases.DecodeEventLogUseCase
-	txNotifierMessenger sdk.MessengerNotifier
-	logger              *log.Logger
+	db               store.DB
+	searchContractUC usecases.SearchContractUseCase
+	decodeLogUC      usecases.DecodeEventLogUseCase
+	outboxMessenger  usecases.OutboxMessenger
+	logger           *log.Logger
 }
 
 func NewNotifyTransactionUseCase(
 	db store.DB,
 	searchContractUC usecases.SearchContractUseCase,
 	decodeLogUC usecases.DecodeEventLogUseCase,
-	txNotifierMessenger sdk.MessengerNotifier,
+	outboxMessenger usecases.OutboxMessenger,
 ) usecases.NotifyTransactionUseCase {
 	return &notifyTransactionUseCase{
-		db:                  db,
-		searchContractUC:    searchContractUC,
-		decodeLogUC:         decodeLogUC,
-		txNotifierMessenger: txNotifierMessenger,
-		logger:              log.NewLogger().SetComponent(notifyTransactionComponent),
+		db:               db,
+		searchContractUC: searchContractUC,
+		decodeLogUC:      decodeLogUC,
+		outboxMessenger:  outboxMessenger,
+		logger:           log.NewLogger().SetComponent(notifyTransactionComponent),
 	}
 }
 
-func (uc *notifyTransactionUseCase) Execute(ctx context.Context, job *entities.Job, errStr string, userInfo *multitenancy.UserInfo) error {
+func (uc *notifyTransactionUseCase) Execute(ctx context.Context, job *entities.Job, errStr string, userInfo *multitenancy.UserInfo) (err error) {
+	ctx, span := tracing.StartSpan(ctx, notifyTransactionComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.WithFields(ctx, log.Field("id", job.ScheduleUUID))
 
 	eventStream, err := uc.db.EventStream().FindOneByTenantAndChain(ctx, job.TenantID, job.ChainUUID, userInfo.AllowedTenants, userInfo.Username)
@@ -64,49 +67,83 @@ func (uc *notifyTransactionUseCase) Execute(ctx context.Context, job *entities.J
 			return errors.InvalidParameterError(errMsg)
 		}
 
-		err = uc.attachContractData(ctx, job.Receipt)
+		err = uc.decodeReceipt(ctx, job, job.Receipt)
 		if err != nil {
 			return errors.FromError(err).ExtendComponent(notifyTransactionComponent)
 		}
 
-		for idx, l := range job.Receipt.Logs {
-			decodedLog, err2 := uc.decodeLogUC.Execute(ctx, job.ChainUUID, l)
-			if err2 != nil {
-				return errors.FromError(err2).ExtendComponent(notifyTransactionComponent)
-			}
-			if decodedLog != nil {
-				job.Receipt.Logs[idx] = decodedLog
+		// Private receipts are decoded with the contract registry as for public ones
+		if job.PrivateReceipt != nil {
+			err = uc.decodeReceipt(ctx, job, job.PrivateReceipt)
+			if err != nil {
+				return errors.FromError(err).ExtendComponent(notifyTransactionComponent)
 			}
 		}
 	}
 
-	notif, err := uc.db.Notification().Insert(ctx, &entities.Notification{
-		SourceUUID: job.ScheduleUUID,
-		SourceType: entities.NotificationSourceTypeJob,
-		Status:     entities.NotificationStatusPending,
-		Type:       jobStatusToNotificationType(job.Status),
-		APIVersion: "v1",
-		Error:      errStr,
+	// Notifications are sent to the notifier through the outbox, within the transaction inserting them
+	var notif *entities.Notification
+	err = uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		notif, err = dbtx.Notification().Insert(ctx, &entities.Notification{
+			SourceUUID: job.ScheduleUUID,
+			SourceType: entities.NotificationSourceTypeJob,
+			Status:     entities.NotificationStatusPending,
+			Type:       jobStatusToNotificationType(job.Status),
+			APIVersion: "v1",
+			Error:      errStr,
+		})
+		if err != nil {
+			return err
+		}
+		notif.Job = job
+
+		if eventStream.Status != entities.EventStreamStatusLive {
+			return nil
+		}
+
+		err = uc.outboxMessenger.WithDB(ctx, dbtx).TransactionNotificationMessage(ctx, eventStream, notif, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to send transaction notification")
+			return err
+		}
+
+		return nil
 	})
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(notifyTransactionComponent)
 	}
-	notif.Job = job
 
-	if eventStream.Status == entities.EventStreamStatusLive {
-		err = uc.txNotifierMessenger.TransactionNotificationMessage(ctx, eventStream, notif, userInfo)
+	logger.WithField("event_stream", eventStream.UUID).WithField("notification", notif.UUID).Debug("notification sent successfully to notifier service")
+	return nil
+}
+
+func (uc *notifyTransactionUseCase) WithDB(db store.DB) usecases.NotifyTransactionUseCase {
+	dbUC := *uc
+	dbUC.db = db
+	return &dbUC
+}
+
+func (uc *notifyTransactionUseCase) decodeReceipt(ctx context.Context, job *entities.Job, receipt *ethereum.Receipt) error {
+	err := uc.attachContractData(ctx, receipt, multitenancy.NewUserInfo(job.TenantID, job.OwnerID))
+	if err != nil {
+		return err
+	}
+
+	for idx, l := range receipt.Logs {
+		decodedLog, err := uc.decodeLogUC.Execute(ctx, job.ChainUUID, l)
 		if err != nil {
-			errMsg := "failed to send transaction notification"
-			uc.logger.WithError(err).Error(errMsg)
-			return errors.DependencyFailureError(errMsg).ExtendComponent(notifyTransactionComponent)
+			return err
+		}
+		if decodedLog != nil {
+			receipt.Logs[idx] = decodedLog
 		}
 	}
 
-	logger.WithField("event_stream", eventStream.UUID).WithField("notification", notif.UUID).Debug("notification sent successfully to notifier service")
 	return nil
 }
 
-func (uc *notifyTransactionUseCase) attachContractData(ctx context.Context, receipt *ethereum.Receipt) error {
+// attachContractData resolves the contract in the registry of the tenant of the job, which may differ from the user notifying
+func (uc *notifyTransactionUseCase) attachContractData(ctx context.Context, receipt *ethereum.Receipt, userInfo *multitenancy.UserInfo) error {
 	var contractAddress *ethcommon.Address
 
 	if receipt.ContractAddress != "" && receipt.ContractAddress != utils.ZeroAddressString {
@@ -124,7 +161,7 @@ func (uc *notifyTransactionUseCase) attachContractData(ctx context.Context, rece
 		return nil
 	}
 
-	eventContract, err := uc.searchContractUC.Execute(ctx, nil, contractAddress)
+	eventContract, err := uc.searchContractUC.Execute(ctx, nil, contractAddress, userInfo)
 	if err != nil {
 		return err
 	}
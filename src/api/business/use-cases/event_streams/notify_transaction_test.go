@@ -9,7 +9,9 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	testdata2 "github.com/consensys/orchestrate/pkg/types/ethereum/testdata"
 	mocks3 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
@@ -27,17 +29,23 @@ func TestNotifyTx(t *testing.T) {
 	mockDB := mocks.NewMockDB(ctrl)
 	mockEventStream := mocks.NewMockEventStreamAgent(ctrl)
 	mockNotification := mocks.NewMockNotificationAgent(ctrl)
-	messenger := mock.NewMockMessengerNotifier(ctrl)
+	messenger := mock.NewMockOrchestrateMessenger(ctrl)
+	outboxMessenger := mocks3.NewMockOutboxMessenger(ctrl)
+	outboxMessenger.EXPECT().WithDB(gomock.Any(), gomock.Any()).Return(messenger).AnyTimes()
 	searchContractsUC := mocks3.NewMockSearchContractUseCase(ctrl)
 	decodeLogUC := mocks3.NewMockDecodeEventLogUseCase(ctrl)
 
 	mockDB.EXPECT().EventStream().Return(mockEventStream).AnyTimes()
 	mockDB.EXPECT().Notification().Return(mockNotification).AnyTimes()
+	mockDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persistFunc func(dbtx store.DB) error) error {
+			return persistFunc(mockDB)
+		}).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
 	errStr := "error"
 
-	usecase := NewNotifyTransactionUseCase(mockDB, searchContractsUC, decodeLogUC, messenger)
+	usecase := NewNotifyTransactionUseCase(mockDB, searchContractsUC, decodeLogUC, outboxMessenger)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
@@ -61,6 +69,28 @@ func TestNotifyTx(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should decode the logs of the private receipt of mined jobs", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Status = entities.StatusMined
+		job.Receipt = testdata2.FakeReceipt()
+		job.PrivateReceipt = testdata2.FakeReceipt()
+		privateLog := job.PrivateReceipt.Logs[0]
+		decodedLog := testdata2.FakeReceiptLogs()
+		decodedLog.Event = "Transfer(address,address,uint256)"
+		eventStream := testdata.FakeWebhookEventStream()
+
+		mockEventStream.EXPECT().FindOneByTenantAndChain(gomock.Any(), job.TenantID, job.ChainUUID, userInfo.AllowedTenants, userInfo.Username).Return(eventStream, nil)
+		decodeLogUC.EXPECT().Execute(gomock.Any(), job.ChainUUID, job.Receipt.Logs[0]).Return(nil, nil)
+		decodeLogUC.EXPECT().Execute(gomock.Any(), job.ChainUUID, privateLog).Return(decodedLog, nil)
+		mockNotification.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(&entities.Notification{}, nil)
+		messenger.EXPECT().TransactionNotificationMessage(gomock.Any(), eventStream, gomock.Any(), userInfo).Return(nil)
+
+		err := usecase.Execute(ctx, job, "", userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, decodedLog, job.PrivateReceipt.Logs[0])
+	})
+
 	t.Run("should do nothing if no event stream is found", func(t *testing.T) {
 		job := testdata.FakeJob()
 
@@ -85,6 +115,21 @@ func TestNotifyTx(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should fail if the notification cannot be written to the outbox", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Status = entities.StatusFailed
+		eventStream := testdata.FakeWebhookEventStream()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockEventStream.EXPECT().FindOneByTenantAndChain(gomock.Any(), job.TenantID, job.ChainUUID, userInfo.AllowedTenants, userInfo.Username).Return(eventStream, nil)
+		mockNotification.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(&entities.Notification{}, nil)
+		messenger.EXPECT().TransactionNotificationMessage(gomock.Any(), eventStream, gomock.Any(), userInfo).Return(expectedErr)
+
+		err := usecase.Execute(ctx, job, errStr, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(notifyTransactionComponent), err)
+	})
+
 	t.Run("should fail with same error if cannot find stream", func(t *testing.T) {
 		job := testdata.FakeJob()
 		expectedErr := errors.NotFoundError("error")
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -24,6 +25,10 @@ type CreateJobUseCase interface {
 	Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) (*entities.Job, error)
 }
 
+type PrepareJobUseCase interface {
+	Execute(ctx context.Context, job *entities.Job, chain *entities.Chain, userInfo *multitenancy.UserInfo) error
+}
+
 type GetJobUseCase interface {
 	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.Job, error)
 }
@@ -34,10 +39,13 @@ type SearchJobsUseCase interface {
 
 type StartJobUseCase interface {
 	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error
+	// WithDB returns the use case running on the given DB, so that the job is started within a DB transaction
+	WithDB(db store.DB) StartJobUseCase
 }
 
 type StartNextJobUseCase interface {
 	Execute(ctx context.Context, prevJobUUID string, userInfo *multitenancy.UserInfo) error
+	WithDB(db store.DB) StartNextJobUseCase
 }
 
 type UpdateJobUseCase interface {
@@ -2,12 +2,11 @@ package jobs
 
 import (
 	"context"
-	"math/big"
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/entities"
-	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
@@ -17,37 +16,37 @@ import (
 const createJobComponent = "use-cases.create-job"
 
 type createJobUseCase struct {
-	db             store.DB
-	getChainUC     usecases.GetChainUseCase
-	logger         *log.Logger
-	defaultStoreID string
+	db           store.DB
+	getChainUC   usecases.GetChainUseCase
+	prepareJobUC usecases.PrepareJobUseCase
+	logger       *log.Logger
 }
 
-func NewCreateJobUseCase(db store.DB, getChainUC usecases.GetChainUseCase, qkmStoreID string) usecases.CreateJobUseCase {
+func NewCreateJobUseCase(db store.DB, getChainUC usecases.GetChainUseCase, prepareJobUC usecases.PrepareJobUseCase) usecases.CreateJobUseCase {
 	return &createJobUseCase{
-		db:             db,
-		getChainUC:     getChainUC,
-		logger:         log.NewLogger().SetComponent(createJobComponent),
-		defaultStoreID: qkmStoreID,
+		db:           db,
+		getChainUC:   getChainUC,
+		prepareJobUC: prepareJobUC,
+		logger:       log.NewLogger().SetComponent(createJobComponent),
 	}
 }
 
-func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) (_ *entities.Job, err error) {
+	ctx, span := tracing.StartSpan(ctx, createJobComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.WithFields(ctx, log.Field("chain", job.ChainUUID), log.Field("schedule", job.ScheduleUUID))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new job")
 
-	chainID, err := uc.getChainID(ctx, job.ChainUUID, userInfo)
+	chain, err := uc.getChain(ctx, job.ChainUUID, userInfo)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(createJobComponent)
 	}
-	job.InternalData.ChainID = chainID
 
-	if job.Transaction.From != nil && job.Type != entities.EthereumRawTransaction {
-		job.InternalData.StoreID, err = uc.getAccountStoreID(ctx, job.Transaction.From, userInfo)
-		if err != nil {
-			return nil, errors.FromError(err).ExtendComponent(createJobComponent)
-		}
+	err = uc.prepareJobUC.Execute(ctx, job, chain, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createJobComponent)
 	}
 
 	_, err = uc.db.Schedule().FindOneByUUID(ctx, job.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username)
@@ -73,24 +72,7 @@ func (uc *createJobUseCase) Execute(ctx context.Context, job *entities.Job, user
 	return job, nil
 }
 
-// nolint
-func (uc *createJobUseCase) getAccountStoreID(ctx context.Context, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (string, error) {
-	acc, err := uc.db.Account().FindOneByAddress(ctx, address.String(), userInfo.AllowedTenants, userInfo.Username)
-	if errors.IsNotFoundError(err) {
-		return "", errors.InvalidParameterError("failed to get account")
-	}
-	if err != nil {
-		return "", err
-	}
-
-	if acc.StoreID == "" {
-		return uc.defaultStoreID, nil
-	}
-
-	return acc.StoreID, nil
-}
-
-func (uc *createJobUseCase) getChainID(ctx context.Context, chainUUID string, userInfo *multitenancy.UserInfo) (*big.Int, error) {
+func (uc *createJobUseCase) getChain(ctx context.Context, chainUUID string, userInfo *multitenancy.UserInfo) (*entities.Chain, error) {
 	chain, err := uc.getChainUC.Execute(ctx, chainUUID, userInfo)
 	if errors.IsNotFoundError(err) {
 		return nil, errors.InvalidParameterError("failed to get chain")
@@ -99,5 +81,5 @@ func (uc *createJobUseCase) getChainID(ctx context.Context, chainUUID string, us
 		return nil, errors.FromError(err)
 	}
 
-	return chain.ChainID, nil
+	return chain, nil
 }
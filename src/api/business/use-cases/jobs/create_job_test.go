@@ -4,7 +4,9 @@ package jobs
 
 import (
 	"context"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
@@ -32,7 +34,7 @@ func TestCreateJob_Execute(t *testing.T) {
 
 	qkmStoreID := "qkm-store-id"
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewCreateJobUseCase(mockDB, mockGetChainUC, qkmStoreID)
+	usecase := NewCreateJobUseCase(mockDB, mockGetChainUC, NewPrepareJobUseCase(mockDB, qkmStoreID))
 	fakeChain := testdata.FakeChain()
 	fakeAccount := testdata.FakeAccount()
 
@@ -62,6 +64,38 @@ func TestCreateJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 	
+	t.Run("should apply the fee policy of the chain successfully", func(t *testing.T) {
+		jobEntity := testdata.FakeJob()
+		jobEntity.ScheduleUUID = fakeSchedule.UUID
+		chain := testdata.FakeChain()
+		chain.FeePolicy = &entities.FeePolicy{
+			MaxFee:               big.NewInt(1000000000),
+			BumpPercentage:       0.1,
+			BumpInterval:         30 * time.Second,
+			MaxBumps:             3,
+			ReplaceWithSameNonce: true,
+		}
+
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), jobEntity.ChainUUID, userInfo).Return(chain, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), jobEntity.Transaction.From.String(),
+			userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeAccount, nil)
+		mockScheduleDA.EXPECT().FindOneByUUID(gomock.Any(), jobEntity.ScheduleUUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(fakeSchedule, nil)
+		mockJobDA.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, job *entities.Job, log *entities.Log) error {
+				assert.Equal(t, chain.FeePolicy.MaxFee, job.InternalData.MaxFee)
+				assert.Equal(t, 30*time.Second, job.InternalData.RetryInterval)
+				assert.Equal(t, 0.1, job.InternalData.GasPriceIncrement)
+				assert.InDelta(t, 0.3, job.InternalData.GasPriceLimit, 1e-9)
+				return nil
+			})
+
+		_, err := usecase.Execute(context.Background(), jobEntity, userInfo)
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("should fail with InvalidParameterError if chain is not found", func(t *testing.T) {
 		jobEntity := testdata.FakeJob()
 	
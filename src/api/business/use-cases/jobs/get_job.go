@@ -16,15 +16,17 @@ const getJobComponent = "use-cases.get-job"
 
 // getJobUseCase is a use case to get a job
 type getJobUseCase struct {
-	db     store.DB
-	logger *log.Logger
+	db           store.DB
+	decodeCallUC usecases.DecodeCallUseCase
+	logger       *log.Logger
 }
 
 // NewGetJobUseCase creates a new GetJobUseCase
-func NewGetJobUseCase(db store.DB) usecases.GetJobUseCase {
+func NewGetJobUseCase(db store.DB, decodeCallUC usecases.DecodeCallUseCase) usecases.GetJobUseCase {
 	return &getJobUseCase{
-		db:     db,
-		logger: log.NewLogger().SetComponent(getJobComponent),
+		db:           db,
+		decodeCallUC: decodeCallUC,
+		logger:       log.NewLogger().SetComponent(getJobComponent),
 	}
 }
 
@@ -36,6 +38,11 @@ func (uc *getJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *
 		return nil, errors.FromError(err).ExtendComponent(getJobComponent)
 	}
 
+	err = uc.decodeCallUC.Execute(ctx, []*entities.Job{job}, "", "", userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getJobComponent)
+	}
+
 	uc.logger.WithContext(ctx).Trace("job found successfully")
 	return job, nil
 }
@@ -10,7 +10,9 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/consensys/orchestrate/pkg/errors"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 )
 
@@ -22,16 +24,18 @@ func TestGetJob_Execute(t *testing.T) {
 
 	mockDB := mocks.NewMockDB(ctrl)
 	mockJobDA := mocks.NewMockJobAgent(ctrl)
+	mockDecodeCallUC := mocks2.NewMockDecodeCallUseCase(ctrl)
 
 	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewGetJobUseCase(mockDB)
+	usecase := NewGetJobUseCase(mockDB, mockDecodeCallUC)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
 
 		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, true).
 			Return(job, nil)
+		mockDecodeCallUC.EXPECT().Execute(gomock.Any(), []*entities.Job{job}, "", "", userInfo).Return(nil)
 		jobResponse, err := usecase.Execute(ctx, job.UUID, userInfo)
 
 		assert.NoError(t, err)
@@ -0,0 +1,81 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const prepareJobComponent = "use-cases.prepare-job"
+
+type prepareJobUseCase struct {
+	db             store.DB
+	defaultStoreID string
+}
+
+func NewPrepareJobUseCase(db store.DB, qkmStoreID string) usecases.PrepareJobUseCase {
+	return &prepareJobUseCase{
+		db:             db,
+		defaultStoreID: qkmStoreID,
+	}
+}
+
+// Execute sets the chain ID, the fee policy of the chain and the key store of the sender account on a job to be inserted
+func (uc *prepareJobUseCase) Execute(ctx context.Context, job *entities.Job, chain *entities.Chain, userInfo *multitenancy.UserInfo) error {
+	job.InternalData.ChainID = chain.ChainID
+	applyFeePolicy(job, chain.FeePolicy)
+
+	if job.Transaction.From != nil && job.Type != entities.EthereumRawTransaction {
+		storeID, err := uc.getAccountStoreID(ctx, job.Transaction.From, userInfo)
+		if err != nil {
+			return errors.FromError(err).ExtendComponent(prepareJobComponent)
+		}
+		job.InternalData.StoreID = storeID
+	}
+
+	return nil
+}
+
+func (uc *prepareJobUseCase) getAccountStoreID(ctx context.Context, address *ethcommon.Address, userInfo *multitenancy.UserInfo) (string, error) {
+	acc, err := uc.db.Account().FindOneByAddress(ctx, address.String(), userInfo.AllowedTenants, userInfo.Username)
+	if errors.IsNotFoundError(err) {
+		return "", errors.InvalidParameterError("failed to get account")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if acc.StoreID == "" {
+		return uc.defaultStoreID, nil
+	}
+
+	return acc.StoreID, nil
+}
+
+// applyFeePolicy sets the fee policy of the chain on jobs which do not define their own
+func applyFeePolicy(job *entities.Job, feePolicy *entities.FeePolicy) {
+	if feePolicy == nil {
+		return
+	}
+
+	if job.InternalData.MaxFee == nil {
+		job.InternalData.MaxFee = feePolicy.MaxFee
+	}
+
+	// Child jobs are the bumps of their parent job
+	if job.InternalData.ParentJobUUID != "" || job.InternalData.RetryInterval != 0 || job.InternalData.GasPriceIncrement != 0 {
+		return
+	}
+
+	job.InternalData.RetryInterval = feePolicy.BumpInterval
+	// Raw transactions cannot be bumped and are always sent again unchanged
+	if feePolicy.ReplaceWithSameNonce && job.Type != entities.EthereumRawTransaction {
+		job.InternalData.GasPriceIncrement = feePolicy.BumpPercentage
+		job.InternalData.GasPriceLimit = feePolicy.BumpPercentage * float64(feePolicy.MaxBumps)
+	}
+}
@@ -0,0 +1,82 @@
+// +build unit
+
+package jobs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareJob_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockAccountDA := mocks.NewMockAccountAgent(ctrl)
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+
+	qkmStoreID := "qkm-store-id"
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewPrepareJobUseCase(mockDB, qkmStoreID)
+	chain := testdata.FakeChain()
+
+	t.Run("should set the chain ID and the key store of the account successfully", func(t *testing.T) {
+		job := testdata.FakeJob()
+		account := testdata.FakeAccount()
+		account.StoreID = "account-store-id"
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), job.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(account, nil)
+
+		err := usecase.Execute(context.Background(), job, chain, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, chain.ChainID, job.InternalData.ChainID)
+		assert.Equal(t, "account-store-id", job.InternalData.StoreID)
+	})
+
+	t.Run("should use the default key store if the account does not define one", func(t *testing.T) {
+		job := testdata.FakeJob()
+		account := testdata.FakeAccount()
+		account.StoreID = ""
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), job.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(account, nil)
+
+		err := usecase.Execute(context.Background(), job, chain, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, qkmStoreID, job.InternalData.StoreID)
+	})
+
+	t.Run("should not look up the account of raw transactions", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Type = entities.EthereumRawTransaction
+		job.InternalData.StoreID = ""
+
+		err := usecase.Execute(context.Background(), job, chain, userInfo)
+
+		require.NoError(t, err)
+		assert.Empty(t, job.InternalData.StoreID)
+	})
+
+	t.Run("should fail with InvalidParameterError if account does not exist", func(t *testing.T) {
+		job := testdata.FakeJob()
+
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), job.Transaction.From.String(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		err := usecase.Execute(context.Background(), job, chain, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
@@ -14,20 +13,20 @@ import (
 const resendJobTxComponent = "use-cases.resend-job-tx"
 
 type resendJobTxUseCase struct {
-	db                store.DB
-	txSenderMessenger sdk.MessengerTxSender
-	logger            *log.Logger
+	db              store.DB
+	outboxMessenger usecases.OutboxMessenger
+	logger          *log.Logger
 }
 
-func NewResendJobTxUseCase(db store.DB, txSenderMessenger sdk.MessengerTxSender) usecases.ResendJobTxUseCase {
+func NewResendJobTxUseCase(db store.DB, outboxMessenger usecases.OutboxMessenger) usecases.ResendJobTxUseCase {
 	return &resendJobTxUseCase{
-		db:                db,
-		txSenderMessenger: txSenderMessenger,
-		logger:            log.NewLogger().SetComponent(resendJobTxComponent),
+		db:              db,
+		outboxMessenger: outboxMessenger,
+		logger:          log.NewLogger().SetComponent(resendJobTxComponent),
 	}
 }
 
-// Execute sends a job to the Kafka topic
+// Execute sends a job to the tx-sender through the outbox
 func (uc *resendJobTxUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
 	ctx = log.WithFields(ctx, log.Field("job", jobUUID))
 	logger := uc.logger.WithContext(ctx)
@@ -39,7 +38,7 @@ func (uc *resendJobTxUseCase) Execute(ctx context.Context, jobUUID string, userI
 	}
 
 	job.InternalData.ParentJobUUID = jobUUID
-	err = uc.txSenderMessenger.StartedJobMessage(ctx, job, userInfo)
+	err = uc.outboxMessenger.WithDB(ctx, uc.db).StartedJobMessage(ctx, job, userInfo)
 	if err != nil {
 		logger.WithError(err).Error("failed to send resend job envelope")
 		return err
@@ -11,6 +11,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
@@ -24,12 +25,14 @@ func TestResendJobTx_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	jobDA := mocks.NewMockJobAgent(ctrl)
-	messenger := mock.NewMockMessengerTxSender(ctrl)
+	messenger := mock.NewMockOrchestrateMessenger(ctrl)
 	db := mocks.NewMockDB(ctrl)
 	db.EXPECT().Job().Return(jobDA).AnyTimes()
+	outboxMessenger := mocks2.NewMockOutboxMessenger(ctrl)
+	outboxMessenger.EXPECT().WithDB(gomock.Any(), db).Return(messenger).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewResendJobTxUseCase(db, messenger)
+	usecase := NewResendJobTxUseCase(db, outboxMessenger)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
@@ -59,7 +62,7 @@ func TestResendJobTx_Execute(t *testing.T) {
 		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(resendJobTxComponent), err)
 	})
 
-	t.Run("should fail with KafkaConnectionError if Produce fails", func(t *testing.T) {
+	t.Run("should fail with same error if the job cannot be written to the outbox", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 		job := testdata.FakeJob()
 		job.Status = entities.StatusPending
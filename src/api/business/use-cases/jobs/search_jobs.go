@@ -16,15 +16,17 @@ const searchJobsComponent = "use-cases.search-jobs"
 
 // searchJobsUseCase is a use case to search jobs
 type searchJobsUseCase struct {
-	db     store.DB
-	logger *log.Logger
+	db           store.DB
+	decodeCallUC usecases.DecodeCallUseCase
+	logger       *log.Logger
 }
 
 // NewSearchJobsUseCase creates a new SearchJobsUseCase
-func NewSearchJobsUseCase(db store.DB) usecases.SearchJobsUseCase {
+func NewSearchJobsUseCase(db store.DB, decodeCallUC usecases.DecodeCallUseCase) usecases.SearchJobsUseCase {
 	return &searchJobsUseCase{
-		db:     db,
-		logger: log.NewLogger().SetComponent(searchJobsComponent),
+		db:           db,
+		decodeCallUC: decodeCallUC,
+		logger:       log.NewLogger().SetComponent(searchJobsComponent),
 	}
 }
 
@@ -35,6 +37,11 @@ func (uc *searchJobsUseCase) Execute(ctx context.Context, filters *entities.JobF
 		return nil, errors.FromError(err).ExtendComponent(searchJobsComponent)
 	}
 
+	err = uc.decodeCallUC.Execute(ctx, jobs, "", "", userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchJobsComponent)
+	}
+
 	uc.logger.Trace("jobs found successfully")
 	return jobs, nil
 }
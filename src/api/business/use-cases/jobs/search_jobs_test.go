@@ -11,6 +11,7 @@ import (
 	"github.com/gofrs/uuid"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/ethereum/go-ethereum/common"
@@ -27,10 +28,11 @@ func TestSearchJobs_Execute(t *testing.T) {
 	mockDB := mocks.NewMockDB(ctrl)
 	mockJobDA := mocks.NewMockJobAgent(ctrl)
 
+	mockDecodeCallUC := mocks2.NewMockDecodeCallUseCase(ctrl)
 	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewSearchJobsUseCase(mockDB)
+	usecase := NewSearchJobsUseCase(mockDB, mockDecodeCallUC)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		txHash := common.HexToHash("0x1")
@@ -42,6 +44,7 @@ func TestSearchJobs_Execute(t *testing.T) {
 		}
 
 		mockJobDA.EXPECT().Search(gomock.Any(), filters, userInfo.AllowedTenants, userInfo.Username).Return(jobs, nil)
+		mockDecodeCallUC.EXPECT().Execute(gomock.Any(), jobs, "", "", userInfo).Return(nil)
 
 		jobResponse, err := usecase.Execute(ctx, filters, userInfo)
 
@@ -5,9 +5,9 @@ import (
 	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/metrics"
 	"github.com/consensys/orchestrate/src/api/store"
@@ -18,28 +18,31 @@ const startJobComponent = "use-cases.start-job"
 
 // startJobUseCase is a use case to start a transaction job
 type startJobUseCase struct {
-	db                store.DB
-	txSenderMessenger sdk.MessengerTxSender
-	metrics           metrics.TransactionSchedulerMetrics
-	logger            *log.Logger
+	db              store.DB
+	outboxMessenger usecases.OutboxMessenger
+	metrics         metrics.TransactionSchedulerMetrics
+	logger          *log.Logger
 }
 
 // NewStartJobUseCase creates a new StartJobUseCase
 func NewStartJobUseCase(
 	db store.DB,
-	txSenderMessenger sdk.MessengerTxSender,
+	outboxMessenger usecases.OutboxMessenger,
 	m metrics.TransactionSchedulerMetrics,
 ) usecases.StartJobUseCase {
 	return &startJobUseCase{
-		db:                db,
-		txSenderMessenger: txSenderMessenger,
-		metrics:           m,
-		logger:            log.NewLogger().SetComponent(startJobComponent),
+		db:              db,
+		outboxMessenger: outboxMessenger,
+		metrics:         m,
+		logger:          log.NewLogger().SetComponent(startJobComponent),
 	}
 }
 
-// Execute sends a job to the Kafka topic
-func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
+// Execute marks a job as started and sends it to the tx-sender through the outbox
+func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (err error) {
+	ctx, span := tracing.StartSpan(ctx, startJobComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	logger := uc.logger.WithContext(ctx).WithField("job", jobUUID)
 	logger.Debug("starting job")
 
@@ -57,23 +60,36 @@ func (uc *startJobUseCase) Execute(ctx context.Context, jobUUID string, userInfo
 		Status: entities.StatusStarted,
 	}
 
-	err = uc.db.Job().Update(ctx, nextJob, jobLog)
-	if err != nil {
-		return err
-	}
+	err = uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		err := dbtx.Job().Update(ctx, nextJob, jobLog)
+		if err != nil {
+			return err
+		}
 
-	uc.addMetrics(time.Since(prevJobUpdateAt), curJob.Status, jobLog.Status, curJob.ChainUUID)
+		err = uc.outboxMessenger.WithDB(ctx, dbtx).StartedJobMessage(ctx, curJob, userInfo)
+		if err != nil {
+			logger.WithError(err).Error("failed to send start job")
+			return err
+		}
 
-	err = uc.txSenderMessenger.StartedJobMessage(ctx, curJob, userInfo)
+		return nil
+	})
 	if err != nil {
-		logger.WithError(err).Error("failed to send start job")
 		return err
 	}
 
+	uc.addMetrics(time.Since(prevJobUpdateAt), curJob.Status, jobLog.Status, curJob.ChainUUID)
+
 	logger.Info("job started successfully")
 	return nil
 }
 
+func (uc *startJobUseCase) WithDB(db store.DB) usecases.StartJobUseCase {
+	dbUC := *uc
+	dbUC.db = db
+	return &dbUC
+}
+
 func (uc *startJobUseCase) addMetrics(elapseTime time.Duration, previousStatus, nextStatus entities.JobStatus, chainUUID string) {
 	baseLabels := []string{
 		"chain_uuid", chainUUID,
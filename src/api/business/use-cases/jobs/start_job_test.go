@@ -11,7 +11,9 @@ import (
 	mock3 "github.com/consensys/orchestrate/pkg/sdk/mock"
 	mock2 "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	"github.com/consensys/orchestrate/src/api/metrics/mock"
+	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/mocks"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
@@ -25,7 +27,9 @@ func TestStartJob_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	jobDA := mocks.NewMockJobAgent(ctrl)
-	messenger := mock3.NewMockMessengerTxSender(ctrl)
+	messenger := mock3.NewMockOrchestrateMessenger(ctrl)
+	outboxMessenger := mocks2.NewMockOutboxMessenger(ctrl)
+	outboxMessenger.EXPECT().WithDB(gomock.Any(), gomock.Any()).Return(messenger).AnyTimes()
 	metrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
 
 	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
@@ -35,9 +39,13 @@ func TestStartJob_Execute(t *testing.T) {
 
 	db := mocks.NewMockDB(ctrl)
 	db.EXPECT().Job().Return(jobDA).AnyTimes()
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persistFunc func(dbtx store.DB) error) error {
+			return persistFunc(db)
+		}).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewStartJobUseCase(db, messenger, metrics)
+	usecase := NewStartJobUseCase(db, outboxMessenger, metrics)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
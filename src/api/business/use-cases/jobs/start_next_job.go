@@ -65,6 +65,13 @@ func (uc *startNextJobUseCase) Execute(ctx context.Context, jobUUID string, user
 	return uc.startJobUseCase.Execute(ctx, nextJob.UUID, userInfo)
 }
 
+func (uc *startNextJobUseCase) WithDB(db store.DB) usecases.StartNextJobUseCase {
+	dbUC := *uc
+	dbUC.db = db
+	dbUC.startJobUseCase = uc.startJobUseCase.WithDB(db)
+	return &dbUC
+}
+
 func (uc *startNextJobUseCase) handleEEAMarkingTx(ctx context.Context, prevJob, job *entities.Job) error {
 	if prevJob.Type != entities.EEAPrivateTransaction {
 		return errors.DataError("expected previous job as type: %s", entities.EEAPrivateTransaction)
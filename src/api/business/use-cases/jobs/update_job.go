@@ -6,9 +6,9 @@ import (
 	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/metrics"
 	"github.com/consensys/orchestrate/src/api/store"
@@ -18,12 +18,14 @@ import (
 const updateJobComponent = "use-cases.update-job"
 
 type updateJobUseCase struct {
-	db                  store.DB
-	startNextJobUC      usecases.StartNextJobUseCase
-	notifyUC            usecases.NotifyTransactionUseCase
-	metrics             metrics.TransactionSchedulerMetrics
-	txListenerMessenger sdk.MessengerTxListener
-	logger              *log.Logger
+	db                   store.DB
+	startNextJobUC       usecases.StartNextJobUseCase
+	notifyUC             usecases.NotifyTransactionUseCase
+	updateSafeProposalUC usecases.UpdateSafeProposalExecutionUseCase
+	updateRelaySpendUC   usecases.UpdateRelaySpendingUseCase
+	metrics              metrics.TransactionSchedulerMetrics
+	outboxMessenger      usecases.OutboxMessenger
+	logger               *log.Logger
 }
 
 func NewUpdateJobUseCase(
@@ -31,20 +33,27 @@ func NewUpdateJobUseCase(
 	startNextJobUC usecases.StartNextJobUseCase,
 	m metrics.TransactionSchedulerMetrics,
 	notifyUC usecases.NotifyTransactionUseCase,
-	txListenerMessenger sdk.MessengerTxListener,
+	outboxMessenger usecases.OutboxMessenger,
+	updateSafeProposalUC usecases.UpdateSafeProposalExecutionUseCase,
+	updateRelaySpendUC usecases.UpdateRelaySpendingUseCase,
 ) usecases.UpdateJobUseCase {
 	return &updateJobUseCase{
-		db:                  db,
-		notifyUC:            notifyUC,
-		startNextJobUC:      startNextJobUC,
-		metrics:             m,
-		txListenerMessenger: txListenerMessenger,
-		logger:              log.NewLogger().SetComponent(updateJobComponent),
+		db:                   db,
+		notifyUC:             notifyUC,
+		updateSafeProposalUC: updateSafeProposalUC,
+		updateRelaySpendUC:   updateRelaySpendUC,
+		startNextJobUC:       startNextJobUC,
+		metrics:              m,
+		outboxMessenger:      outboxMessenger,
+		logger:               log.NewLogger().SetComponent(updateJobComponent),
 	}
 }
 
 func (uc *updateJobUseCase) Execute(ctx context.Context, nextJob *entities.Job, nextStatus entities.JobStatus,
-	nextStatusMsg string, userInfo *multitenancy.UserInfo) (*entities.Job, error) {
+	nextStatusMsg string, userInfo *multitenancy.UserInfo) (_ *entities.Job, err error) {
+	ctx, span := tracing.StartSpan(ctx, updateJobComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.WithFields(ctx, log.Field("job", nextJob.UUID), log.Field("next_status", nextStatus))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("updating job")
@@ -69,47 +78,41 @@ func (uc *updateJobUseCase) Execute(ctx context.Context, nextJob *entities.Job,
 		return nil, errors.InvalidStateError(errMessage).ExtendComponent(updateJobComponent)
 	}
 
-	err = uc.updateJob(ctx, nextJob, nextStatus, nextStatusMsg, prevJob.InternalData.ParentJobUUID, userInfo)
-	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(updateJobComponent)
-	}
+	// The follow-ups of the status change are run within the same DB transaction, their messages being sent through the
+	// outbox, so that a job is never left updated without having notified its status or started its next job
+	var job *entities.Job
+	err = uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		der := uc.updateJob(ctx, dbtx, nextJob, nextStatus, nextStatusMsg, prevJob.InternalData.ParentJobUUID, userInfo)
+		if der != nil {
+			return der
+		}
 
-	uc.addJobStatusMetrics(prevJob, nextStatus)
+		job, der = dbtx.Job().FindOneByUUID(ctx, nextJob.UUID, userInfo.AllowedTenants, userInfo.Username, true)
+		if der != nil {
+			return der
+		}
 
-	job, err := uc.db.Job().FindOneByUUID(ctx, nextJob.UUID, userInfo.AllowedTenants, userInfo.Username, true)
+		return uc.runFollowUps(ctx, dbtx, job, nextJob, nextStatus, nextStatusMsg, userInfo)
+	})
 	if err != nil {
+		logger.WithError(err).Error("failed to update job")
 		return nil, errors.FromError(err).ExtendComponent(updateJobComponent)
 	}
 
-	switch nextStatus {
-	case entities.StatusPending:
-		err = uc.txListenerMessenger.PendingJobMessage(ctx, job, userInfo)
-		if err != nil {
-			errMsg := "failed to send pending job to tx-listener"
-			uc.logger.WithError(err).Error(errMsg)
-			return nil, errors.DependencyFailureError(errMsg).ExtendComponent(updateJobComponent)
-		}
-	case entities.StatusMined:
-		job.Receipt = nextJob.Receipt
-		err = uc.notifyUC.Execute(ctx, job, "", userInfo)
-		if err != nil {
-			return nil, errors.FromError(err).ExtendComponent(updateJobComponent)
-		}
+	uc.addJobStatusMetrics(prevJob, nextStatus)
 
-		err = uc.startNextJobUC.Execute(ctx, job.UUID, userInfo)
-	case entities.StatusFailed:
-		err = uc.notifyUC.Execute(ctx, job, nextStatusMsg, userInfo)
-	case entities.StatusStored:
-		err = uc.startNextJobUC.Execute(ctx, job.UUID, userInfo)
-	}
-	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(updateJobComponent)
+	if nextStatus == entities.StatusMined {
+		// Spending tracking must not prevent the next jobs from being started
+		if der := uc.updateRelaySpendUC.Execute(ctx, job); der != nil {
+			logger.WithError(der).Error("failed to update relay spending")
+		}
 	}
 
+	logger.WithField("status", nextStatus).Info("updated job successfully")
 	return job, nil
 }
 
-func (uc *updateJobUseCase) updateJob(ctx context.Context, job *entities.Job, status entities.JobStatus,
+func (uc *updateJobUseCase) updateJob(ctx context.Context, dbtx store.DB, job *entities.Job, status entities.JobStatus,
 	statusMsg, parentJobUUID string, userInfo *multitenancy.UserInfo) error {
 
 	var jobLog *entities.Log
@@ -120,58 +123,128 @@ func (uc *updateJobUseCase) updateJob(ctx context.Context, job *entities.Job, st
 		}
 	}
 
-	err := uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
-		err := dbtx.Job().Update(ctx, job, jobLog)
+	err := dbtx.Job().Update(ctx, job, jobLog)
+	if err != nil {
+		return err
+	}
+
+	// if we updated to MINED, we need to update the children and sibling jobs to NEVER_MINED
+	if status != entities.StatusMined {
+		return nil
+	}
+
+	if parentJobUUID == "" {
+		parentJobUUID = job.UUID
+	}
+
+	siblingJobs, err := dbtx.Job().GetSiblingJobs(ctx, parentJobUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return err
+	}
+
+	for _, siblingJob := range siblingJobs {
+		// Skip mined job which trigger the update of sibling/children
+		if job.UUID == siblingJob.UUID {
+			continue
+		}
+
+		// Skip not pending sibling jobs
+		if siblingJob.Status != entities.StatusPending {
+			continue
+		}
+
+		siblingJob.Status = entities.StatusNeverMined
+		err = dbtx.Job().Update(ctx, siblingJob, &entities.Log{
+			Status:  entities.StatusNeverMined,
+			Message: fmt.Sprintf("sibling (or parent) job %s was mined instead", job.UUID),
+		})
 		if err != nil {
-			return err
+			return errors.FromError(err).ExtendComponent(updateJobComponent)
 		}
+		uc.logger.WithField("job", siblingJob.UUID).
+			WithField("status", entities.StatusNeverMined).
+			Debug("updated job successfully")
+	}
+
+	return nil
+}
 
-		// if we updated to MINED, we need to update the children and sibling jobs to NEVER_MINED
-		if status != entities.StatusMined {
-			return nil
+func (uc *updateJobUseCase) runFollowUps(ctx context.Context, dbtx store.DB, job, nextJob *entities.Job,
+	status entities.JobStatus, statusMsg string, userInfo *multitenancy.UserInfo) error {
+	switch status {
+	case entities.StatusPending:
+		err := uc.outboxMessenger.WithDB(ctx, dbtx).PendingJobMessage(ctx, job, userInfo)
+		if err != nil {
+			errMsg := "failed to send pending job to tx-listener"
+			uc.logger.WithError(err).Error(errMsg)
+			return errors.DependencyFailureError(errMsg).ExtendComponent(updateJobComponent)
+		}
+	case entities.StatusMined:
+		job.Receipt = nextJob.Receipt
+		if job.PrivateReceipt != nil {
+			err := uc.attachPrivateReceipt(ctx, dbtx, job, userInfo)
+			if err != nil {
+				return err
+			}
 		}
 
-		if parentJobUUID == "" {
-			parentJobUUID = job.UUID
+		err := uc.notifyUC.WithDB(dbtx).Execute(ctx, job, "", userInfo)
+		if err != nil {
+			return err
 		}
 
-		siblingJobs, err := dbtx.Job().GetSiblingJobs(ctx, parentJobUUID, userInfo.AllowedTenants, userInfo.Username)
+		err = uc.updateSafeProposalUC.WithDB(dbtx).Execute(ctx, job, userInfo)
 		if err != nil {
 			return err
 		}
 
-		for _, siblingJob := range siblingJobs {
-			// Skip mined job which trigger the update of sibling/children
-			if job.UUID == siblingJob.UUID {
-				continue
-			}
+		return uc.startNextJobUC.WithDB(dbtx).Execute(ctx, job.UUID, userInfo)
+	case entities.StatusFailed:
+		err := uc.notifyUC.WithDB(dbtx).Execute(ctx, job, statusMsg, userInfo)
+		if err != nil {
+			return err
+		}
 
-			// Skip not pending sibling jobs
-			if siblingJob.Status != entities.StatusPending {
-				continue
-			}
+		return uc.updateSafeProposalUC.WithDB(dbtx).Execute(ctx, job, userInfo)
+	case entities.StatusStored:
+		return uc.startNextJobUC.WithDB(dbtx).Execute(ctx, job.UUID, userInfo)
+	}
 
-			siblingJob.Status = entities.StatusNeverMined
-			err = dbtx.Job().Update(ctx, siblingJob, &entities.Log{
-				Status:  entities.StatusNeverMined,
-				Message: fmt.Sprintf("sibling (or parent) job %s was mined instead", job.UUID),
-			})
-			if err != nil {
-				return errors.FromError(err).ExtendComponent(updateJobComponent)
-			}
-			uc.logger.WithField("job", siblingJob.UUID).
-				WithField("status", entities.StatusNeverMined).
-				Debug("updated job successfully")
-		}
+	return nil
+}
+
+// attachPrivateReceipt stores the private receipt of a marking transaction on the private transaction job it marks, for
+// the receipt to be read back with the private job
+func (uc *updateJobUseCase) attachPrivateReceipt(ctx context.Context, dbtx store.DB, markingJob *entities.Job,
+	userInfo *multitenancy.UserInfo) error {
+	var privTxType entities.JobType
+	switch markingJob.Type {
+	case entities.EEAMarkingTransaction:
+		privTxType = entities.EEAPrivateTransaction
+	case entities.GoQuorumMarkingTransaction:
+		privTxType = entities.GoQuorumPrivateTransaction
+	default:
 		return nil
-	})
+	}
 
+	privJobs, err := dbtx.Job().Search(ctx, &entities.JobFilters{
+		ScheduleUUID: markingJob.ScheduleUUID,
+		Types:        []entities.JobType{privTxType},
+	}, userInfo.AllowedTenants, userInfo.Username)
 	if err != nil {
-		uc.logger.WithError(err).Error("failed to update job")
-		return errors.FromError(err).ExtendComponent(updateJobComponent)
+		return err
+	}
+
+	for _, privJob := range privJobs {
+		if privJob.NextJobUUID != markingJob.UUID {
+			continue
+		}
+
+		privJob.PrivateReceipt = markingJob.PrivateReceipt
+		return dbtx.Job().Update(ctx, privJob, nil)
 	}
 
-	uc.logger.WithField("job", job.UUID).WithField("status", status).Info("updated job successfully")
+	uc.logger.WithField("job", markingJob.UUID).Warn("no private transaction job found for marking job")
 	return nil
 }
 
@@ -6,9 +6,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	mock3 "github.com/consensys/orchestrate/pkg/sdk/mock"
 	mock2 "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	testdata2 "github.com/consensys/orchestrate/pkg/types/ethereum/testdata"
 	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	"github.com/consensys/orchestrate/src/api/metrics/mock"
 	"github.com/consensys/orchestrate/src/api/store"
@@ -29,8 +31,15 @@ func TestUpdateJob_Execute(t *testing.T) {
 	startNextJobUC := mocks2.NewMockStartNextJobUseCase(ctrl)
 	metrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
 	notifyTxUC := mocks2.NewMockNotifyTransactionUseCase(ctrl)
+	updateSafeProposalUC := mocks2.NewMockUpdateSafeProposalExecutionUseCase(ctrl)
+	updateRelaySpendingUC := mocks2.NewMockUpdateRelaySpendingUseCase(ctrl)
+	startNextJobUC.EXPECT().WithDB(gomock.Any()).Return(startNextJobUC).AnyTimes()
+	notifyTxUC.EXPECT().WithDB(gomock.Any()).Return(notifyTxUC).AnyTimes()
+	updateSafeProposalUC.EXPECT().WithDB(gomock.Any()).Return(updateSafeProposalUC).AnyTimes()
 
-	messengerTxListener := mock3.NewMockMessengerTxListener(ctrl)
+	messengerTxListener := mock3.NewMockOrchestrateMessenger(ctrl)
+	outboxMessenger := mocks2.NewMockOutboxMessenger(ctrl)
+	outboxMessenger.EXPECT().WithDB(gomock.Any(), gomock.Any()).Return(messengerTxListener).AnyTimes()
 
 	jobsLatencyHistogram := mock2.NewMockHistogram(ctrl)
 	jobsLatencyHistogram.EXPECT().With(gomock.Any()).AnyTimes().Return(jobsLatencyHistogram)
@@ -50,7 +59,8 @@ func TestUpdateJob_Execute(t *testing.T) {
 	mockDB.EXPECT().Chain().Return(chainDA).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewUpdateJobUseCase(mockDB, startNextJobUC, metrics, notifyTxUC, messengerTxListener)
+	usecase := NewUpdateJobUseCase(mockDB, startNextJobUC, metrics, notifyTxUC, outboxMessenger, updateSafeProposalUC,
+		updateRelaySpendingUC)
 
 	ctx := context.Background()
 
@@ -115,6 +125,8 @@ func TestUpdateJob_Execute(t *testing.T) {
 			})
 
 		notifyTxUC.EXPECT().Execute(gomock.Any(), curJob, "", userInfo).Return(nil)
+		updateSafeProposalUC.EXPECT().Execute(gomock.Any(), curJob, userInfo).Return(nil)
+		updateRelaySpendingUC.EXPECT().Execute(gomock.Any(), curJob).Return(nil)
 
 		startNextJobUC.EXPECT().Execute(gomock.Any(), curJob.UUID, userInfo).Return(nil)
 
@@ -125,6 +137,47 @@ func TestUpdateJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should store the private receipt on the private job of a MINED marking job", func(t *testing.T) {
+		curJob := testdata.FakeJob()
+		curJob.Type = entities.GoQuorumMarkingTransaction
+		curJob.Status = entities.StatusPending
+		curJob.PrivateReceipt = testdata2.FakeReceipt()
+		privJob := testdata.FakeJob()
+		privJob.Type = entities.GoQuorumPrivateTransaction
+		privJob.NextJobUUID = curJob.UUID
+		otherPrivJob := testdata.FakeJob()
+		otherPrivJob.Type = entities.GoQuorumPrivateTransaction
+		nextStatus := entities.StatusMined
+
+		jobDA.EXPECT().FindOneByUUID(gomock.Any(), curJob.UUID, userInfo.AllowedTenants, userInfo.Username, gomock.Any()).
+			Times(2).Return(curJob, nil)
+		jobDA.EXPECT().GetSiblingJobs(gomock.Any(), curJob.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.Job{curJob}, nil)
+		jobDA.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		jobDA.EXPECT().Search(gomock.Any(), &entities.JobFilters{
+			ScheduleUUID: curJob.ScheduleUUID,
+			Types:        []entities.JobType{entities.GoQuorumPrivateTransaction},
+		}, userInfo.AllowedTenants, userInfo.Username).Return([]*entities.Job{otherPrivJob, privJob}, nil)
+		jobDA.EXPECT().Update(gomock.Any(), privJob, nil).
+			DoAndReturn(func(ctx context.Context, job *entities.Job, log *entities.Log) error {
+				assert.Equal(t, curJob.PrivateReceipt, job.PrivateReceipt)
+				return nil
+			})
+
+		notifyTxUC.EXPECT().Execute(gomock.Any(), curJob, "", userInfo).Return(nil)
+		updateSafeProposalUC.EXPECT().Execute(gomock.Any(), curJob, userInfo).Return(nil)
+		updateRelaySpendingUC.EXPECT().Execute(gomock.Any(), curJob).Return(nil)
+		startNextJobUC.EXPECT().Execute(gomock.Any(), curJob.UUID, userInfo).Return(nil)
+
+		_, err := usecase.Execute(ctx, &entities.Job{
+			UUID:           curJob.UUID,
+			PrivateReceipt: curJob.PrivateReceipt,
+		}, nextStatus, "", userInfo)
+
+		assert.NoError(t, err)
+		assert.Nil(t, otherPrivJob.PrivateReceipt)
+	})
+
 	t.Run("should execute use case for FAILED status successfully", func(t *testing.T) {
 		curJob := testdata.FakeJob()
 		curJob.Status = entities.StatusPending
@@ -142,6 +195,7 @@ func TestUpdateJob_Execute(t *testing.T) {
 				return nil
 			})
 		notifyTxUC.EXPECT().Execute(gomock.Any(), curJob, statusMsg, userInfo).Return(nil)
+		updateSafeProposalUC.EXPECT().Execute(gomock.Any(), curJob, userInfo).Return(nil)
 
 		_, err := usecase.Execute(ctx, &entities.Job{
 			UUID: curJob.UUID,
@@ -224,3 +278,56 @@ func TestUpdateJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestUpdateJob_ExecuteRollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockDBTX := mocks.NewMockDB(ctrl)
+	jobDA := mocks.NewMockJobAgent(ctrl)
+	txJobDA := mocks.NewMockJobAgent(ctrl)
+	startNextJobUC := mocks2.NewMockStartNextJobUseCase(ctrl)
+	metrics := mock.NewMockTransactionSchedulerMetrics(ctrl)
+	notifyTxUC := mocks2.NewMockNotifyTransactionUseCase(ctrl)
+	updateSafeProposalUC := mocks2.NewMockUpdateSafeProposalExecutionUseCase(ctrl)
+	updateRelaySpendingUC := mocks2.NewMockUpdateRelaySpendingUseCase(ctrl)
+	outboxMessenger := mocks2.NewMockOutboxMessenger(ctrl)
+
+	mockDB.EXPECT().Job().Return(jobDA).AnyTimes()
+	mockDBTX.EXPECT().Job().Return(txJobDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewUpdateJobUseCase(mockDB, startNextJobUC, metrics, notifyTxUC, outboxMessenger, updateSafeProposalUC,
+		updateRelaySpendingUC)
+
+	ctx := context.Background()
+
+	t.Run("should roll back the status change if the notification fails", func(t *testing.T) {
+		curJob := testdata.FakeJob()
+		curJob.Status = entities.StatusPending
+		expectedErr := errors.PostgresConnectionError("error")
+
+		var txErr error
+		mockDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, persistFunc func(dbtx store.DB) error) error {
+				txErr = persistFunc(mockDBTX)
+				return txErr
+			})
+		jobDA.EXPECT().FindOneByUUID(gomock.Any(), curJob.UUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(curJob, nil)
+		txJobDA.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		txJobDA.EXPECT().GetSiblingJobs(gomock.Any(), curJob.UUID, userInfo.AllowedTenants, userInfo.Username).Return(nil, nil)
+		txJobDA.EXPECT().FindOneByUUID(gomock.Any(), curJob.UUID, userInfo.AllowedTenants, userInfo.Username, true).
+			Return(curJob, nil)
+		notifyTxUC.EXPECT().WithDB(mockDBTX).Return(notifyTxUC)
+		notifyTxUC.EXPECT().Execute(gomock.Any(), curJob, "", userInfo).Return(expectedErr)
+
+		_, err := usecase.Execute(ctx, &entities.Job{
+			UUID: curJob.UUID,
+		}, entities.StatusMined, "tx mined", userInfo)
+
+		assert.Equal(t, expectedErr, txErr)
+		assert.True(t, errors.IsConnectionError(err))
+	})
+}
@@ -94,6 +94,20 @@ func (mr *MockAccountUseCasesMockRecorder) Search() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockAccountUseCases)(nil).Search))
 }
 
+// Rotate mocks base method
+func (m *MockAccountUseCases) Rotate() usecases.RotateAccountUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate")
+	ret0, _ := ret[0].(usecases.RotateAccountUseCase)
+	return ret0
+}
+
+// Rotate indicates an expected call of Rotate
+func (mr *MockAccountUseCasesMockRecorder) Rotate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockAccountUseCases)(nil).Rotate))
+}
+
 // MockGetAccountUseCase is a mock of GetAccountUseCase interface
 type MockGetAccountUseCase struct {
 	ctrl     *gomock.Controller
@@ -246,6 +260,44 @@ func (mr *MockUpdateAccountUseCaseMockRecorder) Execute(ctx, identity, userInfo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateAccountUseCase)(nil).Execute), ctx, identity, userInfo)
 }
 
+// MockRotateAccountUseCase is a mock of RotateAccountUseCase interface
+type MockRotateAccountUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRotateAccountUseCaseMockRecorder
+}
+
+// MockRotateAccountUseCaseMockRecorder is the mock recorder for MockRotateAccountUseCase
+type MockRotateAccountUseCaseMockRecorder struct {
+	mock *MockRotateAccountUseCase
+}
+
+// NewMockRotateAccountUseCase creates a new mock instance
+func NewMockRotateAccountUseCase(ctrl *gomock.Controller) *MockRotateAccountUseCase {
+	mock := &MockRotateAccountUseCase{ctrl: ctrl}
+	mock.recorder = &MockRotateAccountUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRotateAccountUseCase) EXPECT() *MockRotateAccountUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRotateAccountUseCase) Execute(ctx context.Context, address common.Address, newAcc *entities.Account, chainNames []string, userInfo *multitenancy.UserInfo) (*entities.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, address, newAcc, chainNames, userInfo)
+	ret0, _ := ret[0].(*entities.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRotateAccountUseCaseMockRecorder) Execute(ctx, address, newAcc, chainNames, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRotateAccountUseCase)(nil).Execute), ctx, address, newAcc, chainNames, userInfo)
+}
+
 // MockFundAccountUseCase is a mock of FundAccountUseCase interface
 type MockFundAccountUseCase struct {
 	ctrl     *gomock.Controller
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	ethereum "github.com/consensys/orchestrate/pkg/types/ethereum"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	entities "github.com/consensys/orchestrate/src/entities"
@@ -150,6 +151,62 @@ func (mr *MockContractUseCasesMockRecorder) DecodeLog() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeLog", reflect.TypeOf((*MockContractUseCases)(nil).DecodeLog))
 }
 
+// Call mocks base method
+func (m *MockContractUseCases) Call() usecases.CallContractUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call")
+	ret0, _ := ret[0].(usecases.CallContractUseCase)
+	return ret0
+}
+
+// Call indicates an expected call of Call
+func (mr *MockContractUseCasesMockRecorder) Call() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockContractUseCases)(nil).Call))
+}
+
+// Import mocks base method
+func (m *MockContractUseCases) Import() usecases.ImportContractsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import")
+	ret0, _ := ret[0].(usecases.ImportContractsUseCase)
+	return ret0
+}
+
+// Import indicates an expected call of Import
+func (mr *MockContractUseCasesMockRecorder) Import() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockContractUseCases)(nil).Import))
+}
+
+// ResolveProxy mocks base method
+func (m *MockContractUseCases) ResolveProxy() usecases.ResolveProxyContractUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveProxy")
+	ret0, _ := ret[0].(usecases.ResolveProxyContractUseCase)
+	return ret0
+}
+
+// ResolveProxy indicates an expected call of ResolveProxy
+func (mr *MockContractUseCasesMockRecorder) ResolveProxy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveProxy", reflect.TypeOf((*MockContractUseCases)(nil).ResolveProxy))
+}
+
+// DecodeCall mocks base method
+func (m *MockContractUseCases) DecodeCall() usecases.DecodeCallUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecodeCall")
+	ret0, _ := ret[0].(usecases.DecodeCallUseCase)
+	return ret0
+}
+
+// DecodeCall indicates an expected call of DecodeCall
+func (mr *MockContractUseCasesMockRecorder) DecodeCall() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodeCall", reflect.TypeOf((*MockContractUseCases)(nil).DecodeCall))
+}
+
 // MockGetContractsCatalogUseCase is a mock of GetContractsCatalogUseCase interface
 type MockGetContractsCatalogUseCase struct {
 	ctrl     *gomock.Controller
@@ -174,18 +231,18 @@ func (m *MockGetContractsCatalogUseCase) EXPECT() *MockGetContractsCatalogUseCas
 }
 
 // Execute mocks base method
-func (m *MockGetContractsCatalogUseCase) Execute(ctx context.Context) ([]string, error) {
+func (m *MockGetContractsCatalogUseCase) Execute(ctx context.Context, userInfo *multitenancy.UserInfo) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret := m.ctrl.Call(m, "Execute", ctx, userInfo)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractsCatalogUseCaseMockRecorder) Execute(ctx interface{}) *gomock.Call {
+func (mr *MockGetContractsCatalogUseCaseMockRecorder) Execute(ctx, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractsCatalogUseCase)(nil).Execute), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractsCatalogUseCase)(nil).Execute), ctx, userInfo)
 }
 
 // MockGetContractUseCase is a mock of GetContractUseCase interface
@@ -212,18 +269,18 @@ func (m *MockGetContractUseCase) EXPECT() *MockGetContractUseCaseMockRecorder {
 }
 
 // Execute mocks base method
-func (m *MockGetContractUseCase) Execute(ctx context.Context, name, tag string) (*entities.Contract, error) {
+func (m *MockGetContractUseCase) Execute(ctx context.Context, name, tag string, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, name, tag)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, tag, userInfo)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractUseCaseMockRecorder) Execute(ctx, name, tag interface{}) *gomock.Call {
+func (mr *MockGetContractUseCaseMockRecorder) Execute(ctx, name, tag, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractUseCase)(nil).Execute), ctx, name, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractUseCase)(nil).Execute), ctx, name, tag, userInfo)
 }
 
 // MockSearchContractUseCase is a mock of SearchContractUseCase interface
@@ -250,18 +307,18 @@ func (m *MockSearchContractUseCase) EXPECT() *MockSearchContractUseCaseMockRecor
 }
 
 // Execute mocks base method
-func (m *MockSearchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *common.Address) (*entities.Contract, error) {
+func (m *MockSearchContractUseCase) Execute(ctx context.Context, codehash hexutil.Bytes, address *common.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, codehash, address)
+	ret := m.ctrl.Call(m, "Execute", ctx, codehash, address, userInfo)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockSearchContractUseCaseMockRecorder) Execute(ctx, codehash, address interface{}) *gomock.Call {
+func (mr *MockSearchContractUseCaseMockRecorder) Execute(ctx, codehash, address, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchContractUseCase)(nil).Execute), ctx, codehash, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchContractUseCase)(nil).Execute), ctx, codehash, address, userInfo)
 }
 
 // MockGetContractEventsUseCase is a mock of GetContractEventsUseCase interface
@@ -327,18 +384,18 @@ func (m *MockGetContractTagsUseCase) EXPECT() *MockGetContractTagsUseCaseMockRec
 }
 
 // Execute mocks base method
-func (m *MockGetContractTagsUseCase) Execute(ctx context.Context, name string) ([]string, error) {
+func (m *MockGetContractTagsUseCase) Execute(ctx context.Context, name string, userInfo *multitenancy.UserInfo) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, name)
+	ret := m.ctrl.Call(m, "Execute", ctx, name, userInfo)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockGetContractTagsUseCaseMockRecorder) Execute(ctx, name interface{}) *gomock.Call {
+func (mr *MockGetContractTagsUseCaseMockRecorder) Execute(ctx, name, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractTagsUseCase)(nil).Execute), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetContractTagsUseCase)(nil).Execute), ctx, name, userInfo)
 }
 
 // MockRegisterContractUseCase is a mock of RegisterContractUseCase interface
@@ -365,17 +422,17 @@ func (m *MockRegisterContractUseCase) EXPECT() *MockRegisterContractUseCaseMockR
 }
 
 // Execute mocks base method
-func (m *MockRegisterContractUseCase) Execute(ctx context.Context, contract *entities.Contract) error {
+func (m *MockRegisterContractUseCase) Execute(ctx context.Context, contract *entities.Contract, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, contract)
+	ret := m.ctrl.Call(m, "Execute", ctx, contract, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockRegisterContractUseCaseMockRecorder) Execute(ctx, contract interface{}) *gomock.Call {
+func (mr *MockRegisterContractUseCaseMockRecorder) Execute(ctx, contract, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractUseCase)(nil).Execute), ctx, contract)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractUseCase)(nil).Execute), ctx, contract, userInfo)
 }
 
 // MockRegisterContractDeploymentUseCase is a mock of RegisterContractDeploymentUseCase interface
@@ -402,17 +459,17 @@ func (m *MockRegisterContractDeploymentUseCase) EXPECT() *MockRegisterContractDe
 }
 
 // Execute mocks base method
-func (m *MockRegisterContractDeploymentUseCase) Execute(ctx context.Context, chainID string, address common.Address, codeHash hexutil.Bytes) error {
+func (m *MockRegisterContractDeploymentUseCase) Execute(ctx context.Context, chainID string, address common.Address, codeHash hexutil.Bytes, userInfo *multitenancy.UserInfo) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute", ctx, chainID, address, codeHash)
+	ret := m.ctrl.Call(m, "Execute", ctx, chainID, address, codeHash, userInfo)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Execute indicates an expected call of Execute
-func (mr *MockRegisterContractDeploymentUseCaseMockRecorder) Execute(ctx, chainID, address, codeHash interface{}) *gomock.Call {
+func (mr *MockRegisterContractDeploymentUseCaseMockRecorder) Execute(ctx, chainID, address, codeHash, userInfo interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractDeploymentUseCase)(nil).Execute), ctx, chainID, address, codeHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterContractDeploymentUseCase)(nil).Execute), ctx, chainID, address, codeHash, userInfo)
 }
 
 // MockDecodeEventLogUseCase is a mock of DecodeEventLogUseCase interface
@@ -452,3 +509,154 @@ func (mr *MockDecodeEventLogUseCaseMockRecorder) Execute(ctx, chainUUID, eventLo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDecodeEventLogUseCase)(nil).Execute), ctx, chainUUID, eventLog)
 }
+
+// MockCallContractUseCase is a mock of CallContractUseCase interface
+type MockCallContractUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCallContractUseCaseMockRecorder
+}
+
+// MockCallContractUseCaseMockRecorder is the mock recorder for MockCallContractUseCase
+type MockCallContractUseCaseMockRecorder struct {
+	mock *MockCallContractUseCase
+}
+
+// NewMockCallContractUseCase creates a new mock instance
+func NewMockCallContractUseCase(ctrl *gomock.Controller) *MockCallContractUseCase {
+	mock := &MockCallContractUseCase{ctrl: ctrl}
+	mock.recorder = &MockCallContractUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCallContractUseCase) EXPECT() *MockCallContractUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCallContractUseCase) Execute(ctx context.Context, req *entities.ContractCallRequest, userInfo *multitenancy.UserInfo) ([]*entities.ContractCallResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, req, userInfo)
+	ret0, _ := ret[0].([]*entities.ContractCallResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCallContractUseCaseMockRecorder) Execute(ctx, req, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCallContractUseCase)(nil).Execute), ctx, req, userInfo)
+}
+
+// MockImportContractsUseCase is a mock of ImportContractsUseCase interface
+type MockImportContractsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportContractsUseCaseMockRecorder
+}
+
+// MockImportContractsUseCaseMockRecorder is the mock recorder for MockImportContractsUseCase
+type MockImportContractsUseCaseMockRecorder struct {
+	mock *MockImportContractsUseCase
+}
+
+// NewMockImportContractsUseCase creates a new mock instance
+func NewMockImportContractsUseCase(ctrl *gomock.Controller) *MockImportContractsUseCase {
+	mock := &MockImportContractsUseCase{ctrl: ctrl}
+	mock.recorder = &MockImportContractsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockImportContractsUseCase) EXPECT() *MockImportContractsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockImportContractsUseCase) Execute(ctx context.Context, req *entities.ContractImport, userInfo *multitenancy.UserInfo) ([]*entities.Contract, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, req, userInfo)
+	ret0, _ := ret[0].([]*entities.Contract)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockImportContractsUseCaseMockRecorder) Execute(ctx, req, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockImportContractsUseCase)(nil).Execute), ctx, req, userInfo)
+}
+
+// MockResolveProxyContractUseCase is a mock of ResolveProxyContractUseCase interface
+type MockResolveProxyContractUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockResolveProxyContractUseCaseMockRecorder
+}
+
+// MockResolveProxyContractUseCaseMockRecorder is the mock recorder for MockResolveProxyContractUseCase
+type MockResolveProxyContractUseCaseMockRecorder struct {
+	mock *MockResolveProxyContractUseCase
+}
+
+// NewMockResolveProxyContractUseCase creates a new mock instance
+func NewMockResolveProxyContractUseCase(ctrl *gomock.Controller) *MockResolveProxyContractUseCase {
+	mock := &MockResolveProxyContractUseCase{ctrl: ctrl}
+	mock.recorder = &MockResolveProxyContractUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockResolveProxyContractUseCase) EXPECT() *MockResolveProxyContractUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockResolveProxyContractUseCase) Execute(ctx context.Context, chainName string, address common.Address, userInfo *multitenancy.UserInfo) (*entities.Contract, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, chainName, address, userInfo)
+	ret0, _ := ret[0].(*entities.Contract)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockResolveProxyContractUseCaseMockRecorder) Execute(ctx, chainName, address, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResolveProxyContractUseCase)(nil).Execute), ctx, chainName, address, userInfo)
+}
+
+// MockDecodeCallUseCase is a mock of DecodeCallUseCase interface
+type MockDecodeCallUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDecodeCallUseCaseMockRecorder
+}
+
+// MockDecodeCallUseCaseMockRecorder is the mock recorder for MockDecodeCallUseCase
+type MockDecodeCallUseCaseMockRecorder struct {
+	mock *MockDecodeCallUseCase
+}
+
+// NewMockDecodeCallUseCase creates a new mock instance
+func NewMockDecodeCallUseCase(ctrl *gomock.Controller) *MockDecodeCallUseCase {
+	mock := &MockDecodeCallUseCase{ctrl: ctrl}
+	mock.recorder = &MockDecodeCallUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDecodeCallUseCase) EXPECT() *MockDecodeCallUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDecodeCallUseCase) Execute(ctx context.Context, jobs []*entities.Job, contractName, contractTag string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobs, contractName, contractTag, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDecodeCallUseCaseMockRecorder) Execute(ctx, jobs, contractName, contractTag, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDecodeCallUseCase)(nil).Execute), ctx, jobs, contractName, contractTag, userInfo)
+}
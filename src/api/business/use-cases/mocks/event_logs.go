@@ -0,0 +1,193 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: event_logs.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	entities "github.com/consensys/orchestrate/src/entities"
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockEventLogUseCases is a mock of EventLogUseCases interface
+type MockEventLogUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogUseCasesMockRecorder
+}
+
+// MockEventLogUseCasesMockRecorder is the mock recorder for MockEventLogUseCases
+type MockEventLogUseCasesMockRecorder struct {
+	mock *MockEventLogUseCases
+}
+
+// NewMockEventLogUseCases creates a new mock instance
+func NewMockEventLogUseCases(ctrl *gomock.Controller) *MockEventLogUseCases {
+	mock := &MockEventLogUseCases{ctrl: ctrl}
+	mock.recorder = &MockEventLogUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventLogUseCases) EXPECT() *MockEventLogUseCasesMockRecorder {
+	return m.recorder
+}
+
+// Index mocks base method
+func (m *MockEventLogUseCases) Index() usecases.IndexEventLogsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Index")
+	ret0, _ := ret[0].(usecases.IndexEventLogsUseCase)
+	return ret0
+}
+
+// Index indicates an expected call of Index
+func (mr *MockEventLogUseCasesMockRecorder) Index() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Index", reflect.TypeOf((*MockEventLogUseCases)(nil).Index))
+}
+
+// Search mocks base method
+func (m *MockEventLogUseCases) Search() usecases.SearchEventLogsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search")
+	ret0, _ := ret[0].(usecases.SearchEventLogsUseCase)
+	return ret0
+}
+
+// Search indicates an expected call of Search
+func (mr *MockEventLogUseCasesMockRecorder) Search() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockEventLogUseCases)(nil).Search))
+}
+
+// Backfill mocks base method
+func (m *MockEventLogUseCases) Backfill() usecases.BackfillEventLogsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Backfill")
+	ret0, _ := ret[0].(usecases.BackfillEventLogsUseCase)
+	return ret0
+}
+
+// Backfill indicates an expected call of Backfill
+func (mr *MockEventLogUseCasesMockRecorder) Backfill() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backfill", reflect.TypeOf((*MockEventLogUseCases)(nil).Backfill))
+}
+
+// MockIndexEventLogsUseCase is a mock of IndexEventLogsUseCase interface
+type MockIndexEventLogsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIndexEventLogsUseCaseMockRecorder
+}
+
+// MockIndexEventLogsUseCaseMockRecorder is the mock recorder for MockIndexEventLogsUseCase
+type MockIndexEventLogsUseCaseMockRecorder struct {
+	mock *MockIndexEventLogsUseCase
+}
+
+// NewMockIndexEventLogsUseCase creates a new mock instance
+func NewMockIndexEventLogsUseCase(ctrl *gomock.Controller) *MockIndexEventLogsUseCase {
+	mock := &MockIndexEventLogsUseCase{ctrl: ctrl}
+	mock.recorder = &MockIndexEventLogsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIndexEventLogsUseCase) EXPECT() *MockIndexEventLogsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockIndexEventLogsUseCase) Execute(ctx context.Context, chainUUID string, eventLogs []types.Log) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, chainUUID, eventLogs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockIndexEventLogsUseCaseMockRecorder) Execute(ctx, chainUUID, eventLogs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIndexEventLogsUseCase)(nil).Execute), ctx, chainUUID, eventLogs)
+}
+
+// MockSearchEventLogsUseCase is a mock of SearchEventLogsUseCase interface
+type MockSearchEventLogsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchEventLogsUseCaseMockRecorder
+}
+
+// MockSearchEventLogsUseCaseMockRecorder is the mock recorder for MockSearchEventLogsUseCase
+type MockSearchEventLogsUseCaseMockRecorder struct {
+	mock *MockSearchEventLogsUseCase
+}
+
+// NewMockSearchEventLogsUseCase creates a new mock instance
+func NewMockSearchEventLogsUseCase(ctrl *gomock.Controller) *MockSearchEventLogsUseCase {
+	mock := &MockSearchEventLogsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchEventLogsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchEventLogsUseCase) EXPECT() *MockSearchEventLogsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchEventLogsUseCase) Execute(ctx context.Context, chainName string, filters *entities.EventLogFilters, userInfo *multitenancy.UserInfo) ([]*entities.EventLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, chainName, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.EventLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchEventLogsUseCaseMockRecorder) Execute(ctx, chainName, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchEventLogsUseCase)(nil).Execute), ctx, chainName, filters, userInfo)
+}
+
+// MockBackfillEventLogsUseCase is a mock of BackfillEventLogsUseCase interface
+type MockBackfillEventLogsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackfillEventLogsUseCaseMockRecorder
+}
+
+// MockBackfillEventLogsUseCaseMockRecorder is the mock recorder for MockBackfillEventLogsUseCase
+type MockBackfillEventLogsUseCaseMockRecorder struct {
+	mock *MockBackfillEventLogsUseCase
+}
+
+// NewMockBackfillEventLogsUseCase creates a new mock instance
+func NewMockBackfillEventLogsUseCase(ctrl *gomock.Controller) *MockBackfillEventLogsUseCase {
+	mock := &MockBackfillEventLogsUseCase{ctrl: ctrl}
+	mock.recorder = &MockBackfillEventLogsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBackfillEventLogsUseCase) EXPECT() *MockBackfillEventLogsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockBackfillEventLogsUseCase) Execute(ctx context.Context, chainName string, addresses []common.Address, fromBlock, toBlock uint64, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, chainName, addresses, fromBlock, toBlock, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockBackfillEventLogsUseCaseMockRecorder) Execute(ctx, chainName, addresses, fromBlock, toBlock, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBackfillEventLogsUseCase)(nil).Execute), ctx, chainName, addresses, fromBlock, toBlock, userInfo)
+}
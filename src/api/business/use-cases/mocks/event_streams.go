@@ -8,6 +8,7 @@ import (
 	context "context"
 	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	store "github.com/consensys/orchestrate/src/api/store"
 	entities "github.com/consensys/orchestrate/src/entities"
 	common "github.com/ethereum/go-ethereum/common"
 	types "github.com/ethereum/go-ethereum/core/types"
@@ -122,6 +123,20 @@ func (mr *MockEventStreamsUseCasesMockRecorder) NotifyContractEvents() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyContractEvents", reflect.TypeOf((*MockEventStreamsUseCases)(nil).NotifyContractEvents))
 }
 
+// NotifySafeProposal mocks base method
+func (m *MockEventStreamsUseCases) NotifySafeProposal() usecases.NotifySafeProposalUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifySafeProposal")
+	ret0, _ := ret[0].(usecases.NotifySafeProposalUseCase)
+	return ret0
+}
+
+// NotifySafeProposal indicates an expected call of NotifySafeProposal
+func (mr *MockEventStreamsUseCasesMockRecorder) NotifySafeProposal() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifySafeProposal", reflect.TypeOf((*MockEventStreamsUseCases)(nil).NotifySafeProposal))
+}
+
 // Delete mocks base method
 func (m *MockEventStreamsUseCases) Delete() usecases.DeleteEventStreamUseCase {
 	m.ctrl.T.Helper()
@@ -325,6 +340,71 @@ func (mr *MockNotifyTransactionUseCaseMockRecorder) Execute(ctx, job, errStr, us
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockNotifyTransactionUseCase)(nil).Execute), ctx, job, errStr, userInfo)
 }
 
+// WithDB mocks base method
+func (m *MockNotifyTransactionUseCase) WithDB(db store.DB) usecases.NotifyTransactionUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDB", db)
+	ret0, _ := ret[0].(usecases.NotifyTransactionUseCase)
+	return ret0
+}
+
+// WithDB indicates an expected call of WithDB
+func (mr *MockNotifyTransactionUseCaseMockRecorder) WithDB(db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDB", reflect.TypeOf((*MockNotifyTransactionUseCase)(nil).WithDB), db)
+}
+
+// MockNotifySafeProposalUseCase is a mock of NotifySafeProposalUseCase interface
+type MockNotifySafeProposalUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifySafeProposalUseCaseMockRecorder
+}
+
+// MockNotifySafeProposalUseCaseMockRecorder is the mock recorder for MockNotifySafeProposalUseCase
+type MockNotifySafeProposalUseCaseMockRecorder struct {
+	mock *MockNotifySafeProposalUseCase
+}
+
+// NewMockNotifySafeProposalUseCase creates a new mock instance
+func NewMockNotifySafeProposalUseCase(ctrl *gomock.Controller) *MockNotifySafeProposalUseCase {
+	mock := &MockNotifySafeProposalUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotifySafeProposalUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotifySafeProposalUseCase) EXPECT() *MockNotifySafeProposalUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockNotifySafeProposalUseCase) Execute(ctx context.Context, proposal *entities.SafeProposal, notifType entities.NotificationType, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, proposal, notifType, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockNotifySafeProposalUseCaseMockRecorder) Execute(ctx, proposal, notifType, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockNotifySafeProposalUseCase)(nil).Execute), ctx, proposal, notifType, userInfo)
+}
+
+// WithDB mocks base method
+func (m *MockNotifySafeProposalUseCase) WithDB(db store.DB) usecases.NotifySafeProposalUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDB", db)
+	ret0, _ := ret[0].(usecases.NotifySafeProposalUseCase)
+	return ret0
+}
+
+// WithDB indicates an expected call of WithDB
+func (mr *MockNotifySafeProposalUseCaseMockRecorder) WithDB(db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDB", reflect.TypeOf((*MockNotifySafeProposalUseCase)(nil).WithDB), db)
+}
+
 // MockNotifyContractEventsUseCase is a mock of NotifyContractEventsUseCase interface
 type MockNotifyContractEventsUseCase struct {
 	ctrl     *gomock.Controller
@@ -8,6 +8,7 @@ import (
 	context "context"
 	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	store "github.com/consensys/orchestrate/src/api/store"
 	entities "github.com/consensys/orchestrate/src/entities"
 	hexutil "github.com/ethereum/go-ethereum/common/hexutil"
 	gomock "github.com/golang/mock/gomock"
@@ -173,6 +174,43 @@ func (mr *MockCreateJobUseCaseMockRecorder) Execute(ctx, job, userInfo interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateJobUseCase)(nil).Execute), ctx, job, userInfo)
 }
 
+// MockPrepareJobUseCase is a mock of PrepareJobUseCase interface
+type MockPrepareJobUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrepareJobUseCaseMockRecorder
+}
+
+// MockPrepareJobUseCaseMockRecorder is the mock recorder for MockPrepareJobUseCase
+type MockPrepareJobUseCaseMockRecorder struct {
+	mock *MockPrepareJobUseCase
+}
+
+// NewMockPrepareJobUseCase creates a new mock instance
+func NewMockPrepareJobUseCase(ctrl *gomock.Controller) *MockPrepareJobUseCase {
+	mock := &MockPrepareJobUseCase{ctrl: ctrl}
+	mock.recorder = &MockPrepareJobUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPrepareJobUseCase) EXPECT() *MockPrepareJobUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockPrepareJobUseCase) Execute(ctx context.Context, job *entities.Job, chain *entities.Chain, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job, chain, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockPrepareJobUseCaseMockRecorder) Execute(ctx, job, chain, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockPrepareJobUseCase)(nil).Execute), ctx, job, chain, userInfo)
+}
+
 // MockGetJobUseCase is a mock of GetJobUseCase interface
 type MockGetJobUseCase struct {
 	ctrl     *gomock.Controller
@@ -286,6 +324,20 @@ func (mr *MockStartJobUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartJobUseCase)(nil).Execute), ctx, jobUUID, userInfo)
 }
 
+// WithDB mocks base method
+func (m *MockStartJobUseCase) WithDB(db store.DB) usecases.StartJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDB", db)
+	ret0, _ := ret[0].(usecases.StartJobUseCase)
+	return ret0
+}
+
+// WithDB indicates an expected call of WithDB
+func (mr *MockStartJobUseCaseMockRecorder) WithDB(db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDB", reflect.TypeOf((*MockStartJobUseCase)(nil).WithDB), db)
+}
+
 // MockStartNextJobUseCase is a mock of StartNextJobUseCase interface
 type MockStartNextJobUseCase struct {
 	ctrl     *gomock.Controller
@@ -323,6 +375,20 @@ func (mr *MockStartNextJobUseCaseMockRecorder) Execute(ctx, prevJobUUID, userInf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStartNextJobUseCase)(nil).Execute), ctx, prevJobUUID, userInfo)
 }
 
+// WithDB mocks base method
+func (m *MockStartNextJobUseCase) WithDB(db store.DB) usecases.StartNextJobUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDB", db)
+	ret0, _ := ret[0].(usecases.StartNextJobUseCase)
+	return ret0
+}
+
+// WithDB indicates an expected call of WithDB
+func (mr *MockStartNextJobUseCaseMockRecorder) WithDB(db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDB", reflect.TypeOf((*MockStartNextJobUseCase)(nil).WithDB), db)
+}
+
 // MockUpdateJobUseCase is a mock of UpdateJobUseCase interface
 type MockUpdateJobUseCase struct {
 	ctrl     *gomock.Controller
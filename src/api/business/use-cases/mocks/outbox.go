@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: outbox.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	sdk "github.com/consensys/orchestrate/pkg/sdk"
+	store "github.com/consensys/orchestrate/src/api/store"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockOutboxMessenger is a mock of OutboxMessenger interface
+type MockOutboxMessenger struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxMessengerMockRecorder
+}
+
+// MockOutboxMessengerMockRecorder is the mock recorder for MockOutboxMessenger
+type MockOutboxMessengerMockRecorder struct {
+	mock *MockOutboxMessenger
+}
+
+// NewMockOutboxMessenger creates a new mock instance
+func NewMockOutboxMessenger(ctrl *gomock.Controller) *MockOutboxMessenger {
+	mock := &MockOutboxMessenger{ctrl: ctrl}
+	mock.recorder = &MockOutboxMessengerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOutboxMessenger) EXPECT() *MockOutboxMessengerMockRecorder {
+	return m.recorder
+}
+
+// WithDB mocks base method
+func (m *MockOutboxMessenger) WithDB(ctx context.Context, db store.DB) sdk.OrchestrateMessenger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDB", ctx, db)
+	ret0, _ := ret[0].(sdk.OrchestrateMessenger)
+	return ret0
+}
+
+// WithDB indicates an expected call of WithDB
+func (mr *MockOutboxMessengerMockRecorder) WithDB(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDB", reflect.TypeOf((*MockOutboxMessenger)(nil).WithDB), ctx, db)
+}
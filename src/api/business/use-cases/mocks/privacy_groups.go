@@ -0,0 +1,296 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: privacy_groups.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	entities "github.com/consensys/orchestrate/src/entities"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockPrivacyGroupUseCases is a mock of PrivacyGroupUseCases interface
+type MockPrivacyGroupUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyGroupUseCasesMockRecorder
+}
+
+// MockPrivacyGroupUseCasesMockRecorder is the mock recorder for MockPrivacyGroupUseCases
+type MockPrivacyGroupUseCasesMockRecorder struct {
+	mock *MockPrivacyGroupUseCases
+}
+
+// NewMockPrivacyGroupUseCases creates a new mock instance
+func NewMockPrivacyGroupUseCases(ctrl *gomock.Controller) *MockPrivacyGroupUseCases {
+	mock := &MockPrivacyGroupUseCases{ctrl: ctrl}
+	mock.recorder = &MockPrivacyGroupUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPrivacyGroupUseCases) EXPECT() *MockPrivacyGroupUseCasesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockPrivacyGroupUseCases) Create() usecases.CreatePrivacyGroupUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create")
+	ret0, _ := ret[0].(usecases.CreatePrivacyGroupUseCase)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockPrivacyGroupUseCasesMockRecorder) Create() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPrivacyGroupUseCases)(nil).Create))
+}
+
+// Get mocks base method
+func (m *MockPrivacyGroupUseCases) Get() usecases.GetPrivacyGroupUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get")
+	ret0, _ := ret[0].(usecases.GetPrivacyGroupUseCase)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockPrivacyGroupUseCasesMockRecorder) Get() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPrivacyGroupUseCases)(nil).Get))
+}
+
+// Search mocks base method
+func (m *MockPrivacyGroupUseCases) Search() usecases.SearchPrivacyGroupsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search")
+	ret0, _ := ret[0].(usecases.SearchPrivacyGroupsUseCase)
+	return ret0
+}
+
+// Search indicates an expected call of Search
+func (mr *MockPrivacyGroupUseCasesMockRecorder) Search() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockPrivacyGroupUseCases)(nil).Search))
+}
+
+// Delete mocks base method
+func (m *MockPrivacyGroupUseCases) Delete() usecases.DeletePrivacyGroupUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete")
+	ret0, _ := ret[0].(usecases.DeletePrivacyGroupUseCase)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockPrivacyGroupUseCasesMockRecorder) Delete() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPrivacyGroupUseCases)(nil).Delete))
+}
+
+// Find mocks base method
+func (m *MockPrivacyGroupUseCases) Find() usecases.FindPrivacyGroupsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find")
+	ret0, _ := ret[0].(usecases.FindPrivacyGroupsUseCase)
+	return ret0
+}
+
+// Find indicates an expected call of Find
+func (mr *MockPrivacyGroupUseCasesMockRecorder) Find() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockPrivacyGroupUseCases)(nil).Find))
+}
+
+// MockCreatePrivacyGroupUseCase is a mock of CreatePrivacyGroupUseCase interface
+type MockCreatePrivacyGroupUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreatePrivacyGroupUseCaseMockRecorder
+}
+
+// MockCreatePrivacyGroupUseCaseMockRecorder is the mock recorder for MockCreatePrivacyGroupUseCase
+type MockCreatePrivacyGroupUseCaseMockRecorder struct {
+	mock *MockCreatePrivacyGroupUseCase
+}
+
+// NewMockCreatePrivacyGroupUseCase creates a new mock instance
+func NewMockCreatePrivacyGroupUseCase(ctrl *gomock.Controller) *MockCreatePrivacyGroupUseCase {
+	mock := &MockCreatePrivacyGroupUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreatePrivacyGroupUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreatePrivacyGroupUseCase) EXPECT() *MockCreatePrivacyGroupUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreatePrivacyGroupUseCase) Execute(ctx context.Context, group *entities.PrivacyGroup, chainName string, userInfo *multitenancy.UserInfo) (*entities.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, group, chainName, userInfo)
+	ret0, _ := ret[0].(*entities.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreatePrivacyGroupUseCaseMockRecorder) Execute(ctx, group, chainName, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreatePrivacyGroupUseCase)(nil).Execute), ctx, group, chainName, userInfo)
+}
+
+// MockGetPrivacyGroupUseCase is a mock of GetPrivacyGroupUseCase interface
+type MockGetPrivacyGroupUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetPrivacyGroupUseCaseMockRecorder
+}
+
+// MockGetPrivacyGroupUseCaseMockRecorder is the mock recorder for MockGetPrivacyGroupUseCase
+type MockGetPrivacyGroupUseCaseMockRecorder struct {
+	mock *MockGetPrivacyGroupUseCase
+}
+
+// NewMockGetPrivacyGroupUseCase creates a new mock instance
+func NewMockGetPrivacyGroupUseCase(ctrl *gomock.Controller) *MockGetPrivacyGroupUseCase {
+	mock := &MockGetPrivacyGroupUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetPrivacyGroupUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetPrivacyGroupUseCase) EXPECT() *MockGetPrivacyGroupUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetPrivacyGroupUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(*entities.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetPrivacyGroupUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetPrivacyGroupUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockSearchPrivacyGroupsUseCase is a mock of SearchPrivacyGroupsUseCase interface
+type MockSearchPrivacyGroupsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchPrivacyGroupsUseCaseMockRecorder
+}
+
+// MockSearchPrivacyGroupsUseCaseMockRecorder is the mock recorder for MockSearchPrivacyGroupsUseCase
+type MockSearchPrivacyGroupsUseCaseMockRecorder struct {
+	mock *MockSearchPrivacyGroupsUseCase
+}
+
+// NewMockSearchPrivacyGroupsUseCase creates a new mock instance
+func NewMockSearchPrivacyGroupsUseCase(ctrl *gomock.Controller) *MockSearchPrivacyGroupsUseCase {
+	mock := &MockSearchPrivacyGroupsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchPrivacyGroupsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchPrivacyGroupsUseCase) EXPECT() *MockSearchPrivacyGroupsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchPrivacyGroupsUseCase) Execute(ctx context.Context, filters *entities.PrivacyGroupFilters, userInfo *multitenancy.UserInfo) ([]*entities.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchPrivacyGroupsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchPrivacyGroupsUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockDeletePrivacyGroupUseCase is a mock of DeletePrivacyGroupUseCase interface
+type MockDeletePrivacyGroupUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletePrivacyGroupUseCaseMockRecorder
+}
+
+// MockDeletePrivacyGroupUseCaseMockRecorder is the mock recorder for MockDeletePrivacyGroupUseCase
+type MockDeletePrivacyGroupUseCaseMockRecorder struct {
+	mock *MockDeletePrivacyGroupUseCase
+}
+
+// NewMockDeletePrivacyGroupUseCase creates a new mock instance
+func NewMockDeletePrivacyGroupUseCase(ctrl *gomock.Controller) *MockDeletePrivacyGroupUseCase {
+	mock := &MockDeletePrivacyGroupUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeletePrivacyGroupUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeletePrivacyGroupUseCase) EXPECT() *MockDeletePrivacyGroupUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeletePrivacyGroupUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeletePrivacyGroupUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeletePrivacyGroupUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockFindPrivacyGroupsUseCase is a mock of FindPrivacyGroupsUseCase interface
+type MockFindPrivacyGroupsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFindPrivacyGroupsUseCaseMockRecorder
+}
+
+// MockFindPrivacyGroupsUseCaseMockRecorder is the mock recorder for MockFindPrivacyGroupsUseCase
+type MockFindPrivacyGroupsUseCaseMockRecorder struct {
+	mock *MockFindPrivacyGroupsUseCase
+}
+
+// NewMockFindPrivacyGroupsUseCase creates a new mock instance
+func NewMockFindPrivacyGroupsUseCase(ctrl *gomock.Controller) *MockFindPrivacyGroupsUseCase {
+	mock := &MockFindPrivacyGroupsUseCase{ctrl: ctrl}
+	mock.recorder = &MockFindPrivacyGroupsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFindPrivacyGroupsUseCase) EXPECT() *MockFindPrivacyGroupsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockFindPrivacyGroupsUseCase) Execute(ctx context.Context, chainName string, members []string, userInfo *multitenancy.UserInfo) ([]*entities.OnChainPrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, chainName, members, userInfo)
+	ret0, _ := ret[0].([]*entities.OnChainPrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockFindPrivacyGroupsUseCaseMockRecorder) Execute(ctx, chainName, members, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFindPrivacyGroupsUseCase)(nil).Execute), ctx, chainName, members, userInfo)
+}
@@ -0,0 +1,385 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: relayers.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	entities "github.com/consensys/orchestrate/src/entities"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockRelayerUseCases is a mock of RelayerUseCases interface
+type MockRelayerUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerUseCasesMockRecorder
+}
+
+// MockRelayerUseCasesMockRecorder is the mock recorder for MockRelayerUseCases
+type MockRelayerUseCasesMockRecorder struct {
+	mock *MockRelayerUseCases
+}
+
+// NewMockRelayerUseCases creates a new mock instance
+func NewMockRelayerUseCases(ctrl *gomock.Controller) *MockRelayerUseCases {
+	mock := &MockRelayerUseCases{ctrl: ctrl}
+	mock.recorder = &MockRelayerUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRelayerUseCases) EXPECT() *MockRelayerUseCasesMockRecorder {
+	return m.recorder
+}
+
+// Register mocks base method
+func (m *MockRelayerUseCases) Register() usecases.RegisterRelayerUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register")
+	ret0, _ := ret[0].(usecases.RegisterRelayerUseCase)
+	return ret0
+}
+
+// Register indicates an expected call of Register
+func (mr *MockRelayerUseCasesMockRecorder) Register() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockRelayerUseCases)(nil).Register))
+}
+
+// Get mocks base method
+func (m *MockRelayerUseCases) Get() usecases.GetRelayerUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get")
+	ret0, _ := ret[0].(usecases.GetRelayerUseCase)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockRelayerUseCasesMockRecorder) Get() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRelayerUseCases)(nil).Get))
+}
+
+// Search mocks base method
+func (m *MockRelayerUseCases) Search() usecases.SearchRelayersUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search")
+	ret0, _ := ret[0].(usecases.SearchRelayersUseCase)
+	return ret0
+}
+
+// Search indicates an expected call of Search
+func (mr *MockRelayerUseCasesMockRecorder) Search() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRelayerUseCases)(nil).Search))
+}
+
+// Delete mocks base method
+func (m *MockRelayerUseCases) Delete() usecases.DeleteRelayerUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete")
+	ret0, _ := ret[0].(usecases.DeleteRelayerUseCase)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRelayerUseCasesMockRecorder) Delete() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRelayerUseCases)(nil).Delete))
+}
+
+// Relay mocks base method
+func (m *MockRelayerUseCases) Relay() usecases.RelayTxUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relay")
+	ret0, _ := ret[0].(usecases.RelayTxUseCase)
+	return ret0
+}
+
+// Relay indicates an expected call of Relay
+func (mr *MockRelayerUseCasesMockRecorder) Relay() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockRelayerUseCases)(nil).Relay))
+}
+
+// SearchSpendings mocks base method
+func (m *MockRelayerUseCases) SearchSpendings() usecases.SearchRelaySpendingsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSpendings")
+	ret0, _ := ret[0].(usecases.SearchRelaySpendingsUseCase)
+	return ret0
+}
+
+// SearchSpendings indicates an expected call of SearchSpendings
+func (mr *MockRelayerUseCasesMockRecorder) SearchSpendings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSpendings", reflect.TypeOf((*MockRelayerUseCases)(nil).SearchSpendings))
+}
+
+// MockRegisterRelayerUseCase is a mock of RegisterRelayerUseCase interface
+type MockRegisterRelayerUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegisterRelayerUseCaseMockRecorder
+}
+
+// MockRegisterRelayerUseCaseMockRecorder is the mock recorder for MockRegisterRelayerUseCase
+type MockRegisterRelayerUseCaseMockRecorder struct {
+	mock *MockRegisterRelayerUseCase
+}
+
+// NewMockRegisterRelayerUseCase creates a new mock instance
+func NewMockRegisterRelayerUseCase(ctrl *gomock.Controller) *MockRegisterRelayerUseCase {
+	mock := &MockRegisterRelayerUseCase{ctrl: ctrl}
+	mock.recorder = &MockRegisterRelayerUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRegisterRelayerUseCase) EXPECT() *MockRegisterRelayerUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRegisterRelayerUseCase) Execute(ctx context.Context, relayer *entities.Relayer, chainName string, userInfo *multitenancy.UserInfo) (*entities.Relayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, relayer, chainName, userInfo)
+	ret0, _ := ret[0].(*entities.Relayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRegisterRelayerUseCaseMockRecorder) Execute(ctx, relayer, chainName, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterRelayerUseCase)(nil).Execute), ctx, relayer, chainName, userInfo)
+}
+
+// MockGetRelayerUseCase is a mock of GetRelayerUseCase interface
+type MockGetRelayerUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetRelayerUseCaseMockRecorder
+}
+
+// MockGetRelayerUseCaseMockRecorder is the mock recorder for MockGetRelayerUseCase
+type MockGetRelayerUseCaseMockRecorder struct {
+	mock *MockGetRelayerUseCase
+}
+
+// NewMockGetRelayerUseCase creates a new mock instance
+func NewMockGetRelayerUseCase(ctrl *gomock.Controller) *MockGetRelayerUseCase {
+	mock := &MockGetRelayerUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetRelayerUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetRelayerUseCase) EXPECT() *MockGetRelayerUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetRelayerUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Relayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(*entities.Relayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetRelayerUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetRelayerUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockSearchRelayersUseCase is a mock of SearchRelayersUseCase interface
+type MockSearchRelayersUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchRelayersUseCaseMockRecorder
+}
+
+// MockSearchRelayersUseCaseMockRecorder is the mock recorder for MockSearchRelayersUseCase
+type MockSearchRelayersUseCaseMockRecorder struct {
+	mock *MockSearchRelayersUseCase
+}
+
+// NewMockSearchRelayersUseCase creates a new mock instance
+func NewMockSearchRelayersUseCase(ctrl *gomock.Controller) *MockSearchRelayersUseCase {
+	mock := &MockSearchRelayersUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchRelayersUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchRelayersUseCase) EXPECT() *MockSearchRelayersUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchRelayersUseCase) Execute(ctx context.Context, filters *entities.RelayerFilters, userInfo *multitenancy.UserInfo) ([]*entities.Relayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.Relayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchRelayersUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchRelayersUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockDeleteRelayerUseCase is a mock of DeleteRelayerUseCase interface
+type MockDeleteRelayerUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteRelayerUseCaseMockRecorder
+}
+
+// MockDeleteRelayerUseCaseMockRecorder is the mock recorder for MockDeleteRelayerUseCase
+type MockDeleteRelayerUseCaseMockRecorder struct {
+	mock *MockDeleteRelayerUseCase
+}
+
+// NewMockDeleteRelayerUseCase creates a new mock instance
+func NewMockDeleteRelayerUseCase(ctrl *gomock.Controller) *MockDeleteRelayerUseCase {
+	mock := &MockDeleteRelayerUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteRelayerUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteRelayerUseCase) EXPECT() *MockDeleteRelayerUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteRelayerUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteRelayerUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteRelayerUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockRelayTxUseCase is a mock of RelayTxUseCase interface
+type MockRelayTxUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayTxUseCaseMockRecorder
+}
+
+// MockRelayTxUseCaseMockRecorder is the mock recorder for MockRelayTxUseCase
+type MockRelayTxUseCaseMockRecorder struct {
+	mock *MockRelayTxUseCase
+}
+
+// NewMockRelayTxUseCase creates a new mock instance
+func NewMockRelayTxUseCase(ctrl *gomock.Controller) *MockRelayTxUseCase {
+	mock := &MockRelayTxUseCase{ctrl: ctrl}
+	mock.recorder = &MockRelayTxUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRelayTxUseCase) EXPECT() *MockRelayTxUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockRelayTxUseCase) Execute(ctx context.Context, txRequest *entities.TxRequest, metaTx *entities.MetaTransaction, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, txRequest, metaTx, userInfo)
+	ret0, _ := ret[0].(*entities.TxRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockRelayTxUseCaseMockRecorder) Execute(ctx, txRequest, metaTx, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRelayTxUseCase)(nil).Execute), ctx, txRequest, metaTx, userInfo)
+}
+
+// MockSearchRelaySpendingsUseCase is a mock of SearchRelaySpendingsUseCase interface
+type MockSearchRelaySpendingsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchRelaySpendingsUseCaseMockRecorder
+}
+
+// MockSearchRelaySpendingsUseCaseMockRecorder is the mock recorder for MockSearchRelaySpendingsUseCase
+type MockSearchRelaySpendingsUseCaseMockRecorder struct {
+	mock *MockSearchRelaySpendingsUseCase
+}
+
+// NewMockSearchRelaySpendingsUseCase creates a new mock instance
+func NewMockSearchRelaySpendingsUseCase(ctrl *gomock.Controller) *MockSearchRelaySpendingsUseCase {
+	mock := &MockSearchRelaySpendingsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchRelaySpendingsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchRelaySpendingsUseCase) EXPECT() *MockSearchRelaySpendingsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchRelaySpendingsUseCase) Execute(ctx context.Context, filters *entities.RelaySpendingFilters, userInfo *multitenancy.UserInfo) ([]*entities.RelaySpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.RelaySpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchRelaySpendingsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchRelaySpendingsUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockUpdateRelaySpendingUseCase is a mock of UpdateRelaySpendingUseCase interface
+type MockUpdateRelaySpendingUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRelaySpendingUseCaseMockRecorder
+}
+
+// MockUpdateRelaySpendingUseCaseMockRecorder is the mock recorder for MockUpdateRelaySpendingUseCase
+type MockUpdateRelaySpendingUseCaseMockRecorder struct {
+	mock *MockUpdateRelaySpendingUseCase
+}
+
+// NewMockUpdateRelaySpendingUseCase creates a new mock instance
+func NewMockUpdateRelaySpendingUseCase(ctrl *gomock.Controller) *MockUpdateRelaySpendingUseCase {
+	mock := &MockUpdateRelaySpendingUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateRelaySpendingUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUpdateRelaySpendingUseCase) EXPECT() *MockUpdateRelaySpendingUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockUpdateRelaySpendingUseCase) Execute(ctx context.Context, job *entities.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockUpdateRelaySpendingUseCaseMockRecorder) Execute(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateRelaySpendingUseCase)(nil).Execute), ctx, job)
+}
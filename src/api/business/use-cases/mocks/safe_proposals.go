@@ -0,0 +1,297 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: safe_proposals.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	store "github.com/consensys/orchestrate/src/api/store"
+	entities "github.com/consensys/orchestrate/src/entities"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockSafeProposalUseCases is a mock of SafeProposalUseCases interface
+type MockSafeProposalUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockSafeProposalUseCasesMockRecorder
+}
+
+// MockSafeProposalUseCasesMockRecorder is the mock recorder for MockSafeProposalUseCases
+type MockSafeProposalUseCasesMockRecorder struct {
+	mock *MockSafeProposalUseCases
+}
+
+// NewMockSafeProposalUseCases creates a new mock instance
+func NewMockSafeProposalUseCases(ctrl *gomock.Controller) *MockSafeProposalUseCases {
+	mock := &MockSafeProposalUseCases{ctrl: ctrl}
+	mock.recorder = &MockSafeProposalUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSafeProposalUseCases) EXPECT() *MockSafeProposalUseCasesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockSafeProposalUseCases) Create() usecases.CreateSafeProposalUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create")
+	ret0, _ := ret[0].(usecases.CreateSafeProposalUseCase)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockSafeProposalUseCasesMockRecorder) Create() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSafeProposalUseCases)(nil).Create))
+}
+
+// Get mocks base method
+func (m *MockSafeProposalUseCases) Get() usecases.GetSafeProposalUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get")
+	ret0, _ := ret[0].(usecases.GetSafeProposalUseCase)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockSafeProposalUseCasesMockRecorder) Get() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSafeProposalUseCases)(nil).Get))
+}
+
+// Search mocks base method
+func (m *MockSafeProposalUseCases) Search() usecases.SearchSafeProposalsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search")
+	ret0, _ := ret[0].(usecases.SearchSafeProposalsUseCase)
+	return ret0
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSafeProposalUseCasesMockRecorder) Search() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSafeProposalUseCases)(nil).Search))
+}
+
+// Sign mocks base method
+func (m *MockSafeProposalUseCases) Sign() usecases.SignSafeProposalUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign")
+	ret0, _ := ret[0].(usecases.SignSafeProposalUseCase)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign
+func (mr *MockSafeProposalUseCasesMockRecorder) Sign() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockSafeProposalUseCases)(nil).Sign))
+}
+
+// MockCreateSafeProposalUseCase is a mock of CreateSafeProposalUseCase interface
+type MockCreateSafeProposalUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSafeProposalUseCaseMockRecorder
+}
+
+// MockCreateSafeProposalUseCaseMockRecorder is the mock recorder for MockCreateSafeProposalUseCase
+type MockCreateSafeProposalUseCaseMockRecorder struct {
+	mock *MockCreateSafeProposalUseCase
+}
+
+// NewMockCreateSafeProposalUseCase creates a new mock instance
+func NewMockCreateSafeProposalUseCase(ctrl *gomock.Controller) *MockCreateSafeProposalUseCase {
+	mock := &MockCreateSafeProposalUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateSafeProposalUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreateSafeProposalUseCase) EXPECT() *MockCreateSafeProposalUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreateSafeProposalUseCase) Execute(ctx context.Context, proposal *entities.SafeProposal, chainName string, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, proposal, chainName, userInfo)
+	ret0, _ := ret[0].(*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreateSafeProposalUseCaseMockRecorder) Execute(ctx, proposal, chainName, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateSafeProposalUseCase)(nil).Execute), ctx, proposal, chainName, userInfo)
+}
+
+// MockGetSafeProposalUseCase is a mock of GetSafeProposalUseCase interface
+type MockGetSafeProposalUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSafeProposalUseCaseMockRecorder
+}
+
+// MockGetSafeProposalUseCaseMockRecorder is the mock recorder for MockGetSafeProposalUseCase
+type MockGetSafeProposalUseCaseMockRecorder struct {
+	mock *MockGetSafeProposalUseCase
+}
+
+// NewMockGetSafeProposalUseCase creates a new mock instance
+func NewMockGetSafeProposalUseCase(ctrl *gomock.Controller) *MockGetSafeProposalUseCase {
+	mock := &MockGetSafeProposalUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetSafeProposalUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetSafeProposalUseCase) EXPECT() *MockGetSafeProposalUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetSafeProposalUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, userInfo)
+	ret0, _ := ret[0].(*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetSafeProposalUseCaseMockRecorder) Execute(ctx, uuid, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetSafeProposalUseCase)(nil).Execute), ctx, uuid, userInfo)
+}
+
+// MockSearchSafeProposalsUseCase is a mock of SearchSafeProposalsUseCase interface
+type MockSearchSafeProposalsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchSafeProposalsUseCaseMockRecorder
+}
+
+// MockSearchSafeProposalsUseCaseMockRecorder is the mock recorder for MockSearchSafeProposalsUseCase
+type MockSearchSafeProposalsUseCaseMockRecorder struct {
+	mock *MockSearchSafeProposalsUseCase
+}
+
+// NewMockSearchSafeProposalsUseCase creates a new mock instance
+func NewMockSearchSafeProposalsUseCase(ctrl *gomock.Controller) *MockSearchSafeProposalsUseCase {
+	mock := &MockSearchSafeProposalsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchSafeProposalsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchSafeProposalsUseCase) EXPECT() *MockSearchSafeProposalsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchSafeProposalsUseCase) Execute(ctx context.Context, filters *entities.SafeProposalFilters, userInfo *multitenancy.UserInfo) ([]*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchSafeProposalsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchSafeProposalsUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockSignSafeProposalUseCase is a mock of SignSafeProposalUseCase interface
+type MockSignSafeProposalUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignSafeProposalUseCaseMockRecorder
+}
+
+// MockSignSafeProposalUseCaseMockRecorder is the mock recorder for MockSignSafeProposalUseCase
+type MockSignSafeProposalUseCaseMockRecorder struct {
+	mock *MockSignSafeProposalUseCase
+}
+
+// NewMockSignSafeProposalUseCase creates a new mock instance
+func NewMockSignSafeProposalUseCase(ctrl *gomock.Controller) *MockSignSafeProposalUseCase {
+	mock := &MockSignSafeProposalUseCase{ctrl: ctrl}
+	mock.recorder = &MockSignSafeProposalUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSignSafeProposalUseCase) EXPECT() *MockSignSafeProposalUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSignSafeProposalUseCase) Execute(ctx context.Context, uuid string, signature *entities.SafeSignature, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, uuid, signature, userInfo)
+	ret0, _ := ret[0].(*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSignSafeProposalUseCaseMockRecorder) Execute(ctx, uuid, signature, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSignSafeProposalUseCase)(nil).Execute), ctx, uuid, signature, userInfo)
+}
+
+// MockUpdateSafeProposalExecutionUseCase is a mock of UpdateSafeProposalExecutionUseCase interface
+type MockUpdateSafeProposalExecutionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateSafeProposalExecutionUseCaseMockRecorder
+}
+
+// MockUpdateSafeProposalExecutionUseCaseMockRecorder is the mock recorder for MockUpdateSafeProposalExecutionUseCase
+type MockUpdateSafeProposalExecutionUseCaseMockRecorder struct {
+	mock *MockUpdateSafeProposalExecutionUseCase
+}
+
+// NewMockUpdateSafeProposalExecutionUseCase creates a new mock instance
+func NewMockUpdateSafeProposalExecutionUseCase(ctrl *gomock.Controller) *MockUpdateSafeProposalExecutionUseCase {
+	mock := &MockUpdateSafeProposalExecutionUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateSafeProposalExecutionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUpdateSafeProposalExecutionUseCase) EXPECT() *MockUpdateSafeProposalExecutionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockUpdateSafeProposalExecutionUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, job, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockUpdateSafeProposalExecutionUseCaseMockRecorder) Execute(ctx, job, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateSafeProposalExecutionUseCase)(nil).Execute), ctx, job, userInfo)
+}
+
+// WithDB mocks base method
+func (m *MockUpdateSafeProposalExecutionUseCase) WithDB(db store.DB) usecases.UpdateSafeProposalExecutionUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithDB", db)
+	ret0, _ := ret[0].(usecases.UpdateSafeProposalExecutionUseCase)
+	return ret0
+}
+
+// WithDB indicates an expected call of WithDB
+func (mr *MockUpdateSafeProposalExecutionUseCaseMockRecorder) WithDB(db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithDB", reflect.TypeOf((*MockUpdateSafeProposalExecutionUseCase)(nil).WithDB), db)
+}
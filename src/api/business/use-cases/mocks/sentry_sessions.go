@@ -0,0 +1,296 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sentry_sessions.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	multitenancy "github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	entities "github.com/consensys/orchestrate/src/entities"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockSentrySessionUseCases is a mock of SentrySessionUseCases interface
+type MockSentrySessionUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockSentrySessionUseCasesMockRecorder
+}
+
+// MockSentrySessionUseCasesMockRecorder is the mock recorder for MockSentrySessionUseCases
+type MockSentrySessionUseCasesMockRecorder struct {
+	mock *MockSentrySessionUseCases
+}
+
+// NewMockSentrySessionUseCases creates a new mock instance
+func NewMockSentrySessionUseCases(ctrl *gomock.Controller) *MockSentrySessionUseCases {
+	mock := &MockSentrySessionUseCases{ctrl: ctrl}
+	mock.recorder = &MockSentrySessionUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSentrySessionUseCases) EXPECT() *MockSentrySessionUseCasesMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockSentrySessionUseCases) Create() usecases.CreateSentrySessionUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create")
+	ret0, _ := ret[0].(usecases.CreateSentrySessionUseCase)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockSentrySessionUseCasesMockRecorder) Create() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSentrySessionUseCases)(nil).Create))
+}
+
+// Update mocks base method
+func (m *MockSentrySessionUseCases) Update() usecases.UpdateSentrySessionUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update")
+	ret0, _ := ret[0].(usecases.UpdateSentrySessionUseCase)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockSentrySessionUseCasesMockRecorder) Update() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSentrySessionUseCases)(nil).Update))
+}
+
+// Get mocks base method
+func (m *MockSentrySessionUseCases) Get() usecases.GetSentrySessionUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get")
+	ret0, _ := ret[0].(usecases.GetSentrySessionUseCase)
+	return ret0
+}
+
+// Get indicates an expected call of Get
+func (mr *MockSentrySessionUseCasesMockRecorder) Get() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSentrySessionUseCases)(nil).Get))
+}
+
+// Search mocks base method
+func (m *MockSentrySessionUseCases) Search() usecases.SearchSentrySessionsUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search")
+	ret0, _ := ret[0].(usecases.SearchSentrySessionsUseCase)
+	return ret0
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSentrySessionUseCasesMockRecorder) Search() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSentrySessionUseCases)(nil).Search))
+}
+
+// Delete mocks base method
+func (m *MockSentrySessionUseCases) Delete() usecases.DeleteSentrySessionUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete")
+	ret0, _ := ret[0].(usecases.DeleteSentrySessionUseCase)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockSentrySessionUseCasesMockRecorder) Delete() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSentrySessionUseCases)(nil).Delete))
+}
+
+// MockCreateSentrySessionUseCase is a mock of CreateSentrySessionUseCase interface
+type MockCreateSentrySessionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSentrySessionUseCaseMockRecorder
+}
+
+// MockCreateSentrySessionUseCaseMockRecorder is the mock recorder for MockCreateSentrySessionUseCase
+type MockCreateSentrySessionUseCaseMockRecorder struct {
+	mock *MockCreateSentrySessionUseCase
+}
+
+// NewMockCreateSentrySessionUseCase creates a new mock instance
+func NewMockCreateSentrySessionUseCase(ctrl *gomock.Controller) *MockCreateSentrySessionUseCase {
+	mock := &MockCreateSentrySessionUseCase{ctrl: ctrl}
+	mock.recorder = &MockCreateSentrySessionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCreateSentrySessionUseCase) EXPECT() *MockCreateSentrySessionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockCreateSentrySessionUseCase) Execute(ctx context.Context, session *entities.SentrySession, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, session, userInfo)
+	ret0, _ := ret[0].(*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockCreateSentrySessionUseCaseMockRecorder) Execute(ctx, session, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateSentrySessionUseCase)(nil).Execute), ctx, session, userInfo)
+}
+
+// MockUpdateSentrySessionUseCase is a mock of UpdateSentrySessionUseCase interface
+type MockUpdateSentrySessionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateSentrySessionUseCaseMockRecorder
+}
+
+// MockUpdateSentrySessionUseCaseMockRecorder is the mock recorder for MockUpdateSentrySessionUseCase
+type MockUpdateSentrySessionUseCaseMockRecorder struct {
+	mock *MockUpdateSentrySessionUseCase
+}
+
+// NewMockUpdateSentrySessionUseCase creates a new mock instance
+func NewMockUpdateSentrySessionUseCase(ctrl *gomock.Controller) *MockUpdateSentrySessionUseCase {
+	mock := &MockUpdateSentrySessionUseCase{ctrl: ctrl}
+	mock.recorder = &MockUpdateSentrySessionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUpdateSentrySessionUseCase) EXPECT() *MockUpdateSentrySessionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockUpdateSentrySessionUseCase) Execute(ctx context.Context, session *entities.SentrySession, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, session, userInfo)
+	ret0, _ := ret[0].(*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockUpdateSentrySessionUseCaseMockRecorder) Execute(ctx, session, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateSentrySessionUseCase)(nil).Execute), ctx, session, userInfo)
+}
+
+// MockGetSentrySessionUseCase is a mock of GetSentrySessionUseCase interface
+type MockGetSentrySessionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSentrySessionUseCaseMockRecorder
+}
+
+// MockGetSentrySessionUseCaseMockRecorder is the mock recorder for MockGetSentrySessionUseCase
+type MockGetSentrySessionUseCaseMockRecorder struct {
+	mock *MockGetSentrySessionUseCase
+}
+
+// NewMockGetSentrySessionUseCase creates a new mock instance
+func NewMockGetSentrySessionUseCase(ctrl *gomock.Controller) *MockGetSentrySessionUseCase {
+	mock := &MockGetSentrySessionUseCase{ctrl: ctrl}
+	mock.recorder = &MockGetSentrySessionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockGetSentrySessionUseCase) EXPECT() *MockGetSentrySessionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockGetSentrySessionUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
+	ret0, _ := ret[0].(*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockGetSentrySessionUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetSentrySessionUseCase)(nil).Execute), ctx, jobUUID, userInfo)
+}
+
+// MockSearchSentrySessionsUseCase is a mock of SearchSentrySessionsUseCase interface
+type MockSearchSentrySessionsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchSentrySessionsUseCaseMockRecorder
+}
+
+// MockSearchSentrySessionsUseCaseMockRecorder is the mock recorder for MockSearchSentrySessionsUseCase
+type MockSearchSentrySessionsUseCaseMockRecorder struct {
+	mock *MockSearchSentrySessionsUseCase
+}
+
+// NewMockSearchSentrySessionsUseCase creates a new mock instance
+func NewMockSearchSentrySessionsUseCase(ctrl *gomock.Controller) *MockSearchSentrySessionsUseCase {
+	mock := &MockSearchSentrySessionsUseCase{ctrl: ctrl}
+	mock.recorder = &MockSearchSentrySessionsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchSentrySessionsUseCase) EXPECT() *MockSearchSentrySessionsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSearchSentrySessionsUseCase) Execute(ctx context.Context, filters *entities.SentrySessionFilters, userInfo *multitenancy.UserInfo) ([]*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, filters, userInfo)
+	ret0, _ := ret[0].([]*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSearchSentrySessionsUseCaseMockRecorder) Execute(ctx, filters, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSearchSentrySessionsUseCase)(nil).Execute), ctx, filters, userInfo)
+}
+
+// MockDeleteSentrySessionUseCase is a mock of DeleteSentrySessionUseCase interface
+type MockDeleteSentrySessionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSentrySessionUseCaseMockRecorder
+}
+
+// MockDeleteSentrySessionUseCaseMockRecorder is the mock recorder for MockDeleteSentrySessionUseCase
+type MockDeleteSentrySessionUseCaseMockRecorder struct {
+	mock *MockDeleteSentrySessionUseCase
+}
+
+// NewMockDeleteSentrySessionUseCase creates a new mock instance
+func NewMockDeleteSentrySessionUseCase(ctrl *gomock.Controller) *MockDeleteSentrySessionUseCase {
+	mock := &MockDeleteSentrySessionUseCase{ctrl: ctrl}
+	mock.recorder = &MockDeleteSentrySessionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDeleteSentrySessionUseCase) EXPECT() *MockDeleteSentrySessionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockDeleteSentrySessionUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, jobUUID, userInfo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockDeleteSentrySessionUseCaseMockRecorder) Execute(ctx, jobUUID, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteSentrySessionUseCase)(nil).Execute), ctx, jobUUID, userInfo)
+}
@@ -79,6 +79,20 @@ func (mr *MockTransactionUseCasesMockRecorder) Send() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTransactionUseCases)(nil).Send))
 }
 
+// SendBatch mocks base method
+func (m *MockTransactionUseCases) SendBatch() usecases.SendTxBatchUseCase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendBatch")
+	ret0, _ := ret[0].(usecases.SendTxBatchUseCase)
+	return ret0
+}
+
+// SendBatch indicates an expected call of SendBatch
+func (mr *MockTransactionUseCasesMockRecorder) SendBatch() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBatch", reflect.TypeOf((*MockTransactionUseCases)(nil).SendBatch))
+}
+
 // Get mocks base method
 func (m *MockTransactionUseCases) Get() usecases.GetTxUseCase {
 	m.ctrl.T.Helper()
@@ -325,6 +339,44 @@ func (mr *MockSendTxUseCaseMockRecorder) Execute(ctx, txRequest, txData, userInf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendTxUseCase)(nil).Execute), ctx, txRequest, txData, userInfo)
 }
 
+// MockSendTxBatchUseCase is a mock of SendTxBatchUseCase interface
+type MockSendTxBatchUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSendTxBatchUseCaseMockRecorder
+}
+
+// MockSendTxBatchUseCaseMockRecorder is the mock recorder for MockSendTxBatchUseCase
+type MockSendTxBatchUseCaseMockRecorder struct {
+	mock *MockSendTxBatchUseCase
+}
+
+// NewMockSendTxBatchUseCase creates a new mock instance
+func NewMockSendTxBatchUseCase(ctrl *gomock.Controller) *MockSendTxBatchUseCase {
+	mock := &MockSendTxBatchUseCase{ctrl: ctrl}
+	mock.recorder = &MockSendTxBatchUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSendTxBatchUseCase) EXPECT() *MockSendTxBatchUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockSendTxBatchUseCase) Execute(ctx context.Context, items []*entities.TxBatchItem, userInfo *multitenancy.UserInfo) ([]*entities.TxBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, items, userInfo)
+	ret0, _ := ret[0].([]*entities.TxBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockSendTxBatchUseCaseMockRecorder) Execute(ctx, items, userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSendTxBatchUseCase)(nil).Execute), ctx, items, userInfo)
+}
+
 // MockSpeedUpTxUseCase is a mock of SpeedUpTxUseCase interface
 type MockSpeedUpTxUseCase struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,16 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/sdk"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+//go:generate mockgen -source=outbox.go -destination=mocks/outbox.go -package=mocks
+
+// OutboxMessenger provides messengers writing messages to the outbox of a DB, so that messages sent within a DB
+// transaction are only published if the transaction is committed
+type OutboxMessenger interface {
+	WithDB(ctx context.Context, db store.DB) sdk.OrchestrateMessenger
+}
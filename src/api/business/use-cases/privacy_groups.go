@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+//go:generate mockgen -source=privacy_groups.go -destination=mocks/privacy_groups.go -package=mocks
+
+type PrivacyGroupUseCases interface {
+	Create() CreatePrivacyGroupUseCase
+	Get() GetPrivacyGroupUseCase
+	Search() SearchPrivacyGroupsUseCase
+	Delete() DeletePrivacyGroupUseCase
+	Find() FindPrivacyGroupsUseCase
+}
+
+// CreatePrivacyGroupUseCase creates a privacy group on the chain and stores it under the given name
+type CreatePrivacyGroupUseCase interface {
+	Execute(ctx context.Context, group *entities.PrivacyGroup, chainName string, userInfo *multitenancy.UserInfo) (*entities.PrivacyGroup, error)
+}
+
+type GetPrivacyGroupUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.PrivacyGroup, error)
+}
+
+type SearchPrivacyGroupsUseCase interface {
+	Execute(ctx context.Context, filters *entities.PrivacyGroupFilters, userInfo *multitenancy.UserInfo) ([]*entities.PrivacyGroup, error)
+}
+
+// DeletePrivacyGroupUseCase deletes the privacy group on the chain before deleting it from Orchestrate
+type DeletePrivacyGroupUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error
+}
+
+// FindPrivacyGroupsUseCase returns the privacy groups of the chain containing only the given members
+type FindPrivacyGroupsUseCase interface {
+	Execute(ctx context.Context, chainName string, members []string, userInfo *multitenancy.UserInfo) ([]*entities.OnChainPrivacyGroup, error)
+}
@@ -0,0 +1,40 @@
+package privacygroups
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+func getChain(ctx context.Context, searchChainsUC usecases.SearchChainsUseCase, chainName string, userInfo *multitenancy.UserInfo) (*entities.Chain, error) {
+	chains, err := searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chains) == 0 {
+		return nil, errors.InvalidParameterError("chain '%s' does not exist", chainName)
+	}
+
+	return chains[0], nil
+}
+
+// callChain runs the call against the URLs of the chain until one of the nodes is reachable, errors returned by a
+// reachable node, such as an unknown member, not being retried
+func callChain(ctx context.Context, chain *entities.Chain, call func(uri string) error) error {
+	for _, uri := range chain.URLs {
+		err := call(uri)
+		if err != nil && errors.IsConnectionError(err) {
+			log.FromContext(ctx).WithError(err).WithField("url", uri).Warn("failed to reach chain node")
+			continue
+		}
+
+		return err
+	}
+
+	return errors.EthConnectionError("failed to reach all chain URLs")
+}
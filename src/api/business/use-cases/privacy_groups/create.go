@@ -0,0 +1,79 @@
+package privacygroups
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+)
+
+const createPrivacyGroupComponent = "use-cases.create-privacy-group"
+
+type createUseCase struct {
+	db             store.DB
+	searchChainsUC usecases.SearchChainsUseCase
+	ec             ethclient.EEATransactionSender
+	logger         *log.Logger
+}
+
+func NewCreateUseCase(db store.DB, searchChainsUC usecases.SearchChainsUseCase, ec ethclient.EEATransactionSender) usecases.CreatePrivacyGroupUseCase {
+	return &createUseCase{
+		db:             db,
+		searchChainsUC: searchChainsUC,
+		ec:             ec,
+		logger:         log.NewLogger().SetComponent(createPrivacyGroupComponent),
+	}
+}
+
+// Execute creates the privacy group on the first reachable node of the chain, the node forwarding it to its private
+// transaction manager, and stores it under the given name so that transactions can reference it
+func (uc *createUseCase) Execute(ctx context.Context, group *entities.PrivacyGroup, chainName string, userInfo *multitenancy.UserInfo) (*entities.PrivacyGroup, error) {
+	ctx = log.WithFields(ctx, log.Field("privacy_group_name", group.Name), log.Field("chain", chainName))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating new privacy group")
+
+	chain, err := getChain(ctx, uc.searchChainsUC, chainName, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createPrivacyGroupComponent)
+	}
+
+	existing, err := uc.db.PrivacyGroup().Search(ctx, &entities.PrivacyGroupFilters{
+		Names:     []string{group.Name},
+		ChainUUID: chain.UUID,
+		TenantID:  userInfo.TenantID,
+	}, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createPrivacyGroupComponent)
+	}
+
+	if len(existing) > 0 {
+		errMsg := "privacy group with same name already exists on chain"
+		logger.Error(errMsg)
+		return nil, errors.AlreadyExistsError(errMsg).ExtendComponent(createPrivacyGroupComponent)
+	}
+
+	err = callChain(ctx, chain, func(uri string) (der error) {
+		group.PrivacyGroupID, der = uc.ec.PrivCreatePrivacyGroup(ctx, uri, group.Members)
+		return der
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to create privacy group on chain")
+		return nil, errors.FromError(err).ExtendComponent(createPrivacyGroupComponent)
+	}
+
+	group.ChainUUID = chain.UUID
+	group.TenantID = userInfo.TenantID
+	group.OwnerID = userInfo.Username
+	group, err = uc.db.PrivacyGroup().Insert(ctx, group)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createPrivacyGroupComponent)
+	}
+
+	logger.WithField("privacy_group_id", group.PrivacyGroupID).Info("privacy group created successfully")
+	return group, nil
+}
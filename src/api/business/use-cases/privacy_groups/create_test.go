@@ -0,0 +1,114 @@
+// +build unit
+
+package privacygroups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePrivacyGroup_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockPrivacyGroupDA := mocks2.NewMockPrivacyGroupAgent(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockEthClient := mock.NewMockEEATransactionSender(ctrl)
+
+	mockDB.EXPECT().PrivacyGroup().Return(mockPrivacyGroupDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateUseCase(mockDB, mockSearchChainsUC, mockEthClient)
+	chain := testdata.FakeChain()
+	chain.URLs = []string{"http://besu-1:8545", "http://besu-2:8545"}
+
+	t.Run("should create privacy group successfully", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+		privacyGroupID := group.PrivacyGroupID
+		group.PrivacyGroupID = ""
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockPrivacyGroupDA.EXPECT().Search(gomock.Any(), &entities.PrivacyGroupFilters{
+			Names:     []string{group.Name},
+			ChainUUID: chain.UUID,
+			TenantID:  userInfo.TenantID,
+		}, userInfo.AllowedTenants, userInfo.Username).Return([]*entities.PrivacyGroup{}, nil)
+		mockEthClient.EXPECT().PrivCreatePrivacyGroup(gomock.Any(), chain.URLs[0], group.Members).Return(privacyGroupID, nil)
+		mockPrivacyGroupDA.EXPECT().Insert(gomock.Any(), group).Return(group, nil)
+
+		resp, err := usecase.Execute(ctx, group, chain.Name, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, privacyGroupID, resp.PrivacyGroupID)
+		assert.Equal(t, chain.UUID, resp.ChainUUID)
+		assert.Equal(t, userInfo.TenantID, resp.TenantID)
+		assert.Equal(t, userInfo.Username, resp.OwnerID)
+	})
+
+	t.Run("should try next chain URL if node is unreachable", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockPrivacyGroupDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.PrivacyGroup{}, nil)
+		mockEthClient.EXPECT().PrivCreatePrivacyGroup(gomock.Any(), chain.URLs[0], group.Members).
+			Return("", errors.ServiceConnectionError("connection refused"))
+		mockEthClient.EXPECT().PrivCreatePrivacyGroup(gomock.Any(), chain.URLs[1], group.Members).
+			Return(group.PrivacyGroupID, nil)
+		mockPrivacyGroupDA.EXPECT().Insert(gomock.Any(), group).Return(group, nil)
+
+		_, err := usecase.Execute(ctx, group, chain.Name, userInfo)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with AlreadyExistsError if name is already used on chain", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockPrivacyGroupDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.PrivacyGroup{group}, nil)
+
+		_, err := usecase.Execute(ctx, group, chain.Name, userInfo)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{}, nil)
+
+		_, err := usecase.Execute(ctx, group, chain.Name, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail without storing the privacy group if node rejects it", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+		expectedErr := errors.InvalidParameterError("invalid member")
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockPrivacyGroupDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.PrivacyGroup{}, nil)
+		mockEthClient.EXPECT().PrivCreatePrivacyGroup(gomock.Any(), chain.URLs[0], group.Members).Return("", expectedErr)
+
+		_, err := usecase.Execute(ctx, group, chain.Name, userInfo)
+
+		assert.Equal(t, expectedErr.ExtendComponent(createPrivacyGroupComponent), err)
+	})
+}
@@ -0,0 +1,63 @@
+package privacygroups
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+)
+
+const deletePrivacyGroupComponent = "use-cases.delete-privacy-group"
+
+type deleteUseCase struct {
+	db         store.DB
+	getChainUC usecases.GetChainUseCase
+	ec         ethclient.EEATransactionSender
+	logger     *log.Logger
+}
+
+func NewDeleteUseCase(db store.DB, getChainUC usecases.GetChainUseCase, ec ethclient.EEATransactionSender) usecases.DeletePrivacyGroupUseCase {
+	return &deleteUseCase{
+		db:         db,
+		getChainUC: getChainUC,
+		ec:         ec,
+		logger:     log.NewLogger().SetComponent(deletePrivacyGroupComponent),
+	}
+}
+
+func (uc *deleteUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("privacy_group", uuid))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting privacy group")
+
+	group, err := uc.db.PrivacyGroup().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deletePrivacyGroupComponent)
+	}
+
+	chain, err := uc.getChainUC.Execute(ctx, group.ChainUUID, userInfo)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deletePrivacyGroupComponent)
+	}
+
+	err = callChain(ctx, chain, func(uri string) error {
+		_, der := uc.ec.PrivDeletePrivacyGroup(ctx, uri, group.PrivacyGroupID)
+		return der
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to delete privacy group on chain")
+		return errors.FromError(err).ExtendComponent(deletePrivacyGroupComponent)
+	}
+
+	err = uc.db.PrivacyGroup().Delete(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deletePrivacyGroupComponent)
+	}
+
+	logger.Info("privacy group was deleted successfully")
+	return nil
+}
@@ -0,0 +1,72 @@
+// +build unit
+
+package privacygroups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletePrivacyGroup_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockPrivacyGroupDA := mocks2.NewMockPrivacyGroupAgent(ctrl)
+	mockGetChainUC := mocks.NewMockGetChainUseCase(ctrl)
+	mockEthClient := mock.NewMockEEATransactionSender(ctrl)
+
+	mockDB.EXPECT().PrivacyGroup().Return(mockPrivacyGroupDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewDeleteUseCase(mockDB, mockGetChainUC, mockEthClient)
+	chain := testdata.FakeChain()
+
+	t.Run("should delete privacy group on chain and in Orchestrate successfully", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+		group.ChainUUID = chain.UUID
+
+		mockPrivacyGroupDA.EXPECT().FindOneByUUID(gomock.Any(), group.UUID, userInfo.AllowedTenants, userInfo.Username).Return(group, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockEthClient.EXPECT().PrivDeletePrivacyGroup(gomock.Any(), chain.URLs[0], group.PrivacyGroupID).Return(group.PrivacyGroupID, nil)
+		mockPrivacyGroupDA.EXPECT().Delete(gomock.Any(), group.UUID, userInfo.AllowedTenants, userInfo.Username).Return(nil)
+
+		err := usecase.Execute(ctx, group.UUID, userInfo)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with NotFoundError if privacy group does not exist", func(t *testing.T) {
+		mockPrivacyGroupDA.EXPECT().FindOneByUUID(gomock.Any(), "uuid", userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		err := usecase.Execute(ctx, "uuid", userInfo)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should not delete privacy group in Orchestrate if chain nodes are unreachable", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+		group.ChainUUID = chain.UUID
+
+		mockPrivacyGroupDA.EXPECT().FindOneByUUID(gomock.Any(), group.UUID, userInfo.AllowedTenants, userInfo.Username).Return(group, nil)
+		mockGetChainUC.EXPECT().Execute(gomock.Any(), chain.UUID, userInfo).Return(chain, nil)
+		mockEthClient.EXPECT().PrivDeletePrivacyGroup(gomock.Any(), chain.URLs[0], group.PrivacyGroupID).
+			Return("", errors.ServiceConnectionError("connection refused"))
+
+		err := usecase.Execute(ctx, group.UUID, userInfo)
+
+		assert.True(t, errors.IsEthConnectionError(err))
+	})
+}
@@ -0,0 +1,63 @@
+package privacygroups
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/ethclient/types"
+)
+
+const findPrivacyGroupsComponent = "use-cases.find-privacy-groups"
+
+type findUseCase struct {
+	searchChainsUC usecases.SearchChainsUseCase
+	ec             ethclient.EEAChainStateReader
+	logger         *log.Logger
+}
+
+func NewFindUseCase(searchChainsUC usecases.SearchChainsUseCase, ec ethclient.EEAChainStateReader) usecases.FindPrivacyGroupsUseCase {
+	return &findUseCase{
+		searchChainsUC: searchChainsUC,
+		ec:             ec,
+		logger:         log.NewLogger().SetComponent(findPrivacyGroupsComponent),
+	}
+}
+
+// Execute looks up the privacy groups on the chain, including the ones not created through Orchestrate
+func (uc *findUseCase) Execute(ctx context.Context, chainName string, members []string, userInfo *multitenancy.UserInfo) ([]*entities.OnChainPrivacyGroup, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", chainName))
+
+	chain, err := getChain(ctx, uc.searchChainsUC, chainName, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(findPrivacyGroupsComponent)
+	}
+
+	var groups []*types.PrivacyGroup
+	err = callChain(ctx, chain, func(uri string) (der error) {
+		groups, der = uc.ec.PrivFindPrivacyGroup(ctx, uri, members)
+		return der
+	})
+	if err != nil {
+		uc.logger.WithContext(ctx).WithError(err).Error("failed to find privacy groups on chain")
+		return nil, errors.FromError(err).ExtendComponent(findPrivacyGroupsComponent)
+	}
+
+	res := []*entities.OnChainPrivacyGroup{}
+	for _, group := range groups {
+		res = append(res, &entities.OnChainPrivacyGroup{
+			PrivacyGroupID: group.PrivacyGroupID,
+			Name:           group.Name,
+			Description:    group.Description,
+			Type:           group.Type,
+			Members:        group.Members,
+		})
+	}
+
+	uc.logger.WithContext(ctx).Debug("privacy groups found successfully on chain")
+	return res, nil
+}
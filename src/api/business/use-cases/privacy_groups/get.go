@@ -0,0 +1,38 @@
+package privacygroups
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const getPrivacyGroupComponent = "use-cases.get-privacy-group"
+
+type getUseCase struct {
+	db     store.PrivacyGroupAgent
+	logger *log.Logger
+}
+
+func NewGetUseCase(db store.PrivacyGroupAgent) usecases.GetPrivacyGroupUseCase {
+	return &getUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getPrivacyGroupComponent),
+	}
+}
+
+func (uc *getUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.PrivacyGroup, error) {
+	ctx = log.WithFields(ctx, log.Field("privacy_group", uuid))
+
+	group, err := uc.db.FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getPrivacyGroupComponent)
+	}
+
+	uc.logger.WithContext(ctx).Debug("privacy group found successfully")
+	return group, nil
+}
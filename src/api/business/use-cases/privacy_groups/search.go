@@ -0,0 +1,36 @@
+package privacygroups
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchPrivacyGroupsComponent = "use-cases.search-privacy-groups"
+
+type searchUseCase struct {
+	db     store.PrivacyGroupAgent
+	logger *log.Logger
+}
+
+func NewSearchUseCase(db store.PrivacyGroupAgent) usecases.SearchPrivacyGroupsUseCase {
+	return &searchUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchPrivacyGroupsComponent),
+	}
+}
+
+func (uc *searchUseCase) Execute(ctx context.Context, filters *entities.PrivacyGroupFilters, userInfo *multitenancy.UserInfo) ([]*entities.PrivacyGroup, error) {
+	groups, err := uc.db.Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchPrivacyGroupsComponent)
+	}
+
+	uc.logger.Debug("privacy groups found successfully")
+	return groups, nil
+}
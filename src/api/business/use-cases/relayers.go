@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+//go:generate mockgen -source=relayers.go -destination=mocks/relayers.go -package=mocks
+
+type RelayerUseCases interface {
+	Register() RegisterRelayerUseCase
+	Get() GetRelayerUseCase
+	Search() SearchRelayersUseCase
+	Delete() DeleteRelayerUseCase
+	Relay() RelayTxUseCase
+	SearchSpendings() SearchRelaySpendingsUseCase
+}
+
+type RegisterRelayerUseCase interface {
+	Execute(ctx context.Context, relayer *entities.Relayer, chainName string, userInfo *multitenancy.UserInfo) (*entities.Relayer, error)
+}
+
+type GetRelayerUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Relayer, error)
+}
+
+type SearchRelayersUseCase interface {
+	Execute(ctx context.Context, filters *entities.RelayerFilters, userInfo *multitenancy.UserInfo) ([]*entities.Relayer, error)
+}
+
+type DeleteRelayerUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error
+}
+
+// RelayTxUseCase submits a user signed meta transaction through the relayer registered on the chain, the relayer account paying the fees
+type RelayTxUseCase interface {
+	Execute(ctx context.Context, txRequest *entities.TxRequest, metaTx *entities.MetaTransaction, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
+}
+
+type SearchRelaySpendingsUseCase interface {
+	Execute(ctx context.Context, filters *entities.RelaySpendingFilters, userInfo *multitenancy.UserInfo) ([]*entities.RelaySpending, error)
+}
+
+// UpdateRelaySpendingUseCase adds the fee of a mined relayed transaction to the spending of the tenant
+type UpdateRelaySpendingUseCase interface {
+	Execute(ctx context.Context, job *entities.Job) error
+}
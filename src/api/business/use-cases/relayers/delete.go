@@ -0,0 +1,44 @@
+package relayers
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+const deleteRelayerComponent = "use-cases.delete-relayer"
+
+type deleteUseCase struct {
+	db     store.RelayerAgent
+	logger *log.Logger
+}
+
+func NewDeleteUseCase(db store.RelayerAgent) usecases.DeleteRelayerUseCase {
+	return &deleteUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteRelayerComponent),
+	}
+}
+
+func (uc *deleteUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("relayer", uuid))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting relayer")
+
+	_, err := uc.db.FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteRelayerComponent)
+	}
+
+	err = uc.db.Delete(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteRelayerComponent)
+	}
+
+	logger.Info("relayer was deleted successfully")
+	return nil
+}
@@ -0,0 +1,38 @@
+package relayers
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const getRelayerComponent = "use-cases.get-relayer"
+
+type getUseCase struct {
+	db     store.RelayerAgent
+	logger *log.Logger
+}
+
+func NewGetUseCase(db store.RelayerAgent) usecases.GetRelayerUseCase {
+	return &getUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getRelayerComponent),
+	}
+}
+
+func (uc *getUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.Relayer, error) {
+	ctx = log.WithFields(ctx, log.Field("relayer", uuid))
+
+	relayer, err := uc.db.FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getRelayerComponent)
+	}
+
+	uc.logger.WithContext(ctx).Debug("relayer found successfully")
+	return relayer, nil
+}
@@ -0,0 +1,102 @@
+package relayers
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/metatx"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const registerRelayerComponent = "use-cases.register-relayer"
+
+type registerUseCase struct {
+	db             store.DB
+	searchChainsUC usecases.SearchChainsUseCase
+	getContractUC  usecases.GetContractUseCase
+	logger         *log.Logger
+}
+
+func NewRegisterUseCase(db store.DB, searchChainsUC usecases.SearchChainsUseCase, getContractUC usecases.GetContractUseCase) usecases.RegisterRelayerUseCase {
+	return &registerUseCase{
+		db:             db,
+		searchChainsUC: searchChainsUC,
+		getContractUC:  getContractUC,
+		logger:         log.NewLogger().SetComponent(registerRelayerComponent),
+	}
+}
+
+// Execute registers a relayer account submitting meta transactions to a forwarder or entry point contract of the contract registry
+func (uc *registerUseCase) Execute(ctx context.Context, relayer *entities.Relayer, chainName string, userInfo *multitenancy.UserInfo) (*entities.Relayer, error) {
+	ctx = log.WithFields(ctx, log.Field("relayer_name", relayer.Name), log.Field("chain", chainName))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("registering new relayer")
+
+	existing, err := uc.db.Relayer().Search(ctx, &entities.RelayerFilters{
+		Names:    []string{relayer.Name},
+		TenantID: userInfo.TenantID,
+	}, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(registerRelayerComponent)
+	}
+
+	if len(existing) > 0 {
+		errMsg := "relayer with same name already exists"
+		logger.Error(errMsg)
+		return nil, errors.AlreadyExistsError(errMsg).ExtendComponent(registerRelayerComponent)
+	}
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(registerRelayerComponent)
+	}
+
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(registerRelayerComponent)
+	}
+
+	_, err = uc.db.Account().FindOneByAddress(ctx, relayer.Account.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if errors.IsNotFoundError(err) {
+		return nil, errors.InvalidParameterError("cannot find relayer account").ExtendComponent(registerRelayerComponent)
+	} else if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(registerRelayerComponent)
+	}
+
+	if relayer.ContractTag == "" {
+		relayer.ContractTag = entities.DefaultContractTagValue
+	}
+
+	contract, err := uc.getContractUC.Execute(ctx, relayer.ContractName, relayer.ContractTag, userInfo)
+	if errors.IsNotFoundError(err) {
+		return nil, errors.InvalidParameterError("cannot find relayer contract").ExtendComponent(registerRelayerComponent)
+	} else if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(registerRelayerComponent)
+	}
+
+	method := metatx.ExecuteMethod
+	if relayer.Type == entities.RelayerTypeEntryPoint {
+		method = metatx.HandleOpsMethod
+	}
+	if _, ok := contract.ABI.Methods[method]; !ok {
+		errMsg := "relayer contract does not implement " + method
+		logger.WithField("contract", relayer.ContractName).Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(registerRelayerComponent)
+	}
+
+	relayer.ChainUUID = chains[0].UUID
+	relayer.TenantID = userInfo.TenantID
+	relayer.OwnerID = userInfo.Username
+	relayer, err = uc.db.Relayer().Insert(ctx, relayer)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(registerRelayerComponent)
+	}
+
+	logger.WithField("relayer", relayer.UUID).Info("relayer registered successfully")
+	return relayer, nil
+}
@@ -0,0 +1,102 @@
+// +build unit
+
+package relayers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterRelayer_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockRelayerDA := mocks2.NewMockRelayerAgent(ctrl)
+	mockAccountDA := mocks2.NewMockAccountAgent(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+
+	mockDB.EXPECT().Relayer().Return(mockRelayerDA).AnyTimes()
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewRegisterUseCase(mockDB, mockSearchChainsUC, mockGetContractUC)
+	chain := testdata.FakeChain()
+
+	t.Run("should register a forwarder relayer successfully", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+		relayer.ContractTag = ""
+
+		mockRelayerDA.EXPECT().Search(gomock.Any(), &entities.RelayerFilters{Names: []string{relayer.Name}, TenantID: userInfo.TenantID},
+			userInfo.AllowedTenants, userInfo.Username).Return([]*entities.Relayer{}, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), relayer.Account.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(testdata.FakeAccount(), nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), relayer.ContractName, entities.DefaultContractTagValue, userInfo).
+			Return(testdata.FakeForwarderContract(), nil)
+		mockRelayerDA.EXPECT().Insert(gomock.Any(), relayer).Return(relayer, nil)
+
+		resp, err := usecase.Execute(ctx, relayer, chain.Name, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, chain.UUID, resp.ChainUUID)
+		assert.Equal(t, userInfo.TenantID, resp.TenantID)
+		assert.Equal(t, userInfo.Username, resp.OwnerID)
+		assert.Equal(t, entities.DefaultContractTagValue, resp.ContractTag)
+	})
+
+	t.Run("should fail with AlreadyExistsError if name is already used", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+
+		mockRelayerDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.Relayer{relayer}, nil)
+
+		_, err := usecase.Execute(ctx, relayer, chain.Name, userInfo)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if account does not exist", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+
+		mockRelayerDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.Relayer{}, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), relayer.Account.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		_, err := usecase.Execute(ctx, relayer, chain.Name, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if contract does not implement the relayer type", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+		relayer.Type = entities.RelayerTypeEntryPoint
+
+		mockRelayerDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).
+			Return([]*entities.Relayer{}, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), relayer.Account.Hex(), userInfo.AllowedTenants, userInfo.Username).
+			Return(testdata.FakeAccount(), nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), relayer.ContractName, relayer.ContractTag, userInfo).
+			Return(testdata.FakeForwarderContract(), nil)
+
+		_, err := usecase.Execute(ctx, relayer, chain.Name, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
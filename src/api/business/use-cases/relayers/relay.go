@@ -0,0 +1,189 @@
+package relayers
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/metatx"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	eth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const relayTxComponent = "use-cases.relay-tx"
+
+type relayUseCase struct {
+	db             store.DB
+	searchChainsUC usecases.SearchChainsUseCase
+	getContractUC  usecases.GetContractUseCase
+	sendTxUC       usecases.SendTxUseCase
+	ec             ethclient.Client
+	logger         *log.Logger
+}
+
+func NewRelayUseCase(
+	db store.DB,
+	searchChainsUC usecases.SearchChainsUseCase,
+	getContractUC usecases.GetContractUseCase,
+	sendTxUC usecases.SendTxUseCase,
+	ec ethclient.Client,
+) usecases.RelayTxUseCase {
+	return &relayUseCase{
+		db:             db,
+		searchChainsUC: searchChainsUC,
+		getContractUC:  getContractUC,
+		sendTxUC:       sendTxUC,
+		ec:             ec,
+		logger:         log.NewLogger().SetComponent(relayTxComponent),
+	}
+}
+
+func (uc *relayUseCase) Execute(ctx context.Context, txRequest *entities.TxRequest, metaTx *entities.MetaTransaction,
+	userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+	ctx = log.WithFields(ctx, log.Field("chain", txRequest.ChainName), log.Field("relayer_type", metaTx.RelayerType()))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("relaying meta transaction")
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{txRequest.ChainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(relayTxComponent)
+	}
+
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(relayTxComponent)
+	}
+	chain := chains[0]
+
+	relayer, err := uc.selectRelayer(ctx, chain.UUID, metaTx.RelayerType(), userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(relayTxComponent)
+	}
+	logger = logger.WithField("relayer", relayer.UUID)
+
+	// The forwarder contract is resolved in the registry of the relayer owner, who may be another tenant
+	contract, err := uc.getContractUC.Execute(ctx, relayer.ContractName, relayer.ContractTag,
+		multitenancy.NewUserInfo(relayer.TenantID, relayer.OwnerID))
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(relayTxComponent)
+	}
+
+	var txData []byte
+	switch metaTx.RelayerType() {
+	case entities.RelayerTypeEntryPoint:
+		// User operations are validated on chain by the sender smart account, the relayer receives the gas refund
+		txData, err = metatx.EncodeHandleOps(&contract.ABI, []*entities.UserOperation{metaTx.UserOperation}, relayer.Account)
+	default:
+		err = uc.verifyForwardRequest(ctx, chain, relayer, &contract.ABI, metaTx)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(relayTxComponent)
+		}
+		txData, err = metatx.EncodeExecute(&contract.ABI, metaTx.ForwardRequest, metaTx.Signature)
+	}
+	if err != nil {
+		errMsg := "failed to encode meta transaction"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(relayTxComponent)
+	}
+
+	if txRequest.Params == nil {
+		txRequest.Params = &entities.TxRequestParams{}
+	}
+	if txRequest.Params.ETHTransaction == nil {
+		txRequest.Params.ETHTransaction = &entities.ETHTransaction{}
+	}
+	txRequest.Params.From = &relayer.Account
+	txRequest.Params.To = &relayer.ContractAddress
+	if txRequest.Labels == nil {
+		txRequest.Labels = map[string]string{}
+	}
+	txRequest.Labels[entities.RelayerLabel] = relayer.UUID
+	if txRequest.InternalData == nil {
+		txRequest.InternalData = &entities.InternalData{}
+	}
+
+	txRequest, err = uc.sendTxUC.Execute(ctx, txRequest, txData, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(relayTxComponent)
+	}
+
+	logger.WithField("schedule", txRequest.Schedule.UUID).Info("meta transaction relayed successfully")
+	return txRequest, nil
+}
+
+// selectRelayer selects the relayer registered by the tenant on the chain, falling back on a relayer shared by another allowed tenant
+func (uc *relayUseCase) selectRelayer(ctx context.Context, chainUUID string, relayerType entities.RelayerType,
+	userInfo *multitenancy.UserInfo) (*entities.Relayer, error) {
+	relayers, err := uc.db.Relayer().Search(ctx, &entities.RelayerFilters{
+		ChainUUID: chainUUID,
+		Type:      relayerType,
+	}, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(relayers) == 0 {
+		errMsg := "no relayer registered on chain"
+		uc.logger.WithContext(ctx).Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg)
+	}
+
+	for _, relayer := range relayers {
+		if relayer.TenantID == userInfo.TenantID {
+			return relayer, nil
+		}
+	}
+
+	return relayers[0], nil
+}
+
+func (uc *relayUseCase) verifyForwardRequest(ctx context.Context, chain *entities.Chain, relayer *entities.Relayer,
+	forwarderABI *abi.ABI, metaTx *entities.MetaTransaction) error {
+	logger := uc.logger.WithContext(ctx)
+	req := metaTx.ForwardRequest
+
+	// The domain fields are hashed in the order of the EIP-712 specification (name, version, chainId, verifyingContract)
+	// used by ERC-2771 forwarders, which differs from the order used by the key manager typed data signature
+	hash := metatx.ForwardRequestHash(relayer.DomainName, relayer.DomainVersion, chain.ChainID, relayer.ContractAddress, req)
+	signer, err := metatx.RecoverSigner(hash, metaTx.Signature)
+	if err != nil || signer != req.From {
+		errMsg := "signature does not match the forward request and sender"
+		logger.WithField("from", req.From).Error(errMsg)
+		return errors.InvalidParameterError(errMsg)
+	}
+
+	if _, ok := forwarderABI.Methods[metatx.VerifyMethod]; !ok {
+		return nil
+	}
+
+	// The forwarder also checks the nonce of the sender, avoiding to pay for requests that would revert
+	data, err := metatx.EncodeVerify(forwarderABI, req, metaTx.Signature)
+	if err != nil {
+		return errors.InvalidParameterError("failed to encode forward request verification")
+	}
+
+	for _, uri := range chain.URLs {
+		output, der := uc.ec.CallContract(ctx, uri, &eth.CallMsg{To: &relayer.ContractAddress, Data: data}, nil)
+		if der != nil {
+			logger.WithError(der).WithField("url", uri).Warn("failed to verify forward request")
+			continue
+		}
+
+		valid, der := metatx.DecodeVerify(forwarderABI, output)
+		if der != nil || !valid {
+			errMsg := "forward request rejected by forwarder"
+			logger.WithField("nonce", req.Nonce).Error(errMsg)
+			return errors.InvalidParameterError(errMsg)
+		}
+
+		return nil
+	}
+
+	return errors.EthConnectionError("failed to verify forward request on all chain URLs")
+}
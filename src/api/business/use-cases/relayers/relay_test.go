@@ -0,0 +1,149 @@
+// +build unit
+
+package relayers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/metatx"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayTx_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockRelayerDA := mocks2.NewMockRelayerAgent(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockSendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+	mockEthClient := mock.NewMockClient(ctrl)
+
+	mockDB.EXPECT().Relayer().Return(mockRelayerDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewRelayUseCase(mockDB, mockSearchChainsUC, mockGetContractUC, mockSendTxUC, mockEthClient)
+
+	chain := testdata.FakeChain()
+	forwarder := testdata.FakeForwarderContract()
+	boolType, _ := abi.NewType("bool", "", nil)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signedForwardRequest := func(relayer *entities.Relayer) *entities.MetaTransaction {
+		req := testdata.FakeForwardRequest()
+		req.From = crypto.PubkeyToAddress(key.PublicKey)
+		hash := metatx.ForwardRequestHash(relayer.DomainName, relayer.DomainVersion, chain.ChainID, relayer.ContractAddress, req)
+		sig, der := crypto.Sign(hash.Bytes(), key)
+		require.NoError(t, der)
+		sig[64] += 27
+		return &entities.MetaTransaction{ForwardRequest: req, Signature: sig}
+	}
+
+	t.Run("should relay a forward request through the relayer of the tenant", func(t *testing.T) {
+		sharedRelayer := testdata.FakeRelayer()
+		sharedRelayer.TenantID = multitenancy.DefaultTenant
+		relayer := testdata.FakeRelayer()
+		relayer.ChainUUID = chain.UUID
+		metaTx := signedForwardRequest(relayer)
+		txRequest := testdata.FakeTxRequest()
+		txRequest.ChainName = chain.Name
+
+		validOutput, _ := abi.Arguments{{Type: boolType}}.Pack(true)
+		expectedData, _ := metatx.EncodeExecute(&forwarder.ABI, metaTx.ForwardRequest, metaTx.Signature)
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockRelayerDA.EXPECT().Search(gomock.Any(), &entities.RelayerFilters{ChainUUID: chain.UUID, Type: entities.RelayerTypeForwarder},
+			userInfo.AllowedTenants, userInfo.Username).Return([]*entities.Relayer{sharedRelayer, relayer}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), relayer.ContractName, relayer.ContractTag,
+			multitenancy.NewUserInfo(relayer.TenantID, relayer.OwnerID)).Return(forwarder, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), nil).Return(validOutput, nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, expectedData, userInfo).Return(txRequest, nil)
+
+		resp, err := usecase.Execute(ctx, txRequest, metaTx, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, relayer.Account, *resp.Params.From)
+		assert.Equal(t, relayer.ContractAddress, *resp.Params.To)
+		assert.Equal(t, relayer.UUID, resp.Labels[entities.RelayerLabel])
+	})
+
+	t.Run("should relay a user operation through the entry point", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+		relayer.Type = entities.RelayerTypeEntryPoint
+		entryPoint := testdata.FakeEntryPointContract()
+		metaTx := &entities.MetaTransaction{UserOperation: testdata.FakeUserOperation()}
+		txRequest := testdata.FakeTxRequest()
+
+		expectedData, _ := metatx.EncodeHandleOps(&entryPoint.ABI, []*entities.UserOperation{metaTx.UserOperation}, relayer.Account)
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockRelayerDA.EXPECT().Search(gomock.Any(), &entities.RelayerFilters{ChainUUID: chain.UUID, Type: entities.RelayerTypeEntryPoint},
+			userInfo.AllowedTenants, userInfo.Username).Return([]*entities.Relayer{relayer}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), relayer.ContractName, relayer.ContractTag,
+			multitenancy.NewUserInfo(relayer.TenantID, relayer.OwnerID)).Return(entryPoint, nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, expectedData, userInfo).Return(txRequest, nil)
+
+		_, err := usecase.Execute(ctx, txRequest, metaTx, userInfo)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with InvalidParameterError if no relayer is registered on the chain", func(t *testing.T) {
+		metaTx := &entities.MetaTransaction{UserOperation: testdata.FakeUserOperation()}
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockRelayerDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return([]*entities.Relayer{}, nil)
+
+		_, err := usecase.Execute(ctx, testdata.FakeTxRequest(), metaTx, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the signature does not match the sender", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+		metaTx := signedForwardRequest(relayer)
+		metaTx.ForwardRequest.Nonce++
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockRelayerDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return([]*entities.Relayer{relayer}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), relayer.ContractName, relayer.ContractTag,
+			multitenancy.NewUserInfo(relayer.TenantID, relayer.OwnerID)).Return(forwarder, nil)
+
+		_, err := usecase.Execute(ctx, testdata.FakeTxRequest(), metaTx, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the forwarder rejects the request", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+		metaTx := signedForwardRequest(relayer)
+		invalidOutput, _ := abi.Arguments{{Type: boolType}}.Pack(false)
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockRelayerDA.EXPECT().Search(gomock.Any(), gomock.Any(), userInfo.AllowedTenants, userInfo.Username).Return([]*entities.Relayer{relayer}, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), relayer.ContractName, relayer.ContractTag,
+			multitenancy.NewUserInfo(relayer.TenantID, relayer.OwnerID)).Return(forwarder, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), nil).Return(invalidOutput, nil)
+
+		_, err := usecase.Execute(ctx, testdata.FakeTxRequest(), metaTx, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
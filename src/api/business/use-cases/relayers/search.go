@@ -0,0 +1,36 @@
+package relayers
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchRelayersComponent = "use-cases.search-relayers"
+
+type searchUseCase struct {
+	db     store.RelayerAgent
+	logger *log.Logger
+}
+
+func NewSearchUseCase(db store.RelayerAgent) usecases.SearchRelayersUseCase {
+	return &searchUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchRelayersComponent),
+	}
+}
+
+func (uc *searchUseCase) Execute(ctx context.Context, filters *entities.RelayerFilters, userInfo *multitenancy.UserInfo) ([]*entities.Relayer, error) {
+	relayers, err := uc.db.Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchRelayersComponent)
+	}
+
+	uc.logger.Debug("relayers found successfully")
+	return relayers, nil
+}
@@ -0,0 +1,36 @@
+package relayers
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchRelaySpendingsComponent = "use-cases.search-relay-spendings"
+
+type searchSpendingsUseCase struct {
+	db     store.RelayerAgent
+	logger *log.Logger
+}
+
+func NewSearchSpendingsUseCase(db store.RelayerAgent) usecases.SearchRelaySpendingsUseCase {
+	return &searchSpendingsUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchRelaySpendingsComponent),
+	}
+}
+
+func (uc *searchSpendingsUseCase) Execute(ctx context.Context, filters *entities.RelaySpendingFilters, userInfo *multitenancy.UserInfo) ([]*entities.RelaySpending, error) {
+	spendings, err := uc.db.SearchSpendings(ctx, filters, userInfo.AllowedTenants)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchRelaySpendingsComponent)
+	}
+
+	uc.logger.Debug("relay spendings found successfully")
+	return spendings, nil
+}
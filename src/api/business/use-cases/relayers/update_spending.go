@@ -0,0 +1,66 @@
+package relayers
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const updateRelaySpendingComponent = "use-cases.update-relay-spending"
+
+type updateSpendingUseCase struct {
+	db     store.RelayerAgent
+	logger *log.Logger
+}
+
+func NewUpdateSpendingUseCase(db store.RelayerAgent) usecases.UpdateRelaySpendingUseCase {
+	return &updateSpendingUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(updateRelaySpendingComponent),
+	}
+}
+
+func (uc *updateSpendingUseCase) Execute(ctx context.Context, job *entities.Job) error {
+	relayerUUID, ok := job.Labels[entities.RelayerLabel]
+	if !ok || job.Status != entities.StatusMined || job.Receipt == nil {
+		return nil
+	}
+
+	ctx = log.WithFields(ctx, log.Field("relayer", relayerUUID), log.Field("job", job.UUID))
+	logger := uc.logger.WithContext(ctx)
+
+	// The effective gas price is set as gas price of mined jobs
+	gasPrice := big.NewInt(0)
+	if job.Transaction != nil && job.Transaction.GasPrice != nil {
+		gasPrice = job.Transaction.GasPrice.ToInt()
+	} else if effectiveGasPrice, err := hexutil.DecodeBig(job.Receipt.EffectiveGasPrice); err == nil {
+		gasPrice = effectiveGasPrice
+	}
+
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(job.Receipt.GasUsed))
+	added, err := uc.db.AddSpending(ctx, job.UUID, &entities.RelaySpending{
+		RelayerUUID: relayerUUID,
+		TenantID:    job.TenantID,
+		TxCount:     1,
+		GasUsed:     job.Receipt.GasUsed,
+		Fee:         (*hexutil.Big)(fee),
+	})
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(updateRelaySpendingComponent)
+	}
+
+	// The job update can be received several times, its spending being counted on the first one
+	if !added {
+		logger.Debug("relay spending already counted for job")
+		return nil
+	}
+
+	logger.WithField("fee", fee.String()).Debug("relay spending updated successfully")
+	return nil
+}
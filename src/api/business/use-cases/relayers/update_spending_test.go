@@ -0,0 +1,88 @@
+// +build unit
+
+package relayers
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	ethtestdata "github.com/consensys/orchestrate/pkg/types/ethereum/testdata"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateRelaySpending_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRelayerDA := mocks2.NewMockRelayerAgent(ctrl)
+	usecase := NewUpdateSpendingUseCase(mockRelayerDA)
+
+	t.Run("should add the fee of the mined job to the spending of the tenant", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Status = entities.StatusMined
+		job.Labels = map[string]string{entities.RelayerLabel: "relayerUUID"}
+		job.Transaction.GasPrice = (*hexutil.Big)(big.NewInt(10))
+		job.Receipt = ethtestdata.FakeReceipt()
+		job.Receipt.GasUsed = 21000
+
+		mockRelayerDA.EXPECT().AddSpending(gomock.Any(), job.UUID, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, jobUUID string, spending *entities.RelaySpending) (bool, error) {
+				assert.Equal(t, "relayerUUID", spending.RelayerUUID)
+				assert.Equal(t, job.TenantID, spending.TenantID)
+				assert.Equal(t, uint64(1), spending.TxCount)
+				assert.Equal(t, uint64(21000), spending.GasUsed)
+				assert.Equal(t, big.NewInt(210000), spending.Fee.ToInt())
+				return true, nil
+			})
+
+		err := usecase.Execute(ctx, job)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should succeed if the spending of the job is already counted", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Status = entities.StatusMined
+		job.Labels = map[string]string{entities.RelayerLabel: "relayerUUID"}
+		job.Receipt = ethtestdata.FakeReceipt()
+
+		mockRelayerDA.EXPECT().AddSpending(gomock.Any(), job.UUID, gomock.Any()).Return(false, nil)
+
+		err := usecase.Execute(ctx, job)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if spending cannot be added", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Status = entities.StatusMined
+		job.Labels = map[string]string{entities.RelayerLabel: "relayerUUID"}
+		job.Receipt = ethtestdata.FakeReceipt()
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockRelayerDA.EXPECT().AddSpending(gomock.Any(), job.UUID, gomock.Any()).Return(false, expectedErr)
+
+		err := usecase.Execute(ctx, job)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateRelaySpendingComponent), err)
+	})
+
+	t.Run("should ignore jobs not sent by a relayer", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Status = entities.StatusMined
+		job.Receipt = ethtestdata.FakeReceipt()
+
+		err := usecase.Execute(ctx, job)
+
+		require.NoError(t, err)
+	})
+}
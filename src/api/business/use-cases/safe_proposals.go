@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+//go:generate mockgen -source=safe_proposals.go -destination=mocks/safe_proposals.go -package=mocks
+
+type SafeProposalUseCases interface {
+	Create() CreateSafeProposalUseCase
+	Get() GetSafeProposalUseCase
+	Search() SearchSafeProposalsUseCase
+	Sign() SignSafeProposalUseCase
+}
+
+type CreateSafeProposalUseCase interface {
+	Execute(ctx context.Context, proposal *entities.SafeProposal, chainName string, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error)
+}
+
+type GetSafeProposalUseCase interface {
+	Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error)
+}
+
+type SearchSafeProposalsUseCase interface {
+	Execute(ctx context.Context, filters *entities.SafeProposalFilters, userInfo *multitenancy.UserInfo) ([]*entities.SafeProposal, error)
+}
+
+// SignSafeProposalUseCase adds the signature of an owner to a proposal, signing with the owner account when no signature is provided,
+// and sends the execTransaction once the threshold is reached
+type SignSafeProposalUseCase interface {
+	Execute(ctx context.Context, uuid string, signature *entities.SafeSignature, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error)
+}
+
+// UpdateSafeProposalExecutionUseCase updates the proposal executed by a mined or failed job
+type UpdateSafeProposalExecutionUseCase interface {
+	Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error
+	WithDB(db store.DB) UpdateSafeProposalExecutionUseCase
+}
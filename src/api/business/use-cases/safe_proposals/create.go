@@ -0,0 +1,136 @@
+package safeproposals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/safe"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	eth "github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const createSafeProposalComponent = "use-cases.create-safe-proposal"
+
+type createUseCase struct {
+	db             store.DB
+	searchChainsUC usecases.SearchChainsUseCase
+	ec             ethclient.Client
+	logger         *log.Logger
+}
+
+func NewCreateUseCase(db store.DB, searchChainsUC usecases.SearchChainsUseCase, ec ethclient.Client) usecases.CreateSafeProposalUseCase {
+	return &createUseCase{
+		db:             db,
+		searchChainsUC: searchChainsUC,
+		ec:             ec,
+		logger:         log.NewLogger().SetComponent(createSafeProposalComponent),
+	}
+}
+
+type safeState struct {
+	owners    []ethcommon.Address
+	threshold uint64
+	nonce     uint64
+}
+
+// Execute reads the owners, threshold and nonce of the Safe on chain and registers a new proposal waiting for signatures
+func (uc *createUseCase) Execute(ctx context.Context, proposal *entities.SafeProposal, chainName string,
+	userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	ctx = log.WithFields(ctx, log.Field("safe", proposal.SafeAddress), log.Field("chain", chainName))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating safe proposal")
+
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createSafeProposalComponent)
+	}
+
+	if len(chains) == 0 {
+		errMsg := "chain does not exist"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(createSafeProposalComponent)
+	}
+	chain := chains[0]
+
+	state, err := uc.readSafeState(ctx, chain.URLs, proposal.SafeAddress)
+	if err != nil {
+		logger.WithError(err).Error("failed to read safe state")
+		return nil, errors.FromError(err).ExtendComponent(createSafeProposalComponent)
+	}
+
+	// Proposals cannot reuse an already executed nonce, the current Safe nonce is used by default
+	if proposal.Transaction.Nonce < state.nonce {
+		proposal.Transaction.Nonce = state.nonce
+	}
+
+	proposal.ChainUUID = chain.UUID
+	proposal.Owners = state.owners
+	proposal.Threshold = state.threshold
+	proposal.SafeTxHash = safe.TxHash(chain.ChainID, proposal.SafeAddress, proposal.Transaction)
+	proposal.Signatures = []*entities.SafeSignature{}
+	proposal.Status = entities.SafeProposalStatusPending
+	proposal.TenantID = userInfo.TenantID
+	proposal.OwnerID = userInfo.Username
+
+	proposal, err = uc.db.SafeProposal().Insert(ctx, proposal)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createSafeProposalComponent)
+	}
+
+	logger.WithField("safe_proposal", proposal.UUID).Info("safe proposal created successfully")
+	return proposal, nil
+}
+
+func (uc *createUseCase) readSafeState(ctx context.Context, uris []string, safeAddress ethcommon.Address) (*safeState, error) {
+	var err error
+	for _, uri := range uris {
+		state := &safeState{}
+		var output []byte
+
+		output, err = uc.call(ctx, uri, safeAddress, "getOwners")
+		if err != nil {
+			continue
+		}
+		state.owners, err = safe.DecodeOwners(output)
+		if err != nil {
+			return nil, errors.InvalidParameterError("address is not a Safe contract")
+		}
+
+		output, err = uc.call(ctx, uri, safeAddress, "getThreshold")
+		if err != nil {
+			continue
+		}
+		state.threshold, err = safe.DecodeUint("getThreshold", output)
+		if err != nil {
+			return nil, errors.InvalidParameterError("address is not a Safe contract")
+		}
+
+		output, err = uc.call(ctx, uri, safeAddress, "nonce")
+		if err != nil {
+			continue
+		}
+		state.nonce, err = safe.DecodeUint("nonce", output)
+		if err != nil {
+			return nil, errors.InvalidParameterError("address is not a Safe contract")
+		}
+
+		return state, nil
+	}
+
+	return nil, errors.EthConnectionError("failed to call safe contract on all chain URLs")
+}
+
+func (uc *createUseCase) call(ctx context.Context, uri string, safeAddress ethcommon.Address, method string) ([]byte, error) {
+	data, err := safe.EncodeCall(method)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.ec.CallContract(ctx, uri, &eth.CallMsg{To: &safeAddress, Data: data}, nil)
+}
@@ -0,0 +1,124 @@
+// +build unit
+
+package safeproposals
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/safe"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	eth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSafeProposal_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockSafeProposalDA := mocks2.NewMockSafeProposalAgent(ctrl)
+	mockSearchChainsUC := mocks.NewMockSearchChainsUseCase(ctrl)
+	mockEthClient := mock.NewMockClient(ctrl)
+
+	mockDB.EXPECT().SafeProposal().Return(mockSafeProposalDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateUseCase(mockDB, mockSearchChainsUC, mockEthClient)
+
+	owners := []ethcommon.Address{
+		ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+		ethcommon.HexToAddress("0xdbb881a51CD4023E4400CEF3ef73046743f08da3"),
+	}
+	addressesType, _ := abi.NewType("address[]", "", nil)
+	uintType, _ := abi.NewType("uint256", "", nil)
+	ownersOutput, err := abi.Arguments{{Type: addressesType}}.Pack(owners)
+	require.NoError(t, err)
+	thresholdOutput, err := abi.Arguments{{Type: uintType}}.Pack(big.NewInt(2))
+	require.NoError(t, err)
+	nonceOutput, err := abi.Arguments{{Type: uintType}}.Pack(big.NewInt(5))
+	require.NoError(t, err)
+
+	outputs := map[string][]byte{}
+	for method, output := range map[string][]byte{"getOwners": ownersOutput, "getThreshold": thresholdOutput, "nonce": nonceOutput} {
+		data, der := safe.EncodeCall(method)
+		require.NoError(t, der)
+		outputs[string(data)] = output
+	}
+	safeCall := func(ctx context.Context, url string, msg *eth.CallMsg, _ *big.Int) ([]byte, error) {
+		return outputs[string(msg.Data)], nil
+	}
+
+	t.Run("should create safe proposal successfully", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+		chain := testdata.FakeChain()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), nil).DoAndReturn(safeCall).Times(3)
+		mockSafeProposalDA.EXPECT().Insert(gomock.Any(), proposal).Return(proposal, nil)
+
+		resp, err := usecase.Execute(ctx, proposal, chain.Name, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, chain.UUID, resp.ChainUUID)
+		assert.Equal(t, owners, resp.Owners)
+		assert.Equal(t, uint64(2), resp.Threshold)
+		assert.Equal(t, uint64(5), resp.Transaction.Nonce)
+		assert.Equal(t, safe.TxHash(chain.ChainID, proposal.SafeAddress, proposal.Transaction), resp.SafeTxHash)
+		assert.Equal(t, entities.SafeProposalStatusPending, resp.Status)
+	})
+
+	t.Run("should fail with InvalidParameterError if chain does not exist", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{"notFound"}}, userInfo).
+			Return([]*entities.Chain{}, nil)
+
+		resp, err := usecase.Execute(ctx, proposal, "notFound", userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if address is not a Safe", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+		chain := testdata.FakeChain()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), chain.URLs[0], gomock.Any(), nil).Return([]byte{}, nil)
+
+		resp, err := usecase.Execute(ctx, proposal, chain.Name, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with EthConnectionError if chain cannot be reached", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+		chain := testdata.FakeChain()
+
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{chain.Name}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockEthClient.EXPECT().CallContract(gomock.Any(), gomock.Any(), gomock.Any(), nil).
+			Return(nil, errors.EthConnectionError("error")).Times(len(chain.URLs))
+
+		resp, err := usecase.Execute(ctx, proposal, chain.Name, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsEthConnectionError(err))
+	})
+}
@@ -0,0 +1,38 @@
+package safeproposals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const getSafeProposalComponent = "use-cases.get-safe-proposal"
+
+type getUseCase struct {
+	db     store.SafeProposalAgent
+	logger *log.Logger
+}
+
+func NewGetUseCase(db store.SafeProposalAgent) usecases.GetSafeProposalUseCase {
+	return &getUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getSafeProposalComponent),
+	}
+}
+
+func (uc *getUseCase) Execute(ctx context.Context, uuid string, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	ctx = log.WithFields(ctx, log.Field("safe_proposal", uuid))
+
+	proposal, err := uc.db.FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getSafeProposalComponent)
+	}
+
+	uc.logger.WithContext(ctx).Debug("safe proposal found successfully")
+	return proposal, nil
+}
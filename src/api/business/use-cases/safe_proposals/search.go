@@ -0,0 +1,36 @@
+package safeproposals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchSafeProposalsComponent = "use-cases.search-safe-proposals"
+
+type searchUseCase struct {
+	db     store.SafeProposalAgent
+	logger *log.Logger
+}
+
+func NewSearchUseCase(db store.SafeProposalAgent) usecases.SearchSafeProposalsUseCase {
+	return &searchUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchSafeProposalsComponent),
+	}
+}
+
+func (uc *searchUseCase) Execute(ctx context.Context, filters *entities.SafeProposalFilters, userInfo *multitenancy.UserInfo) ([]*entities.SafeProposal, error) {
+	proposals, err := uc.db.Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchSafeProposalsComponent)
+	}
+
+	uc.logger.WithContext(ctx).Debug("safe proposals found successfully")
+	return proposals, nil
+}
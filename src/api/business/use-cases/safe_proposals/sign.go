@@ -0,0 +1,224 @@
+package safeproposals
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/safe"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	signerinfra "github.com/consensys/orchestrate/src/infra/signer"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const signSafeProposalComponent = "use-cases.sign-safe-proposal"
+
+type signUseCase struct {
+	db         store.DB
+	signer     signerinfra.Signer
+	qkmStoreID string
+	sendTxUC   usecases.SendTxUseCase
+	notifyUC   usecases.NotifySafeProposalUseCase
+	logger     *log.Logger
+}
+
+func NewSignUseCase(
+	db store.DB,
+	signer signerinfra.Signer,
+	qkmStoreID string,
+	sendTxUC usecases.SendTxUseCase,
+	notifyUC usecases.NotifySafeProposalUseCase,
+) usecases.SignSafeProposalUseCase {
+	return &signUseCase{
+		db:         db,
+		signer:     signer,
+		qkmStoreID: qkmStoreID,
+		sendTxUC:   sendTxUC,
+		notifyUC:   notifyUC,
+		logger:     log.NewLogger().SetComponent(signSafeProposalComponent),
+	}
+}
+
+// Execute is idempotent: signing twice with the same owner does not add a new signature but retries the execution
+// if the threshold is reached and the execTransaction could not be sent
+func (uc *signUseCase) Execute(ctx context.Context, uuid string, signature *entities.SafeSignature,
+	userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	ctx = log.WithFields(ctx, log.Field("safe_proposal", uuid), log.Field("signer", signature.Signer))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("signing safe proposal")
+
+	proposal, err := uc.db.SafeProposal().FindOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(signSafeProposalComponent)
+	}
+
+	if proposal.Status != entities.SafeProposalStatusPending {
+		errMsg := "safe proposal is not waiting for signatures"
+		logger.WithField("status", proposal.Status).Error(errMsg)
+		return nil, errors.InvalidStateError(errMsg).ExtendComponent(signSafeProposalComponent)
+	}
+
+	if !proposal.IsOwner(signature.Signer) {
+		errMsg := "signer is not an owner of the safe"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(signSafeProposalComponent)
+	}
+
+	if !proposal.HasSigned(signature.Signer) {
+		if len(signature.Signature) == 0 {
+			signature.Signature, err = uc.signWithAccount(ctx, proposal, signature.Signer, userInfo)
+			if err != nil {
+				return nil, errors.FromError(err).ExtendComponent(signSafeProposalComponent)
+			}
+		} else if signer, der := safe.RecoverSigner(proposal.SafeTxHash, signature.Signature); der != nil || signer != signature.Signer {
+			errMsg := "signature does not match the safe transaction hash and signer"
+			logger.Error(errMsg)
+			return nil, errors.InvalidParameterError(errMsg).ExtendComponent(signSafeProposalComponent)
+		}
+
+		proposal, err = uc.addSignature(ctx, uuid, signature, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(signSafeProposalComponent)
+		}
+
+		err = uc.notifyUC.Execute(ctx, proposal, entities.NotificationTypeSafeProposalSigned, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(signSafeProposalComponent)
+		}
+	}
+
+	if proposal.Status == entities.SafeProposalStatusPending && proposal.ThresholdReached() {
+		proposal, err = uc.execute(ctx, proposal, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(signSafeProposalComponent)
+		}
+	}
+
+	logger.WithField("signatures", len(proposal.Signatures)).Info("safe proposal signed successfully")
+	return proposal, nil
+}
+
+func (uc *signUseCase) signWithAccount(ctx context.Context, proposal *entities.SafeProposal, signer ethcommon.Address,
+	userInfo *multitenancy.UserInfo) ([]byte, error) {
+	logger := uc.logger.WithContext(ctx)
+
+	acc, err := uc.db.Account().FindOneByAddress(ctx, signer.Hex(), userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if !acc.CanSign(nil) {
+		errMsg := "account is not allowed to sign"
+		logger.WithField("status", acc.Status).Error(errMsg)
+		return nil, errors.InvalidStateError(errMsg)
+	}
+
+	storeID := acc.StoreID
+	if storeID == "" {
+		storeID = uc.qkmStoreID
+	}
+
+	// Owners sign the Safe transaction hash following EIP-191 (eth_sign), which is supported by the Safe contract
+	sigHex, err := uc.signer.SignMessage(ctx, storeID, signer.Hex(), &qkmtypes.SignMessageRequest{
+		Message: proposal.SafeTxHash.Bytes(),
+	})
+	if err != nil {
+		errMsg := "failed to sign safe transaction hash"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.DependencyFailureError(errMsg)
+	}
+
+	sig, err := hexutil.Decode(sigHex)
+	if err != nil {
+		errMsg := "invalid signature returned by key manager"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.DependencyFailureError(errMsg)
+	}
+
+	return safe.FromEthSign(sig)
+}
+
+func (uc *signUseCase) addSignature(ctx context.Context, uuid string, signature *entities.SafeSignature,
+	userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	var proposal *entities.SafeProposal
+	err := uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		var der error
+		proposal, der = dbtx.SafeProposal().LockOneByUUID(ctx, uuid, userInfo.AllowedTenants, userInfo.Username)
+		if der != nil {
+			return der
+		}
+
+		// Another request could have been processed while signing
+		if proposal.Status != entities.SafeProposalStatusPending {
+			return errors.InvalidStateError("safe proposal is not waiting for signatures")
+		}
+		if proposal.HasSigned(signature.Signer) {
+			return nil
+		}
+
+		signature.CreatedAt = time.Now().UTC()
+		proposal.Signatures = append(proposal.Signatures, signature)
+		proposal, der = dbtx.SafeProposal().Update(ctx, proposal)
+		return der
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+func (uc *signUseCase) execute(ctx context.Context, proposal *entities.SafeProposal, userInfo *multitenancy.UserInfo) (*entities.SafeProposal, error) {
+	logger := uc.logger.WithContext(ctx)
+
+	chain, err := uc.db.Chain().FindOneByUUID(ctx, proposal.ChainUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := safe.EncodeExecTransaction(proposal.Transaction, proposal.Signatures)
+	if err != nil {
+		errMsg := "failed to encode execTransaction"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg)
+	}
+
+	labels := map[string]string{}
+	for k, v := range proposal.Labels {
+		labels[k] = v
+	}
+	labels[entities.SafeProposalLabel] = proposal.UUID
+
+	// The proposal UUID is used as idempotency key so that the execTransaction is sent only once
+	txRequest, err := uc.sendTxUC.Execute(ctx, &entities.TxRequest{
+		IdempotencyKey: proposal.UUID,
+		ChainName:      chain.Name,
+		Params: &entities.TxRequestParams{
+			ETHTransaction: &entities.ETHTransaction{
+				From: &proposal.Sender,
+				To:   &proposal.SafeAddress,
+			},
+		},
+		Labels:       labels,
+		InternalData: &entities.InternalData{},
+	}, txData, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.Status = entities.SafeProposalStatusExecuting
+	proposal.ScheduleUUID = txRequest.Schedule.UUID
+	proposal, err = uc.db.SafeProposal().Update(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.WithField("schedule", proposal.ScheduleUUID).Info("safe proposal threshold reached, execTransaction sent")
+	return proposal, nil
+}
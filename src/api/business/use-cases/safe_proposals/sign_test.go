@@ -0,0 +1,153 @@
+// +build unit
+
+package safeproposals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignSafeProposal_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockSafeProposalDA := mocks2.NewMockSafeProposalAgent(ctrl)
+	mockAccountDA := mocks2.NewMockAccountAgent(ctrl)
+	mockChainDA := mocks2.NewMockChainAgent(ctrl)
+	mockKeyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockSendTxUC := mocks.NewMockSendTxUseCase(ctrl)
+	mockNotifyUC := mocks.NewMockNotifySafeProposalUseCase(ctrl)
+
+	mockDB.EXPECT().SafeProposal().Return(mockSafeProposalDA).AnyTimes()
+	mockDB.EXPECT().Account().Return(mockAccountDA).AnyTimes()
+	mockDB.EXPECT().Chain().Return(mockChainDA).AnyTimes()
+	mockDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(dbtx store.DB) error) error {
+		return persist(mockDB)
+	}).AnyTimes()
+	mockSafeProposalDA.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, proposal *entities.SafeProposal) (*entities.SafeProposal, error) {
+		return proposal, nil
+	}).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewSignUseCase(mockDB, mockKeyManagerClient, "qkmStoreID", mockSendTxUC, mockNotifyUC)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	owner := crypto.PubkeyToAddress(key.PublicKey)
+
+	fakeProposal := func() *entities.SafeProposal {
+		proposal := testdata.FakeSafeProposal()
+		proposal.Owners[1] = owner
+		return proposal
+	}
+	ownerSignature := func(proposal *entities.SafeProposal) hexutil.Bytes {
+		sig, der := crypto.Sign(proposal.SafeTxHash.Bytes(), key)
+		require.NoError(t, der)
+		sig[64] += 27
+		return sig
+	}
+
+	t.Run("should add signature successfully", func(t *testing.T) {
+		proposal := fakeProposal()
+		sig := &entities.SafeSignature{Signer: owner, Signature: ownerSignature(proposal)}
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+		mockSafeProposalDA.EXPECT().LockOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+		mockNotifyUC.EXPECT().Execute(gomock.Any(), proposal, entities.NotificationTypeSafeProposalSigned, userInfo).Return(nil)
+
+		resp, err := usecase.Execute(ctx, proposal.UUID, sig, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.SafeProposalStatusPending, resp.Status)
+		assert.Len(t, resp.Signatures, 1)
+	})
+
+	t.Run("should sign with account and send execTransaction when threshold is reached", func(t *testing.T) {
+		proposal := fakeProposal()
+		proposal.Signatures = []*entities.SafeSignature{{Signer: owner, Signature: ownerSignature(proposal)}}
+		acc := testdata.FakeAccount()
+		acc.Address = proposal.Owners[0]
+		chain := testdata.FakeChain()
+		ethSig := make([]byte, 65)
+		ethSig[64] = 27
+		txRequest := testdata.FakeTxRequest()
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+		mockAccountDA.EXPECT().FindOneByAddress(gomock.Any(), acc.Address.Hex(), userInfo.AllowedTenants, userInfo.Username).Return(acc, nil)
+		mockKeyManagerClient.EXPECT().SignMessage(gomock.Any(), acc.StoreID, acc.Address.Hex(), &qkmtypes.SignMessageRequest{
+			Message: proposal.SafeTxHash.Bytes(),
+		}).Return(hexutil.Encode(ethSig), nil)
+		mockSafeProposalDA.EXPECT().LockOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+		mockNotifyUC.EXPECT().Execute(gomock.Any(), proposal, entities.NotificationTypeSafeProposalSigned, userInfo).Return(nil)
+		mockChainDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.ChainUUID, userInfo.AllowedTenants, userInfo.Username).Return(chain, nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, req *entities.TxRequest, txData []byte, _ *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, proposal.UUID, req.IdempotencyKey)
+				assert.Equal(t, chain.Name, req.ChainName)
+				assert.Equal(t, proposal.SafeAddress, *req.Params.To)
+				assert.Equal(t, proposal.UUID, req.Labels[entities.SafeProposalLabel])
+				assert.NotEmpty(t, txData)
+				return txRequest, nil
+			})
+
+		resp, err := usecase.Execute(ctx, proposal.UUID, &entities.SafeSignature{Signer: acc.Address}, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.SafeProposalStatusExecuting, resp.Status)
+		assert.Equal(t, txRequest.Schedule.UUID, resp.ScheduleUUID)
+		assert.Len(t, resp.Signatures, 2)
+		assert.Equal(t, byte(31), []byte(resp.Signatures[1].Signature)[64])
+	})
+
+	t.Run("should fail with InvalidStateError if proposal is not pending", func(t *testing.T) {
+		proposal := fakeProposal()
+		proposal.Status = entities.SafeProposalStatusExecuted
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+
+		resp, err := usecase.Execute(ctx, proposal.UUID, &entities.SafeSignature{Signer: owner}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if signer is not an owner", func(t *testing.T) {
+		proposal := fakeProposal()
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+
+		resp, err := usecase.Execute(ctx, proposal.UUID, &entities.SafeSignature{Signer: proposal.SafeAddress}, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if signature does not match signer", func(t *testing.T) {
+		proposal := fakeProposal()
+		sig := &entities.SafeSignature{Signer: proposal.Owners[0], Signature: ownerSignature(proposal)}
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+
+		resp, err := usecase.Execute(ctx, proposal.UUID, sig, userInfo)
+
+		assert.Nil(t, resp)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
@@ -0,0 +1,89 @@
+package safeproposals
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const updateSafeProposalExecutionComponent = "use-cases.update-safe-proposal-execution"
+
+type updateExecutionUseCase struct {
+	db       store.DB
+	notifyUC usecases.NotifySafeProposalUseCase
+	logger   *log.Logger
+}
+
+func NewUpdateExecutionUseCase(db store.DB, notifyUC usecases.NotifySafeProposalUseCase) usecases.UpdateSafeProposalExecutionUseCase {
+	return &updateExecutionUseCase{
+		db:       db,
+		notifyUC: notifyUC,
+		logger:   log.NewLogger().SetComponent(updateSafeProposalExecutionComponent),
+	}
+}
+
+func (uc *updateExecutionUseCase) WithDB(db store.DB) usecases.UpdateSafeProposalExecutionUseCase {
+	dbUC := *uc
+	dbUC.db = db
+	dbUC.notifyUC = uc.notifyUC.WithDB(db)
+	return &dbUC
+}
+
+func (uc *updateExecutionUseCase) Execute(ctx context.Context, job *entities.Job, userInfo *multitenancy.UserInfo) error {
+	proposalUUID, ok := job.Labels[entities.SafeProposalLabel]
+	if !ok {
+		return nil
+	}
+
+	// Resent children jobs can fail while the parent job is still pending
+	if job.Status == entities.StatusFailed && job.InternalData.ParentJobUUID != "" {
+		return nil
+	}
+
+	ctx = log.WithFields(ctx, log.Field("safe_proposal", proposalUUID), log.Field("job", job.UUID))
+	logger := uc.logger.WithContext(ctx)
+
+	proposal, err := uc.db.SafeProposal().FindOneByUUID(ctx, proposalUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(updateSafeProposalExecutionComponent)
+	}
+
+	if proposal.ScheduleUUID != job.ScheduleUUID || proposal.Status != entities.SafeProposalStatusExecuting {
+		logger.Warn("job does not execute the safe proposal, ignoring")
+		return nil
+	}
+
+	var notifType entities.NotificationType
+	switch {
+	case job.Status == entities.StatusMined && job.Receipt != nil && job.Receipt.Status == 1:
+		proposal.Status = entities.SafeProposalStatusExecuted
+		notifType = entities.NotificationTypeSafeProposalExecuted
+	case job.Status == entities.StatusMined, job.Status == entities.StatusFailed:
+		proposal.Status = entities.SafeProposalStatusFailed
+		notifType = entities.NotificationTypeSafeProposalFailed
+	default:
+		return nil
+	}
+
+	if job.Transaction != nil {
+		proposal.TxHash = job.Transaction.Hash
+	}
+
+	proposal, err = uc.db.SafeProposal().Update(ctx, proposal)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(updateSafeProposalExecutionComponent)
+	}
+
+	err = uc.notifyUC.Execute(ctx, proposal, notifType, userInfo)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(updateSafeProposalExecutionComponent)
+	}
+
+	logger.WithField("status", proposal.Status).Info("safe proposal execution updated successfully")
+	return nil
+}
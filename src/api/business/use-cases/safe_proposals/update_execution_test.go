@@ -0,0 +1,108 @@
+// +build unit
+
+package safeproposals
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	ethtestdata "github.com/consensys/orchestrate/pkg/types/ethereum/testdata"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSafeProposalExecution_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockSafeProposalDA := mocks2.NewMockSafeProposalAgent(ctrl)
+	mockNotifyUC := mocks.NewMockNotifySafeProposalUseCase(ctrl)
+
+	mockDB.EXPECT().SafeProposal().Return(mockSafeProposalDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewUpdateExecutionUseCase(mockDB, mockNotifyUC)
+
+	fakeExecution := func(status entities.JobStatus) (*entities.SafeProposal, *entities.Job) {
+		proposal := testdata.FakeSafeProposal()
+		proposal.Status = entities.SafeProposalStatusExecuting
+		job := testdata.FakeJob()
+		job.Status = status
+		job.Labels = map[string]string{entities.SafeProposalLabel: proposal.UUID}
+		proposal.ScheduleUUID = job.ScheduleUUID
+		return proposal, job
+	}
+
+	t.Run("should set proposal as executed if execTransaction is mined successfully", func(t *testing.T) {
+		proposal, job := fakeExecution(entities.StatusMined)
+		job.Receipt = ethtestdata.FakeReceipt()
+		job.Receipt.Status = 1
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+		mockSafeProposalDA.EXPECT().Update(gomock.Any(), proposal).Return(proposal, nil)
+		mockNotifyUC.EXPECT().Execute(gomock.Any(), proposal, entities.NotificationTypeSafeProposalExecuted, userInfo).Return(nil)
+
+		err := usecase.Execute(ctx, job, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.SafeProposalStatusExecuted, proposal.Status)
+		assert.Equal(t, job.Transaction.Hash, proposal.TxHash)
+	})
+
+	t.Run("should set proposal as failed if execTransaction is reverted", func(t *testing.T) {
+		proposal, job := fakeExecution(entities.StatusMined)
+		job.Receipt = ethtestdata.FakeReceipt()
+		job.Receipt.Status = 0
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+		mockSafeProposalDA.EXPECT().Update(gomock.Any(), proposal).Return(proposal, nil)
+		mockNotifyUC.EXPECT().Execute(gomock.Any(), proposal, entities.NotificationTypeSafeProposalFailed, userInfo).Return(nil)
+
+		err := usecase.Execute(ctx, job, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.SafeProposalStatusFailed, proposal.Status)
+	})
+
+	t.Run("should ignore jobs not executing a safe proposal", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Status = entities.StatusMined
+
+		err := usecase.Execute(ctx, job, userInfo)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("should ignore job if proposal is not executed by its schedule", func(t *testing.T) {
+		proposal, job := fakeExecution(entities.StatusFailed)
+		proposal.ScheduleUUID = "otherSchedule"
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+
+		err := usecase.Execute(ctx, job, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.SafeProposalStatusExecuting, proposal.Status)
+	})
+
+	t.Run("should fail with same error if update fails", func(t *testing.T) {
+		proposal, job := fakeExecution(entities.StatusFailed)
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockSafeProposalDA.EXPECT().FindOneByUUID(gomock.Any(), proposal.UUID, userInfo.AllowedTenants, userInfo.Username).Return(proposal, nil)
+		mockSafeProposalDA.EXPECT().Update(gomock.Any(), proposal).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, job, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(updateSafeProposalExecutionComponent), err)
+	})
+}
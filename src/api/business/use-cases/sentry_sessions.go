@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+//go:generate mockgen -source=sentry_sessions.go -destination=mocks/sentry_sessions.go -package=mocks
+
+type SentrySessionUseCases interface {
+	Create() CreateSentrySessionUseCase
+	Update() UpdateSentrySessionUseCase
+	Get() GetSentrySessionUseCase
+	Search() SearchSentrySessionsUseCase
+	Delete() DeleteSentrySessionUseCase
+}
+
+// CreateSentrySessionUseCase persists the retry session of a pending job started by the tx-listener
+type CreateSentrySessionUseCase interface {
+	Execute(ctx context.Context, session *entities.SentrySession, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error)
+}
+
+// UpdateSentrySessionUseCase persists the progress of a retry session after each attempt
+type UpdateSentrySessionUseCase interface {
+	Execute(ctx context.Context, session *entities.SentrySession, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error)
+}
+
+type GetSentrySessionUseCase interface {
+	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error)
+}
+
+type SearchSentrySessionsUseCase interface {
+	Execute(ctx context.Context, filters *entities.SentrySessionFilters, userInfo *multitenancy.UserInfo) ([]*entities.SentrySession, error)
+}
+
+// DeleteSentrySessionUseCase cancels a retry session, the tx-listener stopping it before its next attempt
+type DeleteSentrySessionUseCase interface {
+	Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error
+}
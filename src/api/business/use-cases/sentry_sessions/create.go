@@ -0,0 +1,82 @@
+package sentrysessions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const createSentrySessionComponent = "use-cases.create-sentry-session"
+
+type createUseCase struct {
+	db     store.DB
+	logger *log.Logger
+}
+
+func NewCreateUseCase(db store.DB) usecases.CreateSentrySessionUseCase {
+	return &createUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(createSentrySessionComponent),
+	}
+}
+
+func (uc *createUseCase) Execute(ctx context.Context, session *entities.SentrySession, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error) {
+	ctx = log.WithFields(ctx, log.Field("job", session.JobUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("creating sentry session")
+
+	err := checkSentryUser(userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createSentrySessionComponent)
+	}
+
+	job, err := uc.db.Job().FindOneByUUID(ctx, session.JobUUID, userInfo.AllowedTenants, userInfo.Username, false)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createSentrySessionComponent)
+	}
+
+	// Sessions of jobs without retry interval would be retried continuously
+	if job.InternalData == nil || job.InternalData.RetryInterval <= 0 {
+		errMsg := "job has no retry interval"
+		logger.Error(errMsg)
+		return nil, errors.InvalidParameterError(errMsg).ExtendComponent(createSentrySessionComponent)
+	}
+
+	_, err = uc.db.SentrySession().FindOneByJobUUID(ctx, session.JobUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err == nil {
+		errMsg := "sentry session already exists"
+		logger.Error(errMsg)
+		return nil, errors.AlreadyExistsError(errMsg).ExtendComponent(createSentrySessionComponent)
+	}
+	if !errors.IsNotFoundError(err) {
+		return nil, errors.FromError(err).ExtendComponent(createSentrySessionComponent)
+	}
+
+	// Sessions belong to the owner of the job they retry
+	session.ChainUUID = job.ChainUUID
+	session.TenantID = job.TenantID
+	session.OwnerID = job.OwnerID
+
+	session, err = uc.db.SentrySession().Insert(ctx, session)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(createSentrySessionComponent)
+	}
+
+	logger.Info("sentry session created successfully")
+	return session, nil
+}
+
+// checkSentryUser restricts the management of sessions to the transaction sentry, authenticated with the API key when
+// multi-tenancy is enabled
+func checkSentryUser(userInfo *multitenancy.UserInfo) error {
+	if userInfo.AuthMode == multitenancy.AuthMethodJWT {
+		return errors.PermissionDeniedError("sentry sessions can only be managed with the API key")
+	}
+
+	return nil
+}
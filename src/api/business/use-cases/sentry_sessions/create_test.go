@@ -0,0 +1,118 @@
+// +build unit
+
+package sentrysessions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSentrySession_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks2.NewMockDB(ctrl)
+	mockJobDA := mocks2.NewMockJobAgent(ctrl)
+	mockSentrySessionDA := mocks2.NewMockSentrySessionAgent(ctrl)
+
+	mockDB.EXPECT().Job().Return(mockJobDA).AnyTimes()
+	mockDB.EXPECT().SentrySession().Return(mockSentrySessionDA).AnyTimes()
+
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	usecase := NewCreateUseCase(mockDB)
+
+	t.Run("should create a sentry session successfully", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = time.Minute
+		session := testdata.FakeSentrySession()
+		session.JobUUID = job.UUID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockSentrySessionDA.EXPECT().FindOneByJobUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+		mockSentrySessionDA.EXPECT().Insert(gomock.Any(), session).Return(session, nil)
+
+		resp, err := usecase.Execute(ctx, session, userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, job.ChainUUID, resp.ChainUUID)
+		assert.Equal(t, job.TenantID, resp.TenantID)
+		assert.Equal(t, job.OwnerID, resp.OwnerID)
+	})
+
+	t.Run("should fail with AlreadyExistsError if the job already has a session", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = time.Minute
+		session := testdata.FakeSentrySession()
+		session.JobUUID = job.UUID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockSentrySessionDA.EXPECT().FindOneByJobUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(session, nil)
+
+		_, err := usecase.Execute(ctx, session, userInfo)
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if the job has no retry interval", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = 0
+		session := testdata.FakeSentrySession()
+		session.JobUUID = job.UUID
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+
+		_, err := usecase.Execute(ctx, session, userInfo)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with PermissionDeniedError if the user is authenticated with a JWT", func(t *testing.T) {
+		jwtUserInfo := multitenancy.NewUserInfo("tenantOne", "username")
+		jwtUserInfo.AuthMode = multitenancy.AuthMethodJWT
+
+		_, err := usecase.Execute(ctx, testdata.FakeSentrySession(), jwtUserInfo)
+
+		assert.Equal(t, errors.PermissionDenied, errors.FromError(err).GetCode())
+	})
+
+	t.Run("should fail with same error if job is not found", func(t *testing.T) {
+		session := testdata.FakeSentrySession()
+		expectedErr := errors.NotFoundError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), session.JobUUID, userInfo.AllowedTenants, userInfo.Username, false).
+			Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, session, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createSentrySessionComponent), err)
+	})
+
+	t.Run("should fail with same error if insert fails", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = time.Minute
+		session := testdata.FakeSentrySession()
+		session.JobUUID = job.UUID
+		expectedErr := errors.PostgresConnectionError("error")
+
+		mockJobDA.EXPECT().FindOneByUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username, false).Return(job, nil)
+		mockSentrySessionDA.EXPECT().FindOneByJobUUID(gomock.Any(), job.UUID, userInfo.AllowedTenants, userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+		mockSentrySessionDA.EXPECT().Insert(gomock.Any(), session).Return(nil, expectedErr)
+
+		_, err := usecase.Execute(ctx, session, userInfo)
+
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(createSentrySessionComponent), err)
+	})
+}
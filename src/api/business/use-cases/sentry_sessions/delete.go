@@ -0,0 +1,44 @@
+package sentrysessions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+const deleteSentrySessionComponent = "use-cases.delete-sentry-session"
+
+type deleteUseCase struct {
+	db     store.SentrySessionAgent
+	logger *log.Logger
+}
+
+func NewDeleteUseCase(db store.SentrySessionAgent) usecases.DeleteSentrySessionUseCase {
+	return &deleteUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(deleteSentrySessionComponent),
+	}
+}
+
+func (uc *deleteUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) error {
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("deleting sentry session")
+
+	_, err := uc.db.FindOneByJobUUID(ctx, jobUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteSentrySessionComponent)
+	}
+
+	err = uc.db.Delete(ctx, jobUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(deleteSentrySessionComponent)
+	}
+
+	logger.Info("sentry session was deleted successfully")
+	return nil
+}
@@ -0,0 +1,38 @@
+package sentrysessions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const getSentrySessionComponent = "use-cases.get-sentry-session"
+
+type getUseCase struct {
+	db     store.SentrySessionAgent
+	logger *log.Logger
+}
+
+func NewGetUseCase(db store.SentrySessionAgent) usecases.GetSentrySessionUseCase {
+	return &getUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(getSentrySessionComponent),
+	}
+}
+
+func (uc *getUseCase) Execute(ctx context.Context, jobUUID string, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error) {
+	ctx = log.WithFields(ctx, log.Field("job", jobUUID))
+
+	session, err := uc.db.FindOneByJobUUID(ctx, jobUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getSentrySessionComponent)
+	}
+
+	uc.logger.WithContext(ctx).Debug("sentry session found successfully")
+	return session, nil
+}
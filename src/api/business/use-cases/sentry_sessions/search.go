@@ -0,0 +1,36 @@
+package sentrysessions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const searchSentrySessionsComponent = "use-cases.search-sentry-sessions"
+
+type searchUseCase struct {
+	db     store.SentrySessionAgent
+	logger *log.Logger
+}
+
+func NewSearchUseCase(db store.SentrySessionAgent) usecases.SearchSentrySessionsUseCase {
+	return &searchUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(searchSentrySessionsComponent),
+	}
+}
+
+func (uc *searchUseCase) Execute(ctx context.Context, filters *entities.SentrySessionFilters, userInfo *multitenancy.UserInfo) ([]*entities.SentrySession, error) {
+	sessions, err := uc.db.Search(ctx, filters, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(searchSentrySessionsComponent)
+	}
+
+	uc.logger.Debug("sentry sessions found successfully")
+	return sessions, nil
+}
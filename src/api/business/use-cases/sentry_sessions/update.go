@@ -0,0 +1,55 @@
+package sentrysessions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const updateSentrySessionComponent = "use-cases.update-sentry-session"
+
+type updateUseCase struct {
+	db     store.SentrySessionAgent
+	logger *log.Logger
+}
+
+func NewUpdateUseCase(db store.SentrySessionAgent) usecases.UpdateSentrySessionUseCase {
+	return &updateUseCase{
+		db:     db,
+		logger: log.NewLogger().SetComponent(updateSentrySessionComponent),
+	}
+}
+
+func (uc *updateUseCase) Execute(ctx context.Context, session *entities.SentrySession, userInfo *multitenancy.UserInfo) (*entities.SentrySession, error) {
+	ctx = log.WithFields(ctx, log.Field("job", session.JobUUID))
+	logger := uc.logger.WithContext(ctx)
+	logger.Debug("updating sentry session")
+
+	err := checkSentryUser(userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(updateSentrySessionComponent)
+	}
+
+	curSession, err := uc.db.FindOneByJobUUID(ctx, session.JobUUID, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(updateSentrySessionComponent)
+	}
+
+	curSession.Retries = session.Retries
+	curSession.NChildren = session.NChildren
+	curSession.LastChildJobUUID = session.LastChildJobUUID
+	curSession.NextAttemptAt = session.NextAttemptAt
+
+	curSession, err = uc.db.Update(ctx, curSession)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(updateSentrySessionComponent)
+	}
+
+	logger.Debug("sentry session updated successfully")
+	return curSession, nil
+}
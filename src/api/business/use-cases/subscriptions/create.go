@@ -84,7 +84,7 @@ func (uc *createUseCase) Execute(ctx context.Context, subscription *entities.Sub
 	}
 	subscription.EventStreamUUID = eventStream[0].UUID
 
-	contract, err := uc.getContractUC.Execute(ctx, subscription.ContractName, subscription.ContractTag)
+	contract, err := uc.getContractUC.Execute(ctx, subscription.ContractName, subscription.ContractTag, userInfo)
 	if err != nil {
 		return nil, errors.FromError(err).SetComponent(createSubscriptionComponent)
 	} else if contract == nil {
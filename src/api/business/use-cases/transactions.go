@@ -14,6 +14,7 @@ type TransactionUseCases interface {
 	SendContract() SendContractTxUseCase
 	SendDeploy() SendDeployTxUseCase
 	Send() SendTxUseCase
+	SendBatch() SendTxBatchUseCase
 	Get() GetTxUseCase
 	Search() SearchTransactionsUseCase
 	SpeedUp() SpeedUpTxUseCase
@@ -39,6 +40,10 @@ type SendTxUseCase interface {
 	Execute(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
 
+type SendTxBatchUseCase interface {
+	Execute(ctx context.Context, items []*entities.TxBatchItem, userInfo *multitenancy.UserInfo) ([]*entities.TxBatchResult, error)
+}
+
 type SpeedUpTxUseCase interface {
 	Execute(ctx context.Context, scheduleUUID string, gasIncrement float64, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error)
 }
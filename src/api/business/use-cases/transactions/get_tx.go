@@ -18,14 +18,17 @@ const getTxComponent = "use-cases.get-tx"
 type getTxUseCase struct {
 	db                 store.DB
 	getScheduleUsecase usecases.GetScheduleUseCase
+	decodeCallUC       usecases.DecodeCallUseCase
 	logger             *log.Logger
 }
 
 // NewGetTxUseCase creates a new GetTxUseCase
-func NewGetTxUseCase(db store.DB, getScheduleUsecase usecases.GetScheduleUseCase) usecases.GetTxUseCase {
+func NewGetTxUseCase(db store.DB, getScheduleUsecase usecases.GetScheduleUseCase,
+	decodeCallUC usecases.DecodeCallUseCase) usecases.GetTxUseCase {
 	return &getTxUseCase{
 		db:                 db,
 		getScheduleUsecase: getScheduleUsecase,
+		decodeCallUC:       decodeCallUC,
 		logger:             log.NewLogger().SetComponent(getTxComponent),
 	}
 }
@@ -50,6 +53,16 @@ func (uc *getTxUseCase) Execute(ctx context.Context, scheduleUUID string, userIn
 		return nil, errors.FromError(err).ExtendComponent(getTxComponent)
 	}
 
+	// Contract transactions are decoded with the ABI of the requested contract rather than the one at the recipient address
+	var contractName, contractTag string
+	if txRequest.Params != nil && txRequest.Params.MethodSignature != "" {
+		contractName, contractTag = txRequest.Params.ContractName, txRequest.Params.ContractTag
+	}
+	err = uc.decodeCallUC.Execute(ctx, txRequest.Schedule.Jobs, contractName, contractTag, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(getTxComponent)
+	}
+
 	uc.logger.WithContext(ctx).Debug("transaction request found successfully")
 	return txRequest, nil
 }
@@ -25,11 +25,12 @@ func TestGetTx_Execute(t *testing.T) {
 	mockDB := mocks.NewMockDB(ctrl)
 	mockTransactionRequestDA := mocks.NewMockTransactionRequestAgent(ctrl)
 	mockGetScheduleUC := mocks2.NewMockGetScheduleUseCase(ctrl)
+	mockDecodeCallUC := mocks2.NewMockDecodeCallUseCase(ctrl)
 
 	mockDB.EXPECT().TransactionRequest().Return(mockTransactionRequestDA).AnyTimes()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewGetTxUseCase(mockDB, mockGetScheduleUC)
+	usecase := NewGetTxUseCase(mockDB, mockGetScheduleUC, mockDecodeCallUC)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		txRequest := testdata.FakeTxRequest()
@@ -38,6 +39,8 @@ func TestGetTx_Execute(t *testing.T) {
 		mockTransactionRequestDA.EXPECT().FindOneByUUID(gomock.Any(), txRequest.Schedule.UUID, userInfo.AllowedTenants, 
 			userInfo.Username).Return(txRequest, nil)
 		mockGetScheduleUC.EXPECT().Execute(gomock.Any(), txRequest.Schedule.UUID, userInfo).Return(schedule, nil)
+		mockDecodeCallUC.EXPECT().Execute(gomock.Any(), schedule.Jobs, txRequest.Params.ContractName,
+			txRequest.Params.ContractTag, userInfo).Return(nil)
 
 		result, err := usecase.Execute(ctx, txRequest.Schedule.UUID, userInfo)
 
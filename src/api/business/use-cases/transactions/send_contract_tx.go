@@ -8,35 +8,54 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/umbracle/go-web3/abi"
 )
 
 const sendContractTxComponent = "use-cases.send-contract-tx"
 
 type sendContractTxUseCase struct {
-	sendTxUseCase      usecases.SendTxUseCase
-	getContractUseCase usecases.GetContractUseCase
-	logger             *log.Logger
+	sendTxUseCase       usecases.SendTxUseCase
+	getContractUseCase  usecases.GetContractUseCase
+	resolveProxyUseCase usecases.ResolveProxyContractUseCase
+	logger              *log.Logger
 }
 
 // NewSendContractTxUseCase creates a n¬ew SendContractTxUseCase
-func NewSendContractTxUseCase(sendTxUseCase usecases.SendTxUseCase, getContractUseCase usecases.GetContractUseCase) usecases.SendContractTxUseCase {
+func NewSendContractTxUseCase(sendTxUseCase usecases.SendTxUseCase, getContractUseCase usecases.GetContractUseCase,
+	resolveProxyUseCase usecases.ResolveProxyContractUseCase) usecases.SendContractTxUseCase {
 	return &sendContractTxUseCase{
-		sendTxUseCase:      sendTxUseCase,
-		getContractUseCase: getContractUseCase,
-		logger:             log.NewLogger().SetComponent(sendContractTxComponent),
+		sendTxUseCase:       sendTxUseCase,
+		getContractUseCase:  getContractUseCase,
+		resolveProxyUseCase: resolveProxyUseCase,
+		logger:              log.NewLogger().SetComponent(sendContractTxComponent),
 	}
 }
 
 // Execute validates, creates and starts a new contract transaction
 func (uc *sendContractTxUseCase) Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+	txData, err := uc.txData(ctx, txRequest, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
+	}
+
+	return tx, nil
+}
+
+// txData encodes the call of the contract method with its arguments
+func (uc *sendContractTxUseCase) txData(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (hexutil.Bytes, error) {
 	logger := uc.logger.WithContext(ctx).
 		WithField("idempotency-key", txRequest.IdempotencyKey).
 		WithField("method", txRequest.Params.MethodSignature).
 		WithField("args", txRequest.Params.Args)
 	logger.Debug("creating new contract transaction")
 
-	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag)
+	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
 	}
@@ -53,6 +72,16 @@ func (uc *sendContractTxUseCase) Execute(ctx context.Context, txRequest *entitie
 	}
 
 	method := web3ABI.GetMethodBySignature(txRequest.Params.MethodSignature)
+	if method == nil && txRequest.Params.To != nil {
+		// Methods called on a proxy are declared by the ABI of its implementation
+		web3ABI, err = uc.implementationABI(ctx, txRequest, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
+		}
+		if web3ABI != nil {
+			method = web3ABI.GetMethodBySignature(txRequest.Params.MethodSignature)
+		}
+	}
 	if method == nil {
 		errMessage := "method not found"
 		logger.WithError(err).Error(errMessage)
@@ -65,10 +94,21 @@ func (uc *sendContractTxUseCase) Execute(ctx context.Context, txRequest *entitie
 		return nil, errors.InvalidParameterError(err.Error()).ExtendComponent(sendContractTxComponent)
 	}
 
-	tx, err := uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+	return txData, nil
+}
+
+func (uc *sendContractTxUseCase) implementationABI(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*abi.ABI, error) {
+	implementation, err := uc.resolveProxyUseCase.Execute(ctx, txRequest.ChainName, *txRequest.Params.To, userInfo)
+	if err != nil || implementation == nil {
+		return nil, err
+	}
+
+	web3ABI, err := abi.NewABI(implementation.RawABI)
 	if err != nil {
-		return nil, errors.FromError(err).ExtendComponent(sendContractTxComponent)
+		errMessage := "failed to parse proxy implementation ABI for contract transaction"
+		uc.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return nil, errors.DataCorruptedError(errMessage)
 	}
 
-	return tx, nil
+	return web3ABI, nil
 }
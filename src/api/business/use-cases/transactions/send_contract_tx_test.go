@@ -22,6 +22,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 
 	mockSendTxUC := mocks.NewMockSendTxUseCase(ctrl)
 	mockGetContractUC := mocks.NewMockGetContractUseCase(ctrl)
+	mockResolveProxyUC := mocks.NewMockResolveProxyContractUseCase(ctrl)
 
 	ctx := context.Background()
 	txRequest := testdata.FakeTxRequest()
@@ -29,10 +30,10 @@ func TestSendContractTx_Execute(t *testing.T) {
 	txRequestResponse := testdata.FakeTxRequest()
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewSendContractTxUseCase(mockSendTxUC, mockGetContractUC)
+	usecase := NewSendContractTxUseCase(mockSendTxUC, mockGetContractUC, mockResolveProxyUC)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(c, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(c, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -59,7 +60,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 		}
 		expectedTxData := hexutil.MustDecode("0x52ca78230000000000000000000000000000000000000000000000000000000000000020000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da30000000000000000000000000000000000000000000000000000000000000040000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000001f4000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da3")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag).Return(newContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag, userInfo).Return(newContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), newTxRequest, expectedTxData, userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, newTxRequest, userInfo)
@@ -76,7 +77,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 		newTxRequest.Params.Args = []interface{}{"0xdbb881a51CD4023E4400CEF3ef73046743f08da3", "0xdbb881a51CD4023E4400CEF3ef73046743f08da3", 500}
 		expectedTxData := hexutil.MustDecode("0xed629438000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da3000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da300000000000000000000000000000000000000000000000000000000000001f4")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag).Return(newContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag, userInfo).Return(newContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), newTxRequest, expectedTxData, userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, newTxRequest, userInfo)
@@ -85,10 +86,43 @@ func TestSendContractTx_Execute(t *testing.T) {
 		assert.Equal(t, txRequestResponse, response)
 	})
 
+	t.Run("should encode method of proxy implementation successfully", func(t *testing.T) {
+		proxyContract := testdata.FakeContract()
+		proxyContract.RawABI = "[]"
+		implementation := testdata.FakeContract()
+		implementation.RawABI = testdata.ContractABIStruct
+		newTxRequest := testdata.FakeTxRequest()
+		newTxRequest.Params.MethodSignature = "singleTransfer(address,address,uint256)"
+		newTxRequest.Params.Args = []interface{}{"0xdbb881a51CD4023E4400CEF3ef73046743f08da3", "0xdbb881a51CD4023E4400CEF3ef73046743f08da3", 500}
+		expectedTxData := hexutil.MustDecode("0xed629438000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da3000000000000000000000000dbb881a51cd4023e4400cef3ef73046743f08da300000000000000000000000000000000000000000000000000000000000001f4")
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag, userInfo).Return(proxyContract, nil)
+		mockResolveProxyUC.EXPECT().Execute(gomock.Any(), newTxRequest.ChainName, *newTxRequest.Params.To, userInfo).Return(implementation, nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), newTxRequest, expectedTxData, userInfo).Return(txRequestResponse, nil)
+
+		response, err := usecase.Execute(ctx, newTxRequest, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, txRequestResponse, response)
+	})
+
+	t.Run("should fail with InvalidParameterError if method is not found on contract nor proxy implementation", func(t *testing.T) {
+		newTxRequest := testdata.FakeTxRequest()
+		newTxRequest.Params.MethodSignature = "unknown()"
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), newTxRequest.Params.ContractName, newTxRequest.Params.ContractTag, userInfo).Return(c, nil)
+		mockResolveProxyUC.EXPECT().Execute(gomock.Any(), newTxRequest.ChainName, *newTxRequest.Params.To, userInfo).Return(nil, nil)
+
+		response, err := usecase.Execute(ctx, newTxRequest, userInfo)
+
+		assert.Nil(t, response)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	t.Run("should fail with same error if get contract use case fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(nil, expectedErr)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(nil, expectedErr)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
 
@@ -99,7 +133,7 @@ func TestSendContractTx_Execute(t *testing.T) {
 	t.Run("should fail with same error if send tx use case fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(c, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(c, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(nil, expectedErr)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
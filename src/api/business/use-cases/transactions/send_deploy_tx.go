@@ -6,10 +6,14 @@ import (
 	"github.com/umbracle/go-web3/abi"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/create2"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 const sendDeployTxComponent = "use-cases.send-deploy-tx"
@@ -18,26 +22,43 @@ const sendDeployTxComponent = "use-cases.send-deploy-tx"
 type sendDeployTxUsecase struct {
 	sendTxUseCase      usecases.SendTxUseCase
 	getContractUseCase usecases.GetContractUseCase
+	searchChainsUC     usecases.SearchChainsUseCase
+	ec                 ethclient.ChainStateReader
+	create2Factory     ethcommon.Address
 	logger             *log.Logger
 }
 
-func NewSendDeployTxUseCase(sendTxUC usecases.SendTxUseCase, getContractUC usecases.GetContractUseCase) usecases.SendDeployTxUseCase {
+func NewSendDeployTxUseCase(sendTxUC usecases.SendTxUseCase, getContractUC usecases.GetContractUseCase,
+	searchChainsUC usecases.SearchChainsUseCase, ec ethclient.ChainStateReader, create2Factory ethcommon.Address) usecases.SendDeployTxUseCase {
 	return &sendDeployTxUsecase{
 		getContractUseCase: getContractUC,
 		sendTxUseCase:      sendTxUC,
+		searchChainsUC:     searchChainsUC,
+		ec:                 ec,
+		create2Factory:     create2Factory,
 		logger:             log.NewLogger().SetComponent(sendDeployTxComponent),
 	}
 }
 
 // Execute validates, creates and starts a new contract deployment transaction
 func (uc *sendDeployTxUsecase) Execute(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+	txData, err := uc.txData(ctx, txRequest, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+}
+
+// txData builds the deployment data of the contract, the request being updated to be sent to the CREATE2 factory when given a salt
+func (uc *sendDeployTxUsecase) txData(ctx context.Context, txRequest *entities.TxRequest, userInfo *multitenancy.UserInfo) (hexutil.Bytes, error) {
 	logger := uc.logger.WithContext(ctx).
 		WithField("idempotency-key", txRequest.IdempotencyKey).
 		WithField("method", txRequest.Params.MethodSignature).
 		WithField("args", txRequest.Params.Args)
 	logger.Debug("creating new deployment transaction")
 
-	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag)
+	contract, err := uc.getContractUseCase.Execute(ctx, txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo)
 	if contract == nil {
 		return nil, errors.InvalidParameterError("contract not found")
 	}
@@ -69,5 +90,49 @@ func (uc *sendDeployTxUsecase) Execute(ctx context.Context, txRequest *entities.
 	}
 
 	txData := append(contract.Bytecode, arguments...)
-	return uc.sendTxUseCase.Execute(ctx, txRequest, txData, userInfo)
+	if txRequest.Params.Salt != nil {
+		err = uc.checkCreate2Factory(ctx, txRequest.ChainName, userInfo)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(sendDeployTxComponent)
+		}
+
+		// The contract is deployed by the factory at an address depending only on the salt and the init code
+		contractAddress := create2.Address(uc.create2Factory, *txRequest.Params.Salt, txData)
+		logger.WithField("address", contractAddress.Hex()).Debug("deploying contract through CREATE2 factory")
+
+		factory := uc.create2Factory
+		txRequest.Params.To = &factory
+		txRequest.InternalData.ContractAddress = &contractAddress
+		txData = create2.FactoryData(*txRequest.Params.Salt, txData)
+	}
+
+	return txData, nil
+}
+
+// checkCreate2Factory fails if the CREATE2 factory is not deployed on the chain, a transaction to an address without
+// code being mined successfully without deploying anything
+func (uc *sendDeployTxUsecase) checkCreate2Factory(ctx context.Context, chainName string, userInfo *multitenancy.UserInfo) error {
+	chains, err := uc.searchChainsUC.Execute(ctx, &entities.ChainFilters{Names: []string{chainName}}, userInfo)
+	if err != nil {
+		return err
+	}
+	if len(chains) == 0 {
+		return errors.InvalidParameterError("chain '%s' does not exist", chainName)
+	}
+
+	for _, uri := range chains[0].URLs {
+		code, err := uc.ec.CodeAt(ctx, uri, uc.create2Factory, nil)
+		if err != nil {
+			uc.logger.WithContext(ctx).WithError(err).WithField("url", uri).Warn("failed to fetch code of CREATE2 factory")
+			continue
+		}
+
+		if len(code) == 0 {
+			return errors.InvalidParameterError("CREATE2 factory %s is not deployed on chain '%s'", uc.create2Factory.Hex(), chainName)
+		}
+
+		return nil
+	}
+
+	return errors.EthConnectionError("failed to reach all chain URLs")
 }
@@ -7,8 +7,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/create2"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	mocks2 "github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	"github.com/golang/mock/gomock"
@@ -21,19 +27,21 @@ func TestSendDeployTx_Execute(t *testing.T) {
 
 	mockSendTxUC := mocks2.NewMockSendTxUseCase(ctrl)
 	mockGetContractUC := mocks2.NewMockGetContractUseCase(ctrl)
+	mockSearchChainsUC := mocks2.NewMockSearchChainsUseCase(ctrl)
+	mockEthClient := mock.NewMockChainStateReader(ctrl)
 
 	ctx := context.Background()
 	txRequest := testdata.FakeTxRequest()
 	txRequest.Params.Args = nil
 
 	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
-	usecase := NewSendDeployTxUseCase(mockSendTxUC, mockGetContractUC)
+	usecase := NewSendDeployTxUseCase(mockSendTxUC, mockGetContractUC, mockSearchChainsUC, mockEthClient, create2.FactoryAddress)
 
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		txRequestResponse := testdata.FakeTxRequest()
 		fakeContract := testdata.FakeContract()
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(fakeContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(txRequestResponse, nil)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -42,10 +50,57 @@ func TestSendDeployTx_Execute(t *testing.T) {
 		assert.Equal(t, txRequestResponse, response)
 	})
 
+	t.Run("should execute use case successfully through CREATE2 factory", func(t *testing.T) {
+		create2TxRequest := testdata.FakeTxRequest()
+		create2TxRequest.Params.Args = nil
+		create2TxRequest.Params.To = nil
+		salt := ethcommon.HexToHash("0x01")
+		create2TxRequest.Params.Salt = &salt
+		txRequestResponse := testdata.FakeTxRequest()
+		fakeContract := testdata.FakeContract()
+		expectedAddress := create2.Address(create2.FactoryAddress, salt, fakeContract.Bytecode)
+		chain := testdata.FakeChain()
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), create2TxRequest.Params.ContractName, create2TxRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{create2TxRequest.ChainName}}, userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		mockEthClient.EXPECT().CodeAt(gomock.Any(), chain.URLs[0], create2.FactoryAddress, nil).Return([]byte{0x60}, nil)
+		mockSendTxUC.EXPECT().Execute(gomock.Any(), create2TxRequest, gomock.Any(), userInfo).
+			DoAndReturn(func(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+				assert.Equal(t, create2.FactoryAddress, *txRequest.Params.To)
+				assert.Equal(t, expectedAddress, *txRequest.InternalData.ContractAddress)
+				assert.Equal(t, hexutil.Bytes(create2.FactoryData(salt, fakeContract.Bytecode)), txData)
+				return txRequestResponse, nil
+			})
+
+		response, err := usecase.Execute(ctx, create2TxRequest, userInfo)
+
+		assert.NoError(t, err)
+		assert.Equal(t, txRequestResponse, response)
+	})
+
+	t.Run("should fail with InvalidParameterError if CREATE2 factory is not deployed", func(t *testing.T) {
+		create2TxRequest := testdata.FakeTxRequest()
+		create2TxRequest.Params.Args = nil
+		salt := ethcommon.HexToHash("0x01")
+		create2TxRequest.Params.Salt = &salt
+		chain := testdata.FakeChain()
+
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), create2TxRequest.Params.ContractName, create2TxRequest.Params.ContractTag, userInfo).
+			Return(testdata.FakeContract(), nil)
+		mockSearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), userInfo).Return([]*entities.Chain{chain}, nil)
+		mockEthClient.EXPECT().CodeAt(gomock.Any(), chain.URLs[0], create2.FactoryAddress, nil).Return([]byte{}, nil)
+
+		response, err := usecase.Execute(ctx, create2TxRequest, userInfo)
+
+		assert.Nil(t, response)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	t.Run("should fail with same error if validator fails", func(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(nil, expectedErr)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(nil, expectedErr)
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
 
 		assert.Nil(t, response)
@@ -56,7 +111,7 @@ func TestSendDeployTx_Execute(t *testing.T) {
 		expectedErr := fmt.Errorf("error")
 		fakeContract := testdata.FakeContract()
 
-		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag).Return(fakeContract, nil)
+		mockGetContractUC.EXPECT().Execute(gomock.Any(), txRequest.Params.ContractName, txRequest.Params.ContractTag, userInfo).Return(fakeContract, nil)
 		mockSendTxUC.EXPECT().Execute(gomock.Any(), txRequest, gomock.Any(), userInfo).Return(nil, expectedErr)
 
 		response, err := usecase.Execute(ctx, txRequest, userInfo)
@@ -14,6 +14,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
@@ -53,7 +54,10 @@ func NewSendTxUseCase(
 	}
 }
 
-func (uc *sendTxUsecase) Execute(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (*entities.TxRequest, error) {
+func (uc *sendTxUsecase) Execute(ctx context.Context, txRequest *entities.TxRequest, txData hexutil.Bytes, userInfo *multitenancy.UserInfo) (_ *entities.TxRequest, err error) {
+	ctx, span := tracing.StartSpan(ctx, sendTxComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.WithFields(ctx, log.Field("idempotency-key", txRequest.IdempotencyKey))
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("creating new transaction")
@@ -64,6 +68,11 @@ func (uc *sendTxUsecase) Execute(ctx context.Context, txRequest *entities.TxRequ
 		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
 	}
 
+	err = uc.resolvePrivacyGroup(ctx, txRequest, chain.UUID, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendTxComponent)
+	}
+
 	// Step 2: Generate request hash
 	requestHash, err := generateRequestHash(chain.UUID, txRequest.Params)
 	if err != nil {
@@ -120,6 +129,39 @@ func (uc *sendTxUsecase) getChain(ctx context.Context, chainName string, userInf
 	return chains[0], nil
 }
 
+// resolvePrivacyGroup sets the ID of the privacy group referenced by name in the params of the transaction request
+func (uc *sendTxUsecase) resolvePrivacyGroup(ctx context.Context, txRequest *entities.TxRequest, chainUUID string, userInfo *multitenancy.UserInfo) error {
+	if txRequest.Params.PrivacyGroupName == "" {
+		return nil
+	}
+
+	privacyGroupID, err := uc.getPrivacyGroupID(ctx, txRequest.Params.PrivacyGroupName, chainUUID, userInfo)
+	if err != nil {
+		return err
+	}
+
+	txRequest.Params.PrivacyGroupID = privacyGroupID
+	return nil
+}
+
+func (uc *sendTxUsecase) getPrivacyGroupID(ctx context.Context, name, chainUUID string, userInfo *multitenancy.UserInfo) (string, error) {
+	groups, err := uc.db.PrivacyGroup().Search(ctx, &entities.PrivacyGroupFilters{
+		Names:     []string{name},
+		ChainUUID: chainUUID,
+	}, userInfo.AllowedTenants, userInfo.Username)
+	if err != nil {
+		return "", err
+	}
+
+	if len(groups) == 0 {
+		errMessage := fmt.Sprintf("privacy group '%s' does not exist on chain", name)
+		uc.logger.WithContext(ctx).Error(errMessage)
+		return "", errors.InvalidParameterError(errMessage)
+	}
+
+	return groups[0].PrivacyGroupID, nil
+}
+
 func (uc *sendTxUsecase) selectOrInsertTxRequest(
 	ctx context.Context,
 	txRequest *entities.TxRequest,
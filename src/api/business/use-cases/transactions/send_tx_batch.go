@@ -0,0 +1,310 @@
+package transactions
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+)
+
+const sendTxBatchComponent = "use-cases.send-tx-batch"
+
+type sendTxBatchUseCase struct {
+	db             store.DB
+	sendTx         *sendTxUsecase
+	sendContractTx *sendContractTxUseCase
+	sendDeployTx   *sendDeployTxUsecase
+	prepareJobUC   usecases.PrepareJobUseCase
+	logger         *log.Logger
+}
+
+// batchTx is a valid transaction request of a batch, ready to be inserted
+type batchTx struct {
+	idx         int
+	txRequest   *entities.TxRequest
+	txData      hexutil.Bytes
+	chain       *entities.Chain
+	requestHash string
+	jobs        []*entities.Job
+}
+
+func NewSendTxBatchUseCase(
+	db store.DB,
+	searchChainsUC usecases.SearchChainsUseCase,
+	startJobUC usecases.StartJobUseCase,
+	createJobUC usecases.CreateJobUseCase,
+	prepareJobUC usecases.PrepareJobUseCase,
+	getTxUC usecases.GetTxUseCase,
+	getFaucetCandidateUC usecases.GetFaucetCandidateUseCase,
+	getContractUC usecases.GetContractUseCase,
+	resolveProxyUC usecases.ResolveProxyContractUseCase,
+	ec ethclient.ChainStateReader,
+	create2Factory ethcommon.Address,
+) usecases.SendTxBatchUseCase {
+	return &sendTxBatchUseCase{
+		db: db,
+		sendTx: &sendTxUsecase{
+			db:                 db,
+			searchChainsUC:     searchChainsUC,
+			startJobUC:         startJobUC,
+			createJobUC:        createJobUC,
+			getTxUC:            getTxUC,
+			getFaucetCandidate: getFaucetCandidateUC,
+			logger:             log.NewLogger().SetComponent(sendTxComponent),
+		},
+		sendContractTx: &sendContractTxUseCase{
+			getContractUseCase:  getContractUC,
+			resolveProxyUseCase: resolveProxyUC,
+			logger:              log.NewLogger().SetComponent(sendContractTxComponent),
+		},
+		sendDeployTx: &sendDeployTxUsecase{
+			getContractUseCase: getContractUC,
+			searchChainsUC:     searchChainsUC,
+			ec:                 ec,
+			create2Factory:     create2Factory,
+			logger:             log.NewLogger().SetComponent(sendDeployTxComponent),
+		},
+		prepareJobUC: prepareJobUC,
+		logger:       log.NewLogger().SetComponent(sendTxBatchComponent),
+	}
+}
+
+// Execute validates the transaction requests of the batch, inserts the valid ones atomically and starts them.
+// Invalid requests and requests already sent with the same idempotency key are reported without failing the batch
+func (uc *sendTxBatchUseCase) Execute(ctx context.Context, items []*entities.TxBatchItem, userInfo *multitenancy.UserInfo) ([]*entities.TxBatchResult, error) {
+	logger := uc.logger.WithContext(ctx).WithField("size", len(items))
+	logger.Debug("creating batch of transactions")
+
+	results := make([]*entities.TxBatchResult, len(items))
+	chains := map[string]*entities.Chain{}
+	idempotencyKeys := map[string]bool{}
+	var txs []*batchTx
+	for idx, item := range items {
+		tx, err := uc.prepare(ctx, item, chains, userInfo)
+		if err != nil {
+			results[idx] = &entities.TxBatchResult{Status: entities.TxBatchItemInvalid, TxRequest: item.TxRequest, Error: err}
+			continue
+		}
+
+		if key := item.TxRequest.IdempotencyKey; key != "" {
+			if idempotencyKeys[key] {
+				err = errors.InvalidParameterError("idempotency key %s is used by another transaction of the batch", key)
+				results[idx] = &entities.TxBatchResult{Status: entities.TxBatchItemInvalid, TxRequest: item.TxRequest, Error: err}
+				continue
+			}
+			idempotencyKeys[key] = true
+		}
+
+		tx.idx = idx
+		txs = append(txs, tx)
+	}
+
+	txs, err := uc.filterDuplicates(ctx, txs, results, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendTxBatchComponent)
+	}
+
+	err = uc.insert(ctx, txs, userInfo)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(sendTxBatchComponent)
+	}
+
+	for _, tx := range txs {
+		results[tx.idx] = &entities.TxBatchResult{Status: entities.TxBatchItemCreated, TxRequest: tx.txRequest}
+		// The transaction is created even if it fails to start, the error being reported for it to be retried
+		if err = uc.start(ctx, tx, userInfo); err != nil {
+			results[tx.idx].Error = errors.FromError(err).ExtendComponent(sendTxBatchComponent)
+		}
+	}
+
+	logger.WithField("created", len(txs)).Info("batch of transactions created successfully")
+	return results, nil
+}
+
+func (uc *sendTxBatchUseCase) prepare(
+	ctx context.Context,
+	item *entities.TxBatchItem,
+	chains map[string]*entities.Chain,
+	userInfo *multitenancy.UserInfo,
+) (*batchTx, error) {
+	tx := &batchTx{txRequest: item.TxRequest}
+
+	var err error
+	switch item.Type {
+	case entities.TxBatchSend:
+		tx.txData, err = uc.sendContractTx.txData(ctx, tx.txRequest, userInfo)
+	case entities.TxBatchDeploy:
+		tx.txData, err = uc.sendDeployTx.txData(ctx, tx.txRequest, userInfo)
+	case entities.TxBatchTransfer:
+	default:
+		err = errors.InvalidParameterError("invalid transaction type %s", item.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx.chain = chains[tx.txRequest.ChainName]
+	if tx.chain == nil {
+		tx.chain, err = uc.sendTx.getChain(ctx, tx.txRequest.ChainName, userInfo)
+		if err != nil {
+			return nil, err
+		}
+		chains[tx.txRequest.ChainName] = tx.chain
+	}
+
+	err = uc.sendTx.resolvePrivacyGroup(ctx, tx.txRequest, tx.chain.UUID, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.requestHash, err = generateRequestHash(tx.chain.UUID, tx.txRequest.Params)
+	if err != nil {
+		return nil, errors.InvalidParameterError("failed to generate request hash")
+	}
+
+	// Jobs are prepared as the ones of a single transaction, their schedule being inserted with the batch
+	tx.txRequest.Schedule = &entities.Schedule{TenantID: userInfo.TenantID, OwnerID: userInfo.Username}
+	tx.jobs, err = uc.newJobs(ctx, tx, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// filterDuplicates reports the requests already sent with the same idempotency key, rejecting the ones with different params
+func (uc *sendTxBatchUseCase) filterDuplicates(ctx context.Context, txs []*batchTx, results []*entities.TxBatchResult, userInfo *multitenancy.UserInfo) ([]*batchTx, error) {
+	var keys []string
+	for _, tx := range txs {
+		if tx.txRequest.IdempotencyKey != "" {
+			keys = append(keys, tx.txRequest.IdempotencyKey)
+		}
+	}
+	if len(keys) == 0 {
+		return txs, nil
+	}
+
+	existingTxRequests, err := uc.db.TransactionRequest().Search(ctx, &entities.TransactionRequestFilters{IdempotencyKeys: keys},
+		[]string{userInfo.TenantID}, userInfo.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]*entities.TxRequest{}
+	for _, txRequest := range existingTxRequests {
+		existing[txRequest.IdempotencyKey] = txRequest
+	}
+
+	var newTxs []*batchTx
+	for _, tx := range txs {
+		txRequest, ok := existing[tx.txRequest.IdempotencyKey]
+		switch {
+		case !ok || tx.txRequest.IdempotencyKey == "":
+			newTxs = append(newTxs, tx)
+		case txRequest.Hash != tx.requestHash:
+			err = errors.AlreadyExistsError("transaction request with the same idempotency key and different params already exists")
+			results[tx.idx] = &entities.TxBatchResult{Status: entities.TxBatchItemInvalid, TxRequest: tx.txRequest, Error: err}
+		default:
+			txRequest, err = uc.sendTx.getTxUC.Execute(ctx, txRequest.Schedule.UUID, userInfo)
+			if err != nil {
+				return nil, err
+			}
+			results[tx.idx] = &entities.TxBatchResult{Status: entities.TxBatchItemDuplicate, TxRequest: txRequest}
+		}
+	}
+
+	return newTxs, nil
+}
+
+// insert inserts the schedules, transaction requests and jobs of all the transactions in a single database transaction
+func (uc *sendTxBatchUseCase) insert(ctx context.Context, txs []*batchTx, userInfo *multitenancy.UserInfo) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	return uc.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		var schedules []*entities.Schedule
+		for _, tx := range txs {
+			schedules = append(schedules, tx.txRequest.Schedule)
+		}
+
+		err := dbtx.Schedule().InsertMultiple(ctx, schedules)
+		if err != nil {
+			return err
+		}
+
+		var txRequests []*entities.TxRequest
+		var requestHashes []string
+		for _, tx := range txs {
+			txRequests = append(txRequests, tx.txRequest)
+			requestHashes = append(requestHashes, tx.requestHash)
+		}
+
+		err = dbtx.TransactionRequest().InsertMultiple(ctx, txRequests, requestHashes)
+		if err != nil {
+			return err
+		}
+
+		var jobs []*entities.Job
+		for _, tx := range txs {
+			for _, job := range tx.jobs {
+				job.ScheduleUUID = tx.txRequest.Schedule.UUID
+			}
+			tx.txRequest.Schedule.Jobs = tx.jobs
+			jobs = append(jobs, tx.jobs...)
+		}
+
+		return dbtx.Job().InsertMultiple(ctx, jobs)
+	})
+}
+
+func (uc *sendTxBatchUseCase) newJobs(ctx context.Context, tx *batchTx, userInfo *multitenancy.UserInfo) ([]*entities.Job, error) {
+	jobs, err := uc.sendTx.newJobEntities(tx.txRequest, tx.chain.UUID, tx.txData)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextJobUUID string
+	for idx, job := range jobs {
+		if nextJobUUID != "" {
+			job.UUID = nextJobUUID
+		}
+
+		if idx < len(jobs)-1 {
+			nextJobUUID = uuid.Must(uuid.NewV4()).String()
+			job.NextJobUUID = nextJobUUID
+		}
+
+		err = uc.prepareJobUC.Execute(ctx, job, tx.chain, userInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		job.TenantID = userInfo.TenantID
+		job.OwnerID = userInfo.Username
+		job.Status = entities.StatusCreated
+	}
+
+	return jobs, nil
+}
+
+func (uc *sendTxBatchUseCase) start(ctx context.Context, tx *batchTx, userInfo *multitenancy.UserInfo) error {
+	job := tx.txRequest.Schedule.Jobs[0]
+	fctJob, err := uc.sendTx.startFaucetJob(ctx, job.Transaction.From, job.ScheduleUUID, tx.chain, userInfo)
+	if err != nil {
+		return err
+	}
+	if fctJob != nil {
+		tx.txRequest.Schedule.Jobs = append(tx.txRequest.Schedule.Jobs, fctJob)
+	}
+
+	return uc.sendTx.startJobUC.Execute(ctx, job.UUID, userInfo)
+}
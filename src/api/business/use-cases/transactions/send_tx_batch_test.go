@@ -0,0 +1,314 @@
+// +build unit
+
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/jobs"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/store"
+	mocks2 "github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gofrs/uuid"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type sendTxBatchSuite struct {
+	suite.Suite
+	usecase            usecases.SendTxBatchUseCase
+	DB                 *mocks2.MockDB
+	SearchChainsUC     *mocks.MockSearchChainsUseCase
+	TxRequestDA        *mocks2.MockTransactionRequestAgent
+	ScheduleDA         *mocks2.MockScheduleAgent
+	JobDA              *mocks2.MockJobAgent
+	AccountDA          *mocks2.MockAccountAgent
+	PrivacyGroupDA     *mocks2.MockPrivacyGroupAgent
+	StartJobUC         *mocks.MockStartJobUseCase
+	GetTxUC            *mocks.MockGetTxUseCase
+	GetFaucetCandidate *mocks.MockGetFaucetCandidateUseCase
+	userInfo           *multitenancy.UserInfo
+}
+
+func TestSendTxBatch(t *testing.T) {
+	s := new(sendTxBatchSuite)
+	suite.Run(t, s)
+}
+
+func (s *sendTxBatchSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.DB = mocks2.NewMockDB(ctrl)
+	s.SearchChainsUC = mocks.NewMockSearchChainsUseCase(ctrl)
+	s.TxRequestDA = mocks2.NewMockTransactionRequestAgent(ctrl)
+	s.ScheduleDA = mocks2.NewMockScheduleAgent(ctrl)
+	s.JobDA = mocks2.NewMockJobAgent(ctrl)
+	s.AccountDA = mocks2.NewMockAccountAgent(ctrl)
+	s.PrivacyGroupDA = mocks2.NewMockPrivacyGroupAgent(ctrl)
+	s.StartJobUC = mocks.NewMockStartJobUseCase(ctrl)
+	s.GetTxUC = mocks.NewMockGetTxUseCase(ctrl)
+	s.GetFaucetCandidate = mocks.NewMockGetFaucetCandidateUseCase(ctrl)
+
+	s.DB.EXPECT().TransactionRequest().Return(s.TxRequestDA).AnyTimes()
+	s.DB.EXPECT().Schedule().Return(s.ScheduleDA).AnyTimes()
+	s.DB.EXPECT().Job().Return(s.JobDA).AnyTimes()
+	s.DB.EXPECT().Account().Return(s.AccountDA).AnyTimes()
+	s.DB.EXPECT().PrivacyGroup().Return(s.PrivacyGroupDA).AnyTimes()
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+
+	s.usecase = NewSendTxBatchUseCase(
+		s.DB,
+		s.SearchChainsUC,
+		s.StartJobUC,
+		mocks.NewMockCreateJobUseCase(ctrl),
+		jobs.NewPrepareJobUseCase(s.DB, "default-store-id"),
+		s.GetTxUC,
+		s.GetFaucetCandidate,
+		mocks.NewMockGetContractUseCase(ctrl),
+		mocks.NewMockResolveProxyContractUseCase(ctrl),
+		mock.NewMockChainStateReader(ctrl),
+		ethcommon.Address{},
+	)
+}
+
+func (s *sendTxBatchSuite) TestSendTxBatch_Execute() {
+	ctx := context.Background()
+
+	s.T().Run("should create transactions and report invalid transactions successfully", func(t *testing.T) {
+		chain := testdata.FakeChain()
+		account := testdata.FakeAccount()
+		txRequest := fakeBatchTransferTxRequest("key1")
+		items := []*entities.TxBatchItem{
+			{Type: entities.TxBatchTransfer, TxRequest: txRequest},
+			{Type: entities.TxBatchTransfer, TxRequest: fakeBatchTransferTxRequest("key1")},
+		}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), &entities.ChainFilters{Names: []string{txRequest.ChainName}}, s.userInfo).
+			Return([]*entities.Chain{chain}, nil)
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), txRequest.Params.From.String(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(account, nil).Times(2)
+		s.TxRequestDA.EXPECT().Search(gomock.Any(), &entities.TransactionRequestFilters{IdempotencyKeys: []string{"key1"}},
+			[]string{s.userInfo.TenantID}, s.userInfo.Username).Return([]*entities.TxRequest{}, nil)
+		s.DB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(a store.DB) error) error {
+			return persist(s.DB)
+		})
+		s.ScheduleDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, schedules []*entities.Schedule) error {
+			require.Len(t, schedules, 1)
+			schedules[0].UUID = uuid.Must(uuid.NewV4()).String()
+			return nil
+		})
+		s.TxRequestDA.EXPECT().InsertMultiple(gomock.Any(), []*entities.TxRequest{txRequest}, gomock.Any()).Return(nil)
+		s.JobDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobs []*entities.Job) error {
+			require.Len(t, jobs, 1)
+			assert.Equal(t, chain.ChainID, jobs[0].InternalData.ChainID)
+			assert.Equal(t, account.StoreID, jobs[0].InternalData.StoreID)
+			assert.Equal(t, entities.StatusCreated, jobs[0].Status)
+			jobs[0].UUID = "jobUUID"
+			return nil
+		})
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), *txRequest.Params.From, chain, s.userInfo).Return(nil, faucetNotFoundErr)
+		s.StartJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", s.userInfo).Return(nil)
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, entities.TxBatchItemCreated, results[0].Status)
+		assert.NoError(t, results[0].Error)
+		assert.Equal(t, "jobUUID", results[0].TxRequest.Schedule.Jobs[0].UUID)
+		assert.Equal(t, entities.TxBatchItemInvalid, results[1].Status)
+		assert.True(t, errors.IsInvalidParameterError(results[1].Error))
+	})
+
+	s.T().Run("should apply the fee policy of the chain to the jobs of the batch", func(t *testing.T) {
+		chain := testdata.FakeChain()
+		chain.FeePolicy = &entities.FeePolicy{
+			MaxFee:               big.NewInt(1000000000),
+			BumpPercentage:       0.1,
+			BumpInterval:         30 * time.Second,
+			MaxBumps:             3,
+			ReplaceWithSameNonce: true,
+		}
+		txRequest := fakeBatchTransferTxRequest("")
+		items := []*entities.TxBatchItem{{Type: entities.TxBatchTransfer, TxRequest: txRequest}}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.Chain{chain}, nil)
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(testdata.FakeAccount(), nil)
+		s.DB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(a store.DB) error) error {
+			return persist(s.DB)
+		})
+		s.ScheduleDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		s.TxRequestDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		s.JobDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobs []*entities.Job) error {
+			require.Len(t, jobs, 1)
+			assert.Equal(t, chain.FeePolicy.MaxFee, jobs[0].InternalData.MaxFee)
+			assert.Equal(t, 30*time.Second, jobs[0].InternalData.RetryInterval)
+			assert.Equal(t, 0.1, jobs[0].InternalData.GasPriceIncrement)
+			assert.InDelta(t, 0.3, jobs[0].InternalData.GasPriceLimit, 1e-9)
+			return nil
+		})
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), gomock.Any(), chain, s.userInfo).Return(nil, faucetNotFoundErr)
+		s.StartJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(nil)
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TxBatchItemCreated, results[0].Status)
+	})
+
+	s.T().Run("should resolve the privacy group of transactions by name", func(t *testing.T) {
+		chain := testdata.FakeChain()
+		group := testdata.FakePrivacyGroup()
+		txRequest := fakeBatchTransferTxRequest("")
+		txRequest.Params.PrivacyGroupName = group.Name
+		items := []*entities.TxBatchItem{{Type: entities.TxBatchTransfer, TxRequest: txRequest}}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.Chain{chain}, nil)
+		s.PrivacyGroupDA.EXPECT().Search(gomock.Any(), &entities.PrivacyGroupFilters{Names: []string{group.Name}, ChainUUID: chain.UUID},
+			s.userInfo.AllowedTenants, s.userInfo.Username).Return([]*entities.PrivacyGroup{group}, nil)
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(testdata.FakeAccount(), nil)
+		s.DB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(a store.DB) error) error {
+			return persist(s.DB)
+		})
+		s.ScheduleDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		s.TxRequestDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		s.JobDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, jobs []*entities.Job) error {
+			require.Len(t, jobs, 1)
+			assert.Equal(t, group.PrivacyGroupID, jobs[0].Transaction.PrivacyGroupID)
+			return nil
+		})
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), gomock.Any(), chain, s.userInfo).Return(nil, faucetNotFoundErr)
+		s.StartJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(nil)
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TxBatchItemCreated, results[0].Status)
+		assert.Equal(t, group.PrivacyGroupID, results[0].TxRequest.Params.PrivacyGroupID)
+	})
+
+	s.T().Run("should report invalid transactions if privacy group does not exist", func(t *testing.T) {
+		txRequest := fakeBatchTransferTxRequest("")
+		txRequest.Params.PrivacyGroupName = "unknown"
+		items := []*entities.TxBatchItem{{Type: entities.TxBatchTransfer, TxRequest: txRequest}}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.Chain{testdata.FakeChain()}, nil)
+		s.PrivacyGroupDA.EXPECT().Search(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return([]*entities.PrivacyGroup{}, nil)
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TxBatchItemInvalid, results[0].Status)
+		assert.True(t, errors.IsInvalidParameterError(results[0].Error))
+	})
+
+	s.T().Run("should report transactions already sent with the same idempotency key", func(t *testing.T) {
+		chain := testdata.FakeChain()
+		txRequest := fakeBatchTransferTxRequest("key1")
+		requestHash, _ := generateRequestHash(chain.UUID, txRequest.Params)
+		existingTxRequest := testdata.FakeTransferTxRequest()
+		existingTxRequest.IdempotencyKey = "key1"
+		existingTxRequest.Hash = requestHash
+		otherTxRequest := testdata.FakeTransferTxRequest()
+		otherTxRequest.IdempotencyKey = "key2"
+		otherTxRequest.Hash = "otherHash"
+		items := []*entities.TxBatchItem{
+			{Type: entities.TxBatchTransfer, TxRequest: txRequest},
+			{Type: entities.TxBatchTransfer, TxRequest: fakeBatchTransferTxRequest("key2")},
+		}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.Chain{chain}, nil)
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(testdata.FakeAccount(), nil).Times(2)
+		s.TxRequestDA.EXPECT().Search(gomock.Any(), &entities.TransactionRequestFilters{IdempotencyKeys: []string{"key1", "key2"}},
+			[]string{s.userInfo.TenantID}, s.userInfo.Username).Return([]*entities.TxRequest{existingTxRequest, otherTxRequest}, nil)
+		s.GetTxUC.EXPECT().Execute(gomock.Any(), existingTxRequest.Schedule.UUID, s.userInfo).Return(existingTxRequest, nil)
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TxBatchItemDuplicate, results[0].Status)
+		assert.Equal(t, existingTxRequest, results[0].TxRequest)
+		assert.Equal(t, entities.TxBatchItemInvalid, results[1].Status)
+		assert.True(t, errors.IsAlreadyExistsError(results[1].Error))
+	})
+
+	s.T().Run("should report invalid transactions if account does not exist", func(t *testing.T) {
+		items := []*entities.TxBatchItem{{Type: entities.TxBatchTransfer, TxRequest: fakeBatchTransferTxRequest("")}}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.Chain{testdata.FakeChain()}, nil)
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(nil, errors.NotFoundError("error"))
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TxBatchItemInvalid, results[0].Status)
+		assert.True(t, errors.IsInvalidParameterError(results[0].Error))
+	})
+
+	s.T().Run("should fail with same error if insertion fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		items := []*entities.TxBatchItem{{Type: entities.TxBatchTransfer, TxRequest: fakeBatchTransferTxRequest("")}}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.Chain{testdata.FakeChain()}, nil)
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(testdata.FakeAccount(), nil)
+		s.DB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).Return(expectedErr)
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		assert.Nil(t, results)
+		assert.Equal(t, errors.FromError(expectedErr).ExtendComponent(sendTxBatchComponent), err)
+	})
+
+	s.T().Run("should keep transaction created if it fails to start", func(t *testing.T) {
+		chain := testdata.FakeChain()
+		txRequest := fakeBatchTransferTxRequest("")
+		items := []*entities.TxBatchItem{{Type: entities.TxBatchTransfer, TxRequest: txRequest}}
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return([]*entities.Chain{chain}, nil)
+		s.AccountDA.EXPECT().FindOneByAddress(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			Return(testdata.FakeAccount(), nil)
+		s.DB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, persist func(a store.DB) error) error {
+			return persist(s.DB)
+		})
+		s.ScheduleDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		s.TxRequestDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		s.JobDA.EXPECT().InsertMultiple(gomock.Any(), gomock.Any()).Return(nil)
+		s.GetFaucetCandidate.EXPECT().Execute(gomock.Any(), gomock.Any(), chain, s.userInfo).Return(nil, faucetNotFoundErr)
+		s.StartJobUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(fmt.Errorf("error"))
+
+		results, err := s.usecase.Execute(ctx, items, s.userInfo)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TxBatchItemCreated, results[0].Status)
+		assert.Error(t, results[0].Error)
+	})
+}
+
+func fakeBatchTransferTxRequest(idempotencyKey string) *entities.TxRequest {
+	return &entities.TxRequest{
+		IdempotencyKey: idempotencyKey,
+		ChainName:      "chain",
+		Params:         testdata.FakeTransferTransactionParams(),
+		InternalData:   testdata.FakeInternalData(),
+	}
+}
@@ -29,6 +29,7 @@ type sendTxSuite struct {
 	SearchChainsUC     *mocks.MockSearchChainsUseCase
 	TxRequestDA        *mocks2.MockTransactionRequestAgent
 	ScheduleDA         *mocks2.MockScheduleAgent
+	PrivacyGroupDA     *mocks2.MockPrivacyGroupAgent
 	StartJobUC         *mocks.MockStartJobUseCase
 	CreateJobUC        *mocks.MockCreateJobUseCase
 	GetTxUC            *mocks.MockGetTxUseCase
@@ -53,6 +54,7 @@ func (s *sendTxSuite) SetupTest() {
 	s.SearchChainsUC = mocks.NewMockSearchChainsUseCase(ctrl)
 	s.TxRequestDA = mocks2.NewMockTransactionRequestAgent(ctrl)
 	s.ScheduleDA = mocks2.NewMockScheduleAgent(ctrl)
+	s.PrivacyGroupDA = mocks2.NewMockPrivacyGroupAgent(ctrl)
 	s.StartJobUC = mocks.NewMockStartJobUseCase(ctrl)
 	s.CreateJobUC = mocks.NewMockCreateJobUseCase(ctrl)
 	s.GetTxUC = mocks.NewMockGetTxUseCase(ctrl)
@@ -62,6 +64,7 @@ func (s *sendTxSuite) SetupTest() {
 	s.DB.EXPECT().Schedule().Return(s.ScheduleDA).AnyTimes()
 	s.DB.EXPECT().Schedule().Return(s.ScheduleDA).AnyTimes()
 	s.DB.EXPECT().TransactionRequest().Return(s.TxRequestDA).AnyTimes()
+	s.DB.EXPECT().PrivacyGroup().Return(s.PrivacyGroupDA).AnyTimes()
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 
 	s.usecase = NewSendTxUseCase(
@@ -106,6 +109,28 @@ func (s *sendTxSuite) TestSendTx_Success() {
 		assert.Equal(t, txRequest.Schedule.UUID, response.Schedule.UUID)
 	})
 
+	s.T().Run("should execute send successfully a EEA tx to a privacy group referenced by name", func(t *testing.T) {
+		txRequest := testdata.FakeEEATxRequest()
+		txRequest.Params.Protocol = entities.EEAChainType
+		txRequest.Params.PrivateFor = nil
+		group := testdata.FakePrivacyGroup()
+		txRequest.Params.PrivacyGroupName = group.Name
+
+		s.PrivacyGroupDA.EXPECT().Search(gomock.Any(), gomock.Any(), s.userInfo.AllowedTenants, s.userInfo.Username).
+			DoAndReturn(func(ctx context.Context, filters *entities.PrivacyGroupFilters, tenants []string, ownerID string) ([]*entities.PrivacyGroup, error) {
+				if len(filters.Names) != 1 || filters.Names[0] != group.Name || filters.ChainUUID == "" {
+					return nil, fmt.Errorf("invalid privacy group filters %v", filters)
+				}
+				return []*entities.PrivacyGroup{group}, nil
+			})
+
+		response, err := successfulTestExecution(s, txRequest, false, entities.EEAPrivateTransaction,
+			entities.EEAMarkingTransaction)
+		assert.NoError(t, err)
+		assert.Equal(t, group.PrivacyGroupID, response.Params.PrivacyGroupID)
+		assert.Equal(t, group.PrivacyGroupID, response.Schedule.Jobs[0].Transaction.PrivacyGroupID)
+	})
+
 	s.T().Run("should execute send successfully a tessera tx", func(t *testing.T) {
 		txRequest := testdata.FakeTesseraTxRequest()
 		txRequest.Params.Protocol = entities.GoQuorumChainType
@@ -218,6 +243,21 @@ func (s *sendTxSuite) TestSendTx_ExpectedErrors() {
 		assert.True(t, errors.IsInvalidParameterError(err))
 	})
 
+	s.T().Run("should fail with InvalidParameterError if privacy group name does not exist on chain", func(t *testing.T) {
+		txRequest := testdata.FakeEEATxRequest()
+		txRequest.Params.PrivacyGroupName = "my-privacy-group"
+
+		s.SearchChainsUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(chains, nil)
+		s.PrivacyGroupDA.EXPECT().Search(gomock.Any(), &entities.PrivacyGroupFilters{
+			Names:     []string{"my-privacy-group"},
+			ChainUUID: chains[0].UUID,
+		}, s.userInfo.AllowedTenants, s.userInfo.Username).Return([]*entities.PrivacyGroup{}, nil)
+
+		response, err := s.usecase.Execute(ctx, txRequest, txData, s.userInfo)
+		assert.Nil(t, response)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	s.T().Run("should fail with same error if FindOne fails", func(t *testing.T) {
 		expectedErr := errors.PostgresConnectionError("error")
 		txRequest := testdata.FakeTxRequest()
@@ -11,4 +11,9 @@ type UseCases interface {
 	EventStreams() EventStreamsUseCases
 	Subscriptions() SubscriptionUseCases
 	Notifications() NotificationsUseCases
+	SafeProposals() SafeProposalUseCases
+	Relayers() RelayerUseCases
+	EventLogs() EventLogUseCases
+	SentrySessions() SentrySessionUseCases
+	PrivacyGroups() PrivacyGroupUseCases
 }
@@ -1,12 +1,18 @@
 package api
 
 import (
+	"time"
+
 	"github.com/consensys/orchestrate/pkg/sdk/messenger"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	"github.com/consensys/orchestrate/src/api/proxy"
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
 	"github.com/consensys/orchestrate/src/infra/postgres/gopg"
 	quorumkeymanager "github.com/consensys/orchestrate/src/infra/quorum-key-manager/http"
+	"github.com/consensys/orchestrate/src/infra/signer/web3signer"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 type Config struct {
@@ -15,6 +21,14 @@ type Config struct {
 	Multitenancy bool
 	Proxy        *proxy.Config
 	QKM          *quorumkeymanager.Config
+	Web3Signer   *web3signer.Config
+	Keystore     *keystore.Config
 	Kafka        *kafka.Config
 	Messenger    *messenger.Config
+	// OutboxRelayInterval is the polling interval of the relay sending outbox messages to Kafka
+	OutboxRelayInterval time.Duration
+	// Create2Factory is the factory through which contracts are deployed at deterministic addresses
+	Create2Factory ethcommon.Address
+	// Broker replaces Kafka when set, to run all services within a single process
+	Broker *inmemory.Broker
 }
@@ -10,8 +10,13 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	authjwt "github.com/consensys/orchestrate/pkg/toolkit/app/auth/jwt"
 	authkey "github.com/consensys/orchestrate/pkg/toolkit/app/auth/key"
+	"github.com/consensys/orchestrate/src/api/outbox"
+	postgresstore "github.com/consensys/orchestrate/src/api/store/postgres"
 	ethclient "github.com/consensys/orchestrate/src/infra/ethclient/rpc"
+	kafkainfra "github.com/consensys/orchestrate/src/infra/kafka"
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
+	keystorebuilder "github.com/consensys/orchestrate/src/infra/keystore/builder"
+	"github.com/consensys/orchestrate/src/infra/postgres"
 	"github.com/consensys/orchestrate/src/infra/postgres/gopg"
 	qkmhttp "github.com/consensys/orchestrate/src/infra/quorum-key-manager/http"
 	nonclient "github.com/consensys/orchestrate/src/infra/quorum-key-manager/non-client"
@@ -26,28 +31,34 @@ type Daemon struct {
 
 func New(ctx context.Context, cfg *Config, notifierCfg *notifier.Config) (*Daemon, error) {
 	// Initialize infra dependencies
-	qkmClient, err := QKMClient(cfg)
+	postgresClient, err := gopg.New("orchestrate.api", cfg.Postgres)
 	if err != nil {
 		return nil, err
 	}
 
-	postgresClient, err := gopg.New("orchestrate.api", cfg.Postgres)
+	qkmClient, err := QKMClient(cfg, postgresClient)
 	if err != nil {
 		return nil, err
 	}
 
-	kafkaProdClient, err := kafka.NewProducer(cfg.Kafka)
-	if err != nil {
-		return nil, err
+	var kafkaProdClient kafkainfra.Producer
+	if cfg.Broker != nil {
+		kafkaProdClient = cfg.Broker
+	} else {
+		kafkaProdClient, err = kafka.NewProducer(cfg.Kafka)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// @TODO Decouple initialization of api and notifier to prevent this overhead of merging topics
-	messengerClient := messenger.NewProducerClient(&messenger.Config{
+	messengerCfg := &messenger.Config{
 		TopicAPI:        notifierCfg.Messenger.TopicAPI,
 		TopicTxListener: cfg.Messenger.TopicTxListener,
 		TopicTxSender:   cfg.Messenger.TopicTxSender,
 		TopicNotifier:   notifierCfg.ConsumerTopic,
-	}, kafkaProdClient)
+	}
+	messengerClient := messenger.NewProducerClient(messengerCfg, kafkaProdClient)
 	webhookProducer := webhook.New(http.DefaultClient)
 
 	authjwt.Init(ctx)
@@ -69,7 +80,9 @@ func New(ctx context.Context, cfg *Config, notifierCfg *notifier.Config) (*Daemo
 		cfg.QKM.StoreName,
 		ethclient.GlobalClient(),
 		messengerClient,
+		outbox.NewMessenger(messengerCfg),
 		notifierDaemon,
+		outbox.NewRelay(postgresstore.New(postgresClient), kafkaProdClient, cfg.OutboxRelayInterval),
 	)
 
 	if err != nil {
@@ -83,7 +96,13 @@ func (d *Daemon) Run(ctx context.Context) error {
 	return d.App.Run(ctx)
 }
 
-func QKMClient(cfg *Config) (qkmclient.KeyManagerClient, error) {
+// QKMClient returns the key manager holding the keys of the accounts, the local keystore replacing the Quorum Key
+// Manager when configured
+func QKMClient(cfg *Config, postgresClient postgres.Client) (qkmclient.KeyManagerClient, error) {
+	if cfg.Keystore != nil && cfg.Keystore.Type != "" {
+		return keystorebuilder.NewClient(cfg.Keystore, postgresClient)
+	}
+
 	if cfg.QKM.URL != "" {
 		return qkmhttp.New(cfg.QKM)
 	}
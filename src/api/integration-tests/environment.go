@@ -29,6 +29,8 @@ import (
 	httputils "github.com/consensys/orchestrate/pkg/toolkit/app/http"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api"
+	"github.com/consensys/orchestrate/src/api/outbox"
+	postgresstore "github.com/consensys/orchestrate/src/api/store/postgres"
 	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
 	ethclient "github.com/consensys/orchestrate/src/infra/ethclient/rpc"
 	"github.com/consensys/orchestrate/tests/pkg/docker"
@@ -450,12 +452,13 @@ func newAPI(ctx context.Context, cfg *api.Config, notifierConfig *notifier.Confi
 		return nil, err
 	}
 
-	messengerClient := messenger.NewProducerClient(&messenger.Config{
+	messengerCfg := &messenger.Config{
 		TopicAPI:        notifierConfig.Messenger.TopicAPI,
 		TopicTxListener: cfg.Messenger.TopicTxListener,
 		TopicTxSender:   cfg.Messenger.TopicTxSender,
 		TopicNotifier:   notifierConfig.ConsumerTopic,
-	}, kafkaProducer)
+	}
+	messengerClient := messenger.NewProducerClient(messengerCfg, kafkaProducer)
 
 	authjwt.Init(ctx)
 	authkey.Init(ctx)
@@ -485,7 +488,9 @@ func newAPI(ctx context.Context, cfg *api.Config, notifierConfig *notifier.Confi
 		cfg.QKM.StoreName,
 		ethclient.GlobalClient(),
 		messengerClient,
+		outbox.NewMessenger(messengerCfg),
 		notifierDaemon,
+		outbox.NewRelay(postgresstore.New(postgresClient), kafkaProducer, cfg.OutboxRelayInterval),
 	)
 }
 
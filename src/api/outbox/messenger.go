@@ -0,0 +1,26 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/sdk"
+	"github.com/consensys/orchestrate/pkg/sdk/messenger"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/store"
+)
+
+type Messenger struct {
+	cfg *messenger.Config
+}
+
+var _ usecases.OutboxMessenger = &Messenger{}
+
+func NewMessenger(cfg *messenger.Config) *Messenger {
+	return &Messenger{
+		cfg: cfg,
+	}
+}
+
+func (m *Messenger) WithDB(ctx context.Context, db store.DB) sdk.OrchestrateMessenger {
+	return messenger.NewProducerClient(m.cfg, NewProducer(ctx, db.Outbox()))
+}
@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/kafka"
+)
+
+// Producer writes messages to the outbox instead of publishing them
+type Producer struct {
+	ctx   context.Context
+	agent store.OutboxAgent
+}
+
+var _ kafka.Producer = &Producer{}
+
+func NewProducer(ctx context.Context, agent store.OutboxAgent) *Producer {
+	return &Producer{
+		ctx:   ctx,
+		agent: agent,
+	}
+}
+
+func (p *Producer) Send(body interface{}, topic, partitionKey string, headers map[string]interface{}) error {
+	bBody, err := json.Marshal(body)
+	if err != nil {
+		return errors.EncodingError("failed to marshall message body")
+	}
+
+	return p.agent.Insert(p.ctx, &entities.OutboxMessage{
+		Topic:        topic,
+		PartitionKey: partitionKey,
+		Body:         bBody,
+		Headers:      headers,
+	})
+}
+
+func (p *Producer) Close() error {
+	return nil
+}
+
+func (p *Producer) Checker() error {
+	return nil
+}
@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/infra/kafka"
+)
+
+const (
+	relayComponent = "api.outbox.relay"
+	relayBatchSize = 100
+)
+
+// Relay publishes the messages of the outbox, in order, and deletes them once published.
+// A message might be published more than once if the relay stops before deleting it
+type Relay struct {
+	db       store.DB
+	producer kafka.Producer
+	interval time.Duration
+	logger   *log.Logger
+}
+
+var _ app.Daemon = &Relay{}
+
+func NewRelay(db store.DB, producer kafka.Producer, interval time.Duration) *Relay {
+	return &Relay{
+		db:       db,
+		producer: producer,
+		interval: interval,
+		logger:   log.NewLogger().SetComponent(relayComponent),
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) error {
+	r.logger.Debug("starting outbox relay")
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.WithField("reason", ctx.Err().Error()).Info("gracefully stopping outbox relay")
+			return nil
+		case <-ticker.C:
+			// Relay messages until the outbox is drained or an error occurs
+			for {
+				n, err := r.relay(ctx)
+				if err != nil {
+					r.logger.WithError(err).Warn("failed to relay outbox messages, retrying on next tick")
+					break
+				}
+				if n < relayBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+func (r *Relay) Close() error {
+	return nil
+}
+
+func (r *Relay) relay(ctx context.Context) (int, error) {
+	var sent []string
+	var sendErr error
+	err := r.db.RunInTransaction(ctx, func(dbtx store.DB) error {
+		msgs, err := dbtx.Outbox().LockPending(ctx, relayBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range msgs {
+			// Stop at the first failure to preserve ordering
+			sendErr = r.producer.Send(json.RawMessage(msg.Body), msg.Topic, msg.PartitionKey, msg.Headers)
+			if sendErr != nil {
+				break
+			}
+			sent = append(sent, msg.UUID)
+		}
+
+		// Messages published before a failure are deleted anyway so they are not published twice
+		return dbtx.Outbox().Delete(ctx, sent)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(sent) > 0 {
+		r.logger.WithField("count", len(sent)).Debug("outbox messages relayed")
+	}
+
+	if sendErr != nil {
+		return len(sent), sendErr
+	}
+
+	return len(sent), nil
+}
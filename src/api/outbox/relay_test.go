@@ -0,0 +1,79 @@
+// +build unit
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/mocks"
+	"github.com/consensys/orchestrate/src/entities"
+	kafkamocks "github.com/consensys/orchestrate/src/infra/kafka/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelay_relay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockDB := mocks.NewMockDB(ctrl)
+	mockOutbox := mocks.NewMockOutboxAgent(ctrl)
+	mockProducer := kafkamocks.NewMockProducer(ctrl)
+
+	mockDB.EXPECT().Outbox().Return(mockOutbox).AnyTimes()
+	mockDB.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persistFunc func(dbtx store.DB) error) error {
+			return persistFunc(mockDB)
+		}).AnyTimes()
+
+	relay := NewRelay(mockDB, mockProducer, 0)
+	ctx := context.Background()
+
+	msgs := []*entities.OutboxMessage{
+		{UUID: "uuid1", Topic: "topic-tx-sender", PartitionKey: "key1", Body: []byte(`{"a":1}`)},
+		{UUID: "uuid2", Topic: "topic-tx-listener", PartitionKey: "key2", Body: []byte(`{"b":2}`)},
+	}
+
+	t.Run("should publish and delete all pending messages successfully", func(t *testing.T) {
+		mockOutbox.EXPECT().LockPending(gomock.Any(), relayBatchSize).Return(msgs, nil)
+		gomock.InOrder(
+			mockProducer.EXPECT().Send(json.RawMessage(msgs[0].Body), msgs[0].Topic, msgs[0].PartitionKey, gomock.Any()).Return(nil),
+			mockProducer.EXPECT().Send(json.RawMessage(msgs[1].Body), msgs[1].Topic, msgs[1].PartitionKey, gomock.Any()).Return(nil),
+		)
+		mockOutbox.EXPECT().Delete(gomock.Any(), []string{"uuid1", "uuid2"}).Return(nil)
+
+		n, err := relay.relay(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("should delete published messages and stop at the first failure", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		mockOutbox.EXPECT().LockPending(gomock.Any(), relayBatchSize).Return(msgs, nil)
+		mockProducer.EXPECT().Send(gomock.Any(), msgs[0].Topic, gomock.Any(), gomock.Any()).Return(nil)
+		mockProducer.EXPECT().Send(gomock.Any(), msgs[1].Topic, gomock.Any(), gomock.Any()).Return(expectedErr)
+		mockOutbox.EXPECT().Delete(gomock.Any(), []string{"uuid1"}).Return(nil)
+
+		n, err := relay.relay(ctx)
+
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("should fail with same error if LockPending fails", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		mockOutbox.EXPECT().LockPending(gomock.Any(), relayBatchSize).Return(nil, expectedErr)
+
+		_, err := relay.relay(ctx)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,7 +11,9 @@ import (
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/consensys/orchestrate/src/infra/signer"
 	"github.com/consensys/quorum-key-manager/pkg/client"
 	qkmstoretypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
 	qkmutilstypes "github.com/consensys/quorum-key-manager/src/utils/api/types"
@@ -20,13 +23,16 @@ import (
 type AccountsController struct {
 	ucs              usecases.AccountUseCases
 	keyManagerClient client.KeyManagerClient
+	signers          *signer.Router
 	storeName        string
 }
 
-func NewAccountsController(accountUCs usecases.AccountUseCases, keyManagerClient client.KeyManagerClient, qkmStoreID string) *AccountsController {
+func NewAccountsController(accountUCs usecases.AccountUseCases, keyManagerClient client.KeyManagerClient, signers *signer.Router,
+	qkmStoreID string) *AccountsController {
 	return &AccountsController{
 		accountUCs,
 		keyManagerClient,
+		signers,
 		qkmStoreID,
 	}
 }
@@ -38,6 +44,7 @@ func (c *AccountsController) Append(router *mux.Router) {
 	router.Methods(http.MethodPost).Path("/accounts/import").HandlerFunc(c.importKey)
 	router.Methods(http.MethodGet).Path("/accounts/{address}").HandlerFunc(c.getOne)
 	router.Methods(http.MethodPatch).Path("/accounts/{address}").HandlerFunc(c.update)
+	router.Methods(http.MethodPost).Path("/accounts/{address}/rotate").HandlerFunc(c.rotate)
 	router.Methods(http.MethodPost).Path("/accounts/{address}/sign-message").HandlerFunc(c.signMessage)
 	router.Methods(http.MethodPost).Path("/accounts/{address}/sign-typed-data").HandlerFunc(c.signTypedData)
 	router.Methods(http.MethodPost).Path("/accounts/verify-message").HandlerFunc(c.verifyMessageSignature)
@@ -68,7 +75,7 @@ func (c *AccountsController) create(rw http.ResponseWriter, request *http.Reques
 		return
 	}
 
-	acc, err := c.ucs.Create().Execute(ctx, formatters.FormatCreateAccountRequest(req, c.storeName), nil, req.Chain,
+	acc, err := c.ucs.Create().Execute(ctx, formatters.FormatCreateAccountRequest(req, c.defaultStoreID(ctx)), nil, req.Chain,
 		multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
@@ -117,6 +124,10 @@ func (c *AccountsController) getOne(rw http.ResponseWriter, request *http.Reques
 // @Security     ApiKeyAuth
 // @Security     JWTAuth
 // @Param        aliases  query     []string                false  "List of account aliases"  collectionFormat(csv)
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
 // @Success      200      {array}   api.AccountResponse     "List of identities found"
 // @Failure      400      {object}  infra.ErrorResponse  "Invalid filter in the request"
 // @Failure      401      {object}  infra.ErrorResponse  "Unauthorized"
@@ -143,6 +154,11 @@ func (c *AccountsController) search(rw http.ResponseWriter, request *http.Reques
 		response = append(response, formatters.FormatAccountResponse(acc))
 	}
 
+	if len(accs) > 0 {
+		last := accs[len(accs)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(accs), last.CreatedAt, last.UpdatedAt, last.Address.Hex()))
+	}
+
 	_ = json.NewEncoder(rw).Encode(response)
 }
 
@@ -172,7 +188,7 @@ func (c *AccountsController) importKey(rw http.ResponseWriter, request *http.Req
 		return
 	}
 
-	acc, err := c.ucs.Create().Execute(ctx, formatters.FormatImportAccountRequest(req, c.storeName), req.PrivateKey, req.Chain,
+	acc, err := c.ucs.Create().Execute(ctx, formatters.FormatImportAccountRequest(req, c.defaultStoreID(ctx)), req.PrivateKey, req.Chain,
 		multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
@@ -226,6 +242,49 @@ func (c *AccountsController) update(rw http.ResponseWriter, request *http.Reques
 	_ = json.NewEncoder(rw).Encode(formatters.FormatAccountResponse(accRes))
 }
 
+// @Summary      Rotate account by Address
+// @Description  Creates a new account replacing the selected account, transfers its remaining balance on the given chains to the new account and archives the selected account once all transfers are sent. A failed rotation is resumed with the same new account when retried
+// @Tags         Accounts
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.RotateAccountRequest  true  "Account rotation request"
+// @Param        address  path      string                    true  "selected account address"
+// @Success      200      {object}  api.AccountResponse       "New account"
+// @Failure      400      {object}  infra.ErrorResponse    "Invalid request"
+// @Failure      401      {object}  infra.ErrorResponse    "Unauthorized"
+// @Failure      404      {object}  infra.ErrorResponse    "Account not found"
+// @Failure      409      {object}  infra.ErrorResponse    "Account already archived"
+// @Failure      500      {object}  infra.ErrorResponse    "Internal server error"
+// @Router       /accounts/{address}/rotate [post]
+func (c *AccountsController) rotate(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.RotateAccountRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	address, err := utils.ParseHexToMixedCaseEthAddress(mux.Vars(request)["address"])
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	acc, err := c.ucs.Rotate().Execute(ctx, *address, formatters.FormatRotateAccountRequest(req), req.Chains,
+		multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatAccountResponse(acc))
+}
+
 // @Summary      Sign Message (EIP-191)
 // @Description  Sign message, following EIP-191, data using selected account
 // @Tags         Accounts
@@ -239,6 +298,7 @@ func (c *AccountsController) update(rw http.ResponseWriter, request *http.Reques
 // @Failure      400      {object}  infra.ErrorResponse  "Invalid request"
 // @Failure      401      {object}  infra.ErrorResponse  "Unauthorized"
 // @Failure      404      {object}  infra.ErrorResponse  "Account not found"
+// @Failure      409      {object}  infra.ErrorResponse  "Account cannot sign"
 // @Failure      500      {object}  infra.ErrorResponse  "Internal server error"
 // @Router       /accounts/{address}/sign-message [post]
 func (c *AccountsController) signMessage(rw http.ResponseWriter, request *http.Request) {
@@ -256,18 +316,23 @@ func (c *AccountsController) signMessage(rw http.ResponseWriter, request *http.R
 		return
 	}
 
-	_, err = c.ucs.Get().Execute(ctx, *address, multitenancy.UserInfoValue(ctx))
+	acc, err := c.ucs.Get().Execute(ctx, *address, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		infra.WriteError(rw, fmt.Sprintf("account %s was not found", address), http.StatusBadRequest)
 		return
 	}
 
+	if !acc.CanSign(nil) {
+		infra.WriteError(rw, fmt.Sprintf("account %s is %s and cannot sign", address, acc.Status), http.StatusConflict)
+		return
+	}
+
 	qkmStoreID := payloadRequest.StoreID
 	if qkmStoreID == "" {
-		qkmStoreID = c.storeName
+		qkmStoreID = c.accountStoreID(acc)
 	}
 
-	signature, err := c.keyManagerClient.SignMessage(request.Context(), qkmStoreID, address.Hex(), &qkmstoretypes.SignMessageRequest{
+	signature, err := c.signers.SignMessage(request.Context(), qkmStoreID, address.Hex(), &qkmstoretypes.SignMessageRequest{
 		Message: payloadRequest.Message,
 	})
 	if err != nil {
@@ -289,6 +354,7 @@ func (c *AccountsController) signMessage(rw http.ResponseWriter, request *http.R
 // @Failure      400      {object}  infra.ErrorResponse    "Invalid request"
 // @Failure      401      {object}  infra.ErrorResponse    "Unauthorized"
 // @Failure      404      {object}  infra.ErrorResponse    "Account not found"
+// @Failure      409      {object}  infra.ErrorResponse    "Account cannot sign"
 // @Failure      422      {object}  infra.ErrorResponse    "Invalid parameters"
 // @Failure      500      {object}  infra.ErrorResponse    "Internal server error"
 // @Router       /accounts/{address}/sign-typed-data [post]
@@ -307,18 +373,23 @@ func (c *AccountsController) signTypedData(rw http.ResponseWriter, request *http
 		return
 	}
 
-	_, err = c.ucs.Get().Execute(ctx, *address, multitenancy.UserInfoValue(ctx))
+	acc, err := c.ucs.Get().Execute(ctx, *address, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		infra.WriteError(rw, fmt.Sprintf("account %s was not found", address), http.StatusBadRequest)
 		return
 	}
 
+	if !acc.CanSign(nil) {
+		infra.WriteError(rw, fmt.Sprintf("account %s is %s and cannot sign", address, acc.Status), http.StatusConflict)
+		return
+	}
+
 	qkmStoreID := signRequest.StoreID
 	if qkmStoreID == "" {
-		qkmStoreID = c.storeName
+		qkmStoreID = c.accountStoreID(acc)
 	}
 
-	signature, err := c.keyManagerClient.SignTypedData(ctx, qkmStoreID, address.Hex(), &qkmstoretypes.SignTypedDataRequest{
+	signature, err := c.signers.SignTypedData(ctx, qkmStoreID, address.Hex(), &qkmstoretypes.SignTypedDataRequest{
 		DomainSeparator: signRequest.DomainSeparator,
 		Types:           signRequest.Types,
 		Message:         signRequest.Message,
@@ -387,3 +458,23 @@ func (c *AccountsController) verifyMessageSignature(rw http.ResponseWriter, requ
 
 	rw.WriteHeader(http.StatusNoContent)
 }
+
+// accountStoreID returns the store holding the key of the account, accounts without store being held by the default store
+func (c *AccountsController) accountStoreID(acc *entities.Account) string {
+	if acc.StoreID != "" {
+		return acc.StoreID
+	}
+
+	return c.storeName
+}
+
+// defaultStoreID returns the store of the accounts created without store, which can be set per tenant
+func (c *AccountsController) defaultStoreID(ctx context.Context) string {
+	if userInfo := multitenancy.UserInfoValue(ctx); userInfo != nil {
+		if storeID := c.signers.TenantStoreID(userInfo.TenantID); storeID != "" {
+			return storeID
+		}
+	}
+
+	return c.storeName
+}
@@ -20,11 +20,15 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"encoding/json"
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/infra/signer"
+	signermocks "github.com/consensys/orchestrate/src/infra/signer/mocks"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/gorilla/mux"
@@ -39,7 +43,9 @@ type accountsCtrlTestSuite struct {
 	searchAccountUC  *mocks.MockSearchAccountsUseCase
 	updateAccountUC  *mocks.MockUpdateAccountUseCase
 	fundAccountUC    *mocks.MockFundAccountUseCase
+	rotateAccountUC  *mocks.MockRotateAccountUseCase
 	keyManagerClient *qkmmock.MockKeyManagerClient
+	remoteSigner     *signermocks.MockRemoteSigner
 	ctx              context.Context
 	userInfo         *multitenancy.UserInfo
 	router           *mux.Router
@@ -67,10 +73,16 @@ func (s *accountsCtrlTestSuite) FundAccount() usecases.FundAccountUseCase {
 	return s.fundAccountUC
 }
 
+func (s *accountsCtrlTestSuite) Rotate() usecases.RotateAccountUseCase {
+	return s.rotateAccountUC
+}
+
 const (
 	inputTestAddress     = "0x7e654d251da770a068413677967f6d3ea2feA9e4"
 	mixedCaseTestAddress = "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
 	qkmStoreName         = "test-store-name"
+	remoteStoreName      = "test-remote-store-name"
+	remoteTenant         = "tenantRemote"
 )
 
 func TestAccountController(t *testing.T) {
@@ -86,12 +98,15 @@ func (s *accountsCtrlTestSuite) SetupTest() {
 	s.getAccountUC = mocks.NewMockGetAccountUseCase(ctrl)
 	s.searchAccountUC = mocks.NewMockSearchAccountsUseCase(ctrl)
 	s.updateAccountUC = mocks.NewMockUpdateAccountUseCase(ctrl)
+	s.rotateAccountUC = mocks.NewMockRotateAccountUseCase(ctrl)
 	s.keyManagerClient = qkmmock.NewMockKeyManagerClient(ctrl)
+	s.remoteSigner = signermocks.NewMockRemoteSigner(ctrl)
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
 	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
 	s.router = mux.NewRouter()
 
-	controller := NewAccountsController(s, s.keyManagerClient, qkmStoreName)
+	signers := signer.NewRouter(s.keyManagerClient).Mount(remoteStoreName, s.remoteSigner, remoteTenant)
+	controller := NewAccountsController(s, s.keyManagerClient, signers, qkmStoreName)
 	controller.Append(s.router)
 }
 
@@ -117,6 +132,29 @@ func (s *accountsCtrlTestSuite) TestAccountController_Create() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should create account in the remote signer store of the tenant", func(t *testing.T) {
+		req := apitestdata.FakeCreateAccountRequest()
+		req.StoreID = ""
+		requestBytes, _ := json.Marshal(req)
+		accResp := testdata.FakeAccount()
+		userInfo := multitenancy.NewUserInfo(remoteTenant, "username")
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/accounts", bytes.NewReader(requestBytes)).
+			WithContext(multitenancy.WithUserInfo(context.Background(), userInfo))
+
+		s.createAccountUC.EXPECT().Execute(gomock.Any(), gomock.Any(), nil, req.Chain, userInfo).
+			DoAndReturn(func(_ context.Context, acc *entities.Account, _ hexutil.Bytes, _ string, _ *multitenancy.UserInfo) (*entities.Account, error) {
+				assert.Equal(t, remoteStoreName, acc.StoreID)
+				return accResp, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
 	s.T().Run("should fail with Bad request if invalid format", func(t *testing.T) {
 		req := apitestdata.FakeImportAccountRequest()
 		requestBytes, _ := json.Marshal(req)
@@ -243,6 +281,46 @@ func (s *accountsCtrlTestSuite) TestAccountController_UpdateAccount() {
 	})
 }
 
+func (s *accountsCtrlTestSuite) TestAccountController_Rotate() {
+	s.T().Run("should execute rotate account request successfully", func(t *testing.T) {
+		req := &api.RotateAccountRequest{Alias: "new-alias", Chains: []string{"besu"}}
+		rw := httptest.NewRecorder()
+		requestBytes, _ := json.Marshal(req)
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/accounts/"+inputTestAddress+"/rotate", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		acc := testdata.FakeAccount()
+		acc.RotatedFrom = utils.ToPtr(ethcommon.HexToAddress(mixedCaseTestAddress)).(*ethcommon.Address)
+
+		s.rotateAccountUC.EXPECT().Execute(gomock.Any(), ethcommon.HexToAddress(mixedCaseTestAddress),
+			&entities.Account{Alias: req.Alias}, req.Chains, s.userInfo).Return(acc, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := formatters.FormatAccountResponse(acc)
+		expectedBody, _ := json.Marshal(response)
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 409 if account cannot be rotated", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/accounts/"+inputTestAddress+"/rotate", bytes.NewReader([]byte("{}"))).
+			WithContext(s.ctx)
+
+		s.rotateAccountUC.EXPECT().Execute(gomock.Any(), ethcommon.HexToAddress(mixedCaseTestAddress), gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidStateError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+	})
+}
+
 func (s *accountsCtrlTestSuite) TestAccountController_SearchIdentity() {
 	s.T().Run("should execute search account request successfully", func(t *testing.T) {
 		accResp := testdata.FakeAccount()
@@ -254,7 +332,8 @@ func (s *accountsCtrlTestSuite) TestAccountController_SearchIdentity() {
 			WithContext(s.ctx)
 
 		filter := &entities.AccountFilters{
-			Aliases: aliases,
+			Aliases:    aliases,
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
 		}
 
 		s.searchAccountUC.EXPECT().Execute(gomock.Any(), filter, s.userInfo).Return([]*entities.Account{accResp}, nil)
@@ -291,6 +370,30 @@ func (s *accountsCtrlTestSuite) TestAccountController_SignPayload() {
 		assert.Equal(t, signature, rw.Body.String())
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
+
+	s.T().Run("should sign payload with the remote signer holding the account", func(t *testing.T) {
+		acc := testdata.FakeAccount()
+		acc.Address = ethcommon.HexToAddress(inputTestAddress)
+		acc.StoreID = remoteStoreName
+		rw := httptest.NewRecorder()
+		payload := hexutil.MustDecode("0x1234")
+		signature := "0xsignature"
+		requestBytes, _ := json.Marshal(&api.SignMessageRequest{SignMessageRequest: qkmtypes.SignMessageRequest{Message: payload}})
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, fmt.Sprintf("/accounts/%v/sign-message", acc.Address), bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.getAccountUC.EXPECT().Execute(gomock.Any(), ethcommon.HexToAddress(mixedCaseTestAddress), s.userInfo).Return(acc, nil)
+		s.remoteSigner.EXPECT().SignMessage(gomock.Any(), remoteStoreName, mixedCaseTestAddress, &qkmtypes.SignMessageRequest{
+			Message: payload,
+		}).Return(signature, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, signature, rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
 }
 
 func (s *accountsCtrlTestSuite) TestAccountController_VerifySignature() {
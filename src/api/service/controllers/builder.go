@@ -9,6 +9,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http/config/dynamic"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/infra/signer"
 	"github.com/gorilla/mux"
 )
 
@@ -26,6 +27,11 @@ import (
 // @description Accounts represent Ethereum accounts (private keys). By usage of the generated cryptographic key pair, accounts can be used to sign/verify and to encrypt/decrypt messages.
 // @description Contracts represent Solidity contracts management.
 // @description Event Streams represent Event streams management.
+// @description Safe Proposals represent Safe multisig transactions collecting owner signatures before execution.
+// @description Relayers represent accounts submitting user signed meta transactions (ERC-2771 and ERC-4337) and paying their fees.
+// @description Events represent contract events indexed from subscriptions and backfills.
+// @description Sentry Sessions represent the retry sessions of pending jobs run by the transaction sentry.
+// @description Privacy Groups represent Besu privacy groups, named in Orchestrate to be referenced by private transactions.
 
 // @contact.name Contact ConsenSys Codefi Orchestrate
 // @contact.url https://consensys.net/codefi/orchestrate/contact
@@ -43,28 +49,38 @@ import (
 // @name Authorization
 
 type Builder struct {
-	txCtrl            *TransactionsController
-	schedulesCtrl     *SchedulesController
-	jobsCtrl          *JobsController
-	accountsCtrl      *AccountsController
-	faucetsCtrl       *FaucetsController
-	chainsCtrl        *ChainsController
-	contractsCtrl     *ContractsController
-	eventStreamsCtrl  *EventStreamsController
-	subscriptionsCtrl *SubscriptionsController
+	txCtrl             *TransactionsController
+	schedulesCtrl      *SchedulesController
+	jobsCtrl           *JobsController
+	accountsCtrl       *AccountsController
+	faucetsCtrl        *FaucetsController
+	chainsCtrl         *ChainsController
+	contractsCtrl      *ContractsController
+	eventStreamsCtrl   *EventStreamsController
+	subscriptionsCtrl  *SubscriptionsController
+	safeProposalsCtrl  *SafeProposalsController
+	relayersCtrl       *RelayersController
+	eventLogsCtrl      *EventLogsController
+	sentrySessionsCtrl *SentrySessionsController
+	privacyGroupsCtrl  *PrivacyGroupsController
 }
 
-func NewBuilder(ucs usecases.UseCases, keyManagerClient qkm.KeyManagerClient, qkmStoreID string) *Builder {
+func NewBuilder(ucs usecases.UseCases, keyManagerClient qkm.KeyManagerClient, signers *signer.Router, qkmStoreID string) *Builder {
 	return &Builder{
-		txCtrl:            NewTransactionsController(ucs.Transactions()),
-		schedulesCtrl:     NewSchedulesController(ucs.Schedules()),
-		jobsCtrl:          NewJobsController(ucs.Jobs()),
-		accountsCtrl:      NewAccountsController(ucs.Accounts(), keyManagerClient, qkmStoreID),
-		faucetsCtrl:       NewFaucetsController(ucs.Faucets()),
-		chainsCtrl:        NewChainsController(ucs.Chains()),
-		contractsCtrl:     NewContractsController(ucs.Contracts()),
-		eventStreamsCtrl:  NewEventStreamsController(ucs.EventStreams()),
-		subscriptionsCtrl: NewSubscriptionsController(ucs.Subscriptions()),
+		txCtrl:             NewTransactionsController(ucs.Transactions()),
+		schedulesCtrl:      NewSchedulesController(ucs.Schedules()),
+		jobsCtrl:           NewJobsController(ucs.Jobs()),
+		accountsCtrl:       NewAccountsController(ucs.Accounts(), keyManagerClient, signers, qkmStoreID),
+		faucetsCtrl:        NewFaucetsController(ucs.Faucets()),
+		chainsCtrl:         NewChainsController(ucs.Chains()),
+		contractsCtrl:      NewContractsController(ucs.Contracts()),
+		eventStreamsCtrl:   NewEventStreamsController(ucs.EventStreams()),
+		subscriptionsCtrl:  NewSubscriptionsController(ucs.Subscriptions()),
+		safeProposalsCtrl:  NewSafeProposalsController(ucs.SafeProposals()),
+		relayersCtrl:       NewRelayersController(ucs.Relayers()),
+		eventLogsCtrl:      NewEventLogsController(ucs.EventLogs()),
+		sentrySessionsCtrl: NewSentrySessionsController(ucs.SentrySessions()),
+		privacyGroupsCtrl:  NewPrivacyGroupsController(ucs.PrivacyGroups()),
 	}
 }
 
@@ -84,6 +100,11 @@ func (b *Builder) Build(_ context.Context, _ string, configuration interface{},
 	b.contractsCtrl.Append(router)
 	b.eventStreamsCtrl.Append(router)
 	b.subscriptionsCtrl.Append(router)
+	b.safeProposalsCtrl.Append(router)
+	b.relayersCtrl.Append(router)
+	b.eventLogsCtrl.Append(router)
+	b.sentrySessionsCtrl.Append(router)
+	b.privacyGroupsCtrl.Append(router)
 
 	return router, nil
 }
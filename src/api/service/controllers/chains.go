@@ -34,6 +34,10 @@ func (c *ChainsController) Append(router *mux.Router) {
 // @Produce   json
 // @Security  ApiKeyAuth
 // @Security  JWTAuth
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
 // @Success   200  {array}   api.ChainResponse{privateTxManager=entities.PrivateTxManager}
 // @Failure   400  {object}  infra.ErrorResponse  "Invalid request"
 // @Failure   500  {object}  infra.ErrorResponse  "Internal server error"
@@ -59,6 +63,11 @@ func (c *ChainsController) search(rw http.ResponseWriter, request *http.Request)
 		response = append(response, formatters.FormatChainResponse(chain))
 	}
 
+	if len(chains) > 0 {
+		last := chains[len(chains)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(chains), last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
 	_ = json.NewEncoder(rw).Encode(response)
 }
 
@@ -123,6 +123,24 @@ func (s *chainsCtrlTestSuite) TestRegister() {
 		assert.Equal(t, http.StatusBadRequest, rw.Code)
 	})
 
+	s.T().Run("should fail with Bad request if fee policy is invalid", func(t *testing.T) {
+		req := apitestdata.FakeRegisterChainRequest()
+		req.FeePolicy = &api.FeePolicyRequest{
+			BumpPercentage:       0.1,
+			MaxBumps:             3,
+			ReplaceWithSameNonce: true,
+		}
+		requestBytes, _ := json.Marshal(req)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, chainsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	s.T().Run("should fail with 500 if use case fails with an unexpected error", func(t *testing.T) {
 		req := apitestdata.FakeRegisterChainRequest()
 		requestBytes, _ := json.Marshal(req)
@@ -221,7 +239,10 @@ func (s *chainsCtrlTestSuite) TestSearch() {
 			NewRequest(http.MethodGet, "/chains?names="+strings.Join(names, ","), nil).
 			WithContext(s.ctx)
 
-		expectedFilters := &entities.ChainFilters{Names: names}
+		expectedFilters := &entities.ChainFilters{
+			Names:      names,
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+		}
 		s.searchChainUC.EXPECT().Execute(gomock.Any(), expectedFilters, s.userInfo).Return([]*entities.Chain{chain}, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
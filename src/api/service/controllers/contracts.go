@@ -8,6 +8,7 @@ import (
 	infra "github.com/consensys/orchestrate/src/infra/api"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	api "github.com/consensys/orchestrate/src/api/service/types"
@@ -30,6 +31,8 @@ func NewContractsController(contractUCs usecases.ContractUseCases) *ContractsCon
 func (c *ContractsController) Append(router *mux.Router) {
 	router.Methods(http.MethodGet).Path("/contracts").HandlerFunc(c.getCatalog)
 	router.Methods(http.MethodPost).Path("/contracts").HandlerFunc(c.register)
+	router.Methods(http.MethodPost).Path("/contracts/call").HandlerFunc(c.call)
+	router.Methods(http.MethodPost).Path("/contracts/import").HandlerFunc(c.importContracts)
 	router.Methods(http.MethodGet).Path("/contracts/search").HandlerFunc(c.search)
 	router.Methods(http.MethodPost).Path("/contracts/accounts/{chain_id}/{address}").HandlerFunc(c.setCodeHash)
 	router.Methods(http.MethodGet).Path("/contracts/accounts/{chain_id}/{address}/events").HandlerFunc(c.getEvents)
@@ -50,7 +53,7 @@ func (c *ContractsController) getCatalog(rw http.ResponseWriter, request *http.R
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	names, err := c.ucs.GetCatalog().Execute(ctx)
+	names, err := c.ucs.GetCatalog().Execute(ctx, multitenancy.UserInfoValue(ctx))
 
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
@@ -93,13 +96,14 @@ func (c *ContractsController) register(rw http.ResponseWriter, request *http.Req
 		return
 	}
 
-	err = c.ucs.Register().Execute(ctx, contract)
+	userInfo := multitenancy.UserInfoValue(ctx)
+	err = c.ucs.Register().Execute(ctx, contract, userInfo)
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
-	contract, err = c.ucs.Get().Execute(ctx, contract.Name, contract.Tag)
+	contract, err = c.ucs.Get().Execute(ctx, contract.Name, contract.Tag, userInfo)
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
 		return
@@ -132,7 +136,7 @@ func (c *ContractsController) search(rw http.ResponseWriter, request *http.Reque
 		return
 	}
 
-	contract, err := c.ucs.Search().Execute(ctx, req.CodeHash, req.Address)
+	contract, err := c.ucs.Search().Execute(ctx, req.CodeHash, req.Address, multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
 		return
@@ -173,7 +177,8 @@ func (c *ContractsController) setCodeHash(rw http.ResponseWriter, request *http.
 		return
 	}
 
-	err = c.ucs.SetCodeHash().Execute(ctx, chainID, ethcommon.HexToAddress(address), req.CodeHash)
+	err = c.ucs.SetCodeHash().Execute(ctx, chainID, ethcommon.HexToAddress(address), req.CodeHash,
+		multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
 		return
@@ -239,7 +244,7 @@ func (c *ContractsController) getTags(rw http.ResponseWriter, request *http.Requ
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	tags, err := c.ucs.GetTags().Execute(ctx, mux.Vars(request)["name"])
+	tags, err := c.ucs.GetTags().Execute(ctx, mux.Vars(request)["name"], multitenancy.UserInfoValue(ctx))
 
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
@@ -268,7 +273,7 @@ func (c *ContractsController) getContract(rw http.ResponseWriter, request *http.
 	rw.Header().Set("Content-Type", "application/json")
 	ctx := request.Context()
 
-	contract, err := c.ucs.Get().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"])
+	contract, err := c.ucs.Get().Execute(ctx, mux.Vars(request)["name"], mux.Vars(request)["tag"], multitenancy.UserInfoValue(ctx))
 	if err != nil {
 		infra.WriteHTTPErrorResponse(rw, err)
 		return
@@ -276,3 +281,92 @@ func (c *ContractsController) getContract(rw http.ResponseWriter, request *http.
 
 	_ = json.NewEncoder(rw).Encode(formatters.FormatContractResponse(contract))
 }
+
+// @Summary      Calls contract methods
+// @Description  Executes read-only method calls encoded with the ABIs of the contract registry and returns the decoded outputs, optionally batched through Multicall3
+// @Description  Private contracts are called on the private state of an EEA privacy group or of a GoQuorum node by setting the `protocol`
+// @Tags         Contracts
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.CallContractRequest{calls=[]api.ContractCallParams}  true  "Contract call request"
+// @Success      200      {array}   api.ContractCallResponse                                 "Results of the calls, in the order of the request"
+// @Failure      400      {object}  infra.ErrorResponse                                      "Invalid request"
+// @Failure      422      {object}  infra.ErrorResponse                                      "Unprocessable parameters were sent"
+// @Failure      500      {object}  infra.ErrorResponse                                      "Internal server error"
+// @Router       /contracts/call [post]
+func (c *ContractsController) call(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.CallContractRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = req.Validate(); err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	results, err := c.ucs.Call().Execute(ctx, formatters.FormatCallContractRequest(req), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.ContractCallResponse{}
+	for _, result := range results {
+		response = append(response, formatters.FormatContractCallResponse(result))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary      Import contracts from build artifacts
+// @Description  Registers all the contracts of Hardhat, Foundry or Truffle build artifacts under the same tag, linking their libraries
+// @Tags         Contracts
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.ImportContractsRequest{artifacts=[]api.ContractArtifactParams}                                                    true  "Contracts import request"
+// @Success      200      {array}   api.ContractResponse{constructor=entities.ABIComponent,methods=[]entities.ABIComponent,events=[]entities.ABIComponent}  "Imported contracts"
+// @Failure      400      {object}  infra.ErrorResponse                                                                                                  "Invalid request"
+// @Failure      401      {object}  infra.ErrorResponse                                                                                                  "Unauthorized"
+// @Failure      422      {object}  infra.ErrorResponse                                                                                                  "Unprocessable parameters were sent"
+// @Failure      500      {object}  infra.ErrorResponse                                                                                                  "Internal server error"
+// @Router       /contracts/import [post]
+func (c *ContractsController) importContracts(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.ImportContractsRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	contractImport, err := formatters.FormatImportContractsRequest(req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	contracts, err := c.ucs.Import().Execute(ctx, contractImport, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.ContractResponse{}
+	for _, contract := range contracts {
+		response = append(response, formatters.FormatContractResponse(contract))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
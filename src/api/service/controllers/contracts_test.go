@@ -11,12 +11,15 @@ import (
 	"testing"
 
 	"encoding/json"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	api "github.com/consensys/orchestrate/src/api/service/types"
 	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
@@ -34,7 +37,11 @@ type contractsCtrlTestSuite struct {
 	registerContractEvent *mocks.MockRegisterContractDeploymentUseCase
 	registerContract      *mocks.MockRegisterContractUseCase
 	searchContract        *mocks.MockSearchContractUseCase
+	callContract          *mocks.MockCallContractUseCase
+	importContracts       *mocks.MockImportContractsUseCase
 	router                *mux.Router
+	userInfo              *multitenancy.UserInfo
+	ctx                   context.Context
 }
 
 var _ usecases.ContractUseCases = &contractsCtrlTestSuite{}
@@ -63,6 +70,18 @@ func (s *contractsCtrlTestSuite) Search() usecases.SearchContractUseCase {
 func (s *contractsCtrlTestSuite) DecodeLog() usecases.DecodeEventLogUseCase {
 	return nil
 }
+func (s *contractsCtrlTestSuite) Call() usecases.CallContractUseCase {
+	return s.callContract
+}
+func (s *contractsCtrlTestSuite) Import() usecases.ImportContractsUseCase {
+	return s.importContracts
+}
+func (s *contractsCtrlTestSuite) ResolveProxy() usecases.ResolveProxyContractUseCase {
+	return nil
+}
+func (s *contractsCtrlTestSuite) DecodeCall() usecases.DecodeCallUseCase {
+	return nil
+}
 
 func TestContractController(t *testing.T) {
 	s := new(contractsCtrlTestSuite)
@@ -80,14 +99,18 @@ func (s *contractsCtrlTestSuite) SetupTest() {
 	s.registerContractEvent = mocks.NewMockRegisterContractDeploymentUseCase(ctrl)
 	s.registerContract = mocks.NewMockRegisterContractUseCase(ctrl)
 	s.searchContract = mocks.NewMockSearchContractUseCase(ctrl)
+	s.callContract = mocks.NewMockCallContractUseCase(ctrl)
+	s.importContracts = mocks.NewMockImportContractsUseCase(ctrl)
 	s.router = mux.NewRouter()
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
 
 	controller := NewContractsController(s)
 	controller.Append(s.router)
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_Register() {
-	ctx := context.Background()
+	ctx := s.ctx
 	s.T().Run("should execute register contract request successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
 		req := apitestdata.FakeRegisterContractRequest()
@@ -97,10 +120,10 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(nil)
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(nil)
 
 		contract := testdata.FakeContract()
-		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag).Return(contract, nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -129,7 +152,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(fmt.Errorf("error"))
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -144,9 +167,9 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 			WithContext(ctx)
 
 		expectedContract, _ := formatters.FormatRegisterContractRequest(req)
-		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract).Return(nil)
+		s.registerContract.EXPECT().Execute(gomock.Any(), expectedContract, s.userInfo).Return(nil)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag).Return(nil, fmt.Errorf("error"))
+		s.getContract.EXPECT().Execute(gomock.Any(), req.Name, req.Tag, s.userInfo).Return(nil, fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -154,7 +177,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_Register() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
-	ctx := context.Background()
+	ctx := s.ctx
 	chainID := "2017"
 	address := testdata.FakeAddress()
 
@@ -167,7 +190,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
 			WithContext(ctx)
 
 		s.registerContractEvent.EXPECT().
-			Execute(gomock.Any(), chainID, *address, req.CodeHash).
+			Execute(gomock.Any(), chainID, *address, req.CodeHash, s.userInfo).
 			Return(nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
@@ -196,7 +219,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
 			WithContext(ctx)
 
 		s.registerContractEvent.EXPECT().
-			Execute(gomock.Any(), chainID, *address, req.CodeHash).
+			Execute(gomock.Any(), chainID, *address, req.CodeHash, s.userInfo).
 			Return(fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
@@ -205,7 +228,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_CodeHash() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	s.T().Run("should execute get contract successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -214,7 +237,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/%s/%s", contract.Name, contract.Tag), nil).
 			WithContext(ctx)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag).Return(contract, nil)
+		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -228,7 +251,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/%s/%s", contract.Name, contract.Tag), nil).
 			WithContext(ctx)
 
-		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag).Return(nil, fmt.Errorf("error"))
+		s.getContract.EXPECT().Execute(gomock.Any(), contract.Name, contract.Tag, s.userInfo).Return(nil, fmt.Errorf("error"))
 
 		s.router.ServeHTTP(rw, httpRequest)
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
@@ -236,7 +259,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContract() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	req := apitestdata.FakeSearchContractRequest()
 
@@ -247,7 +270,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/search?code_hash=%s", req.CodeHash.String()), nil).
 			WithContext(ctx)
 
-		s.searchContract.EXPECT().Execute(gomock.Any(), req.CodeHash, nil).Return(contract, nil)
+		s.searchContract.EXPECT().Execute(gomock.Any(), req.CodeHash, nil, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -261,7 +284,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 			NewRequest(http.MethodGet, fmt.Sprintf("/contracts/search?address=%s", req.Address.String()), nil).
 			WithContext(ctx)
 
-		s.searchContract.EXPECT().Execute(gomock.Any(), nil, req.Address).Return(contract, nil)
+		s.searchContract.EXPECT().Execute(gomock.Any(), nil, req.Address, s.userInfo).Return(contract, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(formatters.FormatContractResponse(contract))
@@ -270,7 +293,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_SearchContract() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContractEvents() {
-	ctx := context.Background()
+	ctx := s.ctx
 	address := ethcommon.HexToAddress(utils.RandHexString(10))
 	sigHash := utils.StringToHexBytes("0x" + utils.RandHexString(10))
 	indexInput := uint32(2)
@@ -309,7 +332,7 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContractEvents() {
 }
 
 func (s *contractsCtrlTestSuite) TestContractsController_GetContractsCatalog() {
-	ctx := context.Background()
+	ctx := s.ctx
 
 	s.T().Run("should execute get catalog successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -318,10 +341,162 @@ func (s *contractsCtrlTestSuite) TestContractsController_GetContractsCatalog() {
 			WithContext(ctx)
 
 		catalog := []string{"contractOne", "contractTwo"}
-		s.getContractsCatalog.EXPECT().Execute(gomock.Any()).Return(catalog, nil)
+		s.getContractsCatalog.EXPECT().Execute(gomock.Any(), s.userInfo).Return(catalog, nil)
 
 		s.router.ServeHTTP(rw, httpRequest)
 		expectedBody, _ := json.Marshal(catalog)
 		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
 	})
 }
+
+func (s *contractsCtrlTestSuite) TestContractsController_Call() {
+	ctx := s.ctx
+
+	s.T().Run("should execute call contract request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeCallContractRequest()
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/call", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		results := []*entities.ContractCallResult{{Success: true, Outputs: map[string]string{"0": "1000"}}}
+		s.callContract.EXPECT().Execute(gomock.Any(), formatters.FormatCallContractRequest(req), s.userInfo).
+			Return(results, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.ContractCallResponse{formatters.FormatContractCallResponse(results[0])})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid block", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeCallContractRequest()
+		req.Block = "safe"
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/call", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if no call", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeCallContractRequest()
+		req.Calls = nil
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/call", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should execute private call contract request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeCallContractRequest()
+		req.Protocol = entities.EEAChainType
+		req.PrivacyGroupName = "my-privacy-group"
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/call", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		results := []*entities.ContractCallResult{{Success: true, Outputs: map[string]string{"0": "1000"}}}
+		s.callContract.EXPECT().Execute(gomock.Any(), formatters.FormatCallContractRequest(req), s.userInfo).
+			Return(results, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if private call without privacy group", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeCallContractRequest()
+		req.Protocol = entities.EEAChainType
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/call", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *contractsCtrlTestSuite) TestContractsController_Import() {
+	ctx := s.ctx
+
+	s.T().Run("should execute import contracts request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeImportContractsRequest()
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/import", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		expectedImport, _ := formatters.FormatImportContractsRequest(req)
+		contract := testdata.FakeContract()
+		s.importContracts.EXPECT().Execute(gomock.Any(), expectedImport, s.userInfo).
+			Return([]*entities.Contract{contract}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.ContractResponse{formatters.FormatContractResponse(contract)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if no artifact", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeImportContractsRequest()
+		req.Artifacts = nil
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/import", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid artifact", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeImportContractsRequest()
+		req.Artifacts[0].Artifact = json.RawMessage(`{"contractName": "ERC20"}`)
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/import", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 422 if import fails", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		req := apitestdata.FakeImportContractsRequest()
+		requestBytes, _ := json.Marshal(req)
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/contracts/import", bytes.NewReader(requestBytes)).
+			WithContext(ctx)
+
+		s.importContracts.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidParameterError("library SafeMath is not deployed"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rw.Code)
+	})
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/gorilla/mux"
+)
+
+type EventLogsController struct {
+	ucs usecases.EventLogUseCases
+}
+
+func NewEventLogsController(ucs usecases.EventLogUseCases) *EventLogsController {
+	return &EventLogsController{ucs: ucs}
+}
+
+func (c *EventLogsController) Append(router *mux.Router) {
+	router.Methods(http.MethodGet).Path("/events").HandlerFunc(c.search)
+	router.Methods(http.MethodPost).Path("/events/backfill").HandlerFunc(c.backfill)
+}
+
+// @Summary      Search indexed contract events
+// @Description  Searches the events emitted by subscribed or backfilled contracts, sorted by block and log index.
+// @Description  When more events match the filters, the `Link` header holds the URL of the next page.
+// @Tags         Events
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        chain       query     string    true   "name of the chain"
+// @Param        addresses   query     []string  false  "list of contract addresses"  collectionFormat(csv)
+// @Param        event       query     string    false  "event signature, e.g. Transfer(address,address,uint256)"
+// @Param        topic1      query     string    false  "first indexed argument, left padded to 32 bytes"
+// @Param        topic2      query     string    false  "second indexed argument, left padded to 32 bytes"
+// @Param        topic3      query     string    false  "third indexed argument, left padded to 32 bytes"
+// @Param        from_block  query     integer   false  "first block of the range"
+// @Param        to_block    query     integer   false  "last block of the range"
+// @Param        limit       query     integer   false  "maximum number of events returned, 100 by default and at most 1000"
+// @Param        cursor      query     string    false  "cursor of the page returned in the Link header"
+// @Success      200         {array}   api.EventLogResponse
+// @Failure      400         {object}  infra.ErrorResponse  "Invalid filter in the request"
+// @Failure      422         {object}  infra.ErrorResponse  "Chain does not exist"
+// @Failure      500         {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /events [get]
+func (c *EventLogsController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	chainName, filters, err := formatters.FormatEventLogFilters(request)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	eventLogs, err := c.ucs.Search().Execute(ctx, chainName, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.EventLogResponse{}
+	for _, eventLog := range eventLogs {
+		response = append(response, formatters.FormatEventLogResponse(eventLog))
+	}
+
+	if len(eventLogs) == filters.Limit {
+		writeNextPageLink(rw, request, formatters.FormatEventLogCursor(eventLogs[len(eventLogs)-1].Cursor()))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary      Backfill contract events
+// @Description  Scans a range of past blocks, up to 1000000 blocks, for the events emitted by the contracts, the events found being indexed asynchronously without notifying subscriptions
+// @Tags         Events
+// @Accept       json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body  api.BackfillEventLogsRequest  true  "Backfill request"
+// @Success      202
+// @Failure      400  {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      422  {object}  infra.ErrorResponse  "Chain does not exist"
+// @Failure      500  {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /events/backfill [post]
+func (c *EventLogsController) backfill(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	req := &api.BackfillEventLogsRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = req.Validate(); err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = c.ucs.Backfill().Execute(ctx, req.ChainName, req.Addresses, req.FromBlock, req.ToBlock, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,232 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const eventLogsEndpoint = "/events"
+
+type eventLogsCtrlTestSuite struct {
+	suite.Suite
+	indexUC    *mocks.MockIndexEventLogsUseCase
+	searchUC   *mocks.MockSearchEventLogsUseCase
+	backfillUC *mocks.MockBackfillEventLogsUseCase
+	ctx        context.Context
+	userInfo   *multitenancy.UserInfo
+	router     *mux.Router
+}
+
+var _ usecases.EventLogUseCases = &eventLogsCtrlTestSuite{}
+
+func (s *eventLogsCtrlTestSuite) Index() usecases.IndexEventLogsUseCase {
+	return s.indexUC
+}
+
+func (s *eventLogsCtrlTestSuite) Search() usecases.SearchEventLogsUseCase {
+	return s.searchUC
+}
+
+func (s *eventLogsCtrlTestSuite) Backfill() usecases.BackfillEventLogsUseCase {
+	return s.backfillUC
+}
+
+func TestEventLogsController(t *testing.T) {
+	s := new(eventLogsCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *eventLogsCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.indexUC = mocks.NewMockIndexEventLogsUseCase(ctrl)
+	s.searchUC = mocks.NewMockSearchEventLogsUseCase(ctrl)
+	s.backfillUC = mocks.NewMockBackfillEventLogsUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewEventLogsController(s)
+	controller.Append(s.router)
+}
+
+func (s *eventLogsCtrlTestSuite) TestEventLogsController_Search() {
+	eventLog := testdata.FakeEventLog()
+	address := eventLog.Address.Hex()
+
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		sigHash := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+		topic1 := ethcommon.HexToHash("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+		fromBlock := uint64(100)
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, fmt.Sprintf("%s?chain=mainnet&addresses=%s&event=Transfer(address,address,uint256)&topic1=0x1abae27a0cbfb02945720425d3b80c7e09728534&from_block=100",
+				eventLogsEndpoint, address), nil).
+			WithContext(s.ctx)
+
+		s.searchUC.EXPECT().Execute(gomock.Any(), "mainnet", &entities.EventLogFilters{
+			Addresses: []ethcommon.Address{eventLog.Address},
+			SigHash:   &sigHash,
+			Topic1:    &topic1,
+			FromBlock: &fromBlock,
+			Limit:     formatters.DefaultEventLogsLimit,
+		}, s.userInfo).Return([]*entities.EventLog{eventLog}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.EventLogResponse{formatters.FormatEventLogResponse(eventLog)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Empty(t, rw.Header().Get("Link"))
+	})
+
+	s.T().Run("should link to the next page if the page is full", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		cursor := formatters.FormatEventLogCursor(&entities.EventLogCursor{BlockNumber: 10, LogIndex: 2})
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, eventLogsEndpoint+"?chain=mainnet&limit=1&cursor="+cursor, nil).
+			WithContext(s.ctx)
+
+		s.searchUC.EXPECT().Execute(gomock.Any(), "mainnet", &entities.EventLogFilters{
+			After: &entities.EventLogCursor{BlockNumber: 10, LogIndex: 2},
+			Limit: 1,
+		}, s.userInfo).Return([]*entities.EventLog{eventLog}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		nextCursor := formatters.FormatEventLogCursor(eventLog.Cursor())
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, fmt.Sprintf("<%s?chain=mainnet&cursor=%s&limit=1>; rel=\"next\"", eventLogsEndpoint, nextCursor), rw.Header().Get("Link"))
+	})
+
+	s.T().Run("should fail with Bad request if chain is missing", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.NewRequest(http.MethodGet, eventLogsEndpoint+"?addresses="+address, nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if limit is too high", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.NewRequest(http.MethodGet, eventLogsEndpoint+"?chain=mainnet&limit=1001", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if cursor is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.NewRequest(http.MethodGet, eventLogsEndpoint+"?chain=mainnet&cursor=invalid", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *eventLogsCtrlTestSuite) TestEventLogsController_Backfill() {
+	address := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := &api.BackfillEventLogsRequest{
+			ChainName: "mainnet",
+			Addresses: []ethcommon.Address{address},
+			FromBlock: 100,
+			ToBlock:   200,
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, eventLogsEndpoint+"/backfill", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.backfillUC.EXPECT().Execute(gomock.Any(), "mainnet", req.Addresses, uint64(100), uint64(200), s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if block range is invalid", func(t *testing.T) {
+		req := &api.BackfillEventLogsRequest{
+			ChainName: "mainnet",
+			Addresses: []ethcommon.Address{address},
+			FromBlock: 200,
+			ToBlock:   100,
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, eventLogsEndpoint+"/backfill", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if block range is too large", func(t *testing.T) {
+		req := &api.BackfillEventLogsRequest{
+			ChainName: "mainnet",
+			Addresses: []ethcommon.Address{address},
+			FromBlock: 100,
+			ToBlock:   100 + entities.MaxEventLogsBackfillRange,
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, eventLogsEndpoint+"/backfill", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if addresses are missing", func(t *testing.T) {
+		requestBytes, _ := json.Marshal(&api.BackfillEventLogsRequest{ChainName: "mainnet", ToBlock: 100})
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, eventLogsEndpoint+"/backfill", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
@@ -34,6 +34,10 @@ func (c *FaucetsController) Append(router *mux.Router) {
 // @Produce   json
 // @Security  ApiKeyAuth
 // @Security  JWTAuth
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
 // @Success   200  {array}   api.FaucetResponse
 // @Failure   400  {object}  infra.ErrorResponse  "Invalid request"
 // @Failure   500  {object}  infra.ErrorResponse  "Internal server error"
@@ -59,6 +63,11 @@ func (c *FaucetsController) search(rw http.ResponseWriter, request *http.Request
 		response = append(response, formatters.FormatFaucetResponse(faucet))
 	}
 
+	if len(faucets) > 0 {
+		last := faucets[len(faucets)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(faucets), last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
 	_ = json.NewEncoder(rw).Encode(response)
 }
 
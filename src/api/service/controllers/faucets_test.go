@@ -238,8 +238,9 @@ func (s *faucetsCtrlTestSuite) TestFaucetsController_Search() {
 			WithContext(s.ctx)
 
 		expectedFilters := &entities.FaucetFilters{
-			Names:     names,
-			ChainRule: chainRule,
+			Names:      names,
+			ChainRule:  chainRule,
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
 		}
 		s.searchFaucetUC.EXPECT().Execute(gomock.Any(), expectedFilters, s.userInfo).Return([]*entities.Faucet{faucet}, nil)
 
@@ -44,6 +44,21 @@ func (c *JobsController) Append(router *mux.Router) {
 // @Security     JWTAuth
 // @Param        tx_hashes   query     []string                                                                                                                                                              false  "List of transaction hashes"  collectionFormat(csv)
 // @Param        chain_uuid  query     string                                                                                                                                                                false  "Chain UUID"
+// @Param        statuses          query     []string  false  "List of job statuses"  collectionFormat(csv)
+// @Param        types             query     []string  false  "List of job types"  collectionFormat(csv)
+// @Param        label             query     []string  false  "Label of the jobs as key:value, repeated to match several labels"  collectionFormat(multi)
+// @Param        from              query     string    false  "Sender address"
+// @Param        to                query     string    false  "Recipient address"
+// @Param        schedule_uuid     query     string    false  "Schedule UUID"
+// @Param        created_after     query     string    false  "Creation date lower bound, in RFC3339 format"
+// @Param        created_before    query     string    false  "Creation date upper bound, in RFC3339 format"
+// @Param        idempotency_keys  query     []string  false  "List of idempotency keys of the transaction requests"  collectionFormat(csv)
+// @Param        updated_after     query     string    false  "Update date lower bound, in RFC3339 format"
+// @Param        updated_before    query     string    false  "Update date upper bound, in RFC3339 format"
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
 // @Success      200         {array}   api.JobResponse  "List of Jobs found"
 // @Failure      400         {object}  infra.ErrorResponse                                                                                                                                                "Invalid filter in the request"
 // @Failure      500         {object}  infra.ErrorResponse                                                                                                                                                "Internal server error"
@@ -69,6 +84,11 @@ func (c *JobsController) search(rw http.ResponseWriter, request *http.Request) {
 		response = append(response, formatters.FormatJobResponse(job))
 	}
 
+	if len(jobRes) > 0 {
+		last := jobRes[len(jobRes)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(jobRes), last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
 	_ = json.NewEncoder(rw).Encode(response)
 }
 
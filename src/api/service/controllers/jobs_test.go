@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	ethtestdata "github.com/consensys/orchestrate/pkg/types/ethereum/testdata"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
@@ -195,6 +197,28 @@ func (s *jobsCtrlTestSuite) TestJobsController_GetOne() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should return the private receipt of a private job", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, "/jobs/jobUUID", nil).
+			WithContext(s.ctx)
+		jobEntityRes := testdata.FakeJob()
+		jobEntityRes.Type = entities.GoQuorumPrivateTransaction
+		jobEntityRes.PrivateReceipt = ethtestdata.FakeReceipt()
+
+		s.getJobUC.EXPECT().Execute(gomock.Any(), "jobUUID", s.userInfo).Return(jobEntityRes, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		resp := &apitypes.JobResponse{}
+		err := json.Unmarshal(rw.Body.Bytes(), resp)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, jobEntityRes.PrivateReceipt.TxHash, resp.PrivateReceipt.TxHash)
+		assert.Equal(t, jobEntityRes.PrivateReceipt.Status, resp.PrivateReceipt.Status)
+		assert.Equal(t, jobEntityRes.PrivateReceipt.BlockNumber, resp.PrivateReceipt.BlockNumber)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 404 if use case fails with NotFoundError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -210,7 +234,9 @@ func (s *jobsCtrlTestSuite) TestJobsController_GetOne() {
 func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 	s.T().Run("should execute search jobs successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{}
+		filters := &entities.JobFilters{
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+		}
 		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs", nil).WithContext(s.ctx)
 		jobEntities := []*entities.Job{testdata.FakeJob()}
 
@@ -226,7 +252,10 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 
 	s.T().Run("should execute search jobs by tx_hashes successfully", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{TxHashes: []string{common.HexToHash("0x1").String(), common.HexToHash("0x2").String()}}
+		filters := &entities.JobFilters{
+			TxHashes:   []string{common.HexToHash("0x1").String(), common.HexToHash("0x2").String()},
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+		}
 		url := fmt.Sprintf("/jobs?tx_hashes=%s", strings.Join([]string{
 			common.HexToHash("0x1").String(),
 			common.HexToHash("0x2").String(),
@@ -247,6 +276,60 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 		assert.Equal(t, http.StatusOK, rw.Code)
 	})
 
+	s.T().Run("should execute search jobs by statuses, labels, addresses and dates successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		from := common.HexToAddress("0x1")
+		createdAfter, _ := time.Parse(time.RFC3339, "2022-01-01T00:00:00Z")
+		url := fmt.Sprintf("/jobs?statuses=MINED,FAILED&types=eth://ethereum/transaction&label=env:prod&from=%s&created_after=2022-01-01T00:00:00Z", from.Hex())
+		httpRequest := httptest.NewRequest(http.MethodGet, url, nil).WithContext(s.ctx)
+		filters := &entities.JobFilters{
+			Statuses:     []entities.JobStatus{entities.StatusMined, entities.StatusFailed},
+			Types:        []entities.JobType{entities.EthereumTransaction},
+			Labels:       map[string]string{"env": "prod"},
+			From:         &from,
+			Pagination:   &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+			CreatedAfter: createdAfter,
+		}
+
+		s.searchJobUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return([]*entities.Job{}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 400 if label is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs?label=env", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should execute search jobs by page successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs?limit=1&sort=updatedAt&order=desc", nil).WithContext(s.ctx)
+		jobEntities := []*entities.Job{testdata.FakeJob()}
+		filters := &entities.JobFilters{
+			Pagination: &entities.Pagination{Limit: 1, SortBy: entities.SortByUpdatedAt, Order: entities.SortOrderDesc},
+		}
+
+		s.searchJobUC.EXPECT().Execute(gomock.Any(), filters, s.userInfo).Return(jobEntities, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		cursor := &entities.PageCursor{Time: jobEntities[0].UpdatedAt, Key: jobEntities[0].UUID}
+		assert.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, fmt.Sprintf("</jobs?cursor=%s&limit=1&order=desc&sort=updatedAt>; rel=\"next\"", cursor.String()), rw.Header().Get("Link"))
+	})
+
+	s.T().Run("should fail with 400 if sort key is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodGet, "/jobs?sort=name", nil).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails on invalid tx hashes as input", func(t *testing.T) {
 		rw := httptest.NewRecorder()
@@ -266,7 +349,9 @@ func (s *jobsCtrlTestSuite) TestJobsController_Search() {
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.T().Run("should fail with 422 if use case fails with NotFoundError", func(t *testing.T) {
 		rw := httptest.NewRecorder()
-		filters := &entities.JobFilters{}
+		filters := &entities.JobFilters{
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+		}
 		httpRequest := httptest.
 			NewRequest(http.MethodGet, "/jobs", bytes.NewReader(nil)).
 			WithContext(s.ctx)
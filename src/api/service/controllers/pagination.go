@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+// writeNextPageLink sets the URL of the page following the current one in the Link header of the response
+func writeNextPageLink(rw http.ResponseWriter, request *http.Request, cursor string) {
+	next := *request.URL
+	query := next.Query()
+	query.Set("cursor", cursor)
+	next.RawQuery = query.Encode()
+	rw.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"next\"", next.RequestURI()))
+}
+
+// writeNextPageCursor sets the Link header of the response when the results fill the requested page
+func writeNextPageCursor(rw http.ResponseWriter, request *http.Request, cursor *entities.PageCursor) {
+	if cursor != nil {
+		writeNextPageLink(rw, request, cursor.String())
+	}
+}
@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/gorilla/mux"
+)
+
+type PrivacyGroupsController struct {
+	ucs usecases.PrivacyGroupUseCases
+}
+
+func NewPrivacyGroupsController(ucs usecases.PrivacyGroupUseCases) *PrivacyGroupsController {
+	return &PrivacyGroupsController{ucs: ucs}
+}
+
+func (c *PrivacyGroupsController) Append(router *mux.Router) {
+	router.Methods(http.MethodPost).Path("/privacy-groups").HandlerFunc(c.create)
+	router.Methods(http.MethodGet).Path("/privacy-groups").HandlerFunc(c.search)
+	router.Methods(http.MethodPost).Path("/privacy-groups/find").HandlerFunc(c.find)
+	router.Methods(http.MethodGet).Path("/privacy-groups/{uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodDelete).Path("/privacy-groups/{uuid}").HandlerFunc(c.delete)
+}
+
+// @Summary      Creates a new privacy group
+// @Description  Creates a privacy group on a Besu chain, whose nodes use Tessera or Orion, and stores it under a name that private transactions can reference as `privacyGroupName`
+// @Tags         Privacy Groups
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.CreatePrivacyGroupRequest  true  "Privacy group creation request"
+// @Success      200      {object}  api.PrivacyGroupResponse
+// @Failure      400      {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      409      {object}  infra.ErrorResponse  "Privacy group already exists"
+// @Failure      422      {object}  infra.ErrorResponse  "Unprocessable entity"
+// @Failure      500      {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /privacy-groups [post]
+func (c *PrivacyGroupsController) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.CreatePrivacyGroupRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	group, err := c.ucs.Create().Execute(ctx, formatters.FormatCreatePrivacyGroupRequest(req), req.ChainName, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatPrivacyGroupResponse(group))
+}
+
+// @Summary   Search privacy groups created through Orchestrate
+// @Tags      Privacy Groups
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     names             query     []string  false  "List of privacy group names"  collectionFormat(csv)
+// @Param     chain_uuid        query     string    false  "chain ID"
+// @Param     privacy_group_id  query     string    false  "ID of the privacy group on the chain"
+// @Param     limit             query     int       false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param     sort              query     string    false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param     order             query     string    false  "sort order of the results" Enums(asc, desc)
+// @Param     cursor            query     string    false  "cursor of the page returned in the Link header"
+// @Success   200               {array}   api.PrivacyGroupResponse
+// @Failure   400               {object}  infra.ErrorResponse  "Invalid filter in the request"
+// @Failure   500               {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /privacy-groups [get]
+func (c *PrivacyGroupsController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatPrivacyGroupFilters(request)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	groups, err := c.ucs.Search().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.PrivacyGroupResponse{}
+	for _, group := range groups {
+		response = append(response, formatters.FormatPrivacyGroupResponse(group))
+	}
+
+	if len(groups) > 0 {
+		last := groups[len(groups)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(groups), last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary      Find privacy groups on a chain
+// @Description  Returns the privacy groups of the chain containing exactly the given members, including the ones not created through Orchestrate
+// @Tags         Privacy Groups
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.FindPrivacyGroupsRequest  true  "Privacy groups members"
+// @Success      200      {array}   api.OnChainPrivacyGroupResponse
+// @Failure      400      {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      422      {object}  infra.ErrorResponse  "Unprocessable entity"
+// @Failure      500      {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /privacy-groups/find [post]
+func (c *PrivacyGroupsController) find(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.FindPrivacyGroupsRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	groups, err := c.ucs.Find().Execute(ctx, req.ChainName, req.Members, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.OnChainPrivacyGroupResponse{}
+	for _, group := range groups {
+		response = append(response, formatters.FormatOnChainPrivacyGroupResponse(group))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary   Fetch a privacy group by uuid
+// @Tags      Privacy Groups
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid  path      string  true  "UUID of the privacy group"
+// @Success   200   {object}  api.PrivacyGroupResponse
+// @Failure   404   {object}  infra.ErrorResponse  "Privacy group not found"
+// @Failure   500   {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /privacy-groups/{uuid} [get]
+func (c *PrivacyGroupsController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	group, err := c.ucs.Get().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatPrivacyGroupResponse(group))
+}
+
+// @Summary      Deletes a privacy group by uuid
+// @Description  Deletes the privacy group on the chain, then in Orchestrate
+// @Tags         Privacy Groups
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        uuid  path  string  true  "UUID of the privacy group"
+// @Success      204
+// @Failure      404  {object}  infra.ErrorResponse  "Privacy group not found"
+// @Failure      500  {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /privacy-groups/{uuid} [delete]
+func (c *PrivacyGroupsController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.Delete().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,224 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const privacyGroupsEndpoint = "/privacy-groups"
+
+type privacyGroupsCtrlTestSuite struct {
+	suite.Suite
+	createUC *mocks.MockCreatePrivacyGroupUseCase
+	getUC    *mocks.MockGetPrivacyGroupUseCase
+	searchUC *mocks.MockSearchPrivacyGroupsUseCase
+	deleteUC *mocks.MockDeletePrivacyGroupUseCase
+	findUC   *mocks.MockFindPrivacyGroupsUseCase
+	ctx      context.Context
+	userInfo *multitenancy.UserInfo
+	router   *mux.Router
+}
+
+var _ usecases.PrivacyGroupUseCases = &privacyGroupsCtrlTestSuite{}
+
+func (s *privacyGroupsCtrlTestSuite) Create() usecases.CreatePrivacyGroupUseCase {
+	return s.createUC
+}
+
+func (s *privacyGroupsCtrlTestSuite) Get() usecases.GetPrivacyGroupUseCase {
+	return s.getUC
+}
+
+func (s *privacyGroupsCtrlTestSuite) Search() usecases.SearchPrivacyGroupsUseCase {
+	return s.searchUC
+}
+
+func (s *privacyGroupsCtrlTestSuite) Delete() usecases.DeletePrivacyGroupUseCase {
+	return s.deleteUC
+}
+
+func (s *privacyGroupsCtrlTestSuite) Find() usecases.FindPrivacyGroupsUseCase {
+	return s.findUC
+}
+
+func TestPrivacyGroupsController(t *testing.T) {
+	s := new(privacyGroupsCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *privacyGroupsCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.createUC = mocks.NewMockCreatePrivacyGroupUseCase(ctrl)
+	s.getUC = mocks.NewMockGetPrivacyGroupUseCase(ctrl)
+	s.searchUC = mocks.NewMockSearchPrivacyGroupsUseCase(ctrl)
+	s.deleteUC = mocks.NewMockDeletePrivacyGroupUseCase(ctrl)
+	s.findUC = mocks.NewMockFindPrivacyGroupsUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewPrivacyGroupsController(s)
+	controller.Append(s.router)
+}
+
+func (s *privacyGroupsCtrlTestSuite) TestPrivacyGroupsController_Create() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := apitestdata.FakeCreatePrivacyGroupRequest()
+		requestBytes, _ := json.Marshal(req)
+		group := testdata.FakePrivacyGroup()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, privacyGroupsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createUC.EXPECT().Execute(gomock.Any(), formatters.FormatCreatePrivacyGroupRequest(req), req.ChainName, s.userInfo).
+			Return(group, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatPrivacyGroupResponse(group))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if a member is not a base64 public key", func(t *testing.T) {
+		req := apitestdata.FakeCreatePrivacyGroupRequest()
+		req.Members = []string{"invalidMember"}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, privacyGroupsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *privacyGroupsCtrlTestSuite) TestPrivacyGroupsController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, fmt.Sprintf("%s?names=%s&chain_uuid=%s", privacyGroupsEndpoint, group.Name, group.ChainUUID), nil).
+			WithContext(s.ctx)
+
+		s.searchUC.EXPECT().Execute(gomock.Any(), &entities.PrivacyGroupFilters{
+			Names:      []string{group.Name},
+			ChainUUID:  group.ChainUUID,
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+		}, s.userInfo).Return([]*entities.PrivacyGroup{group}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.PrivacyGroupResponse{formatters.FormatPrivacyGroupResponse(group)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+}
+
+func (s *privacyGroupsCtrlTestSuite) TestPrivacyGroupsController_Find() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := &api.FindPrivacyGroupsRequest{
+			ChainName: "besu",
+			Members:   []string{"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=", "B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="},
+		}
+		requestBytes, _ := json.Marshal(req)
+		group := &entities.OnChainPrivacyGroup{
+			PrivacyGroupID: "ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bf=",
+			Type:           "PANTHEON",
+			Members:        req.Members,
+		}
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, privacyGroupsEndpoint+"/find", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.findUC.EXPECT().Execute(gomock.Any(), req.ChainName, req.Members, s.userInfo).
+			Return([]*entities.OnChainPrivacyGroup{group}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.OnChainPrivacyGroupResponse{formatters.FormatOnChainPrivacyGroupResponse(group)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+}
+
+func (s *privacyGroupsCtrlTestSuite) TestPrivacyGroupsController_GetOne() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		group := testdata.FakePrivacyGroup()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, privacyGroupsEndpoint+"/"+group.UUID, nil).
+			WithContext(s.ctx)
+
+		s.getUC.EXPECT().Execute(gomock.Any(), group.UUID, s.userInfo).Return(group, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatPrivacyGroupResponse(group))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if privacy group is not found", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, privacyGroupsEndpoint+"/groupUUID", nil).
+			WithContext(s.ctx)
+
+		s.getUC.EXPECT().Execute(gomock.Any(), "groupUUID", s.userInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *privacyGroupsCtrlTestSuite) TestPrivacyGroupsController_Delete() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, privacyGroupsEndpoint+"/groupUUID", nil).
+			WithContext(s.ctx)
+
+		s.deleteUC.EXPECT().Execute(gomock.Any(), "groupUUID", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+}
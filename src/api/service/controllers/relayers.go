@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/gorilla/mux"
+)
+
+type RelayersController struct {
+	ucs usecases.RelayerUseCases
+}
+
+func NewRelayersController(ucs usecases.RelayerUseCases) *RelayersController {
+	return &RelayersController{ucs: ucs}
+}
+
+func (c *RelayersController) Append(router *mux.Router) {
+	router.Methods(http.MethodPost).Path("/transactions/relay").HandlerFunc(c.relay)
+	router.Methods(http.MethodPost).Path("/relayers").HandlerFunc(c.register)
+	router.Methods(http.MethodGet).Path("/relayers").HandlerFunc(c.search)
+	router.Methods(http.MethodGet).Path("/relayers/spendings").HandlerFunc(c.searchSpendings)
+	router.Methods(http.MethodGet).Path("/relayers/{uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodDelete).Path("/relayers/{uuid}").HandlerFunc(c.delete)
+}
+
+// @Summary      Registers a new relayer
+// @Description  Registers an account submitting meta transactions on a chain through a forwarder (ERC-2771) or an entry point (ERC-4337) registered in the contract registry
+// @Tags         Relayers
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.RegisterRelayerRequest  true  "Relayer registration request"
+// @Success      200      {object}  api.RelayerResponse
+// @Failure      400      {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      409      {object}  infra.ErrorResponse  "Relayer already exists"
+// @Failure      422      {object}  infra.ErrorResponse  "Unprocessable entity"
+// @Failure      500      {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /relayers [post]
+func (c *RelayersController) register(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.RegisterRelayerRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	relayer, err := c.ucs.Register().Execute(ctx, formatters.FormatRegisterRelayerRequest(req), req.ChainName, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatRelayerResponse(relayer))
+}
+
+// @Summary   Search relayers
+// @Tags      Relayers
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     names       query     []string  false  "List of relayer names"  collectionFormat(csv)
+// @Param     chain_uuid  query     string    false  "chain ID"
+// @Param     type        query     string    false  "relayer type"  Enums(ERC2771, ERC4337)
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
+// @Success   200         {array}   api.RelayerResponse
+// @Failure   400         {object}  infra.ErrorResponse  "Invalid filter in the request"
+// @Failure   500         {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /relayers [get]
+func (c *RelayersController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatRelayerFilters(request)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	relayers, err := c.ucs.Search().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.RelayerResponse{}
+	for _, relayer := range relayers {
+		response = append(response, formatters.FormatRelayerResponse(relayer))
+	}
+
+	if len(relayers) > 0 {
+		last := relayers[len(relayers)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(relayers), last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary      Search relayer spendings
+// @Description  Returns the number of relayed transactions mined, the gas used and the fees paid by relayers per tenant
+// @Tags         Relayers
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        relayer_uuid  query     string  false  "UUID of the relayer"
+// @Param        tenant_id     query     string  false  "tenant on behalf of which transactions were relayed"
+// @Success      200           {array}   api.RelaySpendingResponse
+// @Failure      400           {object}  infra.ErrorResponse  "Invalid filter in the request"
+// @Failure      500           {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /relayers/spendings [get]
+func (c *RelayersController) searchSpendings(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatRelaySpendingFilters(request)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	spendings, err := c.ucs.SearchSpendings().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.RelaySpendingResponse{}
+	for _, spending := range spendings {
+		response = append(response, formatters.FormatRelaySpendingResponse(spending))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary   Fetch a relayer by uuid
+// @Tags      Relayers
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid  path      string  true  "UUID of the relayer"
+// @Success   200   {object}  api.RelayerResponse
+// @Failure   404   {object}  infra.ErrorResponse  "Relayer not found"
+// @Failure   500   {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /relayers/{uuid} [get]
+func (c *RelayersController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	relayer, err := c.ucs.Get().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatRelayerResponse(relayer))
+}
+
+// @Summary   Deletes a relayer by uuid
+// @Tags      Relayers
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid  path  string  true  "UUID of the relayer"
+// @Success   204
+// @Failure   404  {object}  infra.ErrorResponse  "Relayer not found"
+// @Failure   500  {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /relayers/{uuid} [delete]
+func (c *RelayersController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.Delete().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Relays a meta transaction
+// @Description  Submits a user signed ERC-2771 forward request or ERC-4337 user operation through the relayer registered on the chain, the relayer account paying the fees
+// @Tags         Transactions
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.RelayTransactionRequest{params=api.RelayParams{gasPricePolicy=api.GasPriceParams{retryPolicy=api.RetryParams}}}  true  "Relay transaction request"
+// @Success      202      {object}  api.TransactionResponse                                                                                          "Created relay transaction request"
+// @Failure      400      {object}  infra.ErrorResponse                                                                                              "Invalid request"
+// @Failure      409      {object}  infra.ErrorResponse                                                                                              "Already existing transaction"
+// @Failure      422      {object}  infra.ErrorResponse                                                                                              "Unprocessable parameters were sent"
+// @Failure      500      {object}  infra.ErrorResponse                                                                                              "Internal server error"
+// @Router       /transactions/relay [post]
+func (c *RelayersController) relay(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.RelayTransactionRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err = req.Params.Validate(); err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	txReq, metaTx := formatters.FormatRelayTransactionRequest(req, request.Header.Get(IdempotencyKeyHeader))
+	txResponse, err := c.ucs.Relay().Execute(ctx, txReq, metaTx, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(rw).Encode(formatters.FormatTxResponse(txResponse))
+}
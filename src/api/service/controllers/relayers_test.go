@@ -0,0 +1,285 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const relayersEndpoint = "/relayers"
+
+type relayersCtrlTestSuite struct {
+	suite.Suite
+	registerUC        *mocks.MockRegisterRelayerUseCase
+	getUC             *mocks.MockGetRelayerUseCase
+	searchUC          *mocks.MockSearchRelayersUseCase
+	deleteUC          *mocks.MockDeleteRelayerUseCase
+	relayUC           *mocks.MockRelayTxUseCase
+	searchSpendingsUC *mocks.MockSearchRelaySpendingsUseCase
+	ctx               context.Context
+	userInfo          *multitenancy.UserInfo
+	router            *mux.Router
+}
+
+var _ usecases.RelayerUseCases = &relayersCtrlTestSuite{}
+
+func (s *relayersCtrlTestSuite) Register() usecases.RegisterRelayerUseCase {
+	return s.registerUC
+}
+
+func (s *relayersCtrlTestSuite) Get() usecases.GetRelayerUseCase {
+	return s.getUC
+}
+
+func (s *relayersCtrlTestSuite) Search() usecases.SearchRelayersUseCase {
+	return s.searchUC
+}
+
+func (s *relayersCtrlTestSuite) Delete() usecases.DeleteRelayerUseCase {
+	return s.deleteUC
+}
+
+func (s *relayersCtrlTestSuite) Relay() usecases.RelayTxUseCase {
+	return s.relayUC
+}
+
+func (s *relayersCtrlTestSuite) SearchSpendings() usecases.SearchRelaySpendingsUseCase {
+	return s.searchSpendingsUC
+}
+
+func TestRelayersController(t *testing.T) {
+	s := new(relayersCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *relayersCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.registerUC = mocks.NewMockRegisterRelayerUseCase(ctrl)
+	s.getUC = mocks.NewMockGetRelayerUseCase(ctrl)
+	s.searchUC = mocks.NewMockSearchRelayersUseCase(ctrl)
+	s.deleteUC = mocks.NewMockDeleteRelayerUseCase(ctrl)
+	s.relayUC = mocks.NewMockRelayTxUseCase(ctrl)
+	s.searchSpendingsUC = mocks.NewMockSearchRelaySpendingsUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewRelayersController(s)
+	controller.Append(s.router)
+}
+
+func (s *relayersCtrlTestSuite) TestRelayersController_Register() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := apitestdata.FakeRegisterRelayerRequest()
+		requestBytes, _ := json.Marshal(req)
+		relayer := testdata.FakeRelayer()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, relayersEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.registerUC.EXPECT().Execute(gomock.Any(), formatters.FormatRegisterRelayerRequest(req), req.ChainName, s.userInfo).
+			Return(relayer, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatRelayerResponse(relayer))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if domain is missing for a forwarder", func(t *testing.T) {
+		req := apitestdata.FakeRegisterRelayerRequest()
+		req.DomainName = ""
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, relayersEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *relayersCtrlTestSuite) TestRelayersController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, fmt.Sprintf("%s?chain_uuid=%s&type=ERC2771", relayersEndpoint, relayer.ChainUUID), nil).
+			WithContext(s.ctx)
+
+		s.searchUC.EXPECT().Execute(gomock.Any(), &entities.RelayerFilters{
+			ChainUUID:  relayer.ChainUUID,
+			Type:       entities.RelayerTypeForwarder,
+			Pagination: &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+		}, s.userInfo).Return([]*entities.Relayer{relayer}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.RelayerResponse{formatters.FormatRelayerResponse(relayer)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid type", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, relayersEndpoint+"?type=invalid", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *relayersCtrlTestSuite) TestRelayersController_SearchSpendings() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		spending := &entities.RelaySpending{RelayerUUID: "relayerUUID", TenantID: "tenantOne", TxCount: 1, GasUsed: 21000}
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, relayersEndpoint+"/spendings?relayer_uuid=relayerUUID", nil).
+			WithContext(s.ctx)
+
+		s.searchSpendingsUC.EXPECT().Execute(gomock.Any(), &entities.RelaySpendingFilters{RelayerUUID: "relayerUUID"}, s.userInfo).
+			Return([]*entities.RelaySpending{spending}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.RelaySpendingResponse{formatters.FormatRelaySpendingResponse(spending)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+}
+
+func (s *relayersCtrlTestSuite) TestRelayersController_GetOne() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		relayer := testdata.FakeRelayer()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, relayersEndpoint+"/"+relayer.UUID, nil).
+			WithContext(s.ctx)
+
+		s.getUC.EXPECT().Execute(gomock.Any(), relayer.UUID, s.userInfo).Return(relayer, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatRelayerResponse(relayer))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 404 if relayer is not found", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, relayersEndpoint+"/relayerUUID", nil).
+			WithContext(s.ctx)
+
+		s.getUC.EXPECT().Execute(gomock.Any(), "relayerUUID", s.userInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *relayersCtrlTestSuite) TestRelayersController_Delete() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, relayersEndpoint+"/relayerUUID", nil).
+			WithContext(s.ctx)
+
+		s.deleteUC.EXPECT().Execute(gomock.Any(), "relayerUUID", s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+}
+
+func (s *relayersCtrlTestSuite) TestRelayersController_Relay() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := apitestdata.FakeRelayTransactionRequest()
+		requestBytes, _ := json.Marshal(req)
+		txRequest := testdata.FakeTxRequest()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/transactions/relay", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		txReq, metaTx := formatters.FormatRelayTransactionRequest(req, "")
+		s.relayUC.EXPECT().Execute(gomock.Any(), txReq, metaTx, s.userInfo).Return(txRequest, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatTxResponse(txRequest))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if both forward request and user operation are set", func(t *testing.T) {
+		req := apitestdata.FakeRelayTransactionRequest()
+		req.Params.UserOperation = &api.UserOperationParams{}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/transactions/relay", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if signature of forward request is missing", func(t *testing.T) {
+		req := apitestdata.FakeRelayTransactionRequest()
+		req.Params.Signature = nil
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, "/transactions/relay", bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
@@ -0,0 +1,165 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/gorilla/mux"
+)
+
+type SafeProposalsController struct {
+	ucs usecases.SafeProposalUseCases
+}
+
+func NewSafeProposalsController(ucs usecases.SafeProposalUseCases) *SafeProposalsController {
+	return &SafeProposalsController{ucs: ucs}
+}
+
+func (c *SafeProposalsController) Append(router *mux.Router) {
+	router.Methods(http.MethodPost).Path("/safe-proposals").HandlerFunc(c.create)
+	router.Methods(http.MethodGet).Path("/safe-proposals").HandlerFunc(c.search)
+	router.Methods(http.MethodGet).Path("/safe-proposals/{uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodPost).Path("/safe-proposals/{uuid}/signatures").HandlerFunc(c.sign)
+}
+
+// @Summary      Creates a new Safe transaction proposal
+// @Description  Reads the owners, threshold and nonce of the Safe and computes the Safe transaction hash to be signed by the owners
+// @Tags         Safe Proposals
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.CreateSafeProposalRequest  true  "Safe proposal request"
+// @Success      200      {object}  api.SafeProposalResponse
+// @Failure      400      {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      422      {object}  infra.ErrorResponse  "Unprocessable entity"
+// @Failure      500      {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /safe-proposals [post]
+func (c *SafeProposalsController) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.CreateSafeProposalRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	proposal, err := c.ucs.Create().Execute(ctx, formatters.FormatCreateSafeProposalRequest(req), req.ChainName, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSafeProposalResponse(proposal))
+}
+
+// @Summary   Search Safe transaction proposals
+// @Tags      Safe Proposals
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     safe        query     string  false  "address of the Safe"
+// @Param     chain_uuid  query     string  false  "chain ID"
+// @Param     status      query     string  false  "proposal status"
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
+// @Success   200         {array}   api.SafeProposalResponse
+// @Failure   400         {object}  infra.ErrorResponse  "Invalid filter in the request"
+// @Failure   500         {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /safe-proposals [get]
+func (c *SafeProposalsController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatSafeProposalFilters(request)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	proposals, err := c.ucs.Search().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.SafeProposalResponse{}
+	for _, proposal := range proposals {
+		response = append(response, formatters.FormatSafeProposalResponse(proposal))
+	}
+
+	if len(proposals) > 0 {
+		last := proposals[len(proposals)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(proposals), last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary   Fetch a Safe transaction proposal by uuid
+// @Tags      Safe Proposals
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     uuid  path      string  true  "UUID of the proposal"
+// @Success   200   {object}  api.SafeProposalResponse
+// @Failure   404   {object}  infra.ErrorResponse  "Proposal not found"
+// @Failure   500   {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /safe-proposals/{uuid} [get]
+func (c *SafeProposalsController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	proposal, err := c.ucs.Get().Execute(ctx, mux.Vars(request)["uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSafeProposalResponse(proposal))
+}
+
+// @Summary      Adds an owner signature to a Safe transaction proposal
+// @Description  If no signature is provided, the signer must be an Orchestrate account. The execTransaction is sent once the threshold is reached
+// @Tags         Safe Proposals
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        uuid     path      string                       true  "UUID of the proposal"
+// @Param        request  body      api.SignSafeProposalRequest  true  "Signature request"
+// @Success      200      {object}  api.SafeProposalResponse
+// @Failure      400      {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      404      {object}  infra.ErrorResponse  "Proposal not found"
+// @Failure      409      {object}  infra.ErrorResponse  "Proposal is not pending"
+// @Failure      422      {object}  infra.ErrorResponse  "Unprocessable entity"
+// @Failure      500      {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /safe-proposals/{uuid}/signatures [post]
+func (c *SafeProposalsController) sign(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.SignSafeProposalRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	proposal, err := c.ucs.Sign().Execute(ctx, mux.Vars(request)["uuid"], formatters.FormatSignSafeProposalRequest(req), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSafeProposalResponse(proposal))
+}
@@ -0,0 +1,192 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	apitestdata "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const safeProposalsEndpoint = "/safe-proposals"
+
+type safeProposalsCtrlTestSuite struct {
+	suite.Suite
+	createUC *mocks.MockCreateSafeProposalUseCase
+	getUC    *mocks.MockGetSafeProposalUseCase
+	searchUC *mocks.MockSearchSafeProposalsUseCase
+	signUC   *mocks.MockSignSafeProposalUseCase
+	ctx      context.Context
+	userInfo *multitenancy.UserInfo
+	router   *mux.Router
+}
+
+var _ usecases.SafeProposalUseCases = &safeProposalsCtrlTestSuite{}
+
+func (s *safeProposalsCtrlTestSuite) Create() usecases.CreateSafeProposalUseCase {
+	return s.createUC
+}
+
+func (s *safeProposalsCtrlTestSuite) Get() usecases.GetSafeProposalUseCase {
+	return s.getUC
+}
+
+func (s *safeProposalsCtrlTestSuite) Search() usecases.SearchSafeProposalsUseCase {
+	return s.searchUC
+}
+
+func (s *safeProposalsCtrlTestSuite) Sign() usecases.SignSafeProposalUseCase {
+	return s.signUC
+}
+
+func TestSafeProposalsController(t *testing.T) {
+	s := new(safeProposalsCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *safeProposalsCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.createUC = mocks.NewMockCreateSafeProposalUseCase(ctrl)
+	s.getUC = mocks.NewMockGetSafeProposalUseCase(ctrl)
+	s.searchUC = mocks.NewMockSearchSafeProposalsUseCase(ctrl)
+	s.signUC = mocks.NewMockSignSafeProposalUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewSafeProposalsController(s)
+	controller.Append(s.router)
+}
+
+func (s *safeProposalsCtrlTestSuite) TestSafeProposalsController_Create() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := apitestdata.FakeCreateSafeProposalRequest()
+		requestBytes, _ := json.Marshal(req)
+		proposal := testdata.FakeSafeProposal()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, safeProposalsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createUC.EXPECT().Execute(gomock.Any(), formatters.FormatCreateSafeProposalRequest(req), req.ChainName, s.userInfo).
+			Return(proposal, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSafeProposalResponse(proposal))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid format", func(t *testing.T) {
+		req := apitestdata.FakeCreateSafeProposalRequest()
+		req.ChainName = ""
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, safeProposalsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *safeProposalsCtrlTestSuite) TestSafeProposalsController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		proposal := testdata.FakeSafeProposal()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, fmt.Sprintf("%s?safe=%s&status=PENDING", safeProposalsEndpoint, proposal.SafeAddress.Hex()), nil).
+			WithContext(s.ctx)
+
+		s.searchUC.EXPECT().Execute(gomock.Any(), &entities.SafeProposalFilters{
+			SafeAddress: &proposal.SafeAddress,
+			Status:      entities.SafeProposalStatusPending,
+			Pagination:  &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
+		}, s.userInfo).Return([]*entities.SafeProposal{proposal}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.SafeProposalResponse{formatters.FormatSafeProposalResponse(proposal)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if invalid status", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, safeProposalsEndpoint+"?status=INVALID", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *safeProposalsCtrlTestSuite) TestSafeProposalsController_Sign() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		req := apitestdata.FakeSignSafeProposalRequest()
+		requestBytes, _ := json.Marshal(req)
+		proposal := testdata.FakeSafeProposal()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/signatures", safeProposalsEndpoint, proposal.UUID), bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.signUC.EXPECT().Execute(gomock.Any(), proposal.UUID, formatters.FormatSignSafeProposalRequest(req), s.userInfo).
+			Return(proposal, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSafeProposalResponse(proposal))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with 409 if proposal is not pending", func(t *testing.T) {
+		req := apitestdata.FakeSignSafeProposalRequest()
+		requestBytes, _ := json.Marshal(req)
+		proposal := testdata.FakeSafeProposal()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, fmt.Sprintf("%s/%s/signatures", safeProposalsEndpoint, proposal.UUID), bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.signUC.EXPECT().Execute(gomock.Any(), proposal.UUID, gomock.Any(), s.userInfo).
+			Return(nil, errors.InvalidStateError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+	})
+}
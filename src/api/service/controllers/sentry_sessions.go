@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/gorilla/mux"
+)
+
+type SentrySessionsController struct {
+	ucs usecases.SentrySessionUseCases
+}
+
+func NewSentrySessionsController(ucs usecases.SentrySessionUseCases) *SentrySessionsController {
+	return &SentrySessionsController{ucs: ucs}
+}
+
+func (c *SentrySessionsController) Append(router *mux.Router) {
+	router.Methods(http.MethodPost).Path("/sentry-sessions").HandlerFunc(c.create)
+	router.Methods(http.MethodGet).Path("/sentry-sessions").HandlerFunc(c.search)
+	router.Methods(http.MethodGet).Path("/sentry-sessions/{job_uuid}").HandlerFunc(c.getOne)
+	router.Methods(http.MethodPatch).Path("/sentry-sessions/{job_uuid}").HandlerFunc(c.update)
+	router.Methods(http.MethodDelete).Path("/sentry-sessions/{job_uuid}").HandlerFunc(c.delete)
+}
+
+// @Summary      Creates a sentry session
+// @Description  Persists the retry session of a pending job started by the transaction sentry, rescheduled when the tx-listener restarts. Reserved to the transaction sentry, authenticated with the API key. The job must have a retry interval
+// @Tags         Sentry Sessions
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.CreateSentrySessionRequest  true  "Sentry session creation request"
+// @Success      200      {object}  api.SentrySessionResponse
+// @Failure      400      {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      403      {object}  infra.ErrorResponse  "Not authenticated with the API key"
+// @Failure      404      {object}  infra.ErrorResponse  "Job not found"
+// @Failure      409      {object}  infra.ErrorResponse  "Sentry session already exists"
+// @Failure      500      {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /sentry-sessions [post]
+func (c *SentrySessionsController) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.CreateSentrySessionRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	session, err := c.ucs.Create().Execute(ctx, formatters.FormatCreateSentrySessionRequest(req), multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSentrySessionResponse(session))
+}
+
+// @Summary      Search active sentry sessions
+// @Description  Returns the retry sessions of pending jobs run by the transaction sentry
+// @Tags         Sentry Sessions
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        chain_uuid  query     string  false  "UUID of the chain"
+// @Success      200         {array}   api.SentrySessionResponse
+// @Failure      400         {object}  infra.ErrorResponse  "Invalid filter in the request"
+// @Failure      500         {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /sentry-sessions [get]
+func (c *SentrySessionsController) search(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	filters, err := formatters.FormatSentrySessionFilters(request)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sessions, err := c.ucs.Search().Execute(ctx, filters, multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	response := []*api.SentrySessionResponse{}
+	for _, session := range sessions {
+		response = append(response, formatters.FormatSentrySessionResponse(session))
+	}
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// @Summary   Fetch the sentry session of a job
+// @Tags      Sentry Sessions
+// @Produce   json
+// @Security  ApiKeyAuth
+// @Security  JWTAuth
+// @Param     job_uuid  path      string  true  "UUID of the retried job"
+// @Success   200       {object}  api.SentrySessionResponse
+// @Failure   404       {object}  infra.ErrorResponse  "Sentry session not found"
+// @Failure   500       {object}  infra.ErrorResponse  "Internal server error"
+// @Router    /sentry-sessions/{job_uuid} [get]
+func (c *SentrySessionsController) getOne(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	session, err := c.ucs.Get().Execute(ctx, mux.Vars(request)["job_uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSentrySessionResponse(session))
+}
+
+// @Summary      Updates the sentry session of a job
+// @Description  Persists the progress of the retry session of a job after each retry. Reserved to the transaction sentry, authenticated with the API key
+// @Tags         Sentry Sessions
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        job_uuid  path      string                          true  "UUID of the retried job"
+// @Param        request   body      api.UpdateSentrySessionRequest  true  "Sentry session update request"
+// @Success      200       {object}  api.SentrySessionResponse
+// @Failure      400       {object}  infra.ErrorResponse  "Invalid request"
+// @Failure      403       {object}  infra.ErrorResponse  "Not authenticated with the API key"
+// @Failure      404       {object}  infra.ErrorResponse  "Sentry session not found"
+// @Failure      500       {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /sentry-sessions/{job_uuid} [patch]
+func (c *SentrySessionsController) update(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	req := &api.UpdateSentrySessionRequest{}
+	err := infra.UnmarshalBody(request.Body, req)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	session, err := c.ucs.Update().Execute(ctx, formatters.FormatUpdateSentrySessionRequest(req, mux.Vars(request)["job_uuid"]),
+		multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_ = json.NewEncoder(rw).Encode(formatters.FormatSentrySessionResponse(session))
+}
+
+// @Summary      Cancels the sentry session of a job
+// @Description  The job is no longer retried, the transaction sentry stopping its session before the next retry
+// @Tags         Sentry Sessions
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        job_uuid  path  string  true  "UUID of the retried job"
+// @Success      204
+// @Failure      404  {object}  infra.ErrorResponse  "Sentry session not found"
+// @Failure      500  {object}  infra.ErrorResponse  "Internal server error"
+// @Router       /sentry-sessions/{job_uuid} [delete]
+func (c *SentrySessionsController) delete(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	err := c.ucs.Delete().Execute(ctx, mux.Vars(request)["job_uuid"], multitenancy.UserInfoValue(ctx))
+	if err != nil {
+		infra.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,242 @@
+// +build unit
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/business/use-cases/mocks"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const sentrySessionsEndpoint = "/sentry-sessions"
+
+type sentrySessionsCtrlTestSuite struct {
+	suite.Suite
+	createUC *mocks.MockCreateSentrySessionUseCase
+	updateUC *mocks.MockUpdateSentrySessionUseCase
+	getUC    *mocks.MockGetSentrySessionUseCase
+	searchUC *mocks.MockSearchSentrySessionsUseCase
+	deleteUC *mocks.MockDeleteSentrySessionUseCase
+	ctx      context.Context
+	userInfo *multitenancy.UserInfo
+	router   *mux.Router
+}
+
+var _ usecases.SentrySessionUseCases = &sentrySessionsCtrlTestSuite{}
+
+func (s *sentrySessionsCtrlTestSuite) Create() usecases.CreateSentrySessionUseCase {
+	return s.createUC
+}
+
+func (s *sentrySessionsCtrlTestSuite) Update() usecases.UpdateSentrySessionUseCase {
+	return s.updateUC
+}
+
+func (s *sentrySessionsCtrlTestSuite) Get() usecases.GetSentrySessionUseCase {
+	return s.getUC
+}
+
+func (s *sentrySessionsCtrlTestSuite) Search() usecases.SearchSentrySessionsUseCase {
+	return s.searchUC
+}
+
+func (s *sentrySessionsCtrlTestSuite) Delete() usecases.DeleteSentrySessionUseCase {
+	return s.deleteUC
+}
+
+func TestSentrySessionsController(t *testing.T) {
+	s := new(sentrySessionsCtrlTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *sentrySessionsCtrlTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+
+	s.createUC = mocks.NewMockCreateSentrySessionUseCase(ctrl)
+	s.updateUC = mocks.NewMockUpdateSentrySessionUseCase(ctrl)
+	s.getUC = mocks.NewMockGetSentrySessionUseCase(ctrl)
+	s.searchUC = mocks.NewMockSearchSentrySessionsUseCase(ctrl)
+	s.deleteUC = mocks.NewMockDeleteSentrySessionUseCase(ctrl)
+
+	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
+	s.ctx = multitenancy.WithUserInfo(context.Background(), s.userInfo)
+	s.router = mux.NewRouter()
+
+	controller := NewSentrySessionsController(s)
+	controller.Append(s.router)
+}
+
+func (s *sentrySessionsCtrlTestSuite) TestSentrySessionsController_Create() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		session := testdata.FakeSentrySession()
+		req := &api.CreateSentrySessionRequest{
+			JobUUID:       session.JobUUID,
+			NextAttemptAt: time.Now().Add(time.Minute),
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, sentrySessionsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(session, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSentrySessionResponse(session))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if job UUID is invalid", func(t *testing.T) {
+		req := &api.CreateSentrySessionRequest{
+			JobUUID:       "invalid",
+			NextAttemptAt: time.Now(),
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, sentrySessionsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with Conflict if session already exists", func(t *testing.T) {
+		session := testdata.FakeSentrySession()
+		req := &api.CreateSentrySessionRequest{
+			JobUUID:       session.JobUUID,
+			NextAttemptAt: time.Now(),
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPost, sentrySessionsEndpoint, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.createUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(nil, errors.AlreadyExistsError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusConflict, rw.Code)
+	})
+}
+
+func (s *sentrySessionsCtrlTestSuite) TestSentrySessionsController_Search() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		session := testdata.FakeSentrySession()
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, sentrySessionsEndpoint+"?chain_uuid="+session.ChainUUID, nil).
+			WithContext(s.ctx)
+
+		s.searchUC.EXPECT().Execute(gomock.Any(), &entities.SentrySessionFilters{ChainUUID: session.ChainUUID}, s.userInfo).
+			Return([]*entities.SentrySession{session}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal([]*api.SentrySessionResponse{formatters.FormatSentrySessionResponse(session)})
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with Bad request if chain UUID is invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodGet, sentrySessionsEndpoint+"?chain_uuid=invalid", nil).
+			WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func (s *sentrySessionsCtrlTestSuite) TestSentrySessionsController_Update() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		session := testdata.FakeSentrySession()
+		req := &api.UpdateSentrySessionRequest{
+			Retries:       session.Retries,
+			NChildren:     session.NChildren,
+			NextAttemptAt: session.NextAttemptAt,
+		}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPatch, sentrySessionsEndpoint+"/"+session.JobUUID, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.updateUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).
+			DoAndReturn(func(_ context.Context, sess *entities.SentrySession, _ *multitenancy.UserInfo) (*entities.SentrySession, error) {
+				assert.Equal(t, session.JobUUID, sess.JobUUID)
+				assert.Equal(t, session.Retries, sess.Retries)
+				return session, nil
+			})
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		expectedBody, _ := json.Marshal(formatters.FormatSentrySessionResponse(session))
+		assert.Equal(t, string(expectedBody)+"\n", rw.Body.String())
+		assert.Equal(t, http.StatusOK, rw.Code)
+	})
+
+	s.T().Run("should fail with NotFound if session does not exist", func(t *testing.T) {
+		session := testdata.FakeSentrySession()
+		req := &api.UpdateSentrySessionRequest{NextAttemptAt: time.Now()}
+		requestBytes, _ := json.Marshal(req)
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodPatch, sentrySessionsEndpoint+"/"+session.JobUUID, bytes.NewReader(requestBytes)).
+			WithContext(s.ctx)
+
+		s.updateUC.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(nil, errors.NotFoundError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNotFound, rw.Code)
+	})
+}
+
+func (s *sentrySessionsCtrlTestSuite) TestSentrySessionsController_Delete() {
+	s.T().Run("should execute request successfully", func(t *testing.T) {
+		jobUUID := testdata.FakeSentrySession().JobUUID
+		rw := httptest.NewRecorder()
+
+		httpRequest := httptest.
+			NewRequest(http.MethodDelete, sentrySessionsEndpoint+"/"+jobUUID, nil).
+			WithContext(s.ctx)
+
+		s.deleteUC.EXPECT().Execute(gomock.Any(), jobUUID, s.userInfo).Return(nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusNoContent, rw.Code)
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
 	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	infra "github.com/consensys/orchestrate/src/infra/api"
@@ -101,6 +102,10 @@ func (c *SubscriptionsController) getOne(rw http.ResponseWriter, request *http.R
 // @Param        names   query     []string false "List of subscription addresses"  collectionFormat(csv)
 // @Param        chain_uuid  query     string false  "Chain UUID"
 // @Param        tenant_id  query     string false  "Tenant ID"
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt, updatedAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
 // @Success      200      {array}   api.SubscriptionResponse "List of subscription found"
 // @Failure      400      {object}  infra.ErrorResponse  "Invalid filter in the request"
 // @Failure      401      {object}  infra.ErrorResponse  "Unauthorized"
@@ -129,6 +134,13 @@ func (c *SubscriptionsController) search(rw http.ResponseWriter, request *http.R
 		filters.TenantID = qTenantID
 	}
 
+	pagination, err := formatters.FormatPagination(request, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filters.Pagination = pagination
+
 	if err := infra.GetValidator().Struct(filters); err != nil {
 		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
 		return
@@ -140,6 +152,11 @@ func (c *SubscriptionsController) search(rw http.ResponseWriter, request *http.R
 		return
 	}
 
+	if len(subscriptions) > 0 {
+		last := subscriptions[len(subscriptions)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(subscriptions), last.CreatedAt, last.UpdatedAt, last.UUID))
+	}
+
 	_ = json.NewEncoder(rw).Encode(api.NewSubscriptionResponses(subscriptions))
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	api "github.com/consensys/orchestrate/src/api/service/types"
@@ -12,6 +13,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	usecases "github.com/consensys/orchestrate/src/api/business/use-cases"
 	"github.com/consensys/orchestrate/src/api/service/formatters"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/gorilla/mux"
 )
 
@@ -37,6 +39,8 @@ func (c *TransactionsController) Append(router *mux.Router) {
 		Handler(http.HandlerFunc(c.sendRaw))
 	router.Methods(http.MethodPost).Path("/transactions/transfer").
 		Handler(http.HandlerFunc(c.transfer))
+	router.Methods(http.MethodPost).Path("/transactions/batch").
+		Handler(http.HandlerFunc(c.sendBatch))
 	router.Methods(http.MethodPost).Path("/transactions/deploy-contract").
 		Handler(http.HandlerFunc(c.deployContract))
 	router.Methods(http.MethodGet).Path("/transactions/{uuid}").
@@ -95,6 +99,7 @@ func (c *TransactionsController) send(rw http.ResponseWriter, request *http.Requ
 // @Description  Creates and executes a new contract deployment request
 // @Description  The transaction can be private (Tessera, EEA).
 // @Description  The transaction can be a One Time Key transaction in 0 gas private networks
+// @Description  Given a salt, the contract is deployed through the CREATE2 factory at an address returned before the transaction is mined
 // @Tags         Transactions
 // @Accept       json
 // @Produce      json
@@ -210,6 +215,63 @@ func (c *TransactionsController) transfer(rw http.ResponseWriter, request *http.
 	_ = json.NewEncoder(rw).Encode(formatters.FormatTxResponse(txResponse))
 }
 
+// @Summary      Creates and sends a batch of transactions
+// @Description  Creates and executes a batch of contract transaction, deployment and transfer requests
+// @Description  Valid transactions are created atomically. Invalid transactions and transactions already sent with the same idempotency key are reported individually without failing the batch
+// @Tags         Transactions
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Security     JWTAuth
+// @Param        request  body      api.SendTransactionBatchRequest  true  "Batch of transaction requests"
+// @Success      202      {object}  api.TransactionBatchResponse     "Result of each transaction request of the batch"
+// @Failure      400      {object}  infra.ErrorResponse              "Invalid request"
+// @Failure      500      {object}  infra.ErrorResponse              "Internal server error"
+// @Router       /transactions/batch [post]
+func (c *TransactionsController) sendBatch(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	batchRequest := &api.SendTransactionBatchRequest{}
+	if err := infra.UnmarshalBody(request.Body, batchRequest); err != nil {
+		infra.WriteError(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	items, errs := formatters.FormatSendTxBatchRequest(batchRequest)
+	results := make([]*entities.TxBatchResult, len(items))
+	var validItems []*entities.TxBatchItem
+	var validIndexes []int
+	for idx, item := range items {
+		if errs[idx] != nil {
+			txRequest := &entities.TxRequest{}
+			if batchRequest.Transactions[idx] != nil {
+				txRequest.IdempotencyKey = batchRequest.Transactions[idx].IdempotencyKey
+			}
+			results[idx] = &entities.TxBatchResult{Status: entities.TxBatchItemInvalid, TxRequest: txRequest, Error: errs[idx]}
+			continue
+		}
+
+		validItems = append(validItems, item)
+		validIndexes = append(validIndexes, idx)
+	}
+
+	if len(validItems) > 0 {
+		validResults, err := c.ucs.SendBatch().Execute(ctx, validItems, multitenancy.UserInfoValue(ctx))
+		if err != nil {
+			infra.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+
+		for i, result := range validResults {
+			results[validIndexes[i]] = result
+		}
+	}
+
+	rw.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(rw).Encode(formatters.FormatTxBatchResponse(results))
+}
+
 // @Summary      Fetch a transaction request by uuid
 // @Description  Fetch a single transaction request by uuid
 // @Tags         Transactions
@@ -244,6 +306,18 @@ func (c *TransactionsController) getOne(rw http.ResponseWriter, request *http.Re
 // @Security     ApiKeyAuth
 // @Security     JWTAuth
 // @Param        idempotency_keys  query     []string                 false  "List of idempotency keys"  collectionFormat(csv)
+// @Param        statuses          query     []string  false  "List of job statuses"  collectionFormat(csv)
+// @Param        types             query     []string  false  "List of job types"  collectionFormat(csv)
+// @Param        label             query     []string  false  "Label of the jobs as key:value, repeated to match several labels"  collectionFormat(multi)
+// @Param        from              query     string    false  "Sender address"
+// @Param        to                query     string    false  "Recipient address"
+// @Param        schedule_uuid     query     string    false  "Schedule UUID"
+// @Param        created_after     query     string    false  "Creation date lower bound, in RFC3339 format"
+// @Param        created_before    query     string    false  "Creation date upper bound, in RFC3339 format"
+// @Param        limit   query     int     false  "maximum number of results per page, 100 by default and at most 1000"
+// @Param        sort    query     string  false  "sort key of the results" Enums(createdAt)
+// @Param        order   query     string  false  "sort order of the results" Enums(asc, desc)
+// @Param        cursor  query     string  false  "cursor of the page returned in the Link header"
 // @Success      200               {array}   api.TransactionResponse  "List of transaction requests found"
 // @Failure      400               {object}  infra.ErrorResponse   "Invalid filter in the request"
 // @Failure      500               {object}  infra.ErrorResponse   "Internal server error"
@@ -269,6 +343,11 @@ func (c *TransactionsController) search(rw http.ResponseWriter, request *http.Re
 		response = append(response, formatters.FormatTxResponse(txRequest))
 	}
 
+	if len(txRequests) > 0 {
+		last := txRequests[len(txRequests)-1]
+		writeNextPageCursor(rw, request, filters.Pagination.Next(len(txRequests), last.CreatedAt, time.Time{}, last.Schedule.UUID))
+	}
+
 	_ = json.NewEncoder(rw).Encode(response)
 }
 
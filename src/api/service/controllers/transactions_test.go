@@ -39,6 +39,7 @@ type transactionsControllerTestSuite struct {
 	searchTxsUsecase      *mocks.MockSearchTransactionsUseCase
 	speedUpTxUseCase      *mocks.MockSpeedUpTxUseCase
 	callOffTxUseCase      *mocks.MockCallOffTxUseCase
+	sendTxBatchUseCase    *mocks.MockSendTxBatchUseCase
 	ctx                   context.Context
 	userInfo              *multitenancy.UserInfo
 	defaultRetryInterval  time.Duration
@@ -72,6 +73,10 @@ func (s *transactionsControllerTestSuite) CallOff() usecases.CallOffTxUseCase {
 	return s.callOffTxUseCase
 }
 
+func (s *transactionsControllerTestSuite) SendBatch() usecases.SendTxBatchUseCase {
+	return s.sendTxBatchUseCase
+}
+
 var _ usecases.TransactionUseCases = &transactionsControllerTestSuite{}
 
 func TestTransactionsController(t *testing.T) {
@@ -90,6 +95,7 @@ func (s *transactionsControllerTestSuite) SetupTest() {
 	s.searchTxsUsecase = mocks.NewMockSearchTransactionsUseCase(ctrl)
 	s.speedUpTxUseCase = mocks.NewMockSpeedUpTxUseCase(ctrl)
 	s.callOffTxUseCase = mocks.NewMockCallOffTxUseCase(ctrl)
+	s.sendTxBatchUseCase = mocks.NewMockSendTxBatchUseCase(ctrl)
 	s.searchTxsUsecase = mocks.NewMockSearchTransactionsUseCase(ctrl)
 	s.defaultRetryInterval = time.Second * 2
 	s.userInfo = multitenancy.NewUserInfo("tenantOne", "username")
@@ -304,6 +310,70 @@ func (s *transactionsControllerTestSuite) TestSendRaw() {
 	})
 }
 
+func (s *transactionsControllerTestSuite) TestSendBatch() {
+	urlPath := "/transactions/batch"
+
+	s.T().Run("should execute request successfully and report invalid transactions", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		transferRequest := apitestdata.FakeSendTransferTransactionRequest()
+		batchRequest := &apitypes.SendTransactionBatchRequest{
+			Transactions: []*apitypes.TransactionBatchItem{
+				{IdempotencyKey: "key1", Transfer: transferRequest},
+				{IdempotencyKey: "key2", Send: apitestdata.FakeSendTransactionRequest(), Transfer: transferRequest},
+			},
+		}
+		requestBytes, _ := json.Marshal(batchRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		txRequestEntityResp := testdata.FakeTransferTxRequest()
+		expectedItems := []*entities.TxBatchItem{{Type: entities.TxBatchTransfer, TxRequest: formatters.FormatTransferRequest(transferRequest, "key1")}}
+		s.sendTxBatchUseCase.EXPECT().Execute(gomock.Any(), expectedItems, s.userInfo).
+			Return([]*entities.TxBatchResult{{Status: entities.TxBatchItemCreated, TxRequest: txRequestEntityResp}}, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		response := &apitypes.TransactionBatchResponse{}
+		err := json.Unmarshal(rw.Body.Bytes(), response)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusAccepted, rw.Code)
+		assert.Len(t, response.Transactions, 2)
+		assert.Equal(t, string(entities.TxBatchItemCreated), response.Transactions[0].Status)
+		assert.Equal(t, txRequestEntityResp.Schedule.UUID, response.Transactions[0].Transaction.UUID)
+		assert.Equal(t, string(entities.TxBatchItemInvalid), response.Transactions[1].Status)
+		assert.Equal(t, "key2", response.Transactions[1].IdempotencyKey)
+		assert.NotNil(t, response.Transactions[1].Error)
+		assert.Nil(t, response.Transactions[1].Transaction)
+	})
+
+	s.T().Run("should fail with 400 if batch is empty", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		requestBytes, _ := json.Marshal(&apitypes.SendTransactionBatchRequest{Transactions: []*apitypes.TransactionBatchItem{}})
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	s.T().Run("should fail with 500 if use case fails", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+
+		batchRequest := &apitypes.SendTransactionBatchRequest{
+			Transactions: []*apitypes.TransactionBatchItem{{Transfer: apitestdata.FakeSendTransferTransactionRequest()}},
+		}
+		requestBytes, _ := json.Marshal(batchRequest)
+		httpRequest := httptest.NewRequest(http.MethodPost, urlPath, bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.sendTxBatchUseCase.EXPECT().Execute(gomock.Any(), gomock.Any(), s.userInfo).Return(nil, fmt.Errorf("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusInternalServerError, rw.Code)
+	})
+}
+
 func (s *transactionsControllerTestSuite) TestTransfer() {
 	urlPath := "/transactions/transfer"
 	idempotencyKey := "idempotencyKey"
@@ -480,6 +550,7 @@ func (s *transactionsControllerTestSuite) TestSearch() {
 		txRequest := testdata.FakeTransferTxRequest()
 		expectedFilers := &entities.TransactionRequestFilters{
 			IdempotencyKeys: []string{"mykey", "mykey1"},
+			Pagination:      &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
 		}
 
 		s.searchTxsUsecase.EXPECT().Execute(gomock.Any(), expectedFilers, s.userInfo).
@@ -506,6 +577,7 @@ func (s *transactionsControllerTestSuite) TestSearch() {
 		httpRequest := httptest.NewRequest(http.MethodGet, urlPath+"?idempotency_keys=mykey,mykey1", nil).WithContext(s.ctx)
 		expectedFilers := &entities.TransactionRequestFilters{
 			IdempotencyKeys: []string{"mykey", "mykey1"},
+			Pagination:      &entities.Pagination{Limit: formatters.DefaultPageLimit, SortBy: entities.SortByCreatedAt, Order: entities.SortOrderAsc},
 		}
 
 		s.searchTxsUsecase.EXPECT().Execute(gomock.Any(), expectedFilers, s.userInfo).
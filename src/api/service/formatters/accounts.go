@@ -21,6 +21,10 @@ func FormatCreateAccountRequest(req *types.CreateAccountRequest, defaultStoreID
 		acc.StoreID = defaultStoreID
 	}
 
+	if req.Address != nil {
+		acc.Address = *req.Address
+	}
+
 	return acc
 }
 
@@ -43,11 +47,19 @@ func FormatUpdateAccountRequest(req *types.UpdateAccountRequest) *entities.Accou
 		Alias:      req.Alias,
 		Attributes: req.Attributes,
 		StoreID:    req.StoreID,
+		Status:     req.Status,
+	}
+}
+
+func FormatRotateAccountRequest(req *types.RotateAccountRequest) *entities.Account {
+	return &entities.Account{
+		Alias:   req.Alias,
+		StoreID: req.StoreID,
 	}
 }
 
 func FormatAccountResponse(iden *entities.Account) *types.AccountResponse {
-	return &types.AccountResponse{
+	res := &types.AccountResponse{
 		Alias:               iden.Alias,
 		Attributes:          iden.Attributes,
 		Address:             iden.Address.String(),
@@ -56,9 +68,19 @@ func FormatAccountResponse(iden *entities.Account) *types.AccountResponse {
 		TenantID:            iden.TenantID,
 		OwnerID:             iden.OwnerID,
 		StoreID:             iden.StoreID,
+		Status:              string(iden.Status),
 		CreatedAt:           iden.CreatedAt,
 		UpdatedAt:           iden.UpdatedAt,
 	}
+
+	if iden.RotatedFrom != nil {
+		res.RotatedFrom = iden.RotatedFrom.Hex()
+	}
+	if iden.RotatedTo != nil {
+		res.RotatedTo = iden.RotatedTo.Hex()
+	}
+
+	return res
 }
 
 func FormatAccountFilterRequest(req *http.Request) (*entities.AccountFilters, error) {
@@ -69,6 +91,12 @@ func FormatAccountFilterRequest(req *http.Request) (*entities.AccountFilters, er
 		filters.Aliases = strings.Split(qAliases, ",")
 	}
 
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	if err := infra.GetValidator().Struct(filters); err != nil {
 		return nil, err
 	}
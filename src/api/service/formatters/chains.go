@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 func FormatChainResponse(chain *entities.Chain) *types.ChainResponse {
@@ -27,6 +28,20 @@ func FormatChainResponse(chain *entities.Chain) *types.ChainResponse {
 		UpdatedAt:                 chain.UpdatedAt,
 	}
 
+	if chain.FeePolicy != nil {
+		res.FeePolicy = &types.FeePolicyResponse{
+			BumpPercentage:       chain.FeePolicy.BumpPercentage,
+			MaxBumps:             chain.FeePolicy.MaxBumps,
+			ReplaceWithSameNonce: chain.FeePolicy.ReplaceWithSameNonce,
+		}
+		if chain.FeePolicy.MaxFee != nil {
+			res.FeePolicy.MaxFee = hexutil.EncodeBig(chain.FeePolicy.MaxFee)
+		}
+		if chain.FeePolicy.BumpInterval != 0 {
+			res.FeePolicy.BumpInterval = chain.FeePolicy.BumpInterval.String()
+		}
+	}
+
 	return res
 }
 
@@ -49,6 +64,14 @@ func FormatRegisterChainRequest(request *types.RegisterChainRequest) (*entities.
 		}
 	}
 
+	if request.FeePolicy != nil {
+		var err error
+		chain.FeePolicy, err = formatFeePolicyRequest(request.FeePolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return chain, nil
 }
 
@@ -70,9 +93,40 @@ func FormatUpdateChainRequest(request *types.UpdateChainRequest, uuid string) (*
 		chain.ListenerDepth = request.Listener.Depth
 	}
 
+	if request.FeePolicy != nil {
+		var err error
+		chain.FeePolicy, err = formatFeePolicyRequest(request.FeePolicy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return chain, nil
 }
 
+func formatFeePolicyRequest(request *types.FeePolicyRequest) (*entities.FeePolicy, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	feePolicy := &entities.FeePolicy{
+		MaxFee:               request.MaxFee.ToInt(),
+		BumpPercentage:       request.BumpPercentage,
+		MaxBumps:             request.MaxBumps,
+		ReplaceWithSameNonce: request.ReplaceWithSameNonce,
+	}
+
+	if request.BumpInterval != "" {
+		var err error
+		feePolicy.BumpInterval, err = time.ParseDuration(request.BumpInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return feePolicy, nil
+}
+
 func FormatChainFiltersRequest(req *http.Request) (*entities.ChainFilters, error) {
 	filters := &entities.ChainFilters{}
 
@@ -81,6 +135,12 @@ func FormatChainFiltersRequest(req *http.Request) (*entities.ChainFilters, error
 		filters.Names = strings.Split(qNames, ",")
 	}
 
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	if err := infra.GetValidator().Struct(filters); err != nil {
 		return nil, err
 	}
@@ -101,7 +161,28 @@ func ChainResponseToEntity(chain *types.ChainResponse) *entities.Chain {
 		ListenerDepth:             chain.ListenerDepth,
 		ListenerBlockTimeDuration: listenerBackOffDuration,
 		Labels:                    chain.Labels,
+		FeePolicy:                 feePolicyResponseToEntity(chain.FeePolicy),
 		CreatedAt:                 chain.CreatedAt,
 		UpdatedAt:                 chain.UpdatedAt,
 	}
 }
+
+func feePolicyResponseToEntity(feePolicy *types.FeePolicyResponse) *entities.FeePolicy {
+	if feePolicy == nil {
+		return nil
+	}
+
+	// Cannot fail as the values coming from a response are expected to be valid
+	bumpInterval, _ := time.ParseDuration(feePolicy.BumpInterval)
+	res := &entities.FeePolicy{
+		BumpPercentage:       feePolicy.BumpPercentage,
+		BumpInterval:         bumpInterval,
+		MaxBumps:             feePolicy.MaxBumps,
+		ReplaceWithSameNonce: feePolicy.ReplaceWithSameNonce,
+	}
+	if feePolicy.MaxFee != "" {
+		res.MaxFee, _ = hexutil.DecodeBig(feePolicy.MaxFee)
+	}
+
+	return res
+}
@@ -2,6 +2,7 @@ package formatters
 
 import (
 	"encoding/json"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/artifacts"
 	"github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -41,6 +43,37 @@ func FormatRegisterContractRequest(req *types.RegisterContractRequest) (*entitie
 	}, nil
 }
 
+func FormatImportContractsRequest(req *types.ImportContractsRequest) (*entities.ContractImport, error) {
+	tag := req.Tag
+	if tag == "" {
+		tag = entities.DefaultContractTagValue
+	}
+
+	contractImport := &entities.ContractImport{
+		Tag:       tag,
+		ChainName: req.ChainName,
+		Libraries: req.Libraries,
+	}
+
+	for i, params := range req.Artifacts {
+		artifact, err := artifacts.Parse(params.Artifact)
+		if err != nil {
+			return nil, errors.InvalidFormatError("artifact %d: %s", i, err.Error())
+		}
+
+		if params.Name != "" {
+			artifact.Name = params.Name
+		}
+		if artifact.Name == "" {
+			return nil, errors.InvalidFormatError("artifact %d: missing contract name", i)
+		}
+
+		contractImport.Artifacts = append(contractImport.Artifacts, artifact)
+	}
+
+	return contractImport, nil
+}
+
 func FormatSearchContractRequest(req *http.Request) (*types.SearchContractRequest, error) {
 	res := &types.SearchContractRequest{}
 	var err error
@@ -102,6 +135,8 @@ func FormatContractResponse(contract *entities.Contract) *types.ContractResponse
 		Constructor:      FormatABIComponentResponse(contract.Constructor),
 		Methods:          FormatABIComponentResponses(contract.Methods),
 		Events:           FormatABIComponentResponses(contract.Events),
+		TenantID:         contract.TenantID,
+		OwnerID:          contract.OwnerID,
 	}
 }
 
@@ -120,3 +155,58 @@ func FormatABIComponentResponses(components []entities.ABIComponent) []types.ABI
 
 	return resp
 }
+
+func FormatCallContractRequest(req *types.CallContractRequest) *entities.ContractCallRequest {
+	callReq := &entities.ContractCallRequest{
+		ChainName:        req.ChainName,
+		Multicall:        req.Multicall,
+		Protocol:         req.Protocol,
+		PrivateFrom:      req.PrivateFrom,
+		PrivacyGroupID:   req.PrivacyGroupID,
+		PrivacyGroupName: req.PrivacyGroupName,
+	}
+
+	switch req.Block {
+	case "", entities.BlockTagLatest:
+	case entities.BlockTagPending:
+		callReq.Pending = true
+	case entities.BlockTagEarliest:
+		callReq.BlockNumber = big.NewInt(0)
+	default:
+		if blockNumber, err := hexutil.DecodeBig(req.Block); err == nil {
+			callReq.BlockNumber = blockNumber
+		} else {
+			callReq.BlockNumber, _ = new(big.Int).SetString(req.Block, 10)
+		}
+	}
+
+	for _, call := range req.Calls {
+		contractTag := call.ContractTag
+		if call.ContractName != "" && contractTag == "" {
+			contractTag = entities.DefaultContractTagValue
+		}
+
+		callReq.Calls = append(callReq.Calls, &entities.ContractCall{
+			To:              call.To,
+			ContractName:    call.ContractName,
+			ContractTag:     contractTag,
+			MethodSignature: call.MethodSignature,
+			Args:            call.Args,
+		})
+	}
+
+	return callReq
+}
+
+func FormatContractCallResponse(result *entities.ContractCallResult) *types.ContractCallResponse {
+	res := &types.ContractCallResponse{
+		Success: result.Success,
+		Outputs: result.Outputs,
+		Error:   result.Error,
+	}
+	if len(result.Data) > 0 {
+		res.Data = result.Data.String()
+	}
+
+	return res
+}
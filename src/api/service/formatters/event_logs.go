@@ -0,0 +1,147 @@
+package formatters
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	DefaultEventLogsLimit = 100
+	MaxEventLogsLimit     = 1000
+)
+
+func FormatEventLogFilters(req *http.Request) (string, *entities.EventLogFilters, error) {
+	query := req.URL.Query()
+	filters := &entities.EventLogFilters{Limit: DefaultEventLogsLimit}
+
+	chainName := query.Get("chain")
+	if chainName == "" {
+		return "", nil, errors.InvalidFormatError("chain is mandatory")
+	}
+
+	qAddresses := query.Get("addresses")
+	if qAddresses != "" {
+		for _, addr := range strings.Split(qAddresses, ",") {
+			if !ethcommon.IsHexAddress(addr) {
+				return "", nil, errors.InvalidFormatError("invalid address %s", addr)
+			}
+			filters.Addresses = append(filters.Addresses, ethcommon.HexToAddress(addr))
+		}
+	}
+
+	if event := query.Get("event"); event != "" {
+		sigHash := crypto.Keccak256Hash([]byte(event))
+		filters.SigHash = &sigHash
+	}
+
+	for i, topic := range []**ethcommon.Hash{&filters.Topic1, &filters.Topic2, &filters.Topic3} {
+		qTopic := query.Get(fmt.Sprintf("topic%d", i+1))
+		if qTopic == "" {
+			continue
+		}
+
+		// Indexed arguments are left padded to 32 bytes, such that an address can be passed as is
+		b, err := hexutil.Decode(qTopic)
+		if err != nil || len(b) > ethcommon.HashLength {
+			return "", nil, errors.InvalidFormatError("invalid topic%d %s", i+1, qTopic)
+		}
+		hash := ethcommon.BytesToHash(b)
+		*topic = &hash
+	}
+
+	for param, block := range map[string]**uint64{"from_block": &filters.FromBlock, "to_block": &filters.ToBlock} {
+		qBlock := query.Get(param)
+		if qBlock == "" {
+			continue
+		}
+
+		blockNumber, err := strconv.ParseUint(qBlock, 10, 64)
+		if err != nil {
+			return "", nil, errors.InvalidFormatError("invalid %s %s", param, qBlock)
+		}
+		*block = &blockNumber
+	}
+
+	if qLimit := query.Get("limit"); qLimit != "" {
+		limit, err := strconv.Atoi(qLimit)
+		if err != nil || limit < 1 || limit > MaxEventLogsLimit {
+			return "", nil, errors.InvalidFormatError("limit must be between 1 and %d", MaxEventLogsLimit)
+		}
+		filters.Limit = limit
+	}
+
+	if qCursor := query.Get("cursor"); qCursor != "" {
+		cursor, err := ParseEventLogCursor(qCursor)
+		if err != nil {
+			return "", nil, err
+		}
+		filters.After = cursor
+	}
+
+	if err := infra.GetValidator().Struct(filters); err != nil {
+		return "", nil, err
+	}
+
+	return chainName, filters, nil
+}
+
+// FormatEventLogCursor encodes the position of an event log into an opaque cursor
+func FormatEventLogCursor(cursor *entities.EventLogCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", cursor.BlockNumber, cursor.LogIndex)))
+}
+
+func ParseEventLogCursor(cursor string) (*entities.EventLogCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	parts := strings.Split(string(b), ":")
+	if len(parts) != 2 {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	blockNumber, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	logIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	return &entities.EventLogCursor{BlockNumber: blockNumber, LogIndex: uint(logIndex)}, nil
+}
+
+func FormatEventLogResponse(eventLog *entities.EventLog) *api.EventLogResponse {
+	topics := []string{}
+	for _, topic := range eventLog.Topics {
+		topics = append(topics, topic.Hex())
+	}
+
+	return &api.EventLogResponse{
+		ChainUUID:   eventLog.ChainUUID,
+		Address:     eventLog.Address.Hex(),
+		Topics:      topics,
+		Data:        hexutil.Encode(eventLog.Data),
+		BlockNumber: eventLog.BlockNumber,
+		BlockHash:   eventLog.BlockHash.Hex(),
+		TxHash:      eventLog.TxHash.Hex(),
+		LogIndex:    eventLog.LogIndex,
+		Event:       eventLog.Event,
+		DecodedData: eventLog.DecodedData,
+		CreatedAt:   eventLog.CreatedAt,
+	}
+}
@@ -65,6 +65,12 @@ func FormatFaucetFilters(req *http.Request) (*entities.FaucetFilters, error) {
 		filters.ChainRule = qChainRule
 	}
 
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	if err := infra.GetValidator().Struct(filters); err != nil {
 		return nil, err
 	}
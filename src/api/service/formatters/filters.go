@@ -0,0 +1,56 @@
+package formatters
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func parseTimeQueryParam(query url.Values, param string) (time.Time, error) {
+	qTime := query.Get(param)
+	if qTime == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, qTime)
+	if err != nil {
+		return time.Time{}, errors.InvalidFormatError("failed to parse %s as time", param)
+	}
+
+	return t, nil
+}
+
+func parseAddressQueryParam(query url.Values, param string) (*ethcommon.Address, error) {
+	qAddress := query.Get(param)
+	if qAddress == "" {
+		return nil, nil
+	}
+
+	if !ethcommon.IsHexAddress(qAddress) {
+		return nil, errors.InvalidFormatError("invalid %s address %s", param, qAddress)
+	}
+
+	address := ethcommon.HexToAddress(qAddress)
+	return &address, nil
+}
+
+// parseLabelsQueryParam parses the labels provided as repeated "label=key:value" query parameters
+func parseLabelsQueryParam(query url.Values) (map[string]string, error) {
+	if len(query["label"]) == 0 {
+		return nil, nil
+	}
+
+	labels := map[string]string{}
+	for _, label := range query["label"] {
+		kv := strings.SplitN(label, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.InvalidFormatError("invalid label %s, expected key:value", label)
+		}
+		labels[kv[0]] = kv[1]
+	}
+
+	return labels, nil
+}
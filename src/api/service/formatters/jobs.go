@@ -1,14 +1,11 @@
 package formatters
 
 import (
-	"fmt"
 	"net/http"
 	"strings"
 	"time"
 
-	"github.com/consensys/orchestrate/pkg/errors"
 	infra "github.com/consensys/orchestrate/src/infra/api"
-	log "github.com/sirupsen/logrus"
 
 	"github.com/consensys/orchestrate/src/entities"
 
@@ -18,23 +15,35 @@ import (
 )
 
 func FormatJobResponse(job *entities.Job) *types.JobResponse {
-	return &types.JobResponse{
-		UUID:          job.UUID,
-		ChainUUID:     job.ChainUUID,
-		ScheduleUUID:  job.ScheduleUUID,
-		NextJobUUID:   job.NextJobUUID,
-		Transaction:   *FormatETHTransactionResponse(job.Transaction),
-		Logs:          job.Logs,
-		Labels:        job.Labels,
-		TenantID:      job.TenantID,
-		OwnerID:       job.OwnerID,
-		Annotations:   FormatInternalDataToAnnotations(job.InternalData),
-		Type:          job.Type,
-		Status:        job.Status,
-		ParentJobUUID: job.InternalData.ParentJobUUID,
-		CreatedAt:     job.CreatedAt,
-		UpdatedAt:     job.UpdatedAt,
+	res := &types.JobResponse{
+		UUID:           job.UUID,
+		ChainUUID:      job.ChainUUID,
+		ScheduleUUID:   job.ScheduleUUID,
+		NextJobUUID:    job.NextJobUUID,
+		Transaction:    *FormatETHTransactionResponse(job.Transaction),
+		Logs:           job.Logs,
+		Labels:         job.Labels,
+		TenantID:       job.TenantID,
+		OwnerID:        job.OwnerID,
+		Annotations:    FormatInternalDataToAnnotations(job.InternalData),
+		Type:           job.Type,
+		Status:         job.Status,
+		ParentJobUUID:  job.InternalData.ParentJobUUID,
+		PrivateReceipt: job.PrivateReceipt,
+		CreatedAt:      job.CreatedAt,
+		UpdatedAt:      job.UpdatedAt,
+	}
+
+	if job.DecodedCall != nil {
+		res.DecodedCall = &types.DecodedCallResponse{
+			ContractName:    job.DecodedCall.ContractName,
+			ContractTag:     job.DecodedCall.ContractTag,
+			MethodSignature: job.DecodedCall.MethodSignature,
+			Inputs:          job.DecodedCall.Inputs,
+		}
 	}
+
+	return res
 }
 
 func FormatJobCreateRequest(request *types.CreateJobRequest) *entities.Job {
@@ -99,16 +108,56 @@ func FormatJobFilterRequest(req *http.Request) (*entities.JobFilters, error) {
 		filters.ParentJobUUID = qParentJobUUID
 	}
 
-	qUpdatedAfter := req.URL.Query().Get("updated_after")
-	if qUpdatedAfter != "" {
-		updatedAfter, err := time.Parse(time.RFC3339, qUpdatedAfter)
-		if err != nil {
-			errMessage := "failed to parse updated_after as time"
-			log.WithError(err).WithField("updated_after", fmt.Sprintf("%q", qUpdatedAfter)).Error(errMessage)
-			return nil, errors.InvalidParameterError(errMessage)
+	qStatuses := req.URL.Query().Get("statuses")
+	if qStatuses != "" {
+		for _, status := range strings.Split(qStatuses, ",") {
+			filters.Statuses = append(filters.Statuses, entities.JobStatus(status))
 		}
+	}
+
+	qTypes := req.URL.Query().Get("types")
+	if qTypes != "" {
+		for _, jobType := range strings.Split(qTypes, ",") {
+			filters.Types = append(filters.Types, entities.JobType(jobType))
+		}
+	}
 
-		filters.UpdatedAfter = updatedAfter
+	qScheduleUUID := req.URL.Query().Get("schedule_uuid")
+	if qScheduleUUID != "" {
+		filters.ScheduleUUID = qScheduleUUID
+	}
+
+	qIdempotencyKeys := req.URL.Query().Get("idempotency_keys")
+	if qIdempotencyKeys != "" {
+		filters.IdempotencyKeys = strings.Split(qIdempotencyKeys, ",")
+	}
+
+	var err error
+	filters.Labels, err = parseLabelsQueryParam(req.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	filters.From, err = parseAddressQueryParam(req.URL.Query(), "from")
+	if err != nil {
+		return nil, err
+	}
+
+	filters.To, err = parseAddressQueryParam(req.URL.Query(), "to")
+	if err != nil {
+		return nil, err
+	}
+
+	for param, t := range map[string]*time.Time{
+		"created_after":  &filters.CreatedAfter,
+		"created_before": &filters.CreatedBefore,
+		"updated_after":  &filters.UpdatedAfter,
+		"updated_before": &filters.UpdatedBefore,
+	} {
+		*t, err = parseTimeQueryParam(req.URL.Query(), param)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	qOnlyParents := req.URL.Query().Get("only_parents")
@@ -121,6 +170,12 @@ func FormatJobFilterRequest(req *http.Request) (*entities.JobFilters, error) {
 		filters.WithLogs = true
 	}
 
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	if err := infra.GetValidator().Struct(filters); err != nil {
 		return nil, err
 	}
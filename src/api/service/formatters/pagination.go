@@ -0,0 +1,67 @@
+package formatters
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+const (
+	DefaultPageLimit = 100
+	MaxPageLimit     = 1000
+)
+
+// FormatPagination parses the pagination query parameters of a search request, the first page of DefaultPageLimit
+// results being returned if none is provided.
+// The results are sorted by one of the given sort fields, the first one by default
+func FormatPagination(req *http.Request, sortFields ...entities.SortField) (*entities.Pagination, error) {
+	query := req.URL.Query()
+	qLimit, qSort, qOrder, qCursor := query.Get("limit"), query.Get("sort"), query.Get("order"), query.Get("cursor")
+
+	pagination := &entities.Pagination{
+		Limit:  DefaultPageLimit,
+		SortBy: sortFields[0],
+		Order:  entities.SortOrderAsc,
+	}
+
+	if qLimit != "" {
+		limit, err := strconv.Atoi(qLimit)
+		if err != nil || limit < 1 || limit > MaxPageLimit {
+			return nil, errors.InvalidFormatError("limit must be between 1 and %d", MaxPageLimit)
+		}
+		pagination.Limit = limit
+	}
+
+	if qSort != "" {
+		pagination.SortBy = ""
+		for _, field := range sortFields {
+			if string(field) == qSort {
+				pagination.SortBy = field
+			}
+		}
+
+		if pagination.SortBy == "" {
+			return nil, errors.InvalidFormatError("cannot sort by %s", qSort)
+		}
+	}
+
+	switch entities.SortOrder(qOrder) {
+	case "":
+	case entities.SortOrderAsc, entities.SortOrderDesc:
+		pagination.Order = entities.SortOrder(qOrder)
+	default:
+		return nil, errors.InvalidFormatError("order must be %s or %s", entities.SortOrderAsc, entities.SortOrderDesc)
+	}
+
+	if qCursor != "" {
+		cursor, err := entities.ParsePageCursor(qCursor)
+		if err != nil {
+			return nil, err
+		}
+		pagination.After = cursor
+	}
+
+	return pagination, nil
+}
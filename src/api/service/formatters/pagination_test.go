@@ -0,0 +1,63 @@
+// +build unit
+
+package formatters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatPagination(t *testing.T) {
+	t.Run("should return the first page by default if no pagination parameter is provided", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+
+		pagination, err := FormatPagination(req, entities.SortByCreatedAt)
+		require.NoError(t, err)
+		assert.Equal(t, &entities.Pagination{
+			Limit:  DefaultPageLimit,
+			SortBy: entities.SortByCreatedAt,
+			Order:  entities.SortOrderAsc,
+		}, pagination)
+	})
+
+	t.Run("should parse pagination parameters successfully", func(t *testing.T) {
+		cursor := &entities.PageCursor{Time: time.Now().UTC(), Key: "uuid"}
+		req := httptest.NewRequest(http.MethodGet, "/jobs?sort=updatedAt&order=desc&cursor="+cursor.String(), nil)
+
+		pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultPageLimit, pagination.Limit)
+		assert.Equal(t, entities.SortByUpdatedAt, pagination.SortBy)
+		assert.Equal(t, entities.SortOrderDesc, pagination.Order)
+		assert.True(t, cursor.Time.Equal(pagination.After.Time))
+		assert.Equal(t, cursor.Key, pagination.After.Key)
+	})
+
+	t.Run("should fail with InvalidFormatError if limit is too high", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/jobs?limit=1001", nil)
+
+		_, err := FormatPagination(req, entities.SortByCreatedAt)
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+
+	t.Run("should fail with InvalidFormatError if sort key is not allowed", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/transactions?sort=updatedAt", nil)
+
+		_, err := FormatPagination(req, entities.SortByCreatedAt)
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+
+	t.Run("should fail with InvalidFormatError if cursor is invalid", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/jobs?cursor=invalid", nil)
+
+		_, err := FormatPagination(req, entities.SortByCreatedAt)
+		assert.True(t, errors.IsInvalidFormatError(err))
+	})
+}
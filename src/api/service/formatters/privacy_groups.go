@@ -0,0 +1,69 @@
+package formatters
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+)
+
+func FormatCreatePrivacyGroupRequest(req *types.CreatePrivacyGroupRequest) *entities.PrivacyGroup {
+	return &entities.PrivacyGroup{
+		Name:        req.Name,
+		Members:     req.Members,
+		Description: req.Description,
+		Labels:      req.Labels,
+	}
+}
+
+func FormatPrivacyGroupResponse(group *entities.PrivacyGroup) *types.PrivacyGroupResponse {
+	return &types.PrivacyGroupResponse{
+		UUID:           group.UUID,
+		Name:           group.Name,
+		ChainUUID:      group.ChainUUID,
+		PrivacyGroupID: group.PrivacyGroupID,
+		Description:    group.Description,
+		Members:        group.Members,
+		Labels:         group.Labels,
+		TenantID:       group.TenantID,
+		OwnerID:        group.OwnerID,
+		CreatedAt:      group.CreatedAt,
+		UpdatedAt:      group.UpdatedAt,
+	}
+}
+
+func FormatOnChainPrivacyGroupResponse(group *entities.OnChainPrivacyGroup) *types.OnChainPrivacyGroupResponse {
+	return &types.OnChainPrivacyGroupResponse{
+		PrivacyGroupID: group.PrivacyGroupID,
+		Name:           group.Name,
+		Description:    group.Description,
+		Type:           group.Type,
+		Members:        group.Members,
+	}
+}
+
+func FormatPrivacyGroupFilters(req *http.Request) (*entities.PrivacyGroupFilters, error) {
+	filters := &entities.PrivacyGroupFilters{}
+
+	qNames := req.URL.Query().Get("names")
+	if qNames != "" {
+		filters.Names = strings.Split(qNames, ",")
+	}
+
+	filters.ChainUUID = req.URL.Query().Get("chain_uuid")
+	filters.PrivacyGroupID = req.URL.Query().Get("privacy_group_id")
+
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
+	if err := infra.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
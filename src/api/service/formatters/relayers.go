@@ -0,0 +1,142 @@
+package formatters
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+)
+
+func FormatRegisterRelayerRequest(req *types.RegisterRelayerRequest) *entities.Relayer {
+	return &entities.Relayer{
+		Name:            req.Name,
+		Type:            entities.RelayerType(req.Type),
+		Account:         req.Account,
+		ContractAddress: req.ContractAddress,
+		ContractName:    req.ContractName,
+		ContractTag:     req.ContractTag,
+		DomainName:      req.DomainName,
+		DomainVersion:   req.DomainVersion,
+	}
+}
+
+func FormatRelayerResponse(relayer *entities.Relayer) *types.RelayerResponse {
+	return &types.RelayerResponse{
+		UUID:            relayer.UUID,
+		Name:            relayer.Name,
+		ChainUUID:       relayer.ChainUUID,
+		Type:            string(relayer.Type),
+		Account:         relayer.Account.Hex(),
+		ContractAddress: relayer.ContractAddress.Hex(),
+		ContractName:    relayer.ContractName,
+		ContractTag:     relayer.ContractTag,
+		DomainName:      relayer.DomainName,
+		DomainVersion:   relayer.DomainVersion,
+		TenantID:        relayer.TenantID,
+		OwnerID:         relayer.OwnerID,
+		CreatedAt:       relayer.CreatedAt,
+		UpdatedAt:       relayer.UpdatedAt,
+	}
+}
+
+func FormatRelayTransactionRequest(req *types.RelayTransactionRequest, idempotencyKey string) (*entities.TxRequest, *entities.MetaTransaction) {
+	txRequest := &entities.TxRequest{
+		IdempotencyKey: idempotencyKey,
+		ChainName:      req.ChainName,
+		Labels:         req.Labels,
+		Params: &entities.TxRequestParams{
+			ETHTransaction: &entities.ETHTransaction{
+				GasPrice:        req.Params.GasPrice,
+				GasFeeCap:       req.Params.GasFeeCap,
+				GasTipCap:       req.Params.GasTipCap,
+				Gas:             req.Params.Gas,
+				TransactionType: entities.TransactionType(req.Params.TransactionType),
+			},
+		},
+		InternalData: buildInternalData(false, &req.Params.GasPricePolicy),
+	}
+
+	metaTx := &entities.MetaTransaction{Signature: req.Params.Signature}
+	if fwdReq := req.Params.ForwardRequest; fwdReq != nil {
+		metaTx.ForwardRequest = &entities.ForwardRequest{
+			From:  fwdReq.From,
+			To:    fwdReq.To,
+			Value: fwdReq.Value,
+			Gas:   fwdReq.Gas,
+			Nonce: fwdReq.Nonce,
+			Data:  fwdReq.Data,
+		}
+	}
+	if userOp := req.Params.UserOperation; userOp != nil {
+		metaTx.UserOperation = &entities.UserOperation{
+			Sender:               userOp.Sender,
+			Nonce:                userOp.Nonce,
+			InitCode:             userOp.InitCode,
+			CallData:             userOp.CallData,
+			CallGasLimit:         userOp.CallGasLimit,
+			VerificationGasLimit: userOp.VerificationGasLimit,
+			PreVerificationGas:   userOp.PreVerificationGas,
+			MaxFeePerGas:         userOp.MaxFeePerGas,
+			MaxPriorityFeePerGas: userOp.MaxPriorityFeePerGas,
+			PaymasterAndData:     userOp.PaymasterAndData,
+			Signature:            userOp.Signature,
+		}
+	}
+
+	return txRequest, metaTx
+}
+
+func FormatRelaySpendingResponse(spending *entities.RelaySpending) *types.RelaySpendingResponse {
+	res := &types.RelaySpendingResponse{
+		RelayerUUID: spending.RelayerUUID,
+		TenantID:    spending.TenantID,
+		TxCount:     spending.TxCount,
+		GasUsed:     spending.GasUsed,
+		Fee:         "0x0",
+		UpdatedAt:   spending.UpdatedAt,
+	}
+	if spending.Fee != nil {
+		res.Fee = spending.Fee.String()
+	}
+
+	return res
+}
+
+func FormatRelayerFilters(req *http.Request) (*entities.RelayerFilters, error) {
+	filters := &entities.RelayerFilters{}
+
+	qNames := req.URL.Query().Get("names")
+	if qNames != "" {
+		filters.Names = strings.Split(qNames, ",")
+	}
+
+	filters.ChainUUID = req.URL.Query().Get("chain_uuid")
+	filters.Type = entities.RelayerType(req.URL.Query().Get("type"))
+
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
+	if err := infra.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
+
+func FormatRelaySpendingFilters(req *http.Request) (*entities.RelaySpendingFilters, error) {
+	filters := &entities.RelaySpendingFilters{
+		RelayerUUID: req.URL.Query().Get("relayer_uuid"),
+		TenantID:    req.URL.Query().Get("tenant_id"),
+	}
+
+	if err := infra.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
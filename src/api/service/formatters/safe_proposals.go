@@ -0,0 +1,109 @@
+package formatters
+
+import (
+	"net/http"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func FormatCreateSafeProposalRequest(req *types.CreateSafeProposalRequest) *entities.SafeProposal {
+	tx := &entities.SafeTransaction{
+		To:        req.To,
+		Value:     req.Value,
+		Data:      req.Data,
+		Operation: entities.SafeOperation(req.Operation),
+		SafeTxGas: req.SafeTxGas,
+		BaseGas:   req.BaseGas,
+		GasPrice:  req.GasPrice,
+		Nonce:     req.Nonce,
+	}
+	if req.GasToken != nil {
+		tx.GasToken = *req.GasToken
+	}
+	if req.RefundReceiver != nil {
+		tx.RefundReceiver = *req.RefundReceiver
+	}
+
+	return &entities.SafeProposal{
+		SafeAddress: req.Safe,
+		Sender:      req.Sender,
+		Transaction: tx,
+		Labels:      req.Labels,
+	}
+}
+
+func FormatSignSafeProposalRequest(req *types.SignSafeProposalRequest) *entities.SafeSignature {
+	return &entities.SafeSignature{
+		Signer:    req.Signer,
+		Signature: req.Signature,
+	}
+}
+
+func FormatSafeProposalResponse(proposal *entities.SafeProposal) *types.SafeProposalResponse {
+	res := &types.SafeProposalResponse{
+		UUID:         proposal.UUID,
+		ChainUUID:    proposal.ChainUUID,
+		Safe:         proposal.SafeAddress.Hex(),
+		Sender:       proposal.Sender.Hex(),
+		Transaction:  proposal.Transaction,
+		SafeTxHash:   proposal.SafeTxHash.Hex(),
+		Owners:       []string{},
+		Threshold:    proposal.Threshold,
+		Signatures:   []*types.SafeSignatureResponse{},
+		Status:       string(proposal.Status),
+		ScheduleUUID: proposal.ScheduleUUID,
+		Labels:       proposal.Labels,
+		TenantID:     proposal.TenantID,
+		OwnerID:      proposal.OwnerID,
+		CreatedAt:    proposal.CreatedAt,
+		UpdatedAt:    proposal.UpdatedAt,
+	}
+
+	for _, owner := range proposal.Owners {
+		res.Owners = append(res.Owners, owner.Hex())
+	}
+	for _, sig := range proposal.Signatures {
+		res.Signatures = append(res.Signatures, &types.SafeSignatureResponse{
+			Signer:    sig.Signer.Hex(),
+			Signature: sig.Signature.String(),
+			CreatedAt: sig.CreatedAt,
+		})
+	}
+	if proposal.TxHash != nil {
+		res.TxHash = proposal.TxHash.Hex()
+	}
+
+	return res
+}
+
+func FormatSafeProposalFilters(req *http.Request) (*entities.SafeProposalFilters, error) {
+	filters := &entities.SafeProposalFilters{}
+
+	qSafe := req.URL.Query().Get("safe")
+	if qSafe != "" {
+		if !ethcommon.IsHexAddress(qSafe) {
+			return nil, errors.InvalidFormatError("invalid safe address")
+		}
+		filters.SafeAddress = utils.ToPtr(ethcommon.HexToAddress(qSafe)).(*ethcommon.Address)
+	}
+
+	filters.ChainUUID = req.URL.Query().Get("chain_uuid")
+	filters.Status = entities.SafeProposalStatus(req.URL.Query().Get("status"))
+
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt, entities.SortByUpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
+	if err := infra.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,71 @@
+package formatters
+
+import (
+	"net/http"
+
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+)
+
+func FormatCreateSentrySessionRequest(req *types.CreateSentrySessionRequest) *entities.SentrySession {
+	return &entities.SentrySession{
+		JobUUID:          req.JobUUID,
+		Retries:          req.Retries,
+		NChildren:        req.NChildren,
+		LastChildJobUUID: req.LastChildJobUUID,
+		NextAttemptAt:    req.NextAttemptAt,
+	}
+}
+
+func FormatUpdateSentrySessionRequest(req *types.UpdateSentrySessionRequest, jobUUID string) *entities.SentrySession {
+	return &entities.SentrySession{
+		JobUUID:          jobUUID,
+		Retries:          req.Retries,
+		NChildren:        req.NChildren,
+		LastChildJobUUID: req.LastChildJobUUID,
+		NextAttemptAt:    req.NextAttemptAt,
+	}
+}
+
+func FormatSentrySessionResponse(session *entities.SentrySession) *types.SentrySessionResponse {
+	return &types.SentrySessionResponse{
+		JobUUID:          session.JobUUID,
+		ChainUUID:        session.ChainUUID,
+		Retries:          session.Retries,
+		NChildren:        session.NChildren,
+		LastChildJobUUID: session.LastChildJobUUID,
+		NextAttemptAt:    session.NextAttemptAt,
+		TenantID:         session.TenantID,
+		OwnerID:          session.OwnerID,
+		CreatedAt:        session.CreatedAt,
+		UpdatedAt:        session.UpdatedAt,
+	}
+}
+
+func SentrySessionResponseToEntity(session *types.SentrySessionResponse) *entities.SentrySession {
+	return &entities.SentrySession{
+		JobUUID:          session.JobUUID,
+		ChainUUID:        session.ChainUUID,
+		Retries:          session.Retries,
+		NChildren:        session.NChildren,
+		LastChildJobUUID: session.LastChildJobUUID,
+		NextAttemptAt:    session.NextAttemptAt,
+		TenantID:         session.TenantID,
+		OwnerID:          session.OwnerID,
+		CreatedAt:        session.CreatedAt,
+		UpdatedAt:        session.UpdatedAt,
+	}
+}
+
+func FormatSentrySessionFilters(req *http.Request) (*entities.SentrySessionFilters, error) {
+	filters := &entities.SentrySessionFilters{
+		ChainUUID: req.URL.Query().Get("chain_uuid"),
+	}
+
+	if err := infra.GetValidator().Struct(filters); err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
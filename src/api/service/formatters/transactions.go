@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	infra "github.com/consensys/orchestrate/src/infra/api"
@@ -38,11 +39,12 @@ func FormatSendTxRequest(sendTxRequest *types.SendTransactionRequest, idempotenc
 				PrivacyFlag:     sendTxRequest.Params.PrivacyFlag,
 				PrivacyGroupID:  sendTxRequest.Params.PrivacyGroupID,
 			},
-			MethodSignature: sendTxRequest.Params.MethodSignature,
-			Args:            sendTxRequest.Params.Args,
-			Protocol:        sendTxRequest.Params.Protocol,
-			ContractTag:     sendTxRequest.Params.ContractTag,
-			ContractName:    sendTxRequest.Params.ContractName,
+			MethodSignature:  sendTxRequest.Params.MethodSignature,
+			Args:             sendTxRequest.Params.Args,
+			Protocol:         sendTxRequest.Params.Protocol,
+			ContractTag:      sendTxRequest.Params.ContractTag,
+			ContractName:     sendTxRequest.Params.ContractName,
+			PrivacyGroupName: sendTxRequest.Params.PrivacyGroupName,
 		},
 		InternalData: buildInternalData(
 			sendTxRequest.Params.OneTimeKey,
@@ -78,10 +80,12 @@ func FormatDeployContractRequest(deployRequest *types.DeployContractRequest, ide
 				PrivacyFlag:     entities.PrivacyFlag(deployRequest.Params.PrivacyFlag),
 				PrivacyGroupID:  deployRequest.Params.PrivacyGroupID,
 			},
-			Args:         deployRequest.Params.Args,
-			ContractName: deployRequest.Params.ContractName,
-			ContractTag:  deployRequest.Params.ContractTag,
-			Protocol:     deployRequest.Params.Protocol,
+			Args:             deployRequest.Params.Args,
+			ContractName:     deployRequest.Params.ContractName,
+			ContractTag:      deployRequest.Params.ContractTag,
+			Protocol:         deployRequest.Params.Protocol,
+			Salt:             deployRequest.Params.Salt,
+			PrivacyGroupName: deployRequest.Params.PrivacyGroupName,
 		},
 		InternalData: buildInternalData(
 			deployRequest.Params.OneTimeKey,
@@ -138,7 +142,7 @@ func FormatTransferRequest(transferRequest *types.TransferRequest, idempotencyKe
 func FormatTxResponse(txRequest *entities.TxRequest) *types.TransactionResponse {
 	scheduleRes := FormatScheduleResponse(txRequest.Schedule)
 
-	return &types.TransactionResponse{
+	res := &types.TransactionResponse{
 		UUID:           txRequest.Schedule.UUID,
 		IdempotencyKey: txRequest.IdempotencyKey,
 		ChainName:      txRequest.ChainName,
@@ -146,6 +150,69 @@ func FormatTxResponse(txRequest *entities.TxRequest) *types.TransactionResponse
 		Jobs:           scheduleRes.Jobs,
 		CreatedAt:      txRequest.CreatedAt,
 	}
+
+	if len(scheduleRes.Jobs) > 0 {
+		res.DecodedCall = scheduleRes.Jobs[0].DecodedCall
+	}
+
+	if len(txRequest.Schedule.Jobs) > 0 && txRequest.Schedule.Jobs[0].InternalData != nil &&
+		txRequest.Schedule.Jobs[0].InternalData.ContractAddress != nil {
+		res.ContractAddress = txRequest.Schedule.Jobs[0].InternalData.ContractAddress.Hex()
+	}
+
+	return res
+}
+
+// FormatSendTxBatchRequest formats the transaction requests of a batch, returning the validation error of each invalid request at its index
+func FormatSendTxBatchRequest(batchRequest *types.SendTransactionBatchRequest) (items []*entities.TxBatchItem, errs []error) {
+	items = make([]*entities.TxBatchItem, len(batchRequest.Transactions))
+	errs = make([]error, len(batchRequest.Transactions))
+	for idx, item := range batchRequest.Transactions {
+		if item == nil {
+			errs[idx] = errors.InvalidParameterError("transaction request cannot be empty")
+			continue
+		}
+
+		if err := item.Validate(); err != nil {
+			errs[idx] = errors.InvalidParameterError(err.Error())
+			continue
+		}
+
+		switch {
+		case item.Send != nil:
+			items[idx] = &entities.TxBatchItem{Type: entities.TxBatchSend, TxRequest: FormatSendTxRequest(item.Send, item.IdempotencyKey)}
+		case item.Deploy != nil:
+			items[idx] = &entities.TxBatchItem{Type: entities.TxBatchDeploy, TxRequest: FormatDeployContractRequest(item.Deploy, item.IdempotencyKey)}
+		default:
+			items[idx] = &entities.TxBatchItem{Type: entities.TxBatchTransfer, TxRequest: FormatTransferRequest(item.Transfer, item.IdempotencyKey)}
+		}
+	}
+
+	return items, errs
+}
+
+func FormatTxBatchResponse(results []*entities.TxBatchResult) *types.TransactionBatchResponse {
+	res := &types.TransactionBatchResponse{Transactions: make([]*types.TransactionBatchItemResponse, len(results))}
+	for idx, result := range results {
+		itemRes := &types.TransactionBatchItemResponse{Status: string(result.Status)}
+		if result.TxRequest != nil {
+			itemRes.IdempotencyKey = result.TxRequest.IdempotencyKey
+			if result.Status != entities.TxBatchItemInvalid {
+				itemRes.Transaction = FormatTxResponse(result.TxRequest)
+			}
+		}
+
+		if result.Error != nil {
+			itemRes.Error = &infra.ErrorResponse{
+				Message: errors.FromError(result.Error).SetComponent("").Error(),
+				Code:    errors.FromError(result.Error).GetCode(),
+			}
+		}
+
+		res.Transactions[idx] = itemRes
+	}
+
+	return res
 }
 
 func FormatTransactionsFilterRequest(req *http.Request) (*entities.TransactionRequestFilters, error) {
@@ -156,6 +223,57 @@ func FormatTransactionsFilterRequest(req *http.Request) (*entities.TransactionRe
 		filters.IdempotencyKeys = strings.Split(qIdempotencyKeys, ",")
 	}
 
+	qScheduleUUID := req.URL.Query().Get("schedule_uuid")
+	if qScheduleUUID != "" {
+		filters.ScheduleUUID = qScheduleUUID
+	}
+
+	qStatuses := req.URL.Query().Get("statuses")
+	if qStatuses != "" {
+		for _, status := range strings.Split(qStatuses, ",") {
+			filters.Statuses = append(filters.Statuses, entities.JobStatus(status))
+		}
+	}
+
+	qTypes := req.URL.Query().Get("types")
+	if qTypes != "" {
+		for _, jobType := range strings.Split(qTypes, ",") {
+			filters.Types = append(filters.Types, entities.JobType(jobType))
+		}
+	}
+
+	var err error
+	filters.Labels, err = parseLabelsQueryParam(req.URL.Query())
+	if err != nil {
+		return nil, err
+	}
+
+	filters.From, err = parseAddressQueryParam(req.URL.Query(), "from")
+	if err != nil {
+		return nil, err
+	}
+
+	filters.To, err = parseAddressQueryParam(req.URL.Query(), "to")
+	if err != nil {
+		return nil, err
+	}
+
+	filters.CreatedAfter, err = parseTimeQueryParam(req.URL.Query(), "created_after")
+	if err != nil {
+		return nil, err
+	}
+
+	filters.CreatedBefore, err = parseTimeQueryParam(req.URL.Query(), "created_before")
+	if err != nil {
+		return nil, err
+	}
+
+	pagination, err := FormatPagination(req, entities.SortByCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	filters.Pagination = pagination
+
 	if err := infra.GetValidator().Struct(filters); err != nil {
 		return nil, err
 	}
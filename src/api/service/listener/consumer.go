@@ -2,7 +2,9 @@ package listener
 
 import (
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
-	messenger "github.com/consensys/orchestrate/src/infra/messenger/kafka"
+	"github.com/consensys/orchestrate/src/infra/messenger"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
+	messengerkafka "github.com/consensys/orchestrate/src/infra/messenger/kafka"
 )
 
 const (
@@ -15,15 +17,36 @@ func NewMessageConsumer(cfg *kafka.Config,
 	subscriptionHandler *SubscriptionHandler,
 	notificationHandler *NotificationHandler,
 	eventStreamHandler *EventStreamHandler,
-) (*messenger.Consumer, error) {
-	consumer, err := messenger.NewMessageConsumer(messageListenerComponent, cfg, topics)
+) (*messengerkafka.Consumer, error) {
+	consumer, err := messengerkafka.NewMessageConsumer(messageListenerComponent, cfg, topics)
 	if err != nil {
 		return nil, err
 	}
 
+	appendHandlers(consumer, jobHandler, subscriptionHandler, notificationHandler, eventStreamHandler)
+	return consumer, nil
+}
+
+func NewInMemoryMessageConsumer(broker *inmemory.Broker,
+	topics []string,
+	jobHandler *JobHandler,
+	subscriptionHandler *SubscriptionHandler,
+	notificationHandler *NotificationHandler,
+	eventStreamHandler *EventStreamHandler,
+) *inmemory.Consumer {
+	consumer := inmemory.NewMessageConsumer(messageListenerComponent, broker, topics)
+	appendHandlers(consumer, jobHandler, subscriptionHandler, notificationHandler, eventStreamHandler)
+	return consumer
+}
+
+func appendHandlers(consumer messenger.Consumer,
+	jobHandler *JobHandler,
+	subscriptionHandler *SubscriptionHandler,
+	notificationHandler *NotificationHandler,
+	eventStreamHandler *EventStreamHandler,
+) {
 	consumer.AppendHandler(UpdateJobMessageType, jobHandler.HandleJobUpdate)
 	consumer.AppendHandler(EventLogsMessageType, subscriptionHandler.HandleEventLogs)
 	consumer.AppendHandler(AckNotificationMessageType, notificationHandler.HandleNotificationAck)
 	consumer.AppendHandler(SuspendEventStreamMessageType, eventStreamHandler.HandleEventStreamSuspend)
-	return consumer, nil
 }
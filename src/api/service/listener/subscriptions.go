@@ -16,11 +16,14 @@ const EventLogsMessageType = "event-logs"
 
 type SubscriptionHandler struct {
 	notifySubscriptionUC usecases.NotifyContractEventsUseCase
+	indexEventLogsUC     usecases.IndexEventLogsUseCase
 }
 
-func NewSubscriptionRouter(notifySubscriptionUC usecases.NotifyContractEventsUseCase) *SubscriptionHandler {
+func NewSubscriptionRouter(notifySubscriptionUC usecases.NotifyContractEventsUseCase,
+	indexEventLogsUC usecases.IndexEventLogsUseCase) *SubscriptionHandler {
 	return &SubscriptionHandler{
 		notifySubscriptionUC: notifySubscriptionUC,
+		indexEventLogsUC:     indexEventLogsUC,
 	}
 }
 
@@ -31,6 +34,16 @@ func (r *SubscriptionHandler) HandleEventLogs(ctx context.Context, msg *entities
 		return errors.InvalidFormatError("invalid event logs request type")
 	}
 
+	err = r.indexEventLogsUC.Execute(ctx, req.ChainUUID, req.EventLogs)
+	if err != nil {
+		return err
+	}
+
+	// Backfilled event logs are only indexed, subscriptions being notified of new events only
+	if req.Backfill {
+		return nil
+	}
+
 	err = r.notifySubscriptionUC.Execute(ctx, req.ChainUUID, req.Address, req.EventLogs, multitenancy.UserInfoValue(ctx))
 	return err
 }
@@ -3,15 +3,18 @@ package types
 import (
 	"time"
 
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type CreateAccountRequest struct {
-	Alias      string            `json:"alias" validate:"omitempty" example:"personal-account" ` // Alias of the account.
-	Chain      string            `json:"chain" validate:"omitempty" example:"besu"`              // Name of the chain. This value should match the chain name defined in the chain creation.
-	StoreID    string            `json:"storeID" validate:"omitempty" example:"qkmStoreID"`      // ID of the Quorum Key Manager store containing the account.
-	Attributes map[string]string `json:"attributes,omitempty"`                                   // Additional information attached to the account.
+	Alias      string             `json:"alias" validate:"omitempty" example:"personal-account" `                                                           // Alias of the account.
+	Chain      string             `json:"chain" validate:"omitempty" example:"besu"`                                                                        // Name of the chain. This value should match the chain name defined in the chain creation.
+	StoreID    string             `json:"storeID" validate:"omitempty" example:"qkmStoreID"`                                                                // ID of the Quorum Key Manager store containing the account.
+	Address    *ethcommon.Address `json:"address,omitempty" validate:"omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"` // Address of an account held by a remote signer, required when the store is a remote signer.
+	Attributes map[string]string  `json:"attributes,omitempty"`                                                                                             // Additional information attached to the account.
 }
 
 type ImportAccountRequest struct {
@@ -23,9 +26,16 @@ type ImportAccountRequest struct {
 }
 
 type UpdateAccountRequest struct {
-	Alias      string            `json:"alias" validate:"omitempty"  example:"personal-account"` // Alias of the account.
-	StoreID    string            `json:"storeID" validate:"omitempty" example:"qkmStoreID"`      // ID of the Quorum Key Manager store containing the account.
-	Attributes map[string]string `json:"attributes,omitempty"`                                   // Additional information attached to the account.
+	Alias      string                 `json:"alias" validate:"omitempty"  example:"personal-account"`         // Alias of the account.
+	StoreID    string                 `json:"storeID" validate:"omitempty" example:"qkmStoreID"`              // ID of the Quorum Key Manager store containing the account.
+	Attributes map[string]string      `json:"attributes,omitempty"`                                           // Additional information attached to the account.
+	Status     entities.AccountStatus `json:"status,omitempty" validate:"isAccountStatus" example:"DISABLED"` // Status of the account. Only ACTIVE accounts can sign transactions.
+}
+
+type RotateAccountRequest struct {
+	Alias   string   `json:"alias" validate:"omitempty" example:"personal-account-v2"`                         // Alias of the new account.
+	StoreID string   `json:"storeID" validate:"omitempty" example:"qkmStoreID"`                                // ID of the Quorum Key Manager store containing the new account. Defaults to the store of the rotated account.
+	Chains  []string `json:"chains,omitempty" validate:"omitempty,unique,dive,required" example:"besu,quorum"` // Names of the chains on which the remaining balance is transferred to the new account.
 }
 
 type SignMessageRequest struct {
@@ -47,6 +57,9 @@ type AccountResponse struct {
 	OwnerID             string            `json:"ownerID,omitempty" example:"foo"`                                                                                                                                               // ID of the account owner.
 	StoreID             string            `json:"storeID,omitempty" example:"myQKMStoreID"`                                                                                                                                      // ID of the Quorum Key Manager store containing the account.
 	Attributes          map[string]string `json:"attributes,omitempty"`                                                                                                                                                          // Additional information attached to the account.
+	Status              string            `json:"status" example:"ACTIVE"`                                                                                                                                                       // Status of the account.
+	RotatedFrom         string            `json:"rotatedFrom,omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                                                                               // Address of the account this account replaces.
+	RotatedTo           string            `json:"rotatedTo,omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"`                                                                                 // Address of the account replacing this account.
 	CreatedAt           time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                                                                                                               // Date and time at which the account was created.
 	UpdatedAt           time.Time         `json:"updatedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`                                                                                                                     // Date and time at which the account details were updated.
 }
@@ -2,6 +2,9 @@ package types
 
 import (
 	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 type RegisterChainRequest struct {
@@ -10,6 +13,7 @@ type RegisterChainRequest struct {
 	PrivateTxManagerURL string                  `json:"privateTxManagerURL,omitempty" validate:"omitempty,url"  example:"http://go-quorum/tessera:9000"`                                        // Private tx manager required by go-quorum for sending private txs
 	Listener            RegisterListenerRequest `json:"listener,omitempty"`
 	Labels              map[string]string       `json:"labels,omitempty"` // List of custom labels. Useful for adding custom information to the chain.
+	FeePolicy           *FeePolicyRequest       `json:"feePolicy,omitempty"`
 }
 
 type RegisterListenerRequest struct {
@@ -18,9 +22,10 @@ type RegisterListenerRequest struct {
 }
 
 type UpdateChainRequest struct {
-	Name     string                 `json:"name,omitempty" example:"mainnet"` // Name of the chain. Must be unique.
-	Listener *UpdateListenerRequest `json:"listener,omitempty"`
-	Labels   map[string]string      `json:"labels,omitempty"` // List of custom labels. Useful for adding custom information to the chain.
+	Name      string                 `json:"name,omitempty" example:"mainnet"` // Name of the chain. Must be unique.
+	Listener  *UpdateListenerRequest `json:"listener,omitempty"`
+	Labels    map[string]string      `json:"labels,omitempty"` // List of custom labels. Useful for adding custom information to the chain.
+	FeePolicy *FeePolicyRequest      `json:"feePolicy,omitempty"`
 }
 
 type UpdateListenerRequest struct {
@@ -31,16 +36,50 @@ type UpdateListenerRequest struct {
 }
 
 type ChainResponse struct {
-	UUID                      string            `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                   // UUID of the registered chain.
-	Name                      string            `json:"name" example:"mainnet"`                                                                // Name of the chain.
-	TenantID                  string            `json:"tenantID" example:"tenant"`                                                             // ID of the tenant executing the API.
-	OwnerID                   string            `json:"ownerID,omitempty" example:"foo"`                                                       // ID of the chain owner.
-	URLs                      []string          `json:"urls" example:"https://mainnet.infura.io/v3/a73136601e6f4924a0baa4ed880b535e"`          // URLs of Ethereum nodes connected to.
-	PrivateTxManagerURL       string            `json:"privateTxManagerURL,omitempty" validate:"url"  example:"http://go-quorum/tessera:9000"` // Private tx manager required by go-quorum for sending private txs
-	ChainID                   uint64            `json:"chainID" example:"2445"`                                                                // [Ethereum chain ID](https://besu.hyperledger.org/en/latest/Concepts/NetworkID-And-ChainID/).
-	ListenerDepth             uint64            `json:"listenerDepth" example:"0"`                                                             // Block depth after which the Transaction Listener considers a block final and processes it.
-	ListenerBlockTimeDuration string            `json:"listenerBlockTimeDuration" example:"5s"`                                                // Time to wait before trying to fetch a new mined block.
-	Labels                    map[string]string `json:"labels,omitempty"`                                                                      // List of custom labels.
-	CreatedAt                 time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                       // Date and time at which the chain was registered.
-	UpdatedAt                 time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`                                       // Date and time at which the chain details were updated.
+	UUID                      string             `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                                   // UUID of the registered chain.
+	Name                      string             `json:"name" example:"mainnet"`                                                                // Name of the chain.
+	TenantID                  string             `json:"tenantID" example:"tenant"`                                                             // ID of the tenant executing the API.
+	OwnerID                   string             `json:"ownerID,omitempty" example:"foo"`                                                       // ID of the chain owner.
+	URLs                      []string           `json:"urls" example:"https://mainnet.infura.io/v3/a73136601e6f4924a0baa4ed880b535e"`          // URLs of Ethereum nodes connected to.
+	PrivateTxManagerURL       string             `json:"privateTxManagerURL,omitempty" validate:"url"  example:"http://go-quorum/tessera:9000"` // Private tx manager required by go-quorum for sending private txs
+	ChainID                   uint64             `json:"chainID" example:"2445"`                                                                // [Ethereum chain ID](https://besu.hyperledger.org/en/latest/Concepts/NetworkID-And-ChainID/).
+	ListenerDepth             uint64             `json:"listenerDepth" example:"0"`                                                             // Block depth after which the Transaction Listener considers a block final and processes it.
+	ListenerBlockTimeDuration string             `json:"listenerBlockTimeDuration" example:"5s"`                                                // Time to wait before trying to fetch a new mined block.
+	Labels                    map[string]string  `json:"labels,omitempty"`                                                                      // List of custom labels.
+	FeePolicy                 *FeePolicyResponse `json:"feePolicy,omitempty"`                                                                   // Fee policy applied to the transactions of the chain.
+	CreatedAt                 time.Time          `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                       // Date and time at which the chain was registered.
+	UpdatedAt                 time.Time          `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`                                       // Date and time at which the chain details were updated.
+}
+
+// FeePolicyRequest is applied by default to the transactions of the chain which do not define their own gas price retry policy
+type FeePolicyRequest struct {
+	MaxFee               *hexutil.Big `json:"maxFee,omitempty" validate:"omitempty" example:"0x174876e800" swaggertype:"string"` // Maximum gas price, or maximum fee per gas of dynamic fee transactions, in wei.
+	BumpPercentage       float64      `json:"bumpPercentage,omitempty" validate:"omitempty,gt=0" example:"0.1"`                  // Gas price increment on every bump of a pending transaction. For example, set `0.1` for a 10% increment.
+	BumpInterval         string       `json:"bumpInterval,omitempty" validate:"omitempty,minDuration=1s" example:"2m"`           // Duration after which a pending transaction is bumped.
+	MaxBumps             int          `json:"maxBumps,omitempty" validate:"omitempty,min=1" example:"5"`                         // Maximum number of bumps of a transaction.
+	ReplaceWithSameNonce bool         `json:"replaceWithSameNonce,omitempty" example:"true"`                                     // Whether pending transactions are replaced by transactions with the same nonce and a bumped gas price, otherwise they are sent again unchanged.
+}
+
+type FeePolicyResponse struct {
+	MaxFee               string  `json:"maxFee,omitempty" example:"0x174876e800"`       // Maximum gas price, or maximum fee per gas of dynamic fee transactions, in wei.
+	BumpPercentage       float64 `json:"bumpPercentage,omitempty" example:"0.1"`        // Gas price increment on every bump of a pending transaction.
+	BumpInterval         string  `json:"bumpInterval,omitempty" example:"2m"`           // Duration after which a pending transaction is bumped.
+	MaxBumps             int     `json:"maxBumps,omitempty" example:"5"`                // Maximum number of bumps of a transaction.
+	ReplaceWithSameNonce bool    `json:"replaceWithSameNonce,omitempty" example:"true"` // Whether pending transactions are replaced by transactions with the same nonce and a bumped gas price.
+}
+
+func (p *FeePolicyRequest) Validate() error {
+	if p.MaxBumps > SentryMaxRetries {
+		return errors.InvalidParameterError("field 'maxBumps' cannot exceed the maximum amount of retries %d", SentryMaxRetries)
+	}
+
+	if p.BumpInterval == "" && (p.BumpPercentage > 0 || p.MaxBumps > 0 || p.ReplaceWithSameNonce) {
+		return errors.InvalidParameterError("field 'bumpInterval' must be specified to bump transactions")
+	}
+
+	if p.ReplaceWithSameNonce && (p.BumpPercentage == 0 || p.MaxBumps == 0) {
+		return errors.InvalidParameterError("fields 'bumpPercentage' and 'maxBumps' must be specified to replace transactions")
+	}
+
+	return nil
 }
@@ -1,8 +1,12 @@
 package types
 
 import (
+	"encoding/json"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -25,6 +29,8 @@ type ContractResponse struct {
 	Constructor      ABIComponentResponse   `json:"constructor"`                                                                                                                                        // Contract constructor.
 	Methods          []ABIComponentResponse `json:"methods"`                                                                                                                                            // List of contract methods.
 	Events           []ABIComponentResponse `json:"events"`                                                                                                                                             // List of contract events.
+	TenantID         string                 `json:"tenantID" example:"tenantFoo"`                                                                                                                       // Tenant owning the contract, `_` for the catalog shared with all tenants.
+	OwnerID          string                 `json:"ownerID,omitempty" example:"foo"`                                                                                                                    // ID of the contract owner.
 }
 
 type ABIComponentResponse struct {
@@ -55,5 +61,77 @@ type EventLogsMessageRequest struct {
 	ChainUUID string            `json:"chain_uuid" validate:"required"`
 	Address   ethcommon.Address `json:"address" validate:"required"`
 	EventLogs []ethtypes.Log    `json:"event_logs" validate:"omitempty"`
+	Backfill  bool              `json:"backfill,omitempty"` // Event logs of past blocks, indexed without notifying subscriptions
 	CreatedAt time.Time         `json:"created_at" validate:"omitempty"`
 }
+
+type CallContractRequest struct {
+	ChainName        string                        `json:"chain" validate:"required" example:"mainnet"`                                                                 // Name of the chain on which to call the contracts.
+	Block            string                        `json:"block,omitempty" validate:"omitempty,isBlockTag" example:"latest"`                                            // Block at which the calls are executed, `latest` (default), `pending`, `earliest` or a block number.
+	Multicall        bool                          `json:"multicall,omitempty" example:"true"`                                                                          // Batches the calls in a single request through Multicall3 if deployed on the chain.
+	Calls            []*ContractCallParams         `json:"calls" validate:"required,min=1,dive,required"`                                                               // List of calls executed on the same block.
+	Protocol         entities.PrivateTxManagerType `json:"protocol,omitempty" validate:"omitempty,isPrivateTxManagerType" example:"EEA"`                                // Executes the calls on the private state, `EEA` or `GoQuorum`.
+	PrivateFrom      string                        `json:"privateFrom,omitempty" validate:"omitempty,base64" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="`    // For GoQuorum, public key of the Tessera enclave selecting the private state of multi-tenant nodes.
+	PrivacyGroupID   string                        `json:"privacyGroupId,omitempty" validate:"omitempty,base64" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="` // For EEA, ID of the privacy group on which to call the contracts. Not used with `privacyGroupName`.
+	PrivacyGroupName string                        `json:"privacyGroupName,omitempty" example:"my-privacy-group"`                                                       // For EEA, name of a privacy group created through Orchestrate on the chain. Not used with `privacyGroupId`.
+}
+
+func (req *CallContractRequest) Validate() error {
+	switch req.Protocol {
+	case "":
+		if req.PrivateFrom != "" || req.PrivacyGroupID != "" || req.PrivacyGroupName != "" {
+			return errors.InvalidParameterError("field 'protocol' cannot be empty when calling private contracts")
+		}
+
+		return nil
+	case entities.EEAChainType:
+		if req.PrivacyGroupID == "" && req.PrivacyGroupName == "" {
+			return errors.InvalidParameterError("fields 'privacyGroupId' and 'privacyGroupName' cannot both be empty")
+		}
+
+		if req.PrivacyGroupID != "" && req.PrivacyGroupName != "" {
+			return errors.InvalidParameterError("fields 'privacyGroupId' and 'privacyGroupName' are mutually exclusive")
+		}
+	case entities.GoQuorumChainType:
+		if req.PrivacyGroupID != "" || req.PrivacyGroupName != "" {
+			return errors.InvalidParameterError("fields 'privacyGroupId' and 'privacyGroupName' are only supported by protocol %s", entities.EEAChainType)
+		}
+	}
+
+	if req.Block == entities.BlockTagPending {
+		return errors.InvalidParameterError("private contracts cannot be called on the pending block")
+	}
+
+	if req.Multicall {
+		return errors.InvalidParameterError("field 'multicall' is not supported when calling private contracts")
+	}
+
+	return nil
+}
+
+type ContractCallParams struct {
+	To              ethcommon.Address `json:"to" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"` // Address of the contract.
+	ContractName    string            `json:"contractName,omitempty" example:"ERC20"`                                                           // Name of the contract, the registered contract deployed at `to` is used if not provided.
+	ContractTag     string            `json:"contractTag,omitempty" example:"v1.0.0"`                                                           // Optional tag attached to the contract.
+	MethodSignature string            `json:"methodSignature" validate:"required" example:"balanceOf(address)"`
+	Args            []interface{}     `json:"args,omitempty"` // Method arguments.
+}
+
+type ContractCallResponse struct {
+	Success bool              `json:"success" example:"true"`                                                                      // Whether the call succeeded.
+	Outputs map[string]string `json:"outputs,omitempty" example:"balance:1000"`                                                    // Decoded outputs, unnamed outputs being indexed by position.
+	Data    string            `json:"data,omitempty" example:"0x00000000000000000000000000000000000000000000000000000000000003e8"` // Raw return data.
+	Error   string            `json:"error,omitempty" example:"execution reverted"`                                                // Error message if the call failed.
+}
+
+type ImportContractsRequest struct {
+	Tag       string                       `json:"tag,omitempty" example:"v1.0.0"`                                                                                // Tag attached to all the imported contracts, `latest` if not provided.
+	ChainName string                       `json:"chain,omitempty" example:"mainnet"`                                                                             // Name of the chain on which the registered deployments of the libraries to link are searched.
+	Libraries map[string]ethcommon.Address `json:"libraries,omitempty" example:"SafeMath:0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"object,string"` // Addresses of the libraries to link, by library name.
+	Artifacts []*ContractArtifactParams    `json:"artifacts" validate:"required,min=1,dive,required"`                                                             // Hardhat, Foundry or Truffle build artifacts.
+}
+
+type ContractArtifactParams struct {
+	Name     string          `json:"name,omitempty" example:"ERC20"`                    // Name of the contract, read from the artifact if not provided.
+	Artifact json.RawMessage `json:"artifact" validate:"required" swaggertype:"object"` // JSON build artifact of the contract.
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type BackfillEventLogsRequest struct {
+	ChainName string              `json:"chain" validate:"required" example:"mainnet"`                                                                                // Name of the chain to scan.
+	Addresses []ethcommon.Address `json:"addresses" validate:"required,min=1,unique" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"array,string"` // Addresses of the contracts emitting the events.
+	FromBlock uint64              `json:"fromBlock" example:"12000000"`                                                                                               // First block of the range to scan.
+	ToBlock   uint64              `json:"toBlock" validate:"required,gtefield=FromBlock" example:"12100000"`                                                          // Last block of the range to scan.
+}
+
+func (req *BackfillEventLogsRequest) Validate() error {
+	if req.ToBlock-req.FromBlock >= entities.MaxEventLogsBackfillRange {
+		return errors.InvalidParameterError("block range cannot exceed %d blocks", entities.MaxEventLogsBackfillRange)
+	}
+
+	return nil
+}
+
+type EventLogResponse struct {
+	ChainUUID   string            `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Address     string            `json:"address" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534"`                        // Address of the contract having emitted the event.
+	Topics      []string          `json:"topics" example:"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"` // Signature hash of the event followed by its indexed arguments.
+	Data        string            `json:"data" example:"0x0000000000000000000000000000000000000000000000000000000000000001"`   // Non-indexed arguments of the event.
+	BlockNumber uint64            `json:"blockNumber" example:"12000000"`
+	BlockHash   string            `json:"blockHash" example:"0x656c34545f90a730a19008c0e7a7cd4fb3895064b48d6d69761bd5abad681056"`
+	TxHash      string            `json:"txHash" example:"0x3b198bfd5d2907285af009e9ae84a0ecd63677110d89d7e030251acb87f6487e"`
+	LogIndex    uint              `json:"logIndex" example:"0"`
+	Event       string            `json:"event,omitempty" example:"Transfer(address,address,uint256)"`                                                                           // Signature of the event, if its ABI is registered.
+	DecodedData map[string]string `json:"decodedData,omitempty" example:"from:0x1abae27a0cbfb02945720425d3b80c7e09728534,to:0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18,value:1"` // Decoded arguments of the event, if its ABI is registered.
+	CreatedAt   time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                                                                                       // Date at which the event was indexed.
+}
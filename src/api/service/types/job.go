@@ -31,40 +31,51 @@ type UpdateJobRequest struct {
 
 // @TODO Support job update message consumer
 type JobUpdateMessageRequest struct {
-	JobUUID      string                   `json:"jobUUID,omitempty"`
-	InternalData *entities.InternalData   `json:"internal_data,omitempty"`
-	Transaction  *entities.ETHTransaction `json:"transaction,omitempty"`
-	Receipt      *ethereum.Receipt        `json:"receipt,omitempty"`
-	Status       entities.JobStatus       `json:"status,omitempty" validate:"isJobStatus" example:"MINED"` // Status of the job.
-	Message      string                   `json:"message,omitempty" example:"Update message"`              // Update message.
+	JobUUID        string                   `json:"jobUUID,omitempty"`
+	InternalData   *entities.InternalData   `json:"internal_data,omitempty"`
+	Transaction    *entities.ETHTransaction `json:"transaction,omitempty"`
+	Receipt        *ethereum.Receipt        `json:"receipt,omitempty"`
+	PrivateReceipt *ethereum.Receipt        `json:"privateReceipt,omitempty"`
+	Status         entities.JobStatus       `json:"status,omitempty" validate:"isJobStatus" example:"MINED"` // Status of the job.
+	Message        string                   `json:"message,omitempty" example:"Update message"`              // Update message.
 }
 
 func (req *JobUpdateMessageRequest) ToJobEntity() *entities.Job {
 	return &entities.Job{
-		UUID:         req.JobUUID,
-		Transaction:  req.Transaction,
-		InternalData: req.InternalData,
-		Receipt:      req.Receipt,
-		Status:       req.Status,
+		UUID:           req.JobUUID,
+		Transaction:    req.Transaction,
+		InternalData:   req.InternalData,
+		Receipt:        req.Receipt,
+		PrivateReceipt: req.PrivateReceipt,
+		Status:         req.Status,
 	}
 }
 
 type JobResponse struct {
-	UUID          string                 `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                    // UUID of the job.
-	ChainUUID     string                 `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`               // UUID of the chain on which the job was created.
-	ScheduleUUID  string                 `json:"scheduleUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`            // UUID of the schedule on which the job was created.
-	NextJobUUID   string                 `json:"nextJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`   // UUID of the next job.
-	ParentJobUUID string                 `json:"parentJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the parent job.
-	TenantID      string                 `json:"tenantID" example:"foo"`                                                 // ID of the tenant executing the API.
-	OwnerID       string                 `json:"ownerID,omitempty" example:"foo"`                                        // ID of the job owner.
-	Transaction   ETHTransactionResponse `json:"transaction"`
-	Logs          []*entities.Log        `json:"logs,omitempty"`   // List of logs.
-	Labels        map[string]string      `json:"labels,omitempty"` // List of custom labels.
-	Annotations   Annotations            `json:"annotations,omitempty"`
-	Status        entities.JobStatus     `json:"status" example:"MINED"`                          // Status of the job.
-	Type          entities.JobType       `json:"type" example:"eth://ethereum/transaction"`       // Type of job.
-	CreatedAt     time.Time              `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the job was created.
-	UpdatedAt     time.Time              `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the job details were updated.
+	UUID           string                 `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                    // UUID of the job.
+	ChainUUID      string                 `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`               // UUID of the chain on which the job was created.
+	ScheduleUUID   string                 `json:"scheduleUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`            // UUID of the schedule on which the job was created.
+	NextJobUUID    string                 `json:"nextJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`   // UUID of the next job.
+	ParentJobUUID  string                 `json:"parentJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the parent job.
+	TenantID       string                 `json:"tenantID" example:"foo"`                                                 // ID of the tenant executing the API.
+	OwnerID        string                 `json:"ownerID,omitempty" example:"foo"`                                        // ID of the job owner.
+	Transaction    ETHTransactionResponse `json:"transaction"`
+	Logs           []*entities.Log        `json:"logs,omitempty"` // List of logs.
+	DecodedCall    *DecodedCallResponse   `json:"decodedCall,omitempty"`
+	PrivateReceipt *ethereum.Receipt      `json:"privateReceipt,omitempty"` // Receipt of the private transaction, for private and marking transaction jobs.
+	Labels         map[string]string      `json:"labels,omitempty"`         // List of custom labels.
+	Annotations    Annotations            `json:"annotations,omitempty"`
+	Status         entities.JobStatus     `json:"status" example:"MINED"`                          // Status of the job.
+	Type           entities.JobType       `json:"type" example:"eth://ethereum/transaction"`       // Type of job.
+	CreatedAt      time.Time              `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the job was created.
+	UpdatedAt      time.Time              `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the job details were updated.
+}
+
+type DecodedCallResponse struct {
+	ContractName    string            `json:"contractName" example:"MyContract"`                   // Name of the contract whose ABI decoded the call.
+	ContractTag     string            `json:"contractTag" example:"v1.1.0"`                        // Tag of the contract whose ABI decoded the call.
+	MethodSignature string            `json:"methodSignature" example:"transfer(address,uint256)"` // Signature of the called method.
+	Inputs          map[string]string `json:"inputs"`                                              // Decoded arguments of the call, by name or by position if unnamed.
 }
 
 type Annotations struct {
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+)
+
+type CreatePrivacyGroupRequest struct {
+	Name        string            `json:"name" validate:"required" example:"my-privacy-group"`                                                                                                      // Name of the privacy group, to be referenced as `privacyGroupName` in private transactions.
+	ChainName   string            `json:"chain" validate:"required" example:"besu"`                                                                                                                 // Name of the chain on which to create the privacy group.
+	Members     []string          `json:"members" validate:"required,min=1,unique,dive,base64" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=,B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="` // Public keys of the private transaction managers (Tessera or Orion) of the members, including the one of the node creating the group.
+	Description string            `json:"description,omitempty" example:"Privacy group of Org1 and Org2"`                                                                                           // Description of the privacy group.
+	Labels      map[string]string `json:"labels,omitempty"`                                                                                                                                         // List of custom labels.
+}
+
+type FindPrivacyGroupsRequest struct {
+	ChainName string   `json:"chain" validate:"required" example:"besu"`                                                                                                                 // Name of the chain on which to find the privacy groups.
+	Members   []string `json:"members" validate:"required,min=1,unique,dive,base64" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=,B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="` // Public keys of the members, only the privacy groups containing exactly these members being returned.
+}
+
+type PrivacyGroupResponse struct {
+	UUID           string            `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Name           string            `json:"name" example:"my-privacy-group"`
+	ChainUUID      string            `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	PrivacyGroupID string            `json:"privacyGroupId" example:"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bf="`
+	Description    string            `json:"description,omitempty" example:"Privacy group of Org1 and Org2"`
+	Members        []string          `json:"members" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=,B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="`
+	Labels         map[string]string `json:"labels,omitempty"`
+	TenantID       string            `json:"tenantID" example:"tenantFoo"`
+	OwnerID        string            `json:"ownerID,omitempty" example:"foo"`
+	CreatedAt      time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt      time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type OnChainPrivacyGroupResponse struct {
+	PrivacyGroupID string   `json:"privacyGroupId" example:"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bf="`
+	Name           string   `json:"name,omitempty" example:"my-privacy-group"`
+	Description    string   `json:"description,omitempty" example:"Privacy group of Org1 and Org2"`
+	Type           string   `json:"type,omitempty" example:"PANTHEON"` // `PANTHEON` for privacy groups created with `priv_createPrivacyGroup`, `ONCHAIN` for flexible privacy groups, `LEGACY` for groups implicitly created by `privateFor` transactions.
+	Members        []string `json:"members" example:"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=,B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="`
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type RegisterRelayerRequest struct {
+	Name            string            `json:"name" validate:"required" example:"relayer-mainnet"`                                                            // Name of the relayer.
+	ChainName       string            `json:"chain" validate:"required" example:"mainnet"`                                                                   // Name of the chain on which the relayer submits meta transactions.
+	Type            string            `json:"type" validate:"required,isRelayerType" example:"ERC2771" enums:"ERC2771,ERC4337"`                              // `ERC2771` to relay forward requests through a forwarder, `ERC4337` to relay user operations through an entry point.
+	Account         ethcommon.Address `json:"account" validate:"required" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`         // Address of the account sending and paying for the meta transactions.
+	ContractAddress ethcommon.Address `json:"contractAddress" validate:"required" example:"0x5Cc634233E4a454d47aACd9fC68801482Fb02610" swaggertype:"string"` // Address of the forwarder or entry point contract.
+	ContractName    string            `json:"contractName" validate:"required" example:"MinimalForwarder"`                                                   // Name of the forwarder or entry point contract in the contract registry.
+	ContractTag     string            `json:"contractTag,omitempty" validate:"omitempty" example:"v1.0.0"`                                                   // Tag of the contract in the contract registry, defaults to `latest`.
+	DomainName      string            `json:"domainName,omitempty" validate:"required_if=Type ERC2771" example:"MinimalForwarder"`                           // Name of the EIP-712 domain of the forwarder, required for `ERC2771`.
+	DomainVersion   string            `json:"domainVersion,omitempty" validate:"required_if=Type ERC2771" example:"0.0.1"`                                   // Version of the EIP-712 domain of the forwarder, required for `ERC2771`.
+}
+
+type RelayerResponse struct {
+	UUID            string    `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Name            string    `json:"name" example:"relayer-mainnet"`
+	ChainUUID       string    `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Type            string    `json:"type" example:"ERC2771"`
+	Account         string    `json:"account" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"`
+	ContractAddress string    `json:"contractAddress" example:"0x5Cc634233E4a454d47aACd9fC68801482Fb02610"`
+	ContractName    string    `json:"contractName" example:"MinimalForwarder"`
+	ContractTag     string    `json:"contractTag" example:"latest"`
+	DomainName      string    `json:"domainName,omitempty" example:"MinimalForwarder"`
+	DomainVersion   string    `json:"domainVersion,omitempty" example:"0.0.1"`
+	TenantID        string    `json:"tenantID" example:"tenantFoo"`
+	OwnerID         string    `json:"ownerID,omitempty" example:"foo"`
+	CreatedAt       time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt       time.Time `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type RelaySpendingResponse struct {
+	RelayerUUID string    `json:"relayerUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the relayer.
+	TenantID    string    `json:"tenantID" example:"tenantFoo"`                               // Tenant on behalf of which the transactions were relayed.
+	TxCount     uint64    `json:"txCount" example:"10"`                                       // Number of relayed transactions mined.
+	GasUsed     uint64    `json:"gasUsed" example:"210000"`                                   // Total gas used by the relayed transactions.
+	Fee         string    `json:"fee" example:"0x2386f26fc10000"`                             // Total fee, in Wei, paid by the relayer.
+	UpdatedAt   time.Time `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type RelayTransactionRequest struct {
+	ChainName string            `json:"chain" validate:"required" example:"myChain"` // Name of the chain on which to relay the transaction.
+	Labels    map[string]string `json:"labels,omitempty"`                            // List of custom labels.
+	Params    RelayParams       `json:"params" validate:"required"`
+}
+
+type RelayParams struct {
+	ForwardRequest  *ForwardRequestParams `json:"forwardRequest,omitempty" validate:"omitempty"`                                                                     // ERC-2771 forward request signed by the user, mutually exclusive with `userOperation`.
+	Signature       hexutil.Bytes         `json:"signature,omitempty" validate:"required_with=ForwardRequest" swaggertype:"string"`                                  // EIP-712 signature of the forward request.
+	UserOperation   *UserOperationParams  `json:"userOperation,omitempty" validate:"omitempty"`                                                                      // ERC-4337 user operation, including its signature, mutually exclusive with `forwardRequest`.
+	Gas             *uint64               `json:"gas,omitempty" example:"300000"`                                                                                    // Gas provided by the relayer.
+	GasPrice        *hexutil.Big          `json:"gasPrice,omitempty" example:"0x5208" swaggertype:"string"`                                                          // If sending a legacy transaction, the gas price, in Wei, paid by the relayer.
+	GasFeeCap       *hexutil.Big          `json:"maxFeePerGas,omitempty" example:"0x4c4b40" swaggertype:"string"`                                                    // If sending an EIP1559 transaction, the maximum total fee, in Wei, the relayer is willing to pay per gas.
+	GasTipCap       *hexutil.Big          `json:"maxPriorityFeePerGas,omitempty" example:"0x59682f00" swaggertype:"string"`                                          // If sending an EIP1559 transaction, the maximum fee, in Wei, the relayer is willing to pay per gas above the base fee.
+	TransactionType string                `json:"transactionType,omitempty" validate:"omitempty,isTransactionType" example:"dynamic_fee" enums:"legacy,dynamic_fee"` // `dynamic_fee` for a post-London fork transaction, `legacy` for a pre-London fork transaction.
+	GasPricePolicy  GasPriceParams        `json:"gasPricePolicy,omitempty"`
+}
+
+type ForwardRequestParams struct {
+	From  ethcommon.Address `json:"from" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"` // Address of the user having signed the request.
+	To    ethcommon.Address `json:"to" validate:"required" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`   // Address of the recipient contract, trusting the forwarder.
+	Value *hexutil.Big      `json:"value,omitempty" example:"0x0" swaggertype:"string"`                                                 // Value transferred, in Wei.
+	Gas   uint64            `json:"gas" validate:"required" example:"100000"`                                                           // Gas forwarded to the recipient call.
+	Nonce uint64            `json:"nonce" example:"0"`                                                                                  // Nonce of the user in the forwarder.
+	Data  hexutil.Bytes     `json:"data,omitempty" example:"0xa9059cbb" swaggertype:"string"`                                           // Data of the recipient call.
+}
+
+type UserOperationParams struct {
+	Sender               ethcommon.Address `json:"sender" validate:"required" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534" swaggertype:"string"` // Address of the smart account.
+	Nonce                *hexutil.Big      `json:"nonce" validate:"required" example:"0x0" swaggertype:"string"`
+	InitCode             hexutil.Bytes     `json:"initCode,omitempty" swaggertype:"string"`
+	CallData             hexutil.Bytes     `json:"callData" validate:"required" swaggertype:"string"`
+	CallGasLimit         *hexutil.Big      `json:"callGasLimit" validate:"required" example:"0x186a0" swaggertype:"string"`
+	VerificationGasLimit *hexutil.Big      `json:"verificationGasLimit" validate:"required" example:"0x186a0" swaggertype:"string"`
+	PreVerificationGas   *hexutil.Big      `json:"preVerificationGas" validate:"required" example:"0xc350" swaggertype:"string"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas" validate:"required" example:"0x4c4b40" swaggertype:"string"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas" validate:"required" example:"0x59682f00" swaggertype:"string"`
+	PaymasterAndData     hexutil.Bytes     `json:"paymasterAndData,omitempty" swaggertype:"string"`
+	Signature            hexutil.Bytes     `json:"signature" validate:"required" swaggertype:"string"`
+}
+
+func (params *RelayParams) Validate() error {
+	if err := infra.GetValidator().Struct(params); err != nil {
+		return err
+	}
+
+	if (params.ForwardRequest == nil) == (params.UserOperation == nil) {
+		return errors.InvalidParameterError("fields 'forwardRequest' and 'userOperation' are mutually exclusive and one is required")
+	}
+
+	return params.GasPricePolicy.RetryPolicy.Validate()
+}
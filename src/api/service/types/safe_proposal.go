@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type CreateSafeProposalRequest struct {
+	ChainName      string             `json:"chain" validate:"required" example:"mainnet"`                                                                       // Name of the chain on which the Safe is deployed.
+	Safe           ethcommon.Address  `json:"safe" validate:"required" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`                // Address of the Safe contract.
+	Sender         ethcommon.Address  `json:"sender" validate:"required" example:"0x7E654d251Da770A068413677967F6d3Ea2FeA9E4" swaggertype:"string"`              // Account sending the execTransaction once the threshold is reached.
+	To             ethcommon.Address  `json:"to" validate:"required" example:"0x5Cc634233E4a454d47aACd9fC68801482Fb02610" swaggertype:"string"`                  // Destination of the Safe transaction.
+	Value          *hexutil.Big       `json:"value,omitempty" validate:"omitempty" example:"0x59682f07" swaggertype:"string"`                                    // Value transferred by the Safe, in Wei.
+	Data           hexutil.Bytes      `json:"data,omitempty" validate:"omitempty" example:"0xa9059cbb" swaggertype:"string"`                                     // Data of the Safe transaction.
+	Operation      uint8              `json:"operation,omitempty" validate:"omitempty,oneof=0 1" example:"0"`                                                    // Operation of the Safe transaction, 0 for a call and 1 for a delegate call.
+	SafeTxGas      uint64             `json:"safeTxGas,omitempty" validate:"omitempty" example:"0"`                                                              // Gas used by the Safe transaction.
+	BaseGas        uint64             `json:"baseGas,omitempty" validate:"omitempty" example:"0"`                                                                // Gas costs independent of the Safe transaction execution.
+	GasPrice       *hexutil.Big       `json:"gasPrice,omitempty" validate:"omitempty" example:"0x0" swaggertype:"string"`                                        // Gas price used for the refund.
+	GasToken       *ethcommon.Address `json:"gasToken,omitempty" validate:"omitempty" example:"0x0000000000000000000000000000000000000000" swaggertype:"string"` // Token used for the refund, zero address for ETH.
+	RefundReceiver *ethcommon.Address `json:"refundReceiver,omitempty" validate:"omitempty" swaggertype:"string"`                                                // Address receiving the refund.
+	Nonce          uint64             `json:"nonce,omitempty" validate:"omitempty" example:"1"`                                                                  // Safe nonce, defaults to the current nonce of the Safe.
+	Labels         map[string]string  `json:"labels,omitempty" validate:"omitempty"`                                                                             // List of custom labels.
+}
+
+type SignSafeProposalRequest struct {
+	Signer    ethcommon.Address `json:"signer" validate:"required" example:"0x7E654d251Da770A068413677967F6d3Ea2FeA9E4" swaggertype:"string"` // Owner of the Safe signing the proposal.
+	Signature hexutil.Bytes     `json:"signature,omitempty" validate:"omitempty" swaggertype:"string"`                                        // Signature of the Safe transaction hash. If empty, the signer must be an Orchestrate account which signs the proposal.
+}
+
+type SafeSignatureResponse struct {
+	Signer    string    `json:"signer" example:"0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"`
+	Signature string    `json:"signature"`
+	CreatedAt time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+type SafeProposalResponse struct {
+	UUID         string                    `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	ChainUUID    string                    `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`
+	Safe         string                    `json:"safe" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"`
+	Sender       string                    `json:"sender" example:"0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"`
+	Transaction  *entities.SafeTransaction `json:"transaction"`
+	SafeTxHash   string                    `json:"safeTxHash"`
+	Owners       []string                  `json:"owners"`
+	Threshold    uint64                    `json:"threshold" example:"2"`
+	Signatures   []*SafeSignatureResponse  `json:"signatures"`
+	Status       string                    `json:"status" example:"PENDING"`
+	ScheduleUUID string                    `json:"scheduleUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // Schedule of the execTransaction once the threshold is reached.
+	TxHash       string                    `json:"txHash,omitempty"`
+	Labels       map[string]string         `json:"labels,omitempty"`
+	TenantID     string                    `json:"tenantID" example:"tenantFoo"`
+	OwnerID      string                    `json:"ownerID,omitempty" example:"foo"`
+	CreatedAt    time.Time                 `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt    time.Time                 `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
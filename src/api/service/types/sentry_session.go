@@ -0,0 +1,33 @@
+package types
+
+import (
+	"time"
+)
+
+type CreateSentrySessionRequest struct {
+	JobUUID          string    `json:"jobUUID" validate:"required,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                     // UUID of the pending job retried by the session.
+	Retries          int       `json:"retries" validate:"min=0" example:"0"`                                                                // Number of retries of the job so far.
+	NChildren        int       `json:"nChildren" validate:"min=0" example:"0"`                                                              // Number of child jobs created with a bumped gas price.
+	LastChildJobUUID string    `json:"lastChildJobUUID,omitempty" validate:"omitempty,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the last job sent, the job itself if no child job was created.
+	NextAttemptAt    time.Time `json:"nextAttemptAt" validate:"required" example:"2020-07-09T12:35:42.115395Z"`                             // Date and time of the next retry of the job.
+}
+
+type UpdateSentrySessionRequest struct {
+	Retries          int       `json:"retries" validate:"min=0" example:"1"`                                                                // Number of retries of the job so far.
+	NChildren        int       `json:"nChildren" validate:"min=0" example:"1"`                                                              // Number of child jobs created with a bumped gas price.
+	LastChildJobUUID string    `json:"lastChildJobUUID,omitempty" validate:"omitempty,uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the last job sent, the job itself if no child job was created.
+	NextAttemptAt    time.Time `json:"nextAttemptAt" validate:"required" example:"2020-07-09T12:35:42.115395Z"`                             // Date and time of the next retry of the job.
+}
+
+type SentrySessionResponse struct {
+	JobUUID          string    `json:"jobUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                    // UUID of the pending job retried by the session.
+	ChainUUID        string    `json:"chainUUID" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"`                  // UUID of the chain of the job.
+	Retries          int       `json:"retries" example:"1"`                                                       // Number of retries of the job so far.
+	NChildren        int       `json:"nChildren" example:"1"`                                                     // Number of child jobs created with a bumped gas price.
+	LastChildJobUUID string    `json:"lastChildJobUUID,omitempty" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the last job sent.
+	NextAttemptAt    time.Time `json:"nextAttemptAt" example:"2020-07-09T12:35:42.115395Z"`                       // Date and time of the next retry of the job.
+	TenantID         string    `json:"tenantID" example:"tenantFoo"`                                              // ID of the tenant owning the job.
+	OwnerID          string    `json:"ownerID,omitempty" example:"foo"`                                           // ID of the owner of the job.
+	CreatedAt        time.Time `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`                           // Date and time at which the session was created.
+	UpdatedAt        time.Time `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`                           // Date and time at which the session was last updated.
+}
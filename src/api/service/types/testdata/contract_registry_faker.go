@@ -44,3 +44,36 @@ func FakeContractResponse() *api.ContractResponse {
 		DeployedBytecode: c.DeployedBytecode,
 	}
 }
+
+func FakeCallContractRequest() *api.CallContractRequest {
+	return &api.CallContractRequest{
+		ChainName: "mainnet",
+		Block:     "0x10",
+		Calls: []*api.ContractCallParams{
+			{
+				To:              *testdata.FakeAddress(),
+				ContractName:    "ERC20",
+				MethodSignature: "balanceOf(address)",
+				Args:            []interface{}{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"},
+			},
+		},
+	}
+}
+
+func FakeImportContractsRequest() *api.ImportContractsRequest {
+	c := testdata.FakeContract()
+	artifact, _ := json.Marshal(map[string]interface{}{
+		"_format":          "hh-sol-artifact-1",
+		"contractName":     c.Name,
+		"abi":              json.RawMessage(c.RawABI),
+		"bytecode":         c.Bytecode.String(),
+		"deployedBytecode": c.DeployedBytecode.String(),
+	})
+
+	return &api.ImportContractsRequest{
+		Tag: c.Tag,
+		Artifacts: []*api.ContractArtifactParams{
+			{Artifact: artifact},
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package testdata
+
+import (
+	api "github.com/consensys/orchestrate/src/api/service/types"
+)
+
+func FakeCreatePrivacyGroupRequest() *api.CreatePrivacyGroupRequest {
+	return &api.CreatePrivacyGroupRequest{
+		Name:      "my-privacy-group",
+		ChainName: "besu",
+		Members: []string{
+			"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+			"B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+		},
+		Description: "Privacy group of Org1 and Org2",
+		Labels:      map[string]string{"project": "foo"},
+	}
+}
@@ -0,0 +1,35 @@
+package testdata
+
+import (
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func FakeRegisterRelayerRequest() *api.RegisterRelayerRequest {
+	return &api.RegisterRelayerRequest{
+		Name:            "relayer-mainnet",
+		ChainName:       "mainnet",
+		Type:            "ERC2771",
+		Account:         ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"),
+		ContractAddress: ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		ContractName:    "MinimalForwarder",
+		DomainName:      "MinimalForwarder",
+		DomainVersion:   "0.0.1",
+	}
+}
+
+func FakeRelayTransactionRequest() *api.RelayTransactionRequest {
+	return &api.RelayTransactionRequest{
+		ChainName: "mainnet",
+		Params: api.RelayParams{
+			ForwardRequest: &api.ForwardRequestParams{
+				From: ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+				To:   ethcommon.HexToAddress("0xdbb881a51CD4023E4400CEF3ef73046743f08da3"),
+				Gas:  100000,
+				Data: hexutil.MustDecode("0xa9059cbb"),
+			},
+			Signature: hexutil.MustDecode("0x0102"),
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package testdata
+
+import (
+	"math/big"
+
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func FakeCreateSafeProposalRequest() *api.CreateSafeProposalRequest {
+	return &api.CreateSafeProposalRequest{
+		ChainName: "mainnet",
+		Safe:      ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		Sender:    ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+		To:        ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"),
+		Value:     (*hexutil.Big)(big.NewInt(1000)),
+		Data:      hexutil.MustDecode("0xa9059cbb"),
+	}
+}
+
+func FakeSignSafeProposalRequest() *api.SignSafeProposalRequest {
+	return &api.SignSafeProposalRequest{
+		Signer: ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+	}
+}
@@ -44,15 +44,17 @@ func (g *RetryParams) Validate() error {
 }
 
 type TransactionResponse struct {
-	UUID           string                  `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the transaction.
-	IdempotencyKey string                  `json:"idempotencyKey" example:"myIdempotencyKey"`           // Idempotency key of the transaction request.
-	ChainName      string                  `json:"chain" example:"myChain"`                             // Chain on which the transaction was created.
-	Params         *ETHTransactionResponse `json:"params"`
-	Jobs           []*JobResponse          `json:"jobs"`                                            // List of jobs in the transaction.
-	CreatedAt      time.Time               `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the transaction was created.
+	UUID            string                  `json:"uuid" example:"b4374e6f-b28a-4bad-b4fe-bda36eaf849c"` // UUID of the transaction.
+	IdempotencyKey  string                  `json:"idempotencyKey" example:"myIdempotencyKey"`           // Idempotency key of the transaction request.
+	ChainName       string                  `json:"chain" example:"myChain"`                             // Chain on which the transaction was created.
+	Params          *ETHTransactionResponse `json:"params"`
+	ContractAddress string                  `json:"contractAddress,omitempty" example:"0x1abae27a0cbfb02945720425d3b80c7e09728534"` // Address of the contract deployed through the CREATE2 factory, predicted before the transaction is mined.
+	DecodedCall     *DecodedCallResponse    `json:"decodedCall,omitempty"`
+	Jobs            []*JobResponse          `json:"jobs"`                                            // List of jobs in the transaction.
+	CreatedAt       time.Time               `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"` // Date and time at which the transaction was created.
 }
 
-func validatePrivateTxParams(protocol entities.PrivateTxManagerType, privateFrom, privacyGroupID string, privateFor []string) error {
+func validatePrivateTxParams(protocol entities.PrivateTxManagerType, privateFrom, privacyGroupID, privacyGroupName string, privateFor []string) error {
 	if protocol == "" {
 		return errors.InvalidParameterError("field 'protocol' cannot be empty")
 	}
@@ -61,8 +63,20 @@ func validatePrivateTxParams(protocol entities.PrivateTxManagerType, privateFrom
 		return errors.InvalidParameterError("fields 'privateFrom' cannot be empty")
 	}
 
+	if privacyGroupName != "" {
+		if protocol != entities.EEAChainType {
+			return errors.InvalidParameterError("field 'privacyGroupName' is only supported by protocol %s", entities.EEAChainType)
+		}
+
+		if privacyGroupID != "" || len(privateFor) > 0 {
+			return errors.InvalidParameterError("fields 'privacyGroupName', 'privacyGroupId' and 'privateFor' are mutually exclusive")
+		}
+
+		return nil
+	}
+
 	if privacyGroupID == "" && len(privateFor) == 0 {
-		return errors.InvalidParameterError("fields 'privacyGroupId' and 'privateFor' cannot both be empty")
+		return errors.InvalidParameterError("fields 'privacyGroupId', 'privacyGroupName' and 'privateFor' cannot all be empty")
 	}
 
 	if len(privateFor) > 0 && privacyGroupID != "" {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
 	infra "github.com/consensys/orchestrate/src/infra/api"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -48,6 +49,37 @@ func TestTransactionParams_FailWithoutFrom(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTransactionParams_PrivacyGroupName(t *testing.T) {
+	newParams := func() *TransactionParams {
+		return &TransactionParams{
+			To:               utils.ToPtr(ethcommon.HexToAddress("0x88a5C2d9919e46F883EB62F7b8Dd9d0CC45bc290")).(*ethcommon.Address),
+			MethodSignature:  "method()",
+			ContractName:     "ContractName",
+			Protocol:         entities.EEAChainType,
+			PrivateFrom:      "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+			PrivacyGroupName: "my-privacy-group",
+		}
+	}
+
+	t.Run("should validate EEA params referencing a privacy group by name", func(t *testing.T) {
+		assert.NoError(t, newParams().Validate())
+	})
+
+	t.Run("should fail if privacy group ID is also set", func(t *testing.T) {
+		params := newParams()
+		params.PrivacyGroupID = "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
+
+		assert.Error(t, params.Validate())
+	})
+
+	t.Run("should fail if protocol is not EEA", func(t *testing.T) {
+		params := newParams()
+		params.Protocol = entities.GoQuorumChainType
+
+		assert.Error(t, params.Validate())
+	})
+}
+
 func TestTransactionParams_Validation(t *testing.T) {
 	testSet := []struct {
 		name          string
@@ -218,6 +250,26 @@ func TestDeployContractParams_FailWithoutFrom(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDeployContractParams_FailWithSaltAndPrivateParams(t *testing.T) {
+	from := ethcommon.HexToAddress("0x88a5C2d9919e46F883EB62F7b8Dd9d0CC45bc290")
+	salt := ethcommon.HexToHash("0x01")
+	params := DeployContractParams{
+		From:         &from,
+		ContractName: "SimpleContract",
+		Salt:         &salt,
+		Protocol:     entities.EEAChainType,
+		PrivateFrom:  "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+		PrivateFor:   []string{"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="},
+	}
+
+	err := params.Validate()
+	assert.Error(t, err)
+
+	params.Protocol, params.PrivateFrom, params.PrivateFor = "", "", nil
+	err = params.Validate()
+	assert.NoError(t, err)
+}
+
 func TestParams_Priority(t *testing.T) {
 	params := DeployContractParams{
 		ContractName:   "SimpleContract",
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/consensys/orchestrate/pkg/errors"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+)
+
+type SendTransactionBatchRequest struct {
+	Transactions []*TransactionBatchItem `json:"transactions" validate:"required,min=1,max=1000"` // List of transaction requests, validated individually.
+}
+
+// go validator does not support mutually exclusive parameters for now
+// See more https://github.com/go-playground/validator/issues/608
+type TransactionBatchItem struct {
+	IdempotencyKey string                  `json:"idempotencyKey,omitempty" example:"myIdempotencyKey"` // Idempotency key of the transaction request.
+	Send           *SendTransactionRequest `json:"send,omitempty"`                                      // Contract transaction request, mutually exclusive with `deploy` and `transfer`.
+	Deploy         *DeployContractRequest  `json:"deploy,omitempty"`                                    // Deployment transaction request, mutually exclusive with `send` and `transfer`.
+	Transfer       *TransferRequest        `json:"transfer,omitempty"`                                  // Transfer transaction request, mutually exclusive with `send` and `deploy`.
+}
+
+type TransactionBatchResponse struct {
+	Transactions []*TransactionBatchItemResponse `json:"transactions"` // Results of the transaction requests, in the order of the batch.
+}
+
+type TransactionBatchItemResponse struct {
+	IdempotencyKey string               `json:"idempotencyKey,omitempty" example:"myIdempotencyKey"`        // Idempotency key of the transaction request.
+	Status         string               `json:"status" example:"created" enums:"created,duplicate,invalid"` // `created` for a new transaction, `duplicate` for a transaction already sent with the same idempotency key, `invalid` for a rejected one.
+	Transaction    *TransactionResponse `json:"transaction,omitempty"`                                      // Created or previously sent transaction.
+	Error          *infra.ErrorResponse `json:"error,omitempty"`                                            // Reason why the transaction was rejected or failed to start.
+}
+
+func (item *TransactionBatchItem) Validate() error {
+	count := 0
+	for _, isSet := range []bool{item.Send != nil, item.Deploy != nil, item.Transfer != nil} {
+		if isSet {
+			count++
+		}
+	}
+	if count != 1 {
+		return errors.InvalidParameterError("exactly one of fields 'send', 'deploy' and 'transfer' must be specified")
+	}
+
+	switch {
+	case item.Send != nil:
+		if err := infra.GetValidator().Struct(item.Send); err != nil {
+			return err
+		}
+		return item.Send.Params.Validate()
+	case item.Deploy != nil:
+		if err := infra.GetValidator().Struct(item.Deploy); err != nil {
+			return err
+		}
+		return item.Deploy.Params.Validate()
+	default:
+		if err := infra.GetValidator().Struct(item.Transfer); err != nil {
+			return err
+		}
+		return item.Transfer.Params.Validate()
+	}
+}
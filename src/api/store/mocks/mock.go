@@ -190,6 +190,90 @@ func (mr *MockDBMockRecorder) Notification() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notification", reflect.TypeOf((*MockDB)(nil).Notification))
 }
 
+// Outbox mocks base method
+func (m *MockDB) Outbox() store.OutboxAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outbox")
+	ret0, _ := ret[0].(store.OutboxAgent)
+	return ret0
+}
+
+// Outbox indicates an expected call of Outbox
+func (mr *MockDBMockRecorder) Outbox() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outbox", reflect.TypeOf((*MockDB)(nil).Outbox))
+}
+
+// SafeProposal mocks base method
+func (m *MockDB) SafeProposal() store.SafeProposalAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SafeProposal")
+	ret0, _ := ret[0].(store.SafeProposalAgent)
+	return ret0
+}
+
+// SafeProposal indicates an expected call of SafeProposal
+func (mr *MockDBMockRecorder) SafeProposal() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SafeProposal", reflect.TypeOf((*MockDB)(nil).SafeProposal))
+}
+
+// Relayer mocks base method
+func (m *MockDB) Relayer() store.RelayerAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relayer")
+	ret0, _ := ret[0].(store.RelayerAgent)
+	return ret0
+}
+
+// Relayer indicates an expected call of Relayer
+func (mr *MockDBMockRecorder) Relayer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relayer", reflect.TypeOf((*MockDB)(nil).Relayer))
+}
+
+// EventLog mocks base method
+func (m *MockDB) EventLog() store.EventLogAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EventLog")
+	ret0, _ := ret[0].(store.EventLogAgent)
+	return ret0
+}
+
+// EventLog indicates an expected call of EventLog
+func (mr *MockDBMockRecorder) EventLog() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventLog", reflect.TypeOf((*MockDB)(nil).EventLog))
+}
+
+// SentrySession mocks base method
+func (m *MockDB) SentrySession() store.SentrySessionAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SentrySession")
+	ret0, _ := ret[0].(store.SentrySessionAgent)
+	return ret0
+}
+
+// SentrySession indicates an expected call of SentrySession
+func (mr *MockDBMockRecorder) SentrySession() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SentrySession", reflect.TypeOf((*MockDB)(nil).SentrySession))
+}
+
+// PrivacyGroup mocks base method
+func (m *MockDB) PrivacyGroup() store.PrivacyGroupAgent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivacyGroup")
+	ret0, _ := ret[0].(store.PrivacyGroupAgent)
+	return ret0
+}
+
+// PrivacyGroup indicates an expected call of PrivacyGroup
+func (mr *MockDBMockRecorder) PrivacyGroup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivacyGroup", reflect.TypeOf((*MockDB)(nil).PrivacyGroup))
+}
+
 // RunInTransaction mocks base method
 func (m *MockDB) RunInTransaction(ctx context.Context, persistFunc func(store.DB) error) error {
 	m.ctrl.T.Helper()
@@ -242,6 +326,20 @@ func (mr *MockTransactionRequestAgentMockRecorder) Insert(ctx, txRequest, reques
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockTransactionRequestAgent)(nil).Insert), ctx, txRequest, requestHash, scheduleUUID)
 }
 
+// InsertMultiple mocks base method
+func (m *MockTransactionRequestAgent) InsertMultiple(ctx context.Context, txRequests []*entities.TxRequest, requestHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMultiple", ctx, txRequests, requestHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMultiple indicates an expected call of InsertMultiple
+func (mr *MockTransactionRequestAgentMockRecorder) InsertMultiple(ctx, txRequests, requestHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMultiple", reflect.TypeOf((*MockTransactionRequestAgent)(nil).InsertMultiple), ctx, txRequests, requestHashes)
+}
+
 // FindOneByIdempotencyKey mocks base method
 func (m *MockTransactionRequestAgent) FindOneByIdempotencyKey(ctx context.Context, idempotencyKey, tenantID, ownerID string) (*entities.TxRequest, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +422,20 @@ func (mr *MockScheduleAgentMockRecorder) Insert(ctx, schedule interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockScheduleAgent)(nil).Insert), ctx, schedule)
 }
 
+// InsertMultiple mocks base method
+func (m *MockScheduleAgent) InsertMultiple(ctx context.Context, schedules []*entities.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMultiple", ctx, schedules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMultiple indicates an expected call of InsertMultiple
+func (mr *MockScheduleAgentMockRecorder) InsertMultiple(ctx, schedules interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMultiple", reflect.TypeOf((*MockScheduleAgent)(nil).InsertMultiple), ctx, schedules)
+}
+
 // FindOneByUUID mocks base method
 func (m *MockScheduleAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.Schedule, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +503,20 @@ func (mr *MockJobAgentMockRecorder) Insert(ctx, job, log interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockJobAgent)(nil).Insert), ctx, job, log)
 }
 
+// InsertMultiple mocks base method
+func (m *MockJobAgent) InsertMultiple(ctx context.Context, jobs []*entities.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMultiple", ctx, jobs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMultiple indicates an expected call of InsertMultiple
+func (mr *MockJobAgentMockRecorder) InsertMultiple(ctx, jobs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMultiple", reflect.TypeOf((*MockJobAgent)(nil).InsertMultiple), ctx, jobs)
+}
+
 // Update mocks base method
 func (m *MockJobAgent) Update(ctx context.Context, job *entities.Job, log *entities.Log) error {
 	m.ctrl.T.Helper()
@@ -792,92 +918,136 @@ func (mr *MockContractAgentMockRecorder) Update(ctx, contract interface{}) *gomo
 }
 
 // RegisterDeployment mocks base method
-func (m *MockContractAgent) RegisterDeployment(ctx context.Context, chainID string, address common.Address, codeHash []byte) error {
+func (m *MockContractAgent) RegisterDeployment(ctx context.Context, chainID string, address common.Address, codeHash []byte, tenantID, ownerID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegisterDeployment", ctx, chainID, address, codeHash)
+	ret := m.ctrl.Call(m, "RegisterDeployment", ctx, chainID, address, codeHash, tenantID, ownerID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RegisterDeployment indicates an expected call of RegisterDeployment
-func (mr *MockContractAgentMockRecorder) RegisterDeployment(ctx, chainID, address, codeHash interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) RegisterDeployment(ctx, chainID, address, codeHash, tenantID, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeployment", reflect.TypeOf((*MockContractAgent)(nil).RegisterDeployment), ctx, chainID, address, codeHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDeployment", reflect.TypeOf((*MockContractAgent)(nil).RegisterDeployment), ctx, chainID, address, codeHash, tenantID, ownerID)
 }
 
 // FindOneByNameAndTag mocks base method
-func (m *MockContractAgent) FindOneByNameAndTag(ctx context.Context, name, tag string) (*entities.Contract, error) {
+func (m *MockContractAgent) FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string, ownerID string) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByNameAndTag", ctx, name, tag)
+	ret := m.ctrl.Call(m, "FindOneByNameAndTag", ctx, name, tag, tenants, ownerID)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByNameAndTag indicates an expected call of FindOneByNameAndTag
-func (mr *MockContractAgentMockRecorder) FindOneByNameAndTag(ctx, name, tag interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) FindOneByNameAndTag(ctx, name, tag, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndTag", reflect.TypeOf((*MockContractAgent)(nil).FindOneByNameAndTag), ctx, name, tag)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByNameAndTag", reflect.TypeOf((*MockContractAgent)(nil).FindOneByNameAndTag), ctx, name, tag, tenants, ownerID)
 }
 
 // FindOneByCodeHash mocks base method
-func (m *MockContractAgent) FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error) {
+func (m *MockContractAgent) FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string, ownerID string) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByCodeHash", ctx, codeHash)
+	ret := m.ctrl.Call(m, "FindOneByCodeHash", ctx, codeHash, tenants, ownerID)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByCodeHash indicates an expected call of FindOneByCodeHash
-func (mr *MockContractAgentMockRecorder) FindOneByCodeHash(ctx, codeHash interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) FindOneByCodeHash(ctx, codeHash, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByCodeHash", reflect.TypeOf((*MockContractAgent)(nil).FindOneByCodeHash), ctx, codeHash)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByCodeHash", reflect.TypeOf((*MockContractAgent)(nil).FindOneByCodeHash), ctx, codeHash, tenants, ownerID)
 }
 
 // FindOneByAddress mocks base method
-func (m *MockContractAgent) FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error) {
+func (m *MockContractAgent) FindOneByAddress(ctx context.Context, address string, tenants []string, ownerID string) (*entities.Contract, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindOneByAddress", ctx, address)
+	ret := m.ctrl.Call(m, "FindOneByAddress", ctx, address, tenants, ownerID)
 	ret0, _ := ret[0].(*entities.Contract)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindOneByAddress indicates an expected call of FindOneByAddress
-func (mr *MockContractAgentMockRecorder) FindOneByAddress(ctx, address interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) FindOneByAddress(ctx, address, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAddress", reflect.TypeOf((*MockContractAgent)(nil).FindOneByAddress), ctx, address)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAddress", reflect.TypeOf((*MockContractAgent)(nil).FindOneByAddress), ctx, address, tenants, ownerID)
+}
+
+// FindDeploymentAddress mocks base method
+func (m *MockContractAgent) FindDeploymentAddress(ctx context.Context, chainID, name string, tenants []string, ownerID string) (*common.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeploymentAddress", ctx, chainID, name, tenants, ownerID)
+	ret0, _ := ret[0].(*common.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeploymentAddress indicates an expected call of FindDeploymentAddress
+func (mr *MockContractAgentMockRecorder) FindDeploymentAddress(ctx, chainID, name, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeploymentAddress", reflect.TypeOf((*MockContractAgent)(nil).FindDeploymentAddress), ctx, chainID, name, tenants, ownerID)
+}
+
+// RegisterProxy mocks base method
+func (m *MockContractAgent) RegisterProxy(ctx context.Context, proxy *entities.ContractProxy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterProxy", ctx, proxy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterProxy indicates an expected call of RegisterProxy
+func (mr *MockContractAgentMockRecorder) RegisterProxy(ctx, proxy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterProxy", reflect.TypeOf((*MockContractAgent)(nil).RegisterProxy), ctx, proxy)
+}
+
+// FindProxy mocks base method
+func (m *MockContractAgent) FindProxy(ctx context.Context, chainID string, address common.Address) (*entities.ContractProxy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProxy", ctx, chainID, address)
+	ret0, _ := ret[0].(*entities.ContractProxy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProxy indicates an expected call of FindProxy
+func (mr *MockContractAgentMockRecorder) FindProxy(ctx, chainID, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProxy", reflect.TypeOf((*MockContractAgent)(nil).FindProxy), ctx, chainID, address)
 }
 
 // ListNames mocks base method
-func (m *MockContractAgent) ListNames(ctx context.Context) ([]string, error) {
+func (m *MockContractAgent) ListNames(ctx context.Context, tenants []string, ownerID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNames", ctx)
+	ret := m.ctrl.Call(m, "ListNames", ctx, tenants, ownerID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListNames indicates an expected call of ListNames
-func (mr *MockContractAgentMockRecorder) ListNames(ctx interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) ListNames(ctx, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNames", reflect.TypeOf((*MockContractAgent)(nil).ListNames), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNames", reflect.TypeOf((*MockContractAgent)(nil).ListNames), ctx, tenants, ownerID)
 }
 
 // ListTags mocks base method
-func (m *MockContractAgent) ListTags(ctx context.Context, name string) ([]string, error) {
+func (m *MockContractAgent) ListTags(ctx context.Context, name string, tenants []string, ownerID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTags", ctx, name)
+	ret := m.ctrl.Call(m, "ListTags", ctx, name, tenants, ownerID)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTags indicates an expected call of ListTags
-func (mr *MockContractAgentMockRecorder) ListTags(ctx, name interface{}) *gomock.Call {
+func (mr *MockContractAgentMockRecorder) ListTags(ctx, name, tenants, ownerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockContractAgent)(nil).ListTags), ctx, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockContractAgent)(nil).ListTags), ctx, name, tenants, ownerID)
 }
 
 // MockContractEventAgent is a mock of ContractEventAgent interface
@@ -1223,3 +1393,510 @@ func (mr *MockNotificationAgentMockRecorder) Update(ctx, notif interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationAgent)(nil).Update), ctx, notif)
 }
+
+// MockOutboxAgent is a mock of OutboxAgent interface
+type MockOutboxAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxAgentMockRecorder
+}
+
+// MockOutboxAgentMockRecorder is the mock recorder for MockOutboxAgent
+type MockOutboxAgentMockRecorder struct {
+	mock *MockOutboxAgent
+}
+
+// NewMockOutboxAgent creates a new mock instance
+func NewMockOutboxAgent(ctrl *gomock.Controller) *MockOutboxAgent {
+	mock := &MockOutboxAgent{ctrl: ctrl}
+	mock.recorder = &MockOutboxAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOutboxAgent) EXPECT() *MockOutboxAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockOutboxAgent) Insert(ctx context.Context, msg *entities.OutboxMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockOutboxAgentMockRecorder) Insert(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockOutboxAgent)(nil).Insert), ctx, msg)
+}
+
+// LockPending mocks base method
+func (m *MockOutboxAgent) LockPending(ctx context.Context, limit int) ([]*entities.OutboxMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPending", ctx, limit)
+	ret0, _ := ret[0].([]*entities.OutboxMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockPending indicates an expected call of LockPending
+func (mr *MockOutboxAgentMockRecorder) LockPending(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPending", reflect.TypeOf((*MockOutboxAgent)(nil).LockPending), ctx, limit)
+}
+
+// Delete mocks base method
+func (m *MockOutboxAgent) Delete(ctx context.Context, uuids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uuids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockOutboxAgentMockRecorder) Delete(ctx, uuids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOutboxAgent)(nil).Delete), ctx, uuids)
+}
+
+// MockSafeProposalAgent is a mock of SafeProposalAgent interface
+type MockSafeProposalAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockSafeProposalAgentMockRecorder
+}
+
+// MockSafeProposalAgentMockRecorder is the mock recorder for MockSafeProposalAgent
+type MockSafeProposalAgentMockRecorder struct {
+	mock *MockSafeProposalAgent
+}
+
+// NewMockSafeProposalAgent creates a new mock instance
+func NewMockSafeProposalAgent(ctrl *gomock.Controller) *MockSafeProposalAgent {
+	mock := &MockSafeProposalAgent{ctrl: ctrl}
+	mock.recorder = &MockSafeProposalAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSafeProposalAgent) EXPECT() *MockSafeProposalAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockSafeProposalAgent) Insert(ctx context.Context, proposal *entities.SafeProposal) (*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, proposal)
+	ret0, _ := ret[0].(*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockSafeProposalAgentMockRecorder) Insert(ctx, proposal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSafeProposalAgent)(nil).Insert), ctx, proposal)
+}
+
+// Update mocks base method
+func (m *MockSafeProposalAgent) Update(ctx context.Context, proposal *entities.SafeProposal) (*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, proposal)
+	ret0, _ := ret[0].(*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockSafeProposalAgentMockRecorder) Update(ctx, proposal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSafeProposalAgent)(nil).Update), ctx, proposal)
+}
+
+// FindOneByUUID mocks base method
+func (m *MockSafeProposalAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUUID", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUUID indicates an expected call of FindOneByUUID
+func (mr *MockSafeProposalAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockSafeProposalAgent)(nil).FindOneByUUID), ctx, uuid, tenants, ownerID)
+}
+
+// LockOneByUUID mocks base method
+func (m *MockSafeProposalAgent) LockOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOneByUUID", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOneByUUID indicates an expected call of LockOneByUUID
+func (mr *MockSafeProposalAgentMockRecorder) LockOneByUUID(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOneByUUID", reflect.TypeOf((*MockSafeProposalAgent)(nil).LockOneByUUID), ctx, uuid, tenants, ownerID)
+}
+
+// Search mocks base method
+func (m *MockSafeProposalAgent) Search(ctx context.Context, filters *entities.SafeProposalFilters, tenants []string, ownerID string) ([]*entities.SafeProposal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*entities.SafeProposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSafeProposalAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSafeProposalAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// MockRelayerAgent is a mock of RelayerAgent interface
+type MockRelayerAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerAgentMockRecorder
+}
+
+// MockRelayerAgentMockRecorder is the mock recorder for MockRelayerAgent
+type MockRelayerAgentMockRecorder struct {
+	mock *MockRelayerAgent
+}
+
+// NewMockRelayerAgent creates a new mock instance
+func NewMockRelayerAgent(ctrl *gomock.Controller) *MockRelayerAgent {
+	mock := &MockRelayerAgent{ctrl: ctrl}
+	mock.recorder = &MockRelayerAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRelayerAgent) EXPECT() *MockRelayerAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockRelayerAgent) Insert(ctx context.Context, relayer *entities.Relayer) (*entities.Relayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, relayer)
+	ret0, _ := ret[0].(*entities.Relayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockRelayerAgentMockRecorder) Insert(ctx, relayer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockRelayerAgent)(nil).Insert), ctx, relayer)
+}
+
+// FindOneByUUID mocks base method
+func (m *MockRelayerAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.Relayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUUID", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(*entities.Relayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUUID indicates an expected call of FindOneByUUID
+func (mr *MockRelayerAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockRelayerAgent)(nil).FindOneByUUID), ctx, uuid, tenants, ownerID)
+}
+
+// Search mocks base method
+func (m *MockRelayerAgent) Search(ctx context.Context, filters *entities.RelayerFilters, tenants []string, ownerID string) ([]*entities.Relayer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*entities.Relayer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockRelayerAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRelayerAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// Delete mocks base method
+func (m *MockRelayerAgent) Delete(ctx context.Context, uuid string, tenants []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockRelayerAgentMockRecorder) Delete(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRelayerAgent)(nil).Delete), ctx, uuid, tenants, ownerID)
+}
+
+// AddSpending mocks base method
+func (m *MockRelayerAgent) AddSpending(ctx context.Context, jobUUID string, spending *entities.RelaySpending) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSpending", ctx, jobUUID, spending)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSpending indicates an expected call of AddSpending
+func (mr *MockRelayerAgentMockRecorder) AddSpending(ctx, jobUUID, spending interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSpending", reflect.TypeOf((*MockRelayerAgent)(nil).AddSpending), ctx, jobUUID, spending)
+}
+
+// SearchSpendings mocks base method
+func (m *MockRelayerAgent) SearchSpendings(ctx context.Context, filters *entities.RelaySpendingFilters, tenants []string) ([]*entities.RelaySpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSpendings", ctx, filters, tenants)
+	ret0, _ := ret[0].([]*entities.RelaySpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSpendings indicates an expected call of SearchSpendings
+func (mr *MockRelayerAgentMockRecorder) SearchSpendings(ctx, filters, tenants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSpendings", reflect.TypeOf((*MockRelayerAgent)(nil).SearchSpendings), ctx, filters, tenants)
+}
+
+// MockPrivacyGroupAgent is a mock of PrivacyGroupAgent interface
+type MockPrivacyGroupAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyGroupAgentMockRecorder
+}
+
+// MockPrivacyGroupAgentMockRecorder is the mock recorder for MockPrivacyGroupAgent
+type MockPrivacyGroupAgentMockRecorder struct {
+	mock *MockPrivacyGroupAgent
+}
+
+// NewMockPrivacyGroupAgent creates a new mock instance
+func NewMockPrivacyGroupAgent(ctrl *gomock.Controller) *MockPrivacyGroupAgent {
+	mock := &MockPrivacyGroupAgent{ctrl: ctrl}
+	mock.recorder = &MockPrivacyGroupAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPrivacyGroupAgent) EXPECT() *MockPrivacyGroupAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockPrivacyGroupAgent) Insert(ctx context.Context, group *entities.PrivacyGroup) (*entities.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, group)
+	ret0, _ := ret[0].(*entities.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockPrivacyGroupAgentMockRecorder) Insert(ctx, group interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockPrivacyGroupAgent)(nil).Insert), ctx, group)
+}
+
+// FindOneByUUID mocks base method
+func (m *MockPrivacyGroupAgent) FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByUUID", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(*entities.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByUUID indicates an expected call of FindOneByUUID
+func (mr *MockPrivacyGroupAgentMockRecorder) FindOneByUUID(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByUUID", reflect.TypeOf((*MockPrivacyGroupAgent)(nil).FindOneByUUID), ctx, uuid, tenants, ownerID)
+}
+
+// Search mocks base method
+func (m *MockPrivacyGroupAgent) Search(ctx context.Context, filters *entities.PrivacyGroupFilters, tenants []string, ownerID string) ([]*entities.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*entities.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockPrivacyGroupAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockPrivacyGroupAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// Delete mocks base method
+func (m *MockPrivacyGroupAgent) Delete(ctx context.Context, uuid string, tenants []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uuid, tenants, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockPrivacyGroupAgentMockRecorder) Delete(ctx, uuid, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPrivacyGroupAgent)(nil).Delete), ctx, uuid, tenants, ownerID)
+}
+
+// MockEventLogAgent is a mock of EventLogAgent interface
+type MockEventLogAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogAgentMockRecorder
+}
+
+// MockEventLogAgentMockRecorder is the mock recorder for MockEventLogAgent
+type MockEventLogAgentMockRecorder struct {
+	mock *MockEventLogAgent
+}
+
+// NewMockEventLogAgent creates a new mock instance
+func NewMockEventLogAgent(ctrl *gomock.Controller) *MockEventLogAgent {
+	mock := &MockEventLogAgent{ctrl: ctrl}
+	mock.recorder = &MockEventLogAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEventLogAgent) EXPECT() *MockEventLogAgentMockRecorder {
+	return m.recorder
+}
+
+// InsertMultiple mocks base method
+func (m *MockEventLogAgent) InsertMultiple(ctx context.Context, eventLogs []*entities.EventLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMultiple", ctx, eventLogs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMultiple indicates an expected call of InsertMultiple
+func (mr *MockEventLogAgentMockRecorder) InsertMultiple(ctx, eventLogs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMultiple", reflect.TypeOf((*MockEventLogAgent)(nil).InsertMultiple), ctx, eventLogs)
+}
+
+// Search mocks base method
+func (m *MockEventLogAgent) Search(ctx context.Context, filters *entities.EventLogFilters, tenants []string, ownerID string) ([]*entities.EventLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*entities.EventLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockEventLogAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockEventLogAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// MockSentrySessionAgent is a mock of SentrySessionAgent interface
+type MockSentrySessionAgent struct {
+	ctrl     *gomock.Controller
+	recorder *MockSentrySessionAgentMockRecorder
+}
+
+// MockSentrySessionAgentMockRecorder is the mock recorder for MockSentrySessionAgent
+type MockSentrySessionAgentMockRecorder struct {
+	mock *MockSentrySessionAgent
+}
+
+// NewMockSentrySessionAgent creates a new mock instance
+func NewMockSentrySessionAgent(ctrl *gomock.Controller) *MockSentrySessionAgent {
+	mock := &MockSentrySessionAgent{ctrl: ctrl}
+	mock.recorder = &MockSentrySessionAgentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSentrySessionAgent) EXPECT() *MockSentrySessionAgentMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockSentrySessionAgent) Insert(ctx context.Context, session *entities.SentrySession) (*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, session)
+	ret0, _ := ret[0].(*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockSentrySessionAgentMockRecorder) Insert(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockSentrySessionAgent)(nil).Insert), ctx, session)
+}
+
+// Update mocks base method
+func (m *MockSentrySessionAgent) Update(ctx context.Context, session *entities.SentrySession) (*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, session)
+	ret0, _ := ret[0].(*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockSentrySessionAgentMockRecorder) Update(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSentrySessionAgent)(nil).Update), ctx, session)
+}
+
+// FindOneByJobUUID mocks base method
+func (m *MockSentrySessionAgent) FindOneByJobUUID(ctx context.Context, jobUUID string, tenants []string, ownerID string) (*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByJobUUID", ctx, jobUUID, tenants, ownerID)
+	ret0, _ := ret[0].(*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByJobUUID indicates an expected call of FindOneByJobUUID
+func (mr *MockSentrySessionAgentMockRecorder) FindOneByJobUUID(ctx, jobUUID, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByJobUUID", reflect.TypeOf((*MockSentrySessionAgent)(nil).FindOneByJobUUID), ctx, jobUUID, tenants, ownerID)
+}
+
+// Search mocks base method
+func (m *MockSentrySessionAgent) Search(ctx context.Context, filters *entities.SentrySessionFilters, tenants []string, ownerID string) ([]*entities.SentrySession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filters, tenants, ownerID)
+	ret0, _ := ret[0].([]*entities.SentrySession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSentrySessionAgentMockRecorder) Search(ctx, filters, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSentrySessionAgent)(nil).Search), ctx, filters, tenants, ownerID)
+}
+
+// Delete mocks base method
+func (m *MockSentrySessionAgent) Delete(ctx context.Context, jobUUID string, tenants []string, ownerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, jobUUID, tenants, ownerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockSentrySessionAgentMockRecorder) Delete(ctx, jobUUID, tenants, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSentrySessionAgent)(nil).Delete), ctx, jobUUID, tenants, ownerID)
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -20,14 +21,17 @@ type Account struct {
 	OwnerID             string
 	Attributes          map[string]string
 	// TODO add internal labels to store accountID
-	StoreID string
+	StoreID     string
+	Status      string `pg:"default:'ACTIVE'"`
+	RotatedFrom string
+	RotatedTo   string
 
 	CreatedAt time.Time `pg:"default:now()"`
 	UpdatedAt time.Time `pg:"default:now()"`
 }
 
 func NewAccount(account *entities.Account) *Account {
-	return &Account{
+	model := &Account{
 		Alias:               account.Alias,
 		Address:             account.Address.Hex(),
 		PublicKey:           account.PublicKey.String(),
@@ -36,9 +40,19 @@ func NewAccount(account *entities.Account) *Account {
 		OwnerID:             account.OwnerID,
 		StoreID:             account.StoreID,
 		Attributes:          account.Attributes,
+		Status:              string(account.Status),
 		CreatedAt:           account.CreatedAt,
 		UpdatedAt:           account.UpdatedAt,
 	}
+
+	if account.RotatedFrom != nil {
+		model.RotatedFrom = account.RotatedFrom.Hex()
+	}
+	if account.RotatedTo != nil {
+		model.RotatedTo = account.RotatedTo.Hex()
+	}
+
+	return model
 }
 
 func NewAccounts(accounts []*Account) []*entities.Account {
@@ -51,7 +65,7 @@ func NewAccounts(accounts []*Account) []*entities.Account {
 }
 
 func (acc *Account) ToEntity() *entities.Account {
-	return &entities.Account{
+	account := &entities.Account{
 		Alias:               acc.Alias,
 		Address:             ethcommon.HexToAddress(acc.Address),
 		PublicKey:           hexutil.MustDecode(acc.PublicKey),
@@ -60,7 +74,17 @@ func (acc *Account) ToEntity() *entities.Account {
 		OwnerID:             acc.OwnerID,
 		StoreID:             acc.StoreID,
 		Attributes:          acc.Attributes,
+		Status:              entities.AccountStatus(acc.Status),
 		CreatedAt:           acc.CreatedAt,
 		UpdatedAt:           acc.UpdatedAt,
 	}
+
+	if acc.RotatedFrom != "" {
+		account.RotatedFrom = utils.ToPtr(ethcommon.HexToAddress(acc.RotatedFrom)).(*ethcommon.Address)
+	}
+	if acc.RotatedTo != "" {
+		account.RotatedTo = utils.ToPtr(ethcommon.HexToAddress(acc.RotatedTo)).(*ethcommon.Address)
+	}
+
+	return account
 }
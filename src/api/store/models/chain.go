@@ -21,6 +21,7 @@ type Chain struct {
 	ListenerDepth             uint64
 	ListenerBlockTimeDuration string
 	Labels                    map[string]string
+	FeePolicy                 *entities.FeePolicy
 	CreatedAt                 time.Time `pg:"default:now()"`
 	UpdatedAt                 time.Time `pg:"default:now()"`
 }
@@ -35,6 +36,7 @@ func NewChain(chain *entities.Chain) *Chain {
 		ListenerDepth:       chain.ListenerDepth,
 		PrivateTxManagerURL: chain.PrivateTxManagerURL,
 		Labels:              chain.Labels,
+		FeePolicy:           chain.FeePolicy,
 		CreatedAt:           chain.CreatedAt,
 		UpdatedAt:           chain.UpdatedAt,
 	}
@@ -72,6 +74,7 @@ func (c *Chain) ToEntity() *entities.Chain {
 		ListenerBlockTimeDuration: listenerBlockTimeDuration,
 		PrivateTxManagerURL:       c.PrivateTxManagerURL,
 		Labels:                    c.Labels,
+		FeePolicy:                 c.FeePolicy,
 		CreatedAt:                 c.CreatedAt,
 		UpdatedAt:                 c.UpdatedAt,
 	}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -24,10 +26,12 @@ func NewArtifact(contract *entities.Contract) *Artifact {
 	}
 }
 
-func (a *Artifact) ToContract(name, tag string) *entities.Contract {
+func (a *Artifact) ToContract(name, tag, tenantID, ownerID string) *entities.Contract {
 	return &entities.Contract{
 		Name:             name,
 		Tag:              tag,
+		TenantID:         tenantID,
+		OwnerID:          ownerID,
 		RawABI:           a.ABI,
 		Bytecode:         hexutil.MustDecode(a.Bytecode),
 		DeployedBytecode: hexutil.MustDecode(a.DeployedBytecode),
@@ -44,13 +48,51 @@ type Codehash struct {
 	ChainID  string `pg:"alias:chain_id"`
 	Address  string
 	Codehash string
+	TenantID string
+	OwnerID  string
 }
 
-func NewCodeHash(chainID string, address common.Address, codeHash []byte) *Codehash {
+func NewCodeHash(chainID string, address common.Address, codeHash []byte, tenantID, ownerID string) *Codehash {
 	return &Codehash{
 		ChainID:  chainID,
 		Address:  address.Hex(),
 		Codehash: hexutil.Encode(codeHash),
+		TenantID: tenantID,
+		OwnerID:  ownerID,
+	}
+}
+
+type ContractProxy struct {
+	tableName struct{} `pg:"contract_proxies"` // nolint:unused,structcheck // reason
+
+	ID             int
+	ChainID        string `pg:"alias:chain_id"`
+	Address        string
+	Implementation string
+	Kind           string
+	CreatedAt      time.Time `pg:"default:now()"`
+	UpdatedAt      time.Time `pg:"default:now()"`
+}
+
+func NewContractProxy(proxy *entities.ContractProxy) *ContractProxy {
+	return &ContractProxy{
+		ChainID:        proxy.ChainID,
+		Address:        proxy.Address.Hex(),
+		Implementation: proxy.Implementation.Hex(),
+		Kind:           string(proxy.Kind),
+		CreatedAt:      proxy.CreatedAt,
+		UpdatedAt:      proxy.UpdatedAt,
+	}
+}
+
+func (p *ContractProxy) ToEntity() *entities.ContractProxy {
+	return &entities.ContractProxy{
+		ChainID:        p.ChainID,
+		Address:        common.HexToAddress(p.Address),
+		Implementation: common.HexToAddress(p.Implementation),
+		Kind:           entities.ProxyKind(p.Kind),
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
 	}
 }
 
@@ -109,11 +151,17 @@ type Repository struct {
 	ID int
 
 	// Repository name
-	Name string
+	Name     string
+	TenantID string
+	OwnerID  string
 }
 
-func NewRepository(name string) *Repository {
-	return &Repository{Name: name}
+func NewRepository(contract *entities.Contract) *Repository {
+	return &Repository{
+		Name:     contract.Name,
+		TenantID: contract.TenantID,
+		OwnerID:  contract.OwnerID,
+	}
 }
 
 type Tag struct {
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type EventLog struct {
+	tableName struct{} `pg:"event_logs"` // nolint:unused,structcheck // reason
+
+	ID          int
+	ChainUUID   string `pg:"alias:chain_uuid"`
+	Address     string
+	SigHash     string
+	Topic1      string
+	Topic2      string
+	Topic3      string
+	Data        string
+	BlockNumber uint64 `pg:",use_zero"`
+	BlockHash   string
+	TxHash      string
+	LogIndex    uint `pg:",use_zero"`
+	Event       string
+	DecodedData map[string]string
+	CreatedAt   time.Time `pg:"default:now()"`
+}
+
+func NewEventLog(eventLog *entities.EventLog) *EventLog {
+	model := &EventLog{
+		ChainUUID:   eventLog.ChainUUID,
+		Address:     eventLog.Address.Hex(),
+		Data:        hexutil.Encode(eventLog.Data),
+		BlockNumber: eventLog.BlockNumber,
+		BlockHash:   eventLog.BlockHash.Hex(),
+		TxHash:      eventLog.TxHash.Hex(),
+		LogIndex:    eventLog.LogIndex,
+		Event:       eventLog.Event,
+		DecodedData: eventLog.DecodedData,
+		CreatedAt:   eventLog.CreatedAt,
+	}
+
+	topics := []*string{&model.SigHash, &model.Topic1, &model.Topic2, &model.Topic3}
+	for i, topic := range eventLog.Topics {
+		if i < len(topics) {
+			*topics[i] = topic.Hex()
+		}
+	}
+
+	return model
+}
+
+func NewEventLogs(eventLogs []*EventLog) []*entities.EventLog {
+	res := []*entities.EventLog{}
+	for _, e := range eventLogs {
+		res = append(res, e.ToEntity())
+	}
+
+	return res
+}
+
+func (e *EventLog) ToEntity() *entities.EventLog {
+	data, _ := hexutil.Decode(e.Data)
+	eventLog := &entities.EventLog{
+		ChainUUID:   e.ChainUUID,
+		Address:     ethcommon.HexToAddress(e.Address),
+		Topics:      []ethcommon.Hash{},
+		Data:        data,
+		BlockNumber: e.BlockNumber,
+		BlockHash:   ethcommon.HexToHash(e.BlockHash),
+		TxHash:      ethcommon.HexToHash(e.TxHash),
+		LogIndex:    e.LogIndex,
+		Event:       e.Event,
+		DecodedData: e.DecodedData,
+		CreatedAt:   e.CreatedAt,
+	}
+
+	for _, topic := range []string{e.SigHash, e.Topic1, e.Topic2, e.Topic3} {
+		if topic == "" {
+			break
+		}
+		eventLog.Topics = append(eventLog.Topics, ethcommon.HexToHash(topic))
+	}
+
+	return eventLog
+}
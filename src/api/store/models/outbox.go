@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type OutboxMessage struct {
+	tableName struct{} `pg:"outbox_messages"` // nolint:unused,structcheck // reason
+
+	ID           int `pg:"alias:id"`
+	UUID         string
+	Topic        string
+	PartitionKey string
+	Body         []byte
+	Headers      map[string]interface{}
+	CreatedAt    time.Time `pg:"default:now()"`
+}
+
+func NewOutboxMessage(msg *entities.OutboxMessage) *OutboxMessage {
+	return &OutboxMessage{
+		UUID:         msg.UUID,
+		Topic:        msg.Topic,
+		PartitionKey: msg.PartitionKey,
+		Body:         msg.Body,
+		Headers:      msg.Headers,
+		CreatedAt:    msg.CreatedAt,
+	}
+}
+
+func NewOutboxMessages(msgs []*OutboxMessage) []*entities.OutboxMessage {
+	res := []*entities.OutboxMessage{}
+	for _, msg := range msgs {
+		res = append(res, msg.ToEntity())
+	}
+
+	return res
+}
+
+func (msg *OutboxMessage) ToEntity() *entities.OutboxMessage {
+	return &entities.OutboxMessage{
+		UUID:         msg.UUID,
+		Topic:        msg.Topic,
+		PartitionKey: msg.PartitionKey,
+		Body:         msg.Body,
+		Headers:      msg.Headers,
+		CreatedAt:    msg.CreatedAt,
+	}
+}
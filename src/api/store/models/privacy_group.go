@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type PrivacyGroup struct {
+	tableName struct{} `pg:"privacy_groups"` // nolint:unused,structcheck // reason
+
+	ID             int `pg:"alias:id"`
+	UUID           string
+	Name           string
+	ChainUUID      string `pg:"alias:chain_uuid"`
+	PrivacyGroupID string `pg:"alias:privacy_group_id"`
+	Description    string
+	Members        []string `pg:"members,array"`
+	Labels         map[string]string
+	TenantID       string    `pg:"alias:tenant_id"`
+	OwnerID        string    `pg:"alias:owner_id"`
+	CreatedAt      time.Time `pg:"default:now()"`
+	UpdatedAt      time.Time `pg:"default:now()"`
+}
+
+func NewPrivacyGroup(group *entities.PrivacyGroup) *PrivacyGroup {
+	return &PrivacyGroup{
+		UUID:           group.UUID,
+		Name:           group.Name,
+		ChainUUID:      group.ChainUUID,
+		PrivacyGroupID: group.PrivacyGroupID,
+		Description:    group.Description,
+		Members:        group.Members,
+		Labels:         group.Labels,
+		TenantID:       group.TenantID,
+		OwnerID:        group.OwnerID,
+		CreatedAt:      group.CreatedAt,
+		UpdatedAt:      group.UpdatedAt,
+	}
+}
+
+func NewPrivacyGroups(groups []*PrivacyGroup) []*entities.PrivacyGroup {
+	res := []*entities.PrivacyGroup{}
+	for _, g := range groups {
+		res = append(res, g.ToEntity())
+	}
+
+	return res
+}
+
+func (g *PrivacyGroup) ToEntity() *entities.PrivacyGroup {
+	return &entities.PrivacyGroup{
+		UUID:           g.UUID,
+		Name:           g.Name,
+		ChainUUID:      g.ChainUUID,
+		PrivacyGroupID: g.PrivacyGroupID,
+		Description:    g.Description,
+		Members:        g.Members,
+		Labels:         g.Labels,
+		TenantID:       g.TenantID,
+		OwnerID:        g.OwnerID,
+		CreatedAt:      g.CreatedAt,
+		UpdatedAt:      g.UpdatedAt,
+	}
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type Relayer struct {
+	tableName struct{} `pg:"relayers"` // nolint:unused,structcheck // reason
+
+	ID              int `pg:"alias:id"`
+	UUID            string
+	Name            string
+	ChainUUID       string `pg:"alias:chain_uuid"`
+	Type            string
+	Account         string
+	ContractAddress string
+	ContractName    string
+	ContractTag     string
+	DomainName      string
+	DomainVersion   string
+	TenantID        string    `pg:"alias:tenant_id"`
+	OwnerID         string    `pg:"alias:owner_id"`
+	CreatedAt       time.Time `pg:"default:now()"`
+	UpdatedAt       time.Time `pg:"default:now()"`
+}
+
+type RelaySpending struct {
+	tableName struct{} `pg:"relay_spendings"` // nolint:unused,structcheck // reason
+
+	ID          int    `pg:"alias:id"`
+	RelayerUUID string `pg:"alias:relayer_uuid"`
+	TenantID    string `pg:"alias:tenant_id"`
+	TxCount     uint64 `pg:",use_zero"`
+	GasUsed     uint64 `pg:",use_zero"`
+	Fee         string
+	UpdatedAt   time.Time `pg:"default:now()"`
+}
+
+func NewRelayer(relayer *entities.Relayer) *Relayer {
+	return &Relayer{
+		UUID:            relayer.UUID,
+		Name:            relayer.Name,
+		ChainUUID:       relayer.ChainUUID,
+		Type:            string(relayer.Type),
+		Account:         relayer.Account.Hex(),
+		ContractAddress: relayer.ContractAddress.Hex(),
+		ContractName:    relayer.ContractName,
+		ContractTag:     relayer.ContractTag,
+		DomainName:      relayer.DomainName,
+		DomainVersion:   relayer.DomainVersion,
+		TenantID:        relayer.TenantID,
+		OwnerID:         relayer.OwnerID,
+		CreatedAt:       relayer.CreatedAt,
+		UpdatedAt:       relayer.UpdatedAt,
+	}
+}
+
+func NewRelayers(relayers []*Relayer) []*entities.Relayer {
+	res := []*entities.Relayer{}
+	for _, r := range relayers {
+		res = append(res, r.ToEntity())
+	}
+
+	return res
+}
+
+func (r *Relayer) ToEntity() *entities.Relayer {
+	return &entities.Relayer{
+		UUID:            r.UUID,
+		Name:            r.Name,
+		ChainUUID:       r.ChainUUID,
+		Type:            entities.RelayerType(r.Type),
+		Account:         ethcommon.HexToAddress(r.Account),
+		ContractAddress: ethcommon.HexToAddress(r.ContractAddress),
+		ContractName:    r.ContractName,
+		ContractTag:     r.ContractTag,
+		DomainName:      r.DomainName,
+		DomainVersion:   r.DomainVersion,
+		TenantID:        r.TenantID,
+		OwnerID:         r.OwnerID,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	}
+}
+
+func NewRelaySpending(spending *entities.RelaySpending) *RelaySpending {
+	model := &RelaySpending{
+		RelayerUUID: spending.RelayerUUID,
+		TenantID:    spending.TenantID,
+		TxCount:     spending.TxCount,
+		GasUsed:     spending.GasUsed,
+		Fee:         "0",
+		UpdatedAt:   spending.UpdatedAt,
+	}
+
+	if spending.Fee != nil {
+		model.Fee = spending.Fee.ToInt().String()
+	}
+
+	return model
+}
+
+func NewRelaySpendings(spendings []*RelaySpending) []*entities.RelaySpending {
+	res := []*entities.RelaySpending{}
+	for _, s := range spendings {
+		res = append(res, s.ToEntity())
+	}
+
+	return res
+}
+
+func (s *RelaySpending) ToEntity() *entities.RelaySpending {
+	fee, _ := new(big.Int).SetString(s.Fee, 10)
+	if fee == nil {
+		fee = big.NewInt(0)
+	}
+
+	return &entities.RelaySpending{
+		RelayerUUID: s.RelayerUUID,
+		TenantID:    s.TenantID,
+		TxCount:     s.TxCount,
+		GasUsed:     s.GasUsed,
+		Fee:         (*hexutil.Big)(fee),
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type SafeProposal struct {
+	tableName struct{} `pg:"safe_proposals"` // nolint:unused,structcheck // reason
+
+	ID           int `pg:"alias:id"`
+	UUID         string
+	ChainUUID    string `pg:"alias:chain_uuid"`
+	SafeAddress  string
+	Sender       string
+	Transaction  *entities.SafeTransaction
+	SafeTxHash   string
+	Owners       []string `pg:",array"`
+	Threshold    uint64   `pg:",use_zero"`
+	Signatures   []*entities.SafeSignature
+	Status       string
+	ScheduleUUID string `pg:"alias:schedule_uuid"`
+	TxHash       string
+	Labels       map[string]string
+	TenantID     string    `pg:"alias:tenant_id"`
+	OwnerID      string    `pg:"alias:owner_id"`
+	CreatedAt    time.Time `pg:"default:now()"`
+	UpdatedAt    time.Time `pg:"default:now()"`
+}
+
+func NewSafeProposal(proposal *entities.SafeProposal) *SafeProposal {
+	model := &SafeProposal{
+		UUID:         proposal.UUID,
+		ChainUUID:    proposal.ChainUUID,
+		SafeAddress:  proposal.SafeAddress.Hex(),
+		Sender:       proposal.Sender.Hex(),
+		Transaction:  proposal.Transaction,
+		SafeTxHash:   proposal.SafeTxHash.Hex(),
+		Threshold:    proposal.Threshold,
+		Signatures:   proposal.Signatures,
+		Status:       string(proposal.Status),
+		ScheduleUUID: proposal.ScheduleUUID,
+		Labels:       proposal.Labels,
+		TenantID:     proposal.TenantID,
+		OwnerID:      proposal.OwnerID,
+		CreatedAt:    proposal.CreatedAt,
+		UpdatedAt:    proposal.UpdatedAt,
+	}
+
+	for _, owner := range proposal.Owners {
+		model.Owners = append(model.Owners, owner.Hex())
+	}
+	if proposal.TxHash != nil {
+		model.TxHash = proposal.TxHash.Hex()
+	}
+
+	return model
+}
+
+func NewSafeProposals(proposals []*SafeProposal) []*entities.SafeProposal {
+	res := []*entities.SafeProposal{}
+	for _, p := range proposals {
+		res = append(res, p.ToEntity())
+	}
+
+	return res
+}
+
+func (p *SafeProposal) ToEntity() *entities.SafeProposal {
+	proposal := &entities.SafeProposal{
+		UUID:         p.UUID,
+		ChainUUID:    p.ChainUUID,
+		SafeAddress:  ethcommon.HexToAddress(p.SafeAddress),
+		Sender:       ethcommon.HexToAddress(p.Sender),
+		Transaction:  p.Transaction,
+		SafeTxHash:   ethcommon.HexToHash(p.SafeTxHash),
+		Owners:       []ethcommon.Address{},
+		Threshold:    p.Threshold,
+		Signatures:   p.Signatures,
+		Status:       entities.SafeProposalStatus(p.Status),
+		ScheduleUUID: p.ScheduleUUID,
+		Labels:       p.Labels,
+		TenantID:     p.TenantID,
+		OwnerID:      p.OwnerID,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+
+	for _, owner := range p.Owners {
+		proposal.Owners = append(proposal.Owners, ethcommon.HexToAddress(owner))
+	}
+	if proposal.Signatures == nil {
+		proposal.Signatures = []*entities.SafeSignature{}
+	}
+	if p.TxHash != "" {
+		proposal.TxHash = utils.ToPtr(ethcommon.HexToHash(p.TxHash)).(*ethcommon.Hash)
+	}
+
+	return proposal
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type SentrySession struct {
+	tableName struct{} `pg:"sentry_sessions"` // nolint:unused,structcheck // reason
+
+	ID               int       `pg:"alias:id"`
+	JobUUID          string    `pg:"alias:job_uuid"`
+	ChainUUID        string    `pg:"alias:chain_uuid"`
+	Retries          int       `pg:",use_zero"`
+	NChildren        int       `pg:",use_zero"`
+	LastChildJobUUID string    `pg:"alias:last_child_job_uuid"`
+	NextAttemptAt    time.Time `pg:"alias:next_attempt_at"`
+	TenantID         string    `pg:"alias:tenant_id"`
+	OwnerID          string    `pg:"alias:owner_id"`
+	CreatedAt        time.Time `pg:"default:now()"`
+	UpdatedAt        time.Time `pg:"default:now()"`
+}
+
+func NewSentrySession(session *entities.SentrySession) *SentrySession {
+	return &SentrySession{
+		JobUUID:          session.JobUUID,
+		ChainUUID:        session.ChainUUID,
+		Retries:          session.Retries,
+		NChildren:        session.NChildren,
+		LastChildJobUUID: session.LastChildJobUUID,
+		NextAttemptAt:    session.NextAttemptAt,
+		TenantID:         session.TenantID,
+		OwnerID:          session.OwnerID,
+		CreatedAt:        session.CreatedAt,
+		UpdatedAt:        session.UpdatedAt,
+	}
+}
+
+func NewSentrySessions(sessions []*SentrySession) []*entities.SentrySession {
+	res := []*entities.SentrySession{}
+	for _, s := range sessions {
+		res = append(res, s.ToEntity())
+	}
+
+	return res
+}
+
+func (s *SentrySession) ToEntity() *entities.SentrySession {
+	return &entities.SentrySession{
+		JobUUID:          s.JobUUID,
+		ChainUUID:        s.ChainUUID,
+		Retries:          s.Retries,
+		NChildren:        s.NChildren,
+		LastChildJobUUID: s.LastChildJobUUID,
+		NextAttemptAt:    s.NextAttemptAt,
+		TenantID:         s.TenantID,
+		OwnerID:          s.OwnerID,
+		CreatedAt:        s.CreatedAt,
+		UpdatedAt:        s.UpdatedAt,
+	}
+}
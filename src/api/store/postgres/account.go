@@ -76,7 +76,10 @@ func (agent *PGAccount) Search(ctx context.Context, filters *entities.AccountFil
 		q = q.Where("tenant_id = ?", filters.TenantID)
 	}
 
-	err := q.WhereAllowedTenants("", tenants).WhereAllowedOwner("", ownerID).Order("id ASC").Select()
+	err := paginate(q, filters.Pagination, "account", "account.address", "id ASC").
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Select()
 	if err != nil && !errors.IsNotFoundError(err) {
 		errMsg := "failed to search accounts"
 		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
@@ -113,8 +113,8 @@ func (agent *PGChain) Search(ctx context.Context, filters *entities.ChainFilters
 		q = q.Where("chain_id = ?", filters.ChainID)
 	}
 
-	err := q.WhereAllowedTenants("", tenants).
-		Order("created_at ASC").
+	err := paginate(q, filters.Pagination, "chain", "chain.uuid", "created_at ASC").
+		WhereAllowedTenants("", tenants).
 		WhereAllowedOwner("", ownerID).
 		Select()
 	if err != nil && !errors.IsNotFoundError(err) {
@@ -3,11 +3,13 @@ package postgres
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/consensys/orchestrate/src/infra/postgres"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/api/store/models"
 	"github.com/consensys/orchestrate/src/entities"
@@ -27,6 +29,8 @@ type contractQuery struct {
 	ABI              string `json:"abi,omitempty"`
 	Bytecode         string `json:"bytecode,omitempty"`
 	DeployedBytecode string `json:"deployed_bytecode,omitempty"`
+	TenantID         string `json:"tenant_id,omitempty"`
+	OwnerID          string `json:"owner_id,omitempty"`
 }
 
 var _ store.ContractAgent = &PGContract{}
@@ -41,8 +45,12 @@ func NewPGContract(client postgres.Client) *PGContract {
 func (agent *PGContract) Register(ctx context.Context, contract *entities.Contract) error {
 	return agent.client.RunInTransaction(ctx, func(c postgres.Client) error {
 		// Insert repository
-		repository := models.NewRepository(contract.Name)
-		err := c.ModelContext(ctx, repository).Where("name = ?name").SelectOrInsert()
+		repository := models.NewRepository(contract)
+		err := c.ModelContext(ctx, repository).
+			Where("name = ?name").
+			Where("tenant_id = ?tenant_id").
+			Where("owner_id IS NOT DISTINCT FROM ?owner_id").
+			SelectOrInsert()
 		if err != nil {
 			errMessage := "failed to select or insert repository"
 			agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
@@ -72,7 +80,16 @@ func (agent *PGContract) Register(ctx context.Context, contract *entities.Contra
 
 func (agent *PGContract) Update(ctx context.Context, contract *entities.Contract) error {
 	// Updating a contract means changing its artifact with the same repository and tag. ex: "latest" is overwritten
-	artifact, err := agent.findArtifactByNameAndTag(ctx, contract.Name, contract.Tag)
+	artifact := &models.Artifact{}
+	err := agent.client.ModelContext(ctx, artifact).
+		Column("artifact.id").
+		Join("JOIN tags AS t ON t.artifact_id = artifact.id").
+		Join("JOIN repositories AS registry ON registry.id = t.repository_id").
+		Where("LOWER(t.name) = LOWER(?)", contract.Tag).
+		Where("LOWER(registry.name) = LOWER(?)", contract.Name).
+		Where("registry.tenant_id = ?", contract.TenantID).
+		Where("registry.owner_id IS NOT DISTINCT FROM ?", nullString(contract.OwnerID)).
+		SelectOne()
 	if err != nil {
 		errMessage := "failed to find contract artifact when updating"
 		agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
@@ -91,15 +108,15 @@ func (agent *PGContract) Update(ctx context.Context, contract *entities.Contract
 	return nil
 }
 
-func (agent *PGContract) RegisterDeployment(ctx context.Context, chainID string, address ethcommon.Address, codeHash []byte) error {
-	codehash := models.NewCodeHash(chainID, address, codeHash)
+func (agent *PGContract) RegisterDeployment(ctx context.Context, chainID string, address ethcommon.Address, codeHash []byte,
+	tenantID, ownerID string) error {
+	codehash := models.NewCodeHash(chainID, address, codeHash, tenantID, ownerID)
 
 	// If uniqueness constraint is broken then it updates the former value
 	err := agent.client.ModelContext(ctx, codehash).
-		OnConflict("ON CONSTRAINT codehashes_chain_id_address_key DO UPDATE").
-		Set("chain_id = ?chain_id").
-		Set("address = ?address").
+		OnConflict("(tenant_id, chain_id, address) DO UPDATE").
 		Set("codehash = ?codehash").
+		Set("owner_id = ?owner_id").
 		Returning("*").
 		Insert()
 	if err != nil {
@@ -111,18 +128,13 @@ func (agent *PGContract) RegisterDeployment(ctx context.Context, chainID string,
 	return nil
 }
 
-func (agent *PGContract) FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error) {
+func (agent *PGContract) FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string, ownerID string) (*entities.Contract, error) {
 	qContract := &contractQuery{}
-	query := `
-SELECT a.abi, a.bytecode, a.deployed_bytecode, r.name as name, t.name as tag 
-FROM artifacts a
-INNER JOIN tags t ON (a.id = t.artifact_id)
-INNER JOIN repositories r ON (r.id = t.repository_id) 
-WHERE a.codehash = ?
-ORDER BY t.id DESC
-LIMIT 1
-`
-	err := agent.client.QueryOneContext(ctx, qContract, query, codeHash)
+	err := agent.selectContract(ctx, tenants, ownerID).
+		Where("artifact.codehash = ?", codeHash).
+		OrderExpr("t.id DESC").
+		Limit(1).
+		SelectColumn(qContract)
 	if err != nil {
 		errMessage := "could not find contract by codehash"
 		agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
@@ -132,18 +144,15 @@ LIMIT 1
 	return parseContract(qContract)
 }
 
-func (agent *PGContract) FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error) {
+func (agent *PGContract) FindOneByAddress(ctx context.Context, address string, tenants []string, ownerID string) (*entities.Contract, error) {
 	qContract := &contractQuery{}
-	query := `
-SELECT a.abi, a.bytecode, a.deployed_bytecode, r.name as name, t.name as tag 
-FROM artifacts a
-INNER JOIN tags t ON (a.id = t.artifact_id)
-INNER JOIN repositories r ON (r.id = t.repository_id) 
-INNER JOIN codehashes ch ON (ch.codehash = a.codehash) 
-WHERE ch.address = ?
-LIMIT 1
-`
-	err := agent.client.QueryOneContext(ctx, qContract, query, address)
+	err := agent.selectContract(ctx, tenants, ownerID).
+		Join("JOIN codehashes AS ch ON ch.codehash = artifact.codehash").
+		Where("ch.address = ?", address).
+		WhereAllowedTenants("ch.tenant_id", tenants).
+		WhereAllowedOwner("ch.owner_id", ownerID).
+		Limit(1).
+		SelectColumn(qContract)
 	if err != nil {
 		errMessage := "could not find contract by address"
 		if errors.IsNotFoundError(err) {
@@ -156,8 +165,13 @@ LIMIT 1
 	return parseContract(qContract)
 }
 
-func (agent *PGContract) FindOneByNameAndTag(ctx context.Context, name, tag string) (*entities.Contract, error) {
-	artifact, err := agent.findArtifactByNameAndTag(ctx, name, tag)
+func (agent *PGContract) FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string, ownerID string) (*entities.Contract, error) {
+	qContract := &contractQuery{}
+	err := agent.selectContract(ctx, tenants, ownerID).
+		Where("LOWER(t.name) = LOWER(?)", tag).
+		Where("LOWER(registry.name) = LOWER(?)", name).
+		Limit(1).
+		SelectColumn(qContract)
 	if err != nil {
 		if errors.IsNotFoundError(err) {
 			return nil, nil
@@ -167,13 +181,85 @@ func (agent *PGContract) FindOneByNameAndTag(ctx context.Context, name, tag stri
 		return nil, errors.FromError(err).SetMessage(errMessage)
 	}
 
-	return artifact.ToContract(name, tag), nil
+	return qContract.toContract(), nil
 }
 
-func (agent *PGContract) ListNames(ctx context.Context) ([]string, error) {
+// FindDeploymentAddress returns the address of the latest registered deployment of a contract on a chain
+func (agent *PGContract) FindDeploymentAddress(ctx context.Context, chainID, name string, tenants []string, ownerID string) (*ethcommon.Address, error) {
+	var addresses []string
+	err := agent.client.ModelContext(ctx, (*models.Codehash)(nil)).
+		ColumnExpr("codehash.address").
+		Join("JOIN artifacts AS artifact ON artifact.codehash = codehash.codehash").
+		Join("JOIN tags AS t ON t.artifact_id = artifact.id").
+		Join("JOIN repositories AS registry ON registry.id = t.repository_id").
+		Where("codehash.chain_id = ?", chainID).
+		Where("LOWER(registry.name) = LOWER(?)", name).
+		WhereAllowedTenants("codehash.tenant_id", tenants).
+		WhereAllowedOwner("codehash.owner_id", ownerID).
+		WhereAllowedTenants("registry.tenant_id", tenants).
+		WhereAllowedOwner("registry.owner_id", ownerID).
+		OrderExpr("codehash.id DESC").
+		Limit(1).
+		SelectColumn(&addresses)
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to find contract deployment address"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	address := ethcommon.HexToAddress(addresses[0])
+	return &address, nil
+}
+
+// RegisterProxy records the implementation of a proxy, overwriting the former one when upgraded
+func (agent *PGContract) RegisterProxy(ctx context.Context, proxy *entities.ContractProxy) error {
+	model := models.NewContractProxy(proxy)
+	model.UpdatedAt = time.Now().UTC()
+
+	err := agent.client.ModelContext(ctx, model).
+		OnConflict("(chain_id, address) DO UPDATE").
+		Set("implementation = ?implementation").
+		Set("kind = ?kind").
+		Set("updated_at = ?updated_at").
+		Returning("*").
+		Insert()
+	if err != nil {
+		errMessage := "could not register contract proxy"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (agent *PGContract) FindProxy(ctx context.Context, chainID string, address ethcommon.Address) (*entities.ContractProxy, error) {
+	model := &models.ContractProxy{}
+	err := agent.client.ModelContext(ctx, model).
+		Where("contract_proxy.chain_id = ?", chainID).
+		Where("contract_proxy.address = ?", address.Hex()).
+		SelectOne()
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		errMessage := "failed to find contract proxy"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGContract) ListNames(ctx context.Context, tenants []string, ownerID string) ([]string, error) {
 	var names []string
 	err := agent.client.ModelContext(ctx, (*models.Repository)(nil)).
-		Column("name").
+		ColumnExpr("DISTINCT ON (lower(name)) name").
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
 		OrderExpr("lower(name)").
 		SelectColumn(&names)
 	if err != nil && !errors.IsNotFoundError(err) {
@@ -185,12 +271,14 @@ func (agent *PGContract) ListNames(ctx context.Context) ([]string, error) {
 	return names, nil
 }
 
-func (agent *PGContract) ListTags(ctx context.Context, name string) ([]string, error) {
+func (agent *PGContract) ListTags(ctx context.Context, name string, tenants []string, ownerID string) ([]string, error) {
 	var tags []string
 	err := agent.client.ModelContext(ctx, (*models.Tag)(nil)).
-		Column("tag.name").
+		ColumnExpr("DISTINCT ON (lower(tag.name)) tag.name").
 		Join("JOIN repositories AS registry ON registry.id = tag.repository_id").
 		Where("lower(registry.name) = lower(?)", name).
+		WhereAllowedTenants("registry.tenant_id", tenants).
+		WhereAllowedOwner("registry.owner_id", ownerID).
 		OrderExpr("lower(tag.name)").
 		SelectColumn(&tags)
 	if err != nil {
@@ -202,20 +290,38 @@ func (agent *PGContract) ListTags(ctx context.Context, name string) ([]string, e
 	return tags, nil
 }
 
-func (agent *PGContract) findArtifactByNameAndTag(ctx context.Context, name, tag string) (*models.Artifact, error) {
-	artifact := &models.Artifact{}
-	err := agent.client.ModelContext(ctx, artifact).
-		Column("artifact.id", "abi", "bytecode", "deployed_bytecode").
+// selectContract selects the contracts of the allowed tenants, the ones owned by the tenant of the user taking
+// precedence over the ones of the default tenant shared with all tenants
+func (agent *PGContract) selectContract(ctx context.Context, tenants []string, ownerID string) postgres.Query {
+	return agent.client.ModelContext(ctx, (*models.Artifact)(nil)).
+		ColumnExpr("artifact.abi, artifact.bytecode, artifact.deployed_bytecode").
+		ColumnExpr("registry.name AS name, t.name AS tag, registry.tenant_id, registry.owner_id").
 		Join("JOIN tags AS t ON t.artifact_id = artifact.id").
 		Join("JOIN repositories AS registry ON registry.id = t.repository_id").
-		Where("LOWER(t.name) = LOWER(?)", tag).
-		Where("LOWER(registry.name) = LOWER(?)", name).
-		SelectOne()
-	if err != nil {
-		return nil, err
+		WhereAllowedTenants("registry.tenant_id", tenants).
+		WhereAllowedOwner("registry.owner_id", ownerID).
+		OrderExpr("registry.tenant_id = ?", multitenancy.DefaultTenant).
+		OrderExpr("registry.owner_id IS NULL")
+}
+
+func (q *contractQuery) toContract() *entities.Contract {
+	return &entities.Contract{
+		Name:             q.Name,
+		Tag:              q.Tag,
+		RawABI:           q.ABI,
+		Bytecode:         hexutil.MustDecode(q.Bytecode),
+		DeployedBytecode: hexutil.MustDecode(q.DeployedBytecode),
+		TenantID:         q.TenantID,
+		OwnerID:          q.OwnerID,
 	}
+}
 
-	return artifact, nil
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
 }
 
 func parseContract(qContract *contractQuery) (*entities.Contract, error) {
@@ -224,12 +330,7 @@ func parseContract(qContract *contractQuery) (*entities.Contract, error) {
 		return nil, err
 	}
 
-	return &entities.Contract{
-		Name:             qContract.Name,
-		Tag:              qContract.Tag,
-		RawABI:           qContract.ABI,
-		ABI:              parsedABI,
-		Bytecode:         hexutil.MustDecode(qContract.Bytecode),
-		DeployedBytecode: hexutil.MustDecode(qContract.DeployedBytecode),
-	}, nil
+	contract := qContract.toContract()
+	contract.ABI = parsedABI
+	return contract, nil
 }
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/go-pg/pg/v10"
+)
+
+type PGEventLog struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+var _ store.EventLogAgent = &PGEventLog{}
+
+func NewPGEventLog(client postgres.Client) *PGEventLog {
+	return &PGEventLog{
+		client: client,
+		logger: log.NewLogger().SetComponent("data-agents.event-log"),
+	}
+}
+
+// InsertMultiple indexes the event logs, ignoring the ones already indexed
+func (agent *PGEventLog) InsertMultiple(ctx context.Context, eventLogs []*entities.EventLog) error {
+	if len(eventLogs) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	var eventLogModels []*models.EventLog
+	for _, eventLog := range eventLogs {
+		model := models.NewEventLog(eventLog)
+		model.CreatedAt = now
+		eventLogModels = append(eventLogModels, model)
+	}
+
+	err := agent.client.ModelContext(ctx, &eventLogModels).
+		OnConflict("(chain_uuid, tx_hash, log_index) DO NOTHING").
+		Insert()
+	if err != nil {
+		errMsg := "failed to insert event logs"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return nil
+}
+
+// Search returns the event logs indexed on chains accessible to the given tenants and owner
+func (agent *PGEventLog) Search(ctx context.Context, filters *entities.EventLogFilters, tenants []string, ownerID string) ([]*entities.EventLog, error) {
+	var eventLogs []*models.EventLog
+
+	q := agent.client.ModelContext(ctx, &eventLogs).
+		Join("JOIN chains AS chain ON chain.uuid = event_log.chain_uuid").
+		Where("event_log.chain_uuid = ?", filters.ChainUUID)
+	if len(filters.Addresses) > 0 {
+		var addresses []string
+		for _, address := range filters.Addresses {
+			addresses = append(addresses, address.Hex())
+		}
+		q = q.Where("event_log.address in (?)", pg.In(addresses))
+	}
+	if filters.SigHash != nil {
+		q = q.Where("event_log.sig_hash = ?", filters.SigHash.Hex())
+	}
+	if filters.Topic1 != nil {
+		q = q.Where("event_log.topic1 = ?", filters.Topic1.Hex())
+	}
+	if filters.Topic2 != nil {
+		q = q.Where("event_log.topic2 = ?", filters.Topic2.Hex())
+	}
+	if filters.Topic3 != nil {
+		q = q.Where("event_log.topic3 = ?", filters.Topic3.Hex())
+	}
+	if filters.FromBlock != nil {
+		q = q.Where("event_log.block_number >= ?", *filters.FromBlock)
+	}
+	if filters.ToBlock != nil {
+		q = q.Where("event_log.block_number <= ?", *filters.ToBlock)
+	}
+	if filters.After != nil {
+		q = q.Where("(event_log.block_number, event_log.log_index) > (?, ?)", filters.After.BlockNumber, filters.After.LogIndex)
+	}
+	if filters.Limit > 0 {
+		q = q.Limit(filters.Limit)
+	}
+
+	err := q.WhereAllowedTenants("chain.tenant_id", tenants).
+		WhereAllowedOwner("chain.owner_id", ownerID).
+		Order("event_log.block_number ASC").Order("event_log.log_index ASC").
+		Select()
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to search event logs"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return models.NewEventLogs(eventLogs), nil
+}
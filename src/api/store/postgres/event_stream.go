@@ -59,7 +59,10 @@ func (agent *PGEventStream) Search(ctx context.Context, filters *entities.EventS
 		q = q.Where("chain_uuid = ?", filters.ChainUUID)
 	}
 
-	err := q.WhereAllowedTenants("", tenants).WhereAllowedOwner("", ownerID).Order("id ASC").Select()
+	err := paginate(q, filters.Pagination, "event_stream", "event_stream.uuid", "id ASC").
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Select()
 	if err != nil && !errors.IsNotFoundError(err) {
 		errMsg := "failed to search event streams"
 		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
@@ -79,8 +79,8 @@ func (agent *PGFaucet) Search(ctx context.Context, filters *entities.FaucetFilte
 		q = q.Where("chain_rule = ?", filters.ChainRule)
 	}
 
-	err := q.WhereAllowedTenants("", tenants).
-		Order("created_at ASC").
+	err := paginate(q, filters.Pagination, "faucet", "faucet.uuid", "created_at ASC").
+		WhereAllowedTenants("", tenants).
 		Select()
 	if err != nil && !errors.IsNotFoundError(err) {
 		errMessage := "failed to search faucet"
@@ -0,0 +1,11 @@
+package postgres
+
+import (
+	"encoding/json"
+)
+
+// labelsJSON formats labels to be matched against a jsonb column with the containment operator
+func labelsJSON(labels map[string]string) string {
+	b, _ := json.Marshal(labels)
+	return string(b)
+}
@@ -83,6 +83,84 @@ func (agent *PGJob) Insert(ctx context.Context, job *entities.Job, jobLog *entit
 	return nil
 }
 
+// InsertMultiple inserts the jobs with their transaction and a first log of the same status as the job
+func (agent *PGJob) InsertMultiple(ctx context.Context, jobs []*entities.Job) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	var scheduleUUIDs []string
+	for _, job := range jobs {
+		scheduleUUIDs = append(scheduleUUIDs, job.ScheduleUUID)
+	}
+
+	scheduleIDs, err := getScheduleIDsByUUIDs(ctx, agent.client, scheduleUUIDs, agent.logger)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	var jobModels []*models.Job
+	var txModels []*models.Transaction
+	for _, job := range jobs {
+		jobModel := models.NewJob(job)
+		if jobModel.UUID == "" {
+			jobModel.UUID = uuid.Must(uuid.NewV4()).String()
+		}
+		jobModel.CreatedAt = now
+		jobModel.UpdatedAt = now
+		scheduleID := scheduleIDs[job.ScheduleUUID]
+		jobModel.ScheduleID = &scheduleID
+		jobModels = append(jobModels, jobModel)
+
+		txModel := models.NewTransaction(job.Transaction)
+		txModel.UUID = uuid.Must(uuid.NewV4()).String()
+		txModel.CreatedAt = now
+		txModel.UpdatedAt = now
+		jobModel.Transaction = txModel
+		txModels = append(txModels, txModel)
+	}
+
+	err = agent.client.RunInTransaction(ctx, func(dbtx postgres.Client) error {
+		err = dbtx.ModelContext(ctx, &txModels).Insert()
+		if err != nil {
+			return err
+		}
+
+		for idx, jobModel := range jobModels {
+			jobModel.TransactionID = &txModels[idx].ID
+		}
+
+		err = dbtx.ModelContext(ctx, &jobModels).Insert()
+		if err != nil {
+			return err
+		}
+
+		var logModels []*models.Log
+		for _, jobModel := range jobModels {
+			logModels = append(logModels, &models.Log{
+				UUID:      uuid.Must(uuid.NewV4()).String(),
+				JobID:     &jobModel.ID,
+				Status:    jobModel.Status,
+				CreatedAt: now,
+			})
+		}
+
+		return dbtx.ModelContext(ctx, &logModels).Insert()
+	})
+	if err != nil {
+		errMsg := "failed to insert jobs"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	for idx, jobModel := range jobModels {
+		utils.CopyPtr(jobModel.ToEntity(), jobs[idx])
+	}
+
+	return nil
+}
+
 func (agent *PGJob) Update(ctx context.Context, job *entities.Job, jobLog *entities.Log) error {
 	curJobModel, err := getJobModelUUID(ctx, agent.client, job.UUID, agent.logger)
 	if err != nil {
@@ -208,6 +286,46 @@ func (agent *PGJob) Search(ctx context.Context, filters *entities.JobFilters, te
 		q = q.Where("job.status = ?", filters.Status)
 	}
 
+	if len(filters.Statuses) > 0 {
+		q = q.Where("job.status in (?)", pg.In(filters.Statuses))
+	}
+
+	if len(filters.Types) > 0 {
+		q = q.Where("job.type in (?)", pg.In(filters.Types))
+	}
+
+	if len(filters.Labels) > 0 {
+		q = q.Where("job.labels @> ?", labelsJSON(filters.Labels))
+	}
+
+	if filters.From != nil {
+		q = q.Where("transaction.sender = ?", filters.From.Hex())
+	}
+
+	if filters.To != nil {
+		q = q.Where("transaction.recipient = ?", filters.To.Hex())
+	}
+
+	if filters.ScheduleUUID != "" {
+		q = q.Where("schedule.uuid = ?", filters.ScheduleUUID)
+	}
+
+	if len(filters.IdempotencyKeys) > 0 {
+		q = q.Where("job.schedule_id in (SELECT schedule_id FROM transaction_requests WHERE idempotency_key in (?))", pg.In(filters.IdempotencyKeys))
+	}
+
+	if !filters.CreatedAfter.IsZero() {
+		q = q.Where("job.created_at >= ?", filters.CreatedAfter)
+	}
+
+	if !filters.CreatedBefore.IsZero() {
+		q = q.Where("job.created_at < ?", filters.CreatedBefore)
+	}
+
+	if !filters.UpdatedBefore.IsZero() {
+		q = q.Where("job.updated_at < ?", filters.UpdatedBefore)
+	}
+
 	if filters.ParentJobUUID != "" {
 		q = q.Where(fmt.Sprintf("(%s) OR (%s)",
 			"job.is_parent is false AND job.internal_data @> '{\"parentJobUUID\": \"?\"}'",
@@ -223,8 +341,8 @@ func (agent *PGJob) Search(ctx context.Context, filters *entities.JobFilters, te
 		q = q.Where("job.updated_at >= ?", filters.UpdatedAfter)
 	}
 
-	err := q.WhereAllowedTenants("schedule.tenant_id", tenants).
-		Order("id ASC").
+	err := paginate(q, filters.Pagination, "job", "job.uuid", "id ASC").
+		WhereAllowedTenants("schedule.tenant_id", tenants).
 		WhereAllowedOwner("schedule.owner_id", ownerID).
 		Select()
 	if err != nil && !errors.IsNotFoundError(err) {
@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createOutboxTable(db migrations.DB) error {
+	log.Debug("Creating outbox messages table...")
+
+	_, err := db.Exec(`
+CREATE TABLE outbox_messages (
+	id SERIAL PRIMARY KEY,
+	uuid UUID NOT NULL,
+	topic TEXT NOT NULL,
+	partition_key TEXT,
+	body BYTEA NOT NULL,
+	headers JSONB,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE(uuid)
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create outbox messages table")
+		return err
+	}
+
+	log.Info("Created outbox messages table")
+
+	return nil
+}
+
+func dropOutboxTable(db migrations.DB) error {
+	log.Debug("Dropping outbox messages table")
+
+	_, err := db.Exec(`DROP TABLE outbox_messages;`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop outbox messages table")
+		return err
+	}
+
+	log.Info("Dropped outbox messages table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createOutboxTable, dropOutboxTable)
+}
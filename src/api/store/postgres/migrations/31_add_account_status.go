@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addAccountStatusColumns(db migrations.DB) error {
+	log.Debug("Adding status and rotation columns to accounts table")
+
+	_, err := db.Exec(`
+ALTER TABLE accounts
+	ADD COLUMN status TEXT DEFAULT 'ACTIVE' NOT NULL,
+	ADD COLUMN rotated_from CHAR(42),
+	ADD COLUMN rotated_to CHAR(42);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add status and rotation columns to accounts table")
+		return err
+	}
+
+	log.Info("Added status and rotation columns to accounts table")
+
+	return nil
+}
+
+func dropAccountStatusColumns(db migrations.DB) error {
+	log.Debug("Removing status and rotation columns from accounts table")
+
+	_, err := db.Exec(`
+ALTER TABLE accounts
+	DROP COLUMN status,
+	DROP COLUMN rotated_from,
+	DROP COLUMN rotated_to;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove status and rotation columns from accounts table")
+		return err
+	}
+
+	log.Info("Removed status and rotation columns from accounts table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addAccountStatusColumns, dropAccountStatusColumns)
+}
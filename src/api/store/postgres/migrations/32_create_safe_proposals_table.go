@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createSafeProposalsTable(db migrations.DB) error {
+	log.Debug("Creating safe proposals table...")
+
+	_, err := db.Exec(`
+CREATE TABLE safe_proposals (
+	id SERIAL PRIMARY KEY,
+	uuid UUID NOT NULL,
+	chain_uuid UUID NOT NULL,
+	safe_address CHAR(42) NOT NULL,
+	sender CHAR(42) NOT NULL,
+	transaction JSONB NOT NULL,
+	safe_tx_hash CHAR(66) NOT NULL,
+	owners TEXT[] NOT NULL,
+	threshold BIGINT NOT NULL,
+	signatures JSONB,
+	status TEXT NOT NULL,
+	schedule_uuid UUID,
+	tx_hash CHAR(66),
+	labels JSONB,
+	tenant_id TEXT NOT NULL,
+	owner_id TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE(uuid)
+);
+
+CREATE INDEX safe_proposals_safe_address_idx on safe_proposals (safe_address, chain_uuid);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create safe proposals table")
+		return err
+	}
+
+	log.Info("Created safe proposals table")
+
+	return nil
+}
+
+func dropSafeProposalsTable(db migrations.DB) error {
+	log.Debug("Dropping safe proposals table")
+
+	_, err := db.Exec(`DROP TABLE safe_proposals;`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop safe proposals table")
+		return err
+	}
+
+	log.Info("Dropped safe proposals table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createSafeProposalsTable, dropSafeProposalsTable)
+}
@@ -0,0 +1,74 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createRelayersTable(db migrations.DB) error {
+	log.Debug("Creating relayers tables...")
+
+	_, err := db.Exec(`
+CREATE TABLE relayers (
+	id SERIAL PRIMARY KEY,
+	uuid UUID NOT NULL,
+	name TEXT NOT NULL,
+	chain_uuid UUID NOT NULL,
+	type TEXT NOT NULL,
+	account CHAR(42) NOT NULL,
+	contract_address CHAR(42) NOT NULL,
+	contract_name TEXT NOT NULL,
+	contract_tag TEXT NOT NULL,
+	domain_name TEXT,
+	domain_version TEXT,
+	tenant_id TEXT NOT NULL,
+	owner_id TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE(uuid),
+	UNIQUE(name, tenant_id)
+);
+
+CREATE INDEX relayers_chain_uuid_idx on relayers (chain_uuid, type);
+
+CREATE TABLE relay_spendings (
+	id SERIAL PRIMARY KEY,
+	relayer_uuid UUID NOT NULL REFERENCES relayers(uuid) ON DELETE CASCADE,
+	tenant_id TEXT NOT NULL,
+	tx_count BIGINT NOT NULL,
+	gas_used BIGINT NOT NULL,
+	fee NUMERIC NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE(relayer_uuid, tenant_id)
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create relayers tables")
+		return err
+	}
+
+	log.Info("Created relayers tables")
+
+	return nil
+}
+
+func dropRelayersTable(db migrations.DB) error {
+	log.Debug("Dropping relayers tables")
+
+	_, err := db.Exec(`
+DROP TABLE relay_spendings;
+DROP TABLE relayers;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop relayers tables")
+		return err
+	}
+
+	log.Info("Dropped relayers tables")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createRelayersTable, dropRelayersTable)
+}
@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+// Contracts registered before multi-tenancy of the registry are moved to the default tenant, shared by all tenants
+func addContractTenantColumns(db migrations.DB) error {
+	log.Debug("Adding tenant and owner columns to contract tables")
+
+	_, err := db.Exec(`
+ALTER TABLE repositories
+	ADD COLUMN tenant_id TEXT DEFAULT '_' NOT NULL,
+	ADD COLUMN owner_id TEXT,
+	DROP CONSTRAINT repositories_name_key;
+
+CREATE UNIQUE INDEX repositories_unique_name_idx ON repositories (tenant_id, COALESCE(owner_id, ''), name);
+
+ALTER TABLE codehashes
+	ADD COLUMN tenant_id TEXT DEFAULT '_' NOT NULL,
+	ADD COLUMN owner_id TEXT,
+	DROP CONSTRAINT codehashes_chain_id_address_key;
+
+CREATE UNIQUE INDEX codehashes_unique_address_idx ON codehashes (tenant_id, chain_id, address);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add tenant and owner columns to contract tables")
+		return err
+	}
+
+	log.Info("Added tenant and owner columns to contract tables")
+
+	return nil
+}
+
+func dropContractTenantColumns(db migrations.DB) error {
+	log.Debug("Removing tenant and owner columns from contract tables")
+
+	_, err := db.Exec(`
+DROP INDEX codehashes_unique_address_idx;
+
+ALTER TABLE codehashes
+	DROP COLUMN tenant_id,
+	DROP COLUMN owner_id,
+	ADD CONSTRAINT codehashes_chain_id_address_key UNIQUE (chain_id, address);
+
+DROP INDEX repositories_unique_name_idx;
+
+ALTER TABLE repositories
+	DROP COLUMN tenant_id,
+	DROP COLUMN owner_id,
+	ADD CONSTRAINT repositories_name_key UNIQUE (name);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not remove tenant and owner columns from contract tables")
+		return err
+	}
+
+	log.Info("Removed tenant and owner columns from contract tables")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addContractTenantColumns, dropContractTenantColumns)
+}
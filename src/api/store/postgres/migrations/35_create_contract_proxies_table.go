@@ -0,0 +1,51 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createContractProxiesTable(db migrations.DB) error {
+	log.Debug("Creating contract proxies table...")
+
+	_, err := db.Exec(`
+CREATE TABLE contract_proxies (
+	id SERIAL PRIMARY KEY,
+	chain_id VARCHAR(66) NOT NULL,
+	address CHAR(42) NOT NULL,
+	implementation CHAR(42) NOT NULL,
+	kind TEXT NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE(chain_id, address)
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create contract proxies table")
+		return err
+	}
+
+	log.Info("Created contract proxies table")
+
+	return nil
+}
+
+func dropContractProxiesTable(db migrations.DB) error {
+	log.Debug("Dropping contract proxies table...")
+
+	_, err := db.Exec(`
+DROP TABLE contract_proxies;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop contract proxies table")
+		return err
+	}
+
+	log.Info("Dropped contract proxies table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createContractProxiesTable, dropContractProxiesTable)
+}
@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createEventLogsTable(db migrations.DB) error {
+	log.Debug("Creating event logs table...")
+
+	_, err := db.Exec(`
+CREATE TABLE event_logs (
+	id SERIAL PRIMARY KEY,
+	chain_uuid UUID NOT NULL,
+	address CHAR(42) NOT NULL,
+	sig_hash CHAR(66),
+	topic1 CHAR(66),
+	topic2 CHAR(66),
+	topic3 CHAR(66),
+	data TEXT,
+	block_number BIGINT NOT NULL,
+	block_hash CHAR(66) NOT NULL,
+	tx_hash CHAR(66) NOT NULL,
+	log_index INTEGER NOT NULL,
+	event TEXT,
+	decoded_data JSONB,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE(chain_uuid, tx_hash, log_index)
+);
+
+CREATE INDEX event_logs_address_idx on event_logs (chain_uuid, address, sig_hash, block_number, log_index);
+CREATE INDEX event_logs_block_idx on event_logs (chain_uuid, block_number, log_index);
+CREATE INDEX event_logs_topic1_idx on event_logs (chain_uuid, sig_hash, topic1);
+CREATE INDEX event_logs_topic2_idx on event_logs (chain_uuid, sig_hash, topic2);
+CREATE INDEX event_logs_topic3_idx on event_logs (chain_uuid, sig_hash, topic3);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create event logs table")
+		return err
+	}
+
+	log.Info("Created event logs table")
+
+	return nil
+}
+
+func dropEventLogsTable(db migrations.DB) error {
+	log.Debug("Dropping event logs table...")
+
+	_, err := db.Exec(`
+DROP TABLE event_logs;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop event logs table")
+		return err
+	}
+
+	log.Info("Dropped event logs table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createEventLogsTable, dropEventLogsTable)
+}
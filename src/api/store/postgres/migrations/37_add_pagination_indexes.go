@@ -0,0 +1,54 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addPaginationIndexes(db migrations.DB) error {
+	log.Debug("Adding pagination indexes...")
+	_, err := db.Exec(`
+CREATE INDEX jobs_created_at_uuid_idx on jobs (created_at, uuid);
+
+CREATE INDEX jobs_updated_at_uuid_idx on jobs (updated_at, uuid);
+
+CREATE INDEX transaction_requests_created_at_idx on transaction_requests (created_at);
+
+CREATE INDEX accounts_created_at_address_idx on accounts (created_at, address);
+
+CREATE INDEX accounts_updated_at_address_idx on accounts (updated_at, address);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add pagination indexes")
+		return err
+	}
+	log.Info("Added pagination indexes")
+
+	return nil
+}
+
+func dropPaginationIndexes(db migrations.DB) error {
+	log.Debug("Dropping pagination indexes...")
+	_, err := db.Exec(`
+DROP INDEX jobs_created_at_uuid_idx;
+
+DROP INDEX jobs_updated_at_uuid_idx;
+
+DROP INDEX transaction_requests_created_at_idx;
+
+DROP INDEX accounts_created_at_address_idx;
+
+DROP INDEX accounts_updated_at_address_idx;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop pagination indexes")
+		return err
+	}
+	log.Info("Dropped pagination indexes")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addPaginationIndexes, dropPaginationIndexes)
+}
@@ -0,0 +1,62 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createAccountNoncesTables(db migrations.DB) error {
+	log.Debug("Creating account nonces tables...")
+
+	_, err := db.Exec(`
+CREATE TABLE account_nonces (
+	key TEXT PRIMARY KEY,
+	next BIGINT,
+	last_sent BIGINT,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE TABLE account_nonce_allocations (
+	key TEXT NOT NULL,
+	nonce BIGINT NOT NULL,
+	released BOOLEAN DEFAULT false NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	PRIMARY KEY (key, nonce)
+);
+
+CREATE TABLE nonce_recoveries (
+	key TEXT PRIMARY KEY,
+	count BIGINT DEFAULT 0 NOT NULL
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create account nonces tables")
+		return err
+	}
+	log.Info("Created account nonces tables")
+
+	return nil
+}
+
+func dropAccountNoncesTables(db migrations.DB) error {
+	log.Debug("Dropping account nonces tables...")
+
+	_, err := db.Exec(`
+DROP TABLE nonce_recoveries;
+
+DROP TABLE account_nonce_allocations;
+
+DROP TABLE account_nonces;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop account nonces tables")
+		return err
+	}
+	log.Info("Dropped account nonces tables")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createAccountNoncesTables, dropAccountNoncesTables)
+}
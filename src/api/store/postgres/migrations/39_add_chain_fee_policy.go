@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addChainFeePolicy(db migrations.DB) error {
+	log.Debug("Adding chain fee policy...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	ADD COLUMN fee_policy JSONB;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add chain fee policy")
+		return err
+	}
+	log.Info("Added chain fee policy")
+
+	return nil
+}
+
+func dropChainFeePolicy(db migrations.DB) error {
+	log.Debug("Dropping chain fee policy...")
+	_, err := db.Exec(`
+ALTER TABLE chains
+	DROP COLUMN fee_policy;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop chain fee policy")
+		return err
+	}
+	log.Info("Dropped chain fee policy")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addChainFeePolicy, dropChainFeePolicy)
+}
@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createSentrySessionsTable(db migrations.DB) error {
+	log.Debug("Creating sentry sessions table...")
+
+	_, err := db.Exec(`
+CREATE TABLE sentry_sessions (
+	id SERIAL PRIMARY KEY,
+	job_uuid UUID NOT NULL UNIQUE,
+	chain_uuid UUID NOT NULL,
+	retries INTEGER DEFAULT 0 NOT NULL,
+	n_children INTEGER DEFAULT 0 NOT NULL,
+	last_child_job_uuid UUID,
+	next_attempt_at TIMESTAMPTZ NOT NULL,
+	tenant_id TEXT NOT NULL,
+	owner_id TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+CREATE INDEX sentry_sessions_chain_uuid_idx on sentry_sessions (chain_uuid);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create sentry sessions table")
+		return err
+	}
+
+	log.Info("Created sentry sessions table")
+
+	return nil
+}
+
+func dropSentrySessionsTable(db migrations.DB) error {
+	log.Debug("Dropping sentry sessions table...")
+
+	_, err := db.Exec(`
+DROP TABLE sentry_sessions;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop sentry sessions table")
+		return err
+	}
+
+	log.Info("Dropped sentry sessions table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createSentrySessionsTable, dropSentrySessionsTable)
+}
@@ -0,0 +1,46 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createKeystoreKeysTable(db migrations.DB) error {
+	log.Debug("Creating keystore keys table...")
+
+	_, err := db.Exec(`
+CREATE TABLE keystore_keys (
+	address CHAR(42) PRIMARY KEY,
+	key_id TEXT,
+	tags JSONB,
+	key_json TEXT NOT NULL,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create keystore keys table")
+		return err
+	}
+	log.Info("Created keystore keys table")
+
+	return nil
+}
+
+func dropKeystoreKeysTable(db migrations.DB) error {
+	log.Debug("Dropping keystore keys table...")
+
+	_, err := db.Exec(`
+DROP TABLE keystore_keys;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop keystore keys table")
+		return err
+	}
+	log.Info("Dropped keystore keys table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createKeystoreKeysTable, dropKeystoreKeysTable)
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createPrivacyGroupsTable(db migrations.DB) error {
+	log.Debug("Creating privacy groups table...")
+
+	_, err := db.Exec(`
+CREATE TABLE privacy_groups (
+	id SERIAL PRIMARY KEY,
+	uuid UUID NOT NULL,
+	name TEXT NOT NULL,
+	chain_uuid UUID NOT NULL,
+	privacy_group_id TEXT NOT NULL,
+	description TEXT,
+	members TEXT[] NOT NULL,
+	labels JSONB,
+	tenant_id TEXT NOT NULL,
+	owner_id TEXT,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+	UNIQUE(uuid),
+	UNIQUE(name, chain_uuid, tenant_id)
+);
+
+CREATE INDEX privacy_groups_chain_uuid_idx on privacy_groups (chain_uuid, privacy_group_id);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create privacy groups table")
+		return err
+	}
+	log.Info("Created privacy groups table")
+
+	return nil
+}
+
+func dropPrivacyGroupsTable(db migrations.DB) error {
+	log.Debug("Dropping privacy groups table...")
+
+	_, err := db.Exec(`
+DROP TABLE privacy_groups;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop privacy groups table")
+		return err
+	}
+	log.Info("Dropped privacy groups table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createPrivacyGroupsTable, dropPrivacyGroupsTable)
+}
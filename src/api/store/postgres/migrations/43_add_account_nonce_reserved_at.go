@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addAccountNonceReservedAt(db migrations.DB) error {
+	log.Debug("Adding account nonce reservation time...")
+	_, err := db.Exec(`
+ALTER TABLE account_nonce_allocations
+	ADD COLUMN reserved_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add account nonce reservation time")
+		return err
+	}
+	log.Info("Added account nonce reservation time")
+
+	return nil
+}
+
+func dropAccountNonceReservedAt(db migrations.DB) error {
+	log.Debug("Dropping account nonce reservation time...")
+	_, err := db.Exec(`
+ALTER TABLE account_nonce_allocations
+	DROP COLUMN reserved_at;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop account nonce reservation time")
+		return err
+	}
+	log.Info("Dropped account nonce reservation time")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addAccountNonceReservedAt, dropAccountNonceReservedAt)
+}
@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func createRelaySpendingJobsTable(db migrations.DB) error {
+	log.Debug("Creating relay spending jobs table...")
+	_, err := db.Exec(`
+CREATE TABLE relay_spending_jobs (
+	job_uuid UUID PRIMARY KEY,
+	relayer_uuid UUID NOT NULL REFERENCES relayers(uuid) ON DELETE CASCADE,
+	created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not create relay spending jobs table")
+		return err
+	}
+	log.Info("Created relay spending jobs table")
+
+	return nil
+}
+
+func dropRelaySpendingJobsTable(db migrations.DB) error {
+	log.Debug("Dropping relay spending jobs table...")
+	_, err := db.Exec(`
+DROP TABLE relay_spending_jobs;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop relay spending jobs table")
+		return err
+	}
+	log.Info("Dropped relay spending jobs table")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(createRelaySpendingJobsTable, dropRelaySpendingJobsTable)
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/go-pg/migrations/v7"
+	log "github.com/sirupsen/logrus"
+)
+
+func addJobPrivateReceipt(db migrations.DB) error {
+	log.Debug("Adding job private receipt...")
+	_, err := db.Exec(`
+ALTER TABLE jobs
+	ADD COLUMN private_receipt jsonb;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not add job private receipt")
+		return err
+	}
+	log.Info("Added job private receipt")
+
+	return nil
+}
+
+func dropJobPrivateReceipt(db migrations.DB) error {
+	log.Debug("Dropping job private receipt...")
+	_, err := db.Exec(`
+ALTER TABLE jobs
+	DROP COLUMN private_receipt;
+`)
+	if err != nil {
+		log.WithError(err).Error("Could not drop job private receipt")
+		return err
+	}
+	log.Info("Dropped job private receipt")
+
+	return nil
+}
+
+func init() {
+	Collection.MustRegisterTx(addJobPrivateReceipt, dropJobPrivateReceipt)
+}
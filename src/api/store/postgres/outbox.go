@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/go-pg/pg/v10"
+	"github.com/gofrs/uuid"
+)
+
+// outboxLockKey identifies the advisory lock allowing a single relay to publish outbox messages at a time,
+// so that messages are published in order
+const outboxLockKey = 202203301
+
+type PGOutbox struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+var _ store.OutboxAgent = &PGOutbox{}
+
+func NewPGOutbox(client postgres.Client) *PGOutbox {
+	return &PGOutbox{
+		client: client,
+		logger: log.NewLogger().SetComponent("data-agents.outbox"),
+	}
+}
+
+func (agent *PGOutbox) Insert(ctx context.Context, msg *entities.OutboxMessage) error {
+	model := models.NewOutboxMessage(msg)
+	model.UUID = uuid.Must(uuid.NewV4()).String()
+	model.CreatedAt = time.Now().UTC()
+
+	err := agent.client.ModelContext(ctx, model).Insert()
+	if err != nil {
+		errMsg := "failed to insert outbox message"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	msg.UUID = model.UUID
+	msg.CreatedAt = model.CreatedAt
+
+	return nil
+}
+
+func (agent *PGOutbox) LockPending(ctx context.Context, limit int) ([]*entities.OutboxMessage, error) {
+	var locked bool
+	err := agent.client.QueryOneContext(ctx, pg.Scan(&locked), "SELECT pg_try_advisory_xact_lock(?)", outboxLockKey)
+	if err != nil {
+		errMsg := "failed to lock outbox messages"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	// Another relay is already publishing messages
+	if !locked {
+		return []*entities.OutboxMessage{}, nil
+	}
+
+	var msgs []*models.OutboxMessage
+	err = agent.client.ModelContext(ctx, &msgs).Order("id ASC").Limit(limit).Select()
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to fetch pending outbox messages"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return models.NewOutboxMessages(msgs), nil
+}
+
+func (agent *PGOutbox) Delete(ctx context.Context, uuids []string) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	err := agent.client.ModelContext(ctx, (*models.OutboxMessage)(nil)).Where("uuid in (?)", pg.In(uuids)).Delete()
+	if err != nil {
+		errMsg := "failed to delete outbox messages"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+)
+
+// paginate sorts the results by the timestamp of the pagination then by their unique key, starting after the cursor,
+// or by the default order if the search is not paginated
+func paginate(q postgres.Query, pagination *entities.Pagination, table, key, defaultOrder string) postgres.Query {
+	if pagination == nil {
+		return q.Order(defaultOrder)
+	}
+
+	column := table + ".created_at"
+	if pagination.SortBy == entities.SortByUpdatedAt {
+		column = table + ".updated_at"
+	}
+
+	direction, comparison := "ASC", ">"
+	if pagination.Order == entities.SortOrderDesc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if pagination.After != nil {
+		q = q.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", column, key, comparison), pagination.After.Time, pagination.After.Key)
+	}
+
+	q = q.OrderExpr(fmt.Sprintf("%s %s, %s %s", column, direction, key, direction))
+	if pagination.Limit > 0 {
+		q = q.Limit(pagination.Limit)
+	}
+
+	return q
+}
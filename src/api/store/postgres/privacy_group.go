@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/go-pg/pg/v10"
+	"github.com/gofrs/uuid"
+)
+
+type PGPrivacyGroup struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+var _ store.PrivacyGroupAgent = &PGPrivacyGroup{}
+
+func NewPGPrivacyGroup(client postgres.Client) *PGPrivacyGroup {
+	return &PGPrivacyGroup{
+		client: client,
+		logger: log.NewLogger().SetComponent("data-agents.privacy-group"),
+	}
+}
+
+func (agent *PGPrivacyGroup) Insert(ctx context.Context, group *entities.PrivacyGroup) (*entities.PrivacyGroup, error) {
+	model := models.NewPrivacyGroup(group)
+	model.UUID = uuid.Must(uuid.NewV4()).String()
+	model.CreatedAt = time.Now().UTC()
+	model.UpdatedAt = model.CreatedAt
+
+	err := agent.client.ModelContext(ctx, model).Insert()
+	if err != nil {
+		errMsg := "failed to insert privacy group"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGPrivacyGroup) FindOneByUUID(ctx context.Context, groupUUID string, tenants []string, ownerID string) (*entities.PrivacyGroup, error) {
+	model := &models.PrivacyGroup{}
+	err := agent.client.ModelContext(ctx, model).
+		Where("uuid = ?", groupUUID).
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		SelectOne()
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.FromError(err).SetMessage("privacy group not found")
+		}
+
+		errMsg := "failed to select privacy group"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGPrivacyGroup) Search(ctx context.Context, filters *entities.PrivacyGroupFilters, tenants []string, ownerID string) ([]*entities.PrivacyGroup, error) {
+	var groups []*models.PrivacyGroup
+
+	q := agent.client.ModelContext(ctx, &groups)
+	if len(filters.Names) > 0 {
+		q = q.Where("name in (?)", pg.In(filters.Names))
+	}
+	if filters.ChainUUID != "" {
+		q = q.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+	if filters.PrivacyGroupID != "" {
+		q = q.Where("privacy_group_id = ?", filters.PrivacyGroupID)
+	}
+	if filters.TenantID != "" {
+		q = q.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	err := paginate(q, filters.Pagination, "privacy_group", "privacy_group.uuid", "id ASC").
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Select()
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to search privacy groups"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return models.NewPrivacyGroups(groups), nil
+}
+
+func (agent *PGPrivacyGroup) Delete(ctx context.Context, groupUUID string, tenants []string, ownerID string) error {
+	err := agent.client.ModelContext(ctx, &models.PrivacyGroup{}).
+		Where("uuid = ?", groupUUID).
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Delete()
+	if err != nil {
+		errMsg := "failed to delete privacy group"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return nil
+}
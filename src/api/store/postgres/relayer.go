@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/go-pg/pg/v10"
+	"github.com/gofrs/uuid"
+)
+
+type PGRelayer struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+var _ store.RelayerAgent = &PGRelayer{}
+
+func NewPGRelayer(client postgres.Client) *PGRelayer {
+	return &PGRelayer{
+		client: client,
+		logger: log.NewLogger().SetComponent("data-agents.relayer"),
+	}
+}
+
+func (agent *PGRelayer) Insert(ctx context.Context, relayer *entities.Relayer) (*entities.Relayer, error) {
+	model := models.NewRelayer(relayer)
+	model.UUID = uuid.Must(uuid.NewV4()).String()
+	model.CreatedAt = time.Now().UTC()
+	model.UpdatedAt = model.CreatedAt
+
+	err := agent.client.ModelContext(ctx, model).Insert()
+	if err != nil {
+		errMsg := "failed to insert relayer"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGRelayer) FindOneByUUID(ctx context.Context, relayerUUID string, tenants []string, ownerID string) (*entities.Relayer, error) {
+	model := &models.Relayer{}
+	err := agent.client.ModelContext(ctx, model).
+		Where("uuid = ?", relayerUUID).
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		SelectOne()
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.FromError(err).SetMessage("relayer not found")
+		}
+
+		errMsg := "failed to select relayer"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGRelayer) Search(ctx context.Context, filters *entities.RelayerFilters, tenants []string, ownerID string) ([]*entities.Relayer, error) {
+	var relayers []*models.Relayer
+
+	q := agent.client.ModelContext(ctx, &relayers)
+	if len(filters.Names) > 0 {
+		q = q.Where("name in (?)", pg.In(filters.Names))
+	}
+	if filters.ChainUUID != "" {
+		q = q.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+	if filters.Type != "" {
+		q = q.Where("type = ?", filters.Type)
+	}
+	if filters.TenantID != "" {
+		q = q.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	err := paginate(q, filters.Pagination, "relayer", "relayer.uuid", "id ASC").
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Select()
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to search relayers"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return models.NewRelayers(relayers), nil
+}
+
+func (agent *PGRelayer) Delete(ctx context.Context, relayerUUID string, tenants []string, ownerID string) error {
+	err := agent.client.ModelContext(ctx, &models.Relayer{}).
+		Where("uuid = ?", relayerUUID).
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Delete()
+	if err != nil {
+		errMsg := "failed to delete relayer"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return nil
+}
+
+// Counts the spending of a job only once, the job being recorded with the increment in the same statement
+const addSpendingQuery = `
+WITH spent AS (
+	INSERT INTO relay_spending_jobs (job_uuid, relayer_uuid) VALUES (?0, ?1)
+	ON CONFLICT (job_uuid) DO NOTHING
+	RETURNING job_uuid
+), added AS (
+	INSERT INTO relay_spendings (relayer_uuid, tenant_id, tx_count, gas_used, fee, updated_at)
+	SELECT ?1::uuid, ?2, ?3, ?4, ?5::numeric, ?6 FROM spent
+	ON CONFLICT (relayer_uuid, tenant_id) DO UPDATE SET
+		tx_count = relay_spendings.tx_count + EXCLUDED.tx_count,
+		gas_used = relay_spendings.gas_used + EXCLUDED.gas_used,
+		fee = relay_spendings.fee + EXCLUDED.fee,
+		updated_at = EXCLUDED.updated_at
+	RETURNING id
+)
+SELECT count(*) FROM added`
+
+// AddSpending increments the spending of the tenant atomically, creating it on first relayed transaction.
+// The spending of a job is added only once, false being returned if it was already counted
+func (agent *PGRelayer) AddSpending(ctx context.Context, jobUUID string, spending *entities.RelaySpending) (bool, error) {
+	model := models.NewRelaySpending(spending)
+
+	var added int
+	err := agent.client.QueryOneContext(ctx, pg.Scan(&added), addSpendingQuery, jobUUID, model.RelayerUUID, model.TenantID,
+		model.TxCount, model.GasUsed, model.Fee, time.Now().UTC())
+	if err != nil {
+		errMsg := "failed to add relay spending"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return false, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return added > 0, nil
+}
+
+func (agent *PGRelayer) SearchSpendings(ctx context.Context, filters *entities.RelaySpendingFilters, tenants []string) ([]*entities.RelaySpending, error) {
+	var spendings []*models.RelaySpending
+
+	q := agent.client.ModelContext(ctx, &spendings)
+	if filters.RelayerUUID != "" {
+		q = q.Where("relayer_uuid = ?", filters.RelayerUUID)
+	}
+	if filters.TenantID != "" {
+		q = q.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	err := q.WhereAllowedTenants("", tenants).Order("id ASC").Select()
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to search relay spendings"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return models.NewRelaySpendings(spendings), nil
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/gofrs/uuid"
+)
+
+type PGSafeProposal struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+var _ store.SafeProposalAgent = &PGSafeProposal{}
+
+func NewPGSafeProposal(client postgres.Client) *PGSafeProposal {
+	return &PGSafeProposal{
+		client: client,
+		logger: log.NewLogger().SetComponent("data-agents.safe_proposal"),
+	}
+}
+
+func (agent *PGSafeProposal) Insert(ctx context.Context, proposal *entities.SafeProposal) (*entities.SafeProposal, error) {
+	model := models.NewSafeProposal(proposal)
+	model.UUID = uuid.Must(uuid.NewV4()).String()
+	model.CreatedAt = time.Now().UTC()
+	model.UpdatedAt = model.CreatedAt
+
+	err := agent.client.ModelContext(ctx, model).Insert()
+	if err != nil {
+		errMsg := "failed to insert safe proposal"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGSafeProposal) Update(ctx context.Context, proposal *entities.SafeProposal) (*entities.SafeProposal, error) {
+	model := models.NewSafeProposal(proposal)
+	model.UpdatedAt = time.Now().UTC()
+
+	err := agent.client.ModelContext(ctx, model).
+		Where("uuid = ?", proposal.UUID).
+		UpdateNotZero()
+	if err != nil {
+		errMsg := "failed to update safe proposal"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGSafeProposal) FindOneByUUID(ctx context.Context, proposalUUID string, tenants []string, ownerID string) (*entities.SafeProposal, error) {
+	return agent.findOneByUUID(ctx, proposalUUID, tenants, ownerID, false)
+}
+
+func (agent *PGSafeProposal) LockOneByUUID(ctx context.Context, proposalUUID string, tenants []string, ownerID string) (*entities.SafeProposal, error) {
+	return agent.findOneByUUID(ctx, proposalUUID, tenants, ownerID, true)
+}
+
+func (agent *PGSafeProposal) Search(ctx context.Context, filters *entities.SafeProposalFilters, tenants []string, ownerID string) ([]*entities.SafeProposal, error) {
+	var proposals []*models.SafeProposal
+
+	q := agent.client.ModelContext(ctx, &proposals)
+	if filters.SafeAddress != nil {
+		q = q.Where("safe_address = ?", filters.SafeAddress.Hex())
+	}
+	if filters.ChainUUID != "" {
+		q = q.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+	if filters.Status != "" {
+		q = q.Where("status = ?", filters.Status)
+	}
+	if filters.TenantID != "" {
+		q = q.Where("tenant_id = ?", filters.TenantID)
+	}
+
+	err := paginate(q, filters.Pagination, "safe_proposal", "safe_proposal.uuid", "id ASC").
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Select()
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to search safe proposals"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return models.NewSafeProposals(proposals), nil
+}
+
+func (agent *PGSafeProposal) findOneByUUID(ctx context.Context, proposalUUID string, tenants []string, ownerID string, forUpdate bool) (*entities.SafeProposal, error) {
+	model := &models.SafeProposal{}
+	q := agent.client.ModelContext(ctx, model).
+		Where("uuid = ?", proposalUUID).
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID)
+	if forUpdate {
+		q = q.For("UPDATE")
+	}
+
+	err := q.SelectOne()
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.FromError(err).SetMessage("safe proposal not found")
+		}
+
+		errMsg := "failed to select safe proposal"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
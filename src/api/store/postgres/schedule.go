@@ -10,6 +10,7 @@ import (
 	"github.com/consensys/orchestrate/src/api/store"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/go-pg/pg/v10"
 	"github.com/gofrs/uuid"
 
 	"github.com/consensys/orchestrate/src/api/store/models"
@@ -87,6 +88,34 @@ func (agent *PGSchedule) FindAll(ctx context.Context, tenants []string, ownerID
 	return models.NewSchedules(schedules), nil
 }
 
+func (agent *PGSchedule) InsertMultiple(ctx context.Context, schedules []*entities.Schedule) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	var scheduleModels []*models.Schedule
+	for _, schedule := range schedules {
+		model := models.NewSchedule(schedule)
+		model.UUID = uuid.Must(uuid.NewV4()).String()
+		model.CreatedAt = now
+		scheduleModels = append(scheduleModels, model)
+	}
+
+	err := agent.client.ModelContext(ctx, &scheduleModels).Insert()
+	if err != nil {
+		errMessage := "failed to insert schedules"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	for idx, model := range scheduleModels {
+		utils.CopyPtr(model.ToEntity(), schedules[idx])
+	}
+
+	return nil
+}
+
 func getScheduleIDByUUID(ctx context.Context, client postgres.Client, scheduleUUID string, logger *log.Logger) (int, error) {
 	model := &models.Schedule{}
 	err := client.ModelContext(ctx, model).Column("id").Where("uuid = ?", scheduleUUID).Select()
@@ -98,3 +127,20 @@ func getScheduleIDByUUID(ctx context.Context, client postgres.Client, scheduleUU
 
 	return model.ID, nil
 }
+
+func getScheduleIDsByUUIDs(ctx context.Context, client postgres.Client, scheduleUUIDs []string, logger *log.Logger) (map[string]int, error) {
+	var scheduleModels []*models.Schedule
+	err := client.ModelContext(ctx, &scheduleModels).Column("id", "uuid").Where("uuid in (?)", pg.In(scheduleUUIDs)).Select()
+	if err != nil {
+		errMsg := "failed to find schedules by uuid"
+		logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	scheduleIDs := make(map[string]int, len(scheduleModels))
+	for _, model := range scheduleModels {
+		scheduleIDs[model.UUID] = model.ID
+	}
+
+	return scheduleIDs, nil
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/store"
+	"github.com/consensys/orchestrate/src/api/store/models"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+)
+
+type PGSentrySession struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+var _ store.SentrySessionAgent = &PGSentrySession{}
+
+func NewPGSentrySession(client postgres.Client) *PGSentrySession {
+	return &PGSentrySession{
+		client: client,
+		logger: log.NewLogger().SetComponent("data-agents.sentry-session"),
+	}
+}
+
+func (agent *PGSentrySession) Insert(ctx context.Context, session *entities.SentrySession) (*entities.SentrySession, error) {
+	model := models.NewSentrySession(session)
+	model.CreatedAt = time.Now().UTC()
+	model.UpdatedAt = model.CreatedAt
+
+	err := agent.client.ModelContext(ctx, model).Insert()
+	if err != nil {
+		errMsg := "failed to insert sentry session"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGSentrySession) Update(ctx context.Context, session *entities.SentrySession) (*entities.SentrySession, error) {
+	model := models.NewSentrySession(session)
+	model.UpdatedAt = time.Now().UTC()
+
+	err := agent.client.ModelContext(ctx, model).
+		Column("retries", "n_children", "last_child_job_uuid", "next_attempt_at", "updated_at").
+		Where("job_uuid = ?", session.JobUUID).
+		Update()
+	if err != nil {
+		errMsg := "failed to update sentry session"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGSentrySession) FindOneByJobUUID(ctx context.Context, jobUUID string, tenants []string, ownerID string) (*entities.SentrySession, error) {
+	model := &models.SentrySession{}
+	err := agent.client.ModelContext(ctx, model).
+		Where("job_uuid = ?", jobUUID).
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		SelectOne()
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.FromError(err).SetMessage("sentry session not found")
+		}
+
+		errMsg := "failed to select sentry session"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return model.ToEntity(), nil
+}
+
+func (agent *PGSentrySession) Search(ctx context.Context, filters *entities.SentrySessionFilters, tenants []string, ownerID string) ([]*entities.SentrySession, error) {
+	var sessions []*models.SentrySession
+
+	q := agent.client.ModelContext(ctx, &sessions)
+	if filters.ChainUUID != "" {
+		q = q.Where("chain_uuid = ?", filters.ChainUUID)
+	}
+
+	err := q.WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Order("id ASC").
+		Select()
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to search sentry sessions"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return models.NewSentrySessions(sessions), nil
+}
+
+func (agent *PGSentrySession) Delete(ctx context.Context, jobUUID string, tenants []string, ownerID string) error {
+	err := agent.client.ModelContext(ctx, &models.SentrySession{}).
+		Where("job_uuid = ?", jobUUID).
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Delete()
+	if err != nil {
+		errMsg := "failed to delete sentry session"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return nil
+}
@@ -19,6 +19,12 @@ type PGStore struct {
 	eventStream   store.EventStreamAgent
 	subscription  store.SubscriptionAgent
 	notification  store.NotificationAgent
+	outbox        store.OutboxAgent
+	safeProposal  store.SafeProposalAgent
+	relayer       store.RelayerAgent
+	eventLog      store.EventLogAgent
+	sentrySession store.SentrySessionAgent
+	privacyGroup  store.PrivacyGroupAgent
 	client        postgres.Client
 }
 
@@ -37,6 +43,12 @@ func New(client postgres.Client) *PGStore {
 		eventStream:   NewPGEventStream(client),
 		subscription:  NewPGSubscription(client),
 		notification:  NewPGNotification(client),
+		outbox:        NewPGOutbox(client),
+		safeProposal:  NewPGSafeProposal(client),
+		relayer:       NewPGRelayer(client),
+		eventLog:      NewPGEventLog(client),
+		sentrySession: NewPGSentrySession(client),
+		privacyGroup:  NewPGPrivacyGroup(client),
 		client:        client,
 	}
 }
@@ -85,6 +97,30 @@ func (s *PGStore) Notification() store.NotificationAgent {
 	return s.notification
 }
 
+func (s *PGStore) Outbox() store.OutboxAgent {
+	return s.outbox
+}
+
+func (s *PGStore) SafeProposal() store.SafeProposalAgent {
+	return s.safeProposal
+}
+
+func (s *PGStore) Relayer() store.RelayerAgent {
+	return s.relayer
+}
+
+func (s *PGStore) EventLog() store.EventLogAgent {
+	return s.eventLog
+}
+
+func (s *PGStore) SentrySession() store.SentrySessionAgent {
+	return s.sentrySession
+}
+
+func (s *PGStore) PrivacyGroup() store.PrivacyGroupAgent {
+	return s.privacyGroup
+}
+
 func (s *PGStore) RunInTransaction(ctx context.Context, persist func(a store.DB) error) error {
 	return s.client.RunInTransaction(ctx, func(dbTx postgres.Client) error {
 		return persist(New(dbTx))
@@ -64,7 +64,10 @@ func (agent *PGSubscription) Search(ctx context.Context, filters *entities.Subsc
 		q = q.Where("chain_uuid = ?", filters.ChainUUID)
 	}
 
-	err := q.WhereAllowedTenants("", tenants).WhereAllowedOwner("", ownerID).Order("id ASC").Select()
+	err := paginate(q, filters.Pagination, "subscription", "subscription.uuid", "id ASC").
+		WhereAllowedTenants("", tenants).
+		WhereAllowedOwner("", ownerID).
+		Select()
 	if err != nil && !errors.IsNotFoundError(err) {
 		errMsg := "failed to search subscriptions"
 		agent.logger.WithContext(ctx).WithError(err).Error(errMsg)
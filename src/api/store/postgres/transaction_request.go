@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/consensys/orchestrate/src/infra/postgres"
@@ -52,6 +54,44 @@ func (agent *PGTransactionRequest) Insert(ctx context.Context, txRequest *entiti
 	return model.ToEntity(), nil
 }
 
+func (agent *PGTransactionRequest) InsertMultiple(ctx context.Context, txRequests []*entities.TxRequest, requestHashes []string) error {
+	if len(txRequests) == 0 {
+		return nil
+	}
+
+	var scheduleUUIDs []string
+	for _, txRequest := range txRequests {
+		scheduleUUIDs = append(scheduleUUIDs, txRequest.Schedule.UUID)
+	}
+
+	scheduleIDs, err := getScheduleIDsByUUIDs(ctx, agent.client, scheduleUUIDs, agent.logger)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	var txRequestModels []*models.TransactionRequest
+	for idx, txRequest := range txRequests {
+		txRequest.CreatedAt = now
+		txRequest.Params.CreatedAt = now
+		txRequest.Params.UpdatedAt = now
+
+		model := models.NewTxRequest(txRequest, requestHashes[idx])
+		scheduleID := scheduleIDs[txRequest.Schedule.UUID]
+		model.ScheduleID = &scheduleID
+		txRequestModels = append(txRequestModels, model)
+	}
+
+	err = agent.client.ModelContext(ctx, &txRequestModels).Insert()
+	if err != nil {
+		errMessage := "failed to insert transaction requests"
+		agent.logger.WithContext(ctx).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
 func (agent *PGTransactionRequest) FindOneByIdempotencyKey(ctx context.Context, idempotencyKey, tenantID, ownerID string) (*entities.TxRequest, error) {
 	txRequest := &models.TransactionRequest{}
 
@@ -105,7 +145,56 @@ func (agent *PGTransactionRequest) Search(ctx context.Context, filters *entities
 		q = q.Where("transaction_request.idempotency_key in (?)", pg.In(filters.IdempotencyKeys))
 	}
 
-	err := q.WhereAllowedTenants("schedule.tenant_id", tenants).
+	if filters.ScheduleUUID != "" {
+		q = q.Where("schedule.uuid = ?", filters.ScheduleUUID)
+	}
+
+	// Transaction parameters are stored as JSON, addresses being marshalled in lower case
+	if filters.From != nil {
+		q = q.Where("transaction_request.params->>'From' = ?", strings.ToLower(filters.From.Hex()))
+	}
+
+	if filters.To != nil {
+		q = q.Where("transaction_request.params->>'To' = ?", strings.ToLower(filters.To.Hex()))
+	}
+
+	if !filters.CreatedAfter.IsZero() {
+		q = q.Where("transaction_request.created_at >= ?", filters.CreatedAfter)
+	}
+
+	if !filters.CreatedBefore.IsZero() {
+		q = q.Where("transaction_request.created_at < ?", filters.CreatedBefore)
+	}
+
+	// Statuses, types and labels are held by the jobs of the transaction request
+	if len(filters.Statuses) > 0 || len(filters.Types) > 0 || len(filters.Labels) > 0 {
+		jobConditions := []string{"jobs.schedule_id = transaction_request.schedule_id"}
+		var params []interface{}
+		if len(filters.Statuses) > 0 {
+			jobConditions = append(jobConditions, "jobs.status in (?)")
+			params = append(params, pg.In(filters.Statuses))
+		}
+
+		if len(filters.Types) > 0 {
+			jobConditions = append(jobConditions, "jobs.type in (?)")
+			params = append(params, pg.In(filters.Types))
+		}
+
+		if len(filters.Labels) > 0 {
+			jobConditions = append(jobConditions, "jobs.labels @> ?")
+			params = append(params, labelsJSON(filters.Labels))
+		}
+
+		q = q.Where(fmt.Sprintf("EXISTS (SELECT 1 FROM jobs WHERE %s)", strings.Join(jobConditions, " AND ")), params...)
+	}
+
+	// Transaction requests are never updated, sorting by update date is not supported
+	if filters.Pagination != nil && filters.Pagination.SortBy == entities.SortByUpdatedAt {
+		return nil, errors.InvalidParameterError("transaction requests cannot be sorted by updatedAt")
+	}
+
+	err := paginate(q, filters.Pagination, "transaction_request", "schedule.uuid", "transaction_request.id ASC").
+		WhereAllowedTenants("schedule.tenant_id", tenants).
 		WhereAllowedOwner("schedule.owner_id", ownerID).
 		Select()
 	if err != nil && !errors.IsNotFoundError(err) {
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"testing"
+	"time"
 )
 
 type pgTransactionRequestTestSuite struct {
@@ -124,7 +125,7 @@ func (s *pgTransactionRequestTestSuite) TestFindOneByUUID() {
 			}),
 			mockQuery.EXPECT().Where("schedule.uuid = ?", scheduleUUID).Return(mockQuery),
 			mockQuery.EXPECT().Relation("Schedule").Return(mockQuery),
-			mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery),
+		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery),
 			mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery),
 			mockQuery.EXPECT().SelectOne().DoAndReturn(func() error {
 				txRequestModel.RequestHash = fakeTxRequest.Hash
@@ -174,7 +175,7 @@ func (s *pgTransactionRequestTestSuite) TestFindOneByUUID() {
 			s.mockPGClient.EXPECT().ModelContext(ctx, &models.TransactionRequest{}).Return(mockQuery),
 			mockQuery.EXPECT().Where("schedule.uuid = ?", scheduleUUID).Return(mockQuery),
 			mockQuery.EXPECT().Relation("Schedule").Return(mockQuery),
-			mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery),
+		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery),
 			mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery),
 			mockQuery.EXPECT().SelectOne().Return(expectedErr),
 		)
@@ -278,6 +279,7 @@ func (s *pgTransactionRequestTestSuite) TestSearch() {
 
 		s.mockPGClient.EXPECT().ModelContext(ctx, gomock.Any()).Return(mockQuery)
 		mockQuery.EXPECT().Relation("Schedule").Return(mockQuery)
+		mockQuery.EXPECT().Order("transaction_request.id ASC").Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery)
 		mockQuery.EXPECT().Select().Return(nil)
@@ -295,6 +297,7 @@ func (s *pgTransactionRequestTestSuite) TestSearch() {
 		s.mockPGClient.EXPECT().ModelContext(ctx, gomock.Any()).Return(mockQuery)
 		mockQuery.EXPECT().Relation("Schedule").Return(mockQuery)
 		mockQuery.EXPECT().Where("transaction_request.idempotency_key in (?)", gomock.Any()).Return(mockQuery)
+		mockQuery.EXPECT().Order("transaction_request.id ASC").Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery)
 		mockQuery.EXPECT().Select().Return(nil)
@@ -303,12 +306,67 @@ func (s *pgTransactionRequestTestSuite) TestSearch() {
 		require.NoError(t, err)
 	})
 
+	s.T().Run("should search successfully with job filters", func(t *testing.T) {
+		mockQuery := mocks.NewMockQuery(ctrl)
+		filters := &entities.TransactionRequestFilters{
+			Statuses: []entities.JobStatus{entities.StatusMined},
+			Labels:   map[string]string{"env": "prod"},
+		}
+
+		s.mockPGClient.EXPECT().ModelContext(ctx, gomock.Any()).Return(mockQuery)
+		mockQuery.EXPECT().Relation("Schedule").Return(mockQuery)
+		mockQuery.EXPECT().
+			Where("EXISTS (SELECT 1 FROM jobs WHERE jobs.schedule_id = transaction_request.schedule_id AND jobs.status in (?) AND jobs.labels @> ?)", gomock.Any(), `{"env":"prod"}`).
+			Return(mockQuery)
+		mockQuery.EXPECT().Order("transaction_request.id ASC").Return(mockQuery)
+		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery)
+		mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery)
+		mockQuery.EXPECT().Select().Return(nil)
+
+		_, err := s.dataAgent.Search(ctx, filters, tenants, owner)
+		require.NoError(t, err)
+	})
+
+	s.T().Run("should search successfully with pagination", func(t *testing.T) {
+		mockQuery := mocks.NewMockQuery(ctrl)
+		after := &entities.PageCursor{Time: time.Now(), Key: "uuid"}
+		filters := &entities.TransactionRequestFilters{
+			Pagination: &entities.Pagination{Limit: 10, Order: entities.SortOrderDesc, After: after},
+		}
+
+		s.mockPGClient.EXPECT().ModelContext(ctx, gomock.Any()).Return(mockQuery)
+		mockQuery.EXPECT().Relation("Schedule").Return(mockQuery)
+		mockQuery.EXPECT().Where("(transaction_request.created_at, schedule.uuid) < (?, ?)", after.Time, after.Key).Return(mockQuery)
+		mockQuery.EXPECT().OrderExpr("transaction_request.created_at DESC, schedule.uuid DESC").Return(mockQuery)
+		mockQuery.EXPECT().Limit(10).Return(mockQuery)
+		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery)
+		mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery)
+		mockQuery.EXPECT().Select().Return(nil)
+
+		_, err := s.dataAgent.Search(ctx, filters, tenants, owner)
+		require.NoError(t, err)
+	})
+
+	s.T().Run("should fail with InvalidParameterError if sorting by updatedAt", func(t *testing.T) {
+		mockQuery := mocks.NewMockQuery(ctrl)
+		filters := &entities.TransactionRequestFilters{
+			Pagination: &entities.Pagination{SortBy: entities.SortByUpdatedAt},
+		}
+
+		s.mockPGClient.EXPECT().ModelContext(ctx, gomock.Any()).Return(mockQuery)
+		mockQuery.EXPECT().Relation("Schedule").Return(mockQuery)
+
+		_, err := s.dataAgent.Search(ctx, filters, tenants, owner)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
 	s.T().Run("should return empty array if Select fails with NotFoundError", func(t *testing.T) {
 		notFoundErr := errors.NotFoundError("error")
 		mockQuery := mocks.NewMockQuery(ctrl)
 
 		s.mockPGClient.EXPECT().ModelContext(ctx, gomock.Any()).Return(mockQuery)
 		mockQuery.EXPECT().Relation("Schedule").Return(mockQuery)
+		mockQuery.EXPECT().Order("transaction_request.id ASC").Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery)
 		mockQuery.EXPECT().Select().Return(notFoundErr)
@@ -325,6 +383,7 @@ func (s *pgTransactionRequestTestSuite) TestSearch() {
 
 		s.mockPGClient.EXPECT().ModelContext(ctx, gomock.Any()).Return(mockQuery)
 		mockQuery.EXPECT().Relation("Schedule").Return(mockQuery)
+		mockQuery.EXPECT().Order("transaction_request.id ASC").Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedTenants("schedule.tenant_id", tenants).Return(mockQuery)
 		mockQuery.EXPECT().WhereAllowedOwner("schedule.owner_id", owner).Return(mockQuery)
 		mockQuery.EXPECT().Select().Return(expectedErr)
@@ -21,11 +21,18 @@ type DB interface {
 	EventStream() EventStreamAgent
 	Subscription() SubscriptionAgent
 	Notification() NotificationAgent
+	Outbox() OutboxAgent
+	SafeProposal() SafeProposalAgent
+	Relayer() RelayerAgent
+	EventLog() EventLogAgent
+	SentrySession() SentrySessionAgent
+	PrivacyGroup() PrivacyGroupAgent
 	RunInTransaction(ctx context.Context, persistFunc func(db DB) error) error
 }
 
 type TransactionRequestAgent interface {
 	Insert(ctx context.Context, txRequest *entities.TxRequest, requestHash string, scheduleUUID string) (*entities.TxRequest, error)
+	InsertMultiple(ctx context.Context, txRequests []*entities.TxRequest, requestHashes []string) error
 	FindOneByIdempotencyKey(ctx context.Context, idempotencyKey string, tenantID string, ownerID string) (*entities.TxRequest, error)
 	FindOneByUUID(ctx context.Context, scheduleUUID string, tenants []string, ownerID string) (*entities.TxRequest, error)
 	Search(ctx context.Context, filters *entities.TransactionRequestFilters, tenants []string, ownerID string) ([]*entities.TxRequest, error)
@@ -33,12 +40,14 @@ type TransactionRequestAgent interface {
 
 type ScheduleAgent interface {
 	Insert(ctx context.Context, schedule *entities.Schedule) error
+	InsertMultiple(ctx context.Context, schedules []*entities.Schedule) error
 	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.Schedule, error)
 	FindAll(ctx context.Context, tenants []string, ownerID string) ([]*entities.Schedule, error)
 }
 
 type JobAgent interface {
 	Insert(ctx context.Context, job *entities.Job, log *entities.Log) error
+	InsertMultiple(ctx context.Context, jobs []*entities.Job) error
 	Update(ctx context.Context, job *entities.Job, log *entities.Log) error
 	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string, withLogs bool) (*entities.Job, error)
 	Search(ctx context.Context, filters *entities.JobFilters, tenants []string, ownerID string) ([]*entities.Job, error)
@@ -72,12 +81,15 @@ type ChainAgent interface {
 type ContractAgent interface {
 	Register(ctx context.Context, contract *entities.Contract) error
 	Update(ctx context.Context, contract *entities.Contract) error
-	RegisterDeployment(ctx context.Context, chainID string, address ethcommon.Address, codeHash []byte) error
-	FindOneByNameAndTag(ctx context.Context, name, tag string) (*entities.Contract, error)
-	FindOneByCodeHash(ctx context.Context, codeHash string) (*entities.Contract, error)
-	FindOneByAddress(ctx context.Context, address string) (*entities.Contract, error)
-	ListNames(ctx context.Context) ([]string, error)
-	ListTags(ctx context.Context, name string) ([]string, error)
+	RegisterDeployment(ctx context.Context, chainID string, address ethcommon.Address, codeHash []byte, tenantID, ownerID string) error
+	FindOneByNameAndTag(ctx context.Context, name, tag string, tenants []string, ownerID string) (*entities.Contract, error)
+	FindOneByCodeHash(ctx context.Context, codeHash string, tenants []string, ownerID string) (*entities.Contract, error)
+	FindOneByAddress(ctx context.Context, address string, tenants []string, ownerID string) (*entities.Contract, error)
+	FindDeploymentAddress(ctx context.Context, chainID, name string, tenants []string, ownerID string) (*ethcommon.Address, error)
+	RegisterProxy(ctx context.Context, proxy *entities.ContractProxy) error
+	FindProxy(ctx context.Context, chainID string, address ethcommon.Address) (*entities.ContractProxy, error)
+	ListNames(ctx context.Context, tenants []string, ownerID string) ([]string, error)
+	ListTags(ctx context.Context, name string, tenants []string, ownerID string) ([]string, error)
 }
 
 type ContractEventAgent interface {
@@ -108,3 +120,48 @@ type NotificationAgent interface {
 	Insert(ctx context.Context, notif *entities.Notification) (*entities.Notification, error)
 	Update(ctx context.Context, notif *entities.Notification) (*entities.Notification, error)
 }
+
+type OutboxAgent interface {
+	Insert(ctx context.Context, msg *entities.OutboxMessage) error
+	// LockPending must be called within a DB transaction, it returns no message if another transaction already holds the lock
+	LockPending(ctx context.Context, limit int) ([]*entities.OutboxMessage, error)
+	Delete(ctx context.Context, uuids []string) error
+}
+
+type SafeProposalAgent interface {
+	Insert(ctx context.Context, proposal *entities.SafeProposal) (*entities.SafeProposal, error)
+	Update(ctx context.Context, proposal *entities.SafeProposal) (*entities.SafeProposal, error)
+	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.SafeProposal, error)
+	// LockOneByUUID must be called within a DB transaction, the proposal is locked until the transaction ends
+	LockOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.SafeProposal, error)
+	Search(ctx context.Context, filters *entities.SafeProposalFilters, tenants []string, ownerID string) ([]*entities.SafeProposal, error)
+}
+
+type RelayerAgent interface {
+	Insert(ctx context.Context, relayer *entities.Relayer) (*entities.Relayer, error)
+	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.Relayer, error)
+	Search(ctx context.Context, filters *entities.RelayerFilters, tenants []string, ownerID string) ([]*entities.Relayer, error)
+	Delete(ctx context.Context, uuid string, tenants []string, ownerID string) error
+	AddSpending(ctx context.Context, jobUUID string, spending *entities.RelaySpending) (bool, error)
+	SearchSpendings(ctx context.Context, filters *entities.RelaySpendingFilters, tenants []string) ([]*entities.RelaySpending, error)
+}
+
+type PrivacyGroupAgent interface {
+	Insert(ctx context.Context, group *entities.PrivacyGroup) (*entities.PrivacyGroup, error)
+	FindOneByUUID(ctx context.Context, uuid string, tenants []string, ownerID string) (*entities.PrivacyGroup, error)
+	Search(ctx context.Context, filters *entities.PrivacyGroupFilters, tenants []string, ownerID string) ([]*entities.PrivacyGroup, error)
+	Delete(ctx context.Context, uuid string, tenants []string, ownerID string) error
+}
+
+type EventLogAgent interface {
+	InsertMultiple(ctx context.Context, eventLogs []*entities.EventLog) error
+	Search(ctx context.Context, filters *entities.EventLogFilters, tenants []string, ownerID string) ([]*entities.EventLog, error)
+}
+
+type SentrySessionAgent interface {
+	Insert(ctx context.Context, session *entities.SentrySession) (*entities.SentrySession, error)
+	Update(ctx context.Context, session *entities.SentrySession) (*entities.SentrySession, error)
+	FindOneByJobUUID(ctx context.Context, jobUUID string, tenants []string, ownerID string) (*entities.SentrySession, error)
+	Search(ctx context.Context, filters *entities.SentrySessionFilters, tenants []string, ownerID string) ([]*entities.SentrySession, error)
+	Delete(ctx context.Context, jobUUID string, tenants []string, ownerID string) error
+}
@@ -7,6 +7,14 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
+type AccountStatus string
+
+const (
+	AccountStatusActive   AccountStatus = "ACTIVE"
+	AccountStatusDisabled AccountStatus = "DISABLED"
+	AccountStatusArchived AccountStatus = "ARCHIVED"
+)
+
 type Account struct {
 	Alias               string
 	Address             ethcommon.Address
@@ -16,6 +24,22 @@ type Account struct {
 	OwnerID             string
 	StoreID             string
 	Attributes          map[string]string
+	Status              AccountStatus
+	RotatedFrom         *ethcommon.Address
+	RotatedTo           *ethcommon.Address
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
+
+// CanSign indicates whether the account is allowed to sign a transaction sent to the given address.
+// Archived accounts can only sign transactions sending their remaining funds to the account they were rotated to
+func (acc *Account) CanSign(to *ethcommon.Address) bool {
+	switch acc.Status {
+	case AccountStatusActive, "":
+		return true
+	case AccountStatusArchived:
+		return acc.RotatedTo != nil && to != nil && *acc.RotatedTo == *to
+	default:
+		return false
+	}
+}
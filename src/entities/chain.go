@@ -16,6 +16,16 @@ type Chain struct {
 	ListenerDepth             uint64
 	ListenerBlockTimeDuration time.Duration
 	Labels                    map[string]string
+	FeePolicy                 *FeePolicy
 	CreatedAt                 time.Time
 	UpdatedAt                 time.Time
 }
+
+// FeePolicy is applied to the jobs of a chain which do not define their own gas price policy
+type FeePolicy struct {
+	MaxFee               *big.Int      `json:"maxFee,omitempty"`               // Ceiling of the gas price, or of the max fee per gas, in wei
+	BumpPercentage       float64       `json:"bumpPercentage,omitempty"`       // Gas price increment on every bump, 0.1 being 10%
+	BumpInterval         time.Duration `json:"bumpInterval,omitempty"`         // Duration after which a pending transaction is bumped, no bump if zero
+	MaxBumps             int           `json:"maxBumps,omitempty"`             // Maximum number of bumps of a transaction
+	ReplaceWithSameNonce bool          `json:"replaceWithSameNonce,omitempty"` // Whether bumps replace transactions with the same nonce, otherwise transactions are sent again unchanged
+}
@@ -0,0 +1,43 @@
+package entities
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// ContractImport registers a batch of build artifacts under the same tag
+type ContractImport struct {
+	Tag       string
+	ChainName string // chain on which the deployments of the libraries to link are searched
+	Libraries map[string]ethcommon.Address
+	Artifacts []*ContractArtifact
+}
+
+// ContractArtifact is a contract extracted from a Hardhat, Foundry or Truffle build output, its bytecodes possibly
+// containing placeholders of the libraries to link
+type ContractArtifact struct {
+	Name                   string
+	RawABI                 string
+	Bytecode               string
+	DeployedBytecode       string
+	LinkReferences         []*LinkReference
+	DeployedLinkReferences []*LinkReference
+}
+
+// LinkReference locates the placeholders of a library address in a bytecode
+type LinkReference struct {
+	Library string
+	Offsets []int // in bytes
+}
+
+func (a *ContractArtifact) Libraries() []string {
+	var libraries []string
+	seen := map[string]bool{}
+	for _, ref := range append(a.LinkReferences, a.DeployedLinkReferences...) {
+		if !seen[ref.Library] {
+			seen[ref.Library] = true
+			libraries = append(libraries, ref.Library)
+		}
+	}
+
+	return libraries
+}
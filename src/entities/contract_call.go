@@ -0,0 +1,44 @@
+package entities
+
+import (
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	BlockTagLatest   = "latest"
+	BlockTagPending  = "pending"
+	BlockTagEarliest = "earliest"
+)
+
+// ContractCallRequest is a batch of read-only contract calls executed on the same block
+type ContractCallRequest struct {
+	ChainName   string
+	BlockNumber *big.Int // nil for the latest block
+	Pending     bool
+	Multicall   bool
+	Calls       []*ContractCall
+	// Private state on which the calls are executed, public state if no protocol is set
+	Protocol         PrivateTxManagerType
+	PrivateFrom      string
+	PrivacyGroupID   string
+	PrivacyGroupName string
+}
+
+// ContractCall is a method call of a contract, the ABI being taken from the contract registry by name or by deployed address
+type ContractCall struct {
+	To              ethcommon.Address
+	ContractName    string
+	ContractTag     string
+	MethodSignature string
+	Args            []interface{}
+}
+
+type ContractCallResult struct {
+	Success bool
+	Outputs map[string]string
+	Data    hexutil.Bytes
+	Error   string
+}
@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type ProxyKind string
+
+const (
+	ProxyKindEIP1967 ProxyKind = "eip1967"
+	ProxyKindEIP1822 ProxyKind = "eip1822"
+	ProxyKindBeacon  ProxyKind = "beacon" // EIP-1967 beacon proxy, its implementation being held by the beacon
+)
+
+// ContractProxy points an upgradeable proxy deployed on a chain to its current implementation, or to its beacon
+type ContractProxy struct {
+	ChainID        string
+	Address        ethcommon.Address
+	Implementation ethcommon.Address
+	Kind           ProxyKind
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -21,6 +21,8 @@ type Contract struct {
 	Constructor      ABIComponent
 	Methods          []ABIComponent
 	Events           []ABIComponent
+	TenantID         string
+	OwnerID          string
 }
 
 type ABIComponent struct {
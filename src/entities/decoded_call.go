@@ -0,0 +1,9 @@
+package entities
+
+// DecodedCall is the method call of a transaction decoded with the ABI of the contract registry
+type DecodedCall struct {
+	ContractName    string
+	ContractTag     string
+	MethodSignature string
+	Inputs          map[string]string
+}
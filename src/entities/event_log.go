@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/types/ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// EventLog is a contract event emitted on a chain, indexed so that it can be queried afterwards
+type EventLog struct {
+	ChainUUID   string
+	Address     ethcommon.Address
+	Topics      []ethcommon.Hash
+	Data        hexutil.Bytes
+	BlockNumber uint64
+	BlockHash   ethcommon.Hash
+	TxHash      ethcommon.Hash
+	LogIndex    uint
+	Event       string // Signature of the event, empty if the log could not be decoded
+	DecodedData map[string]string
+	CreatedAt   time.Time
+}
+
+// EventLogCursor points to the position of an event log on its chain, logs being sorted by block and log index
+type EventLogCursor struct {
+	BlockNumber uint64
+	LogIndex    uint
+}
+
+func NewEventLog(chainUUID string, l *ethtypes.Log) *EventLog {
+	return &EventLog{
+		ChainUUID:   chainUUID,
+		Address:     l.Address,
+		Topics:      l.Topics,
+		Data:        l.Data,
+		BlockNumber: l.BlockNumber,
+		BlockHash:   l.BlockHash,
+		TxHash:      l.TxHash,
+		LogIndex:    l.Index,
+	}
+}
+
+// ToLog formats the event log as expected by the event decoder
+func (e *EventLog) ToLog() *ethereum.Log {
+	topics := make([]string, len(e.Topics))
+	for i, topic := range e.Topics {
+		topics[i] = topic.Hex()
+	}
+
+	return &ethereum.Log{
+		Address:     e.Address.Hex(),
+		Topics:      topics,
+		Data:        hexutil.Encode(e.Data),
+		BlockNumber: e.BlockNumber,
+		TxHash:      e.TxHash.Hex(),
+		BlockHash:   e.BlockHash.Hex(),
+		Index:       uint64(e.LogIndex),
+	}
+}
+
+func (e *EventLog) Cursor() *EventLogCursor {
+	return &EventLogCursor{BlockNumber: e.BlockNumber, LogIndex: e.LogIndex}
+}
+
+const (
+	// MaxEventLogsBackfillRange is the largest number of blocks that can be backfilled in a single request
+	MaxEventLogsBackfillRange = 1000000
+	// EventLogsBackfillMessageRange is the number of blocks scanned by each backfill message sent to the tx-listener
+	EventLogsBackfillMessageRange = 10000
+)
+
+// EventLogsBackfill is a range of blocks of which the event logs emitted by the addresses are indexed
+type EventLogsBackfill struct {
+	ChainUUID string
+	Addresses []ethcommon.Address
+	FromBlock uint64
+	ToBlock   uint64
+}
@@ -7,44 +7,116 @@ import (
 )
 
 type JobFilters struct {
-	TxHashes      []string  `validate:"omitempty,unique,dive,isHash"`
-	ChainUUID     string    `validate:"omitempty,uuid"`
-	Status        JobStatus `validate:"omitempty,isJobStatus"`
-	UpdatedAfter  time.Time `validate:"omitempty"`
-	ParentJobUUID string    `validate:"omitempty"`
-	OnlyParents   bool      `validate:"omitempty"`
-	WithLogs      bool      `validate:"omitempty"`
+	TxHashes        []string          `validate:"omitempty,unique,dive,isHash"`
+	ChainUUID       string            `validate:"omitempty,uuid"`
+	Status          JobStatus         `validate:"omitempty,isJobStatus"`
+	Statuses        []JobStatus       `validate:"omitempty,unique,dive,isJobStatus"`
+	Types           []JobType         `validate:"omitempty,unique,dive,isJobType"`
+	Labels          map[string]string `validate:"omitempty"`
+	From            *ethcommon.Address
+	To              *ethcommon.Address
+	ScheduleUUID    string      `validate:"omitempty,uuid"`
+	IdempotencyKeys []string    `validate:"omitempty,unique"`
+	CreatedAfter    time.Time   `validate:"omitempty"`
+	CreatedBefore   time.Time   `validate:"omitempty"`
+	UpdatedAfter    time.Time   `validate:"omitempty"`
+	UpdatedBefore   time.Time   `validate:"omitempty"`
+	ParentJobUUID   string      `validate:"omitempty"`
+	OnlyParents     bool        `validate:"omitempty"`
+	WithLogs        bool        `validate:"omitempty"`
+	Pagination      *Pagination `validate:"omitempty"`
 }
 
+// TransactionRequestFilters filters the transaction requests, the statuses, types and labels applying to their jobs
 type TransactionRequestFilters struct {
-	IdempotencyKeys []string `validate:"omitempty,unique"`
+	IdempotencyKeys []string          `validate:"omitempty,unique"`
+	ScheduleUUID    string            `validate:"omitempty,uuid"`
+	Statuses        []JobStatus       `validate:"omitempty,unique,dive,isJobStatus"`
+	Types           []JobType         `validate:"omitempty,unique,dive,isJobType"`
+	Labels          map[string]string `validate:"omitempty"`
+	From            *ethcommon.Address
+	To              *ethcommon.Address
+	CreatedAfter    time.Time   `validate:"omitempty"`
+	CreatedBefore   time.Time   `validate:"omitempty"`
+	Pagination      *Pagination `validate:"omitempty"`
 }
 
 type FaucetFilters struct {
-	Names     []string `validate:"omitempty,unique"`
-	ChainRule string   `validate:"omitempty"`
-	TenantID  string   `validate:"omitempty"`
+	Names      []string    `validate:"omitempty,unique"`
+	ChainRule  string      `validate:"omitempty"`
+	TenantID   string      `validate:"omitempty"`
+	Pagination *Pagination `validate:"omitempty"`
 }
 
 type AccountFilters struct {
-	Aliases  []string `validate:"omitempty,unique"`
-	TenantID string   `validate:"omitempty"`
+	Aliases    []string    `validate:"omitempty,unique"`
+	TenantID   string      `validate:"omitempty"`
+	Pagination *Pagination `validate:"omitempty"`
 }
 
 type EventStreamFilters struct {
-	Names     []string `validate:"omitempty,unique"`
-	TenantID  string   `validate:"omitempty"`
-	ChainUUID string   `validate:"omitempty"`
+	Names      []string    `validate:"omitempty,unique"`
+	TenantID   string      `validate:"omitempty"`
+	ChainUUID  string      `validate:"omitempty"`
+	Pagination *Pagination `validate:"omitempty"`
 }
 
 type SubscriptionFilters struct {
-	Addresses []ethcommon.Address `validate:"omitempty,unique"`
-	TenantID  string              `validate:"omitempty"`
-	ChainUUID string              `validate:"omitempty"`
+	Addresses  []ethcommon.Address `validate:"omitempty,unique"`
+	TenantID   string              `validate:"omitempty"`
+	ChainUUID  string              `validate:"omitempty"`
+	Pagination *Pagination         `validate:"omitempty"`
 }
 
 type ChainFilters struct {
-	Names    []string `validate:"omitempty,unique"`
-	ChainID  string   `validate:"omitempty"`
-	TenantID string   `validate:"omitempty"`
+	Names      []string    `validate:"omitempty,unique"`
+	ChainID    string      `validate:"omitempty"`
+	TenantID   string      `validate:"omitempty"`
+	Pagination *Pagination `validate:"omitempty"`
+}
+
+type SafeProposalFilters struct {
+	SafeAddress *ethcommon.Address `validate:"omitempty"`
+	ChainUUID   string             `validate:"omitempty"`
+	Status      SafeProposalStatus `validate:"omitempty,isSafeProposalStatus"`
+	TenantID    string             `validate:"omitempty"`
+	Pagination  *Pagination        `validate:"omitempty"`
+}
+
+type RelayerFilters struct {
+	Names      []string    `validate:"omitempty,unique"`
+	ChainUUID  string      `validate:"omitempty"`
+	Type       RelayerType `validate:"omitempty,isRelayerType"`
+	TenantID   string      `validate:"omitempty"`
+	Pagination *Pagination `validate:"omitempty"`
+}
+
+type PrivacyGroupFilters struct {
+	Names          []string    `validate:"omitempty,unique"`
+	ChainUUID      string      `validate:"omitempty"`
+	PrivacyGroupID string      `validate:"omitempty"`
+	TenantID       string      `validate:"omitempty"`
+	Pagination     *Pagination `validate:"omitempty"`
+}
+
+type RelaySpendingFilters struct {
+	RelayerUUID string `validate:"omitempty"`
+	TenantID    string `validate:"omitempty"`
+}
+
+type EventLogFilters struct {
+	ChainUUID string              `validate:"omitempty,uuid"`
+	Addresses []ethcommon.Address `validate:"omitempty,unique"`
+	SigHash   *ethcommon.Hash     `validate:"omitempty"`
+	Topic1    *ethcommon.Hash     `validate:"omitempty"`
+	Topic2    *ethcommon.Hash     `validate:"omitempty"`
+	Topic3    *ethcommon.Hash     `validate:"omitempty"`
+	FromBlock *uint64             `validate:"omitempty"`
+	ToBlock   *uint64             `validate:"omitempty"`
+	After     *EventLogCursor     `validate:"omitempty"`
+	Limit     int                 `validate:"omitempty,min=1"`
+}
+
+type SentrySessionFilters struct {
+	ChainUUID string `validate:"omitempty,uuid"`
 }
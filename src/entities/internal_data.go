@@ -3,6 +3,8 @@ package entities
 import (
 	"math/big"
 	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 type InternalData struct {
@@ -16,4 +18,8 @@ type InternalData struct {
 	RetryInterval     time.Duration `json:"retryInterval"`
 	ExpectedNonce     string        `json:"expectedNonce,omitempty"` // Using string because 0 is a valid
 	StoreID           string        `json:"storeID,omitempty"`
+	// ContractAddress is the address of a contract deployed through a CREATE2 factory, predicted before mining
+	ContractAddress *ethcommon.Address `json:"contractAddress,omitempty"`
+	// MaxFee is the ceiling of the gas price, or of the max fee per gas, of the transactions of the job in wei
+	MaxFee *big.Int `json:"maxFee,omitempty"`
 }
@@ -44,21 +44,23 @@ func (js *JobStatus) String() string {
 }
 
 type Job struct {
-	UUID         string
-	NextJobUUID  string
-	ChainUUID    string
-	ScheduleUUID string
-	TenantID     string
-	OwnerID      string
-	Type         JobType
-	Status       JobStatus
-	Labels       map[string]string
-	InternalData *InternalData
-	Transaction  *ETHTransaction
-	Receipt      *ethereum.Receipt
-	Logs         []*Log
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	UUID           string
+	NextJobUUID    string
+	ChainUUID      string
+	ScheduleUUID   string
+	TenantID       string
+	OwnerID        string
+	Type           JobType
+	Status         JobStatus
+	Labels         map[string]string
+	InternalData   *InternalData
+	Transaction    *ETHTransaction
+	Receipt        *ethereum.Receipt
+	PrivateReceipt *ethereum.Receipt
+	Logs           []*Log
+	DecodedCall    *DecodedCall
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
 }
 
 func (job *Job) ShouldBeRetried() bool {
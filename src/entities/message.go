@@ -3,8 +3,9 @@ package entities
 type RequestMessageType string
 
 type Message struct {
-	Type   RequestMessageType
-	Body   []byte
-	Offset int64
-	Commit func() error `json:"-"`
+	Type      RequestMessageType
+	Body      []byte
+	Partition int32
+	Offset    int64
+	Commit    func() error `json:"-"`
 }
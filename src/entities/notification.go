@@ -13,6 +13,10 @@ type NotificationSourceType string
 const (
 	NotificationTypeTxMined  NotificationType = "transaction.mined"
 	NotificationTypeTxFailed NotificationType = "transaction.failed"
+
+	NotificationTypeSafeProposalSigned   NotificationType = "safe_proposal.signed"
+	NotificationTypeSafeProposalExecuted NotificationType = "safe_proposal.executed"
+	NotificationTypeSafeProposalFailed   NotificationType = "safe_proposal.failed"
 )
 const (
 	NotificationStatusPending NotificationStatus = "PENDING"
@@ -22,6 +26,7 @@ const (
 const (
 	NotificationSourceTypeJob           NotificationSourceType = "job"
 	NotificationSourceTypeContractEvent NotificationSourceType = "contract_event"
+	NotificationSourceTypeSafeProposal  NotificationSourceType = "safe_proposal"
 )
 
 func (n *NotificationType) String() string {
@@ -38,15 +43,16 @@ func (n *NotificationSourceType) String() string {
 
 // @TODO Refactor to decouple message types
 type Notification struct {
-	SourceUUID string
-	SourceType NotificationSourceType
-	Status     NotificationStatus
-	UUID       string
-	Type       NotificationType
-	APIVersion string
-	Job        *Job
-	EventLogs  []*ethereum.Log
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
-	Error      string
+	SourceUUID   string
+	SourceType   NotificationSourceType
+	Status       NotificationStatus
+	UUID         string
+	Type         NotificationType
+	APIVersion   string
+	Job          *Job
+	EventLogs    []*ethereum.Log
+	SafeProposal *SafeProposal
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Error        string
 }
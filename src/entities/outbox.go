@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+// OutboxMessage is a message stored in the same DB transaction as the state change that produced it.
+// It is relayed to the messenger once the transaction is committed
+type OutboxMessage struct {
+	UUID         string
+	Topic        string
+	PartitionKey string
+	Body         []byte
+	Headers      map[string]interface{}
+	CreatedAt    time.Time
+}
@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+)
+
+type SortField string
+type SortOrder string
+
+const (
+	SortByCreatedAt SortField = "createdAt"
+	SortByUpdatedAt SortField = "updatedAt"
+)
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Pagination bounds a search to a page of results, sorted by a timestamp and by the unique key of the results to be stable
+type Pagination struct {
+	Limit  int         `validate:"omitempty,min=1"`
+	SortBy SortField   `validate:"omitempty,isSortField"`
+	Order  SortOrder   `validate:"omitempty,isSortOrder"`
+	After  *PageCursor `validate:"omitempty"`
+}
+
+// PageCursor points to the last result of the former page
+type PageCursor struct {
+	Time time.Time
+	Key  string
+}
+
+// Next returns the cursor of the page following the results, nil if the results do not fill the page
+func (p *Pagination) Next(count int, createdAt, updatedAt time.Time, key string) *PageCursor {
+	if p == nil || p.Limit == 0 || count < p.Limit {
+		return nil
+	}
+
+	if p.SortBy == SortByUpdatedAt {
+		return &PageCursor{Time: updatedAt, Key: key}
+	}
+
+	return &PageCursor{Time: createdAt, Key: key}
+}
+
+// String encodes the cursor as an opaque string passed as query parameter
+func (c *PageCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s|%s", c.Time.UTC().Format(time.RFC3339Nano), c.Key)))
+}
+
+func ParsePageCursor(cursor string) (*PageCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	parts := strings.SplitN(string(b), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid cursor")
+	}
+
+	return &PageCursor{Time: t, Key: parts[1]}, nil
+}
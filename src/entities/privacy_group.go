@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+// PrivacyGroup is a Besu privacy group created through Orchestrate, referenced by name in private transactions
+type PrivacyGroup struct {
+	UUID           string
+	Name           string
+	ChainUUID      string
+	PrivacyGroupID string
+	Description    string
+	Members        []string
+	Labels         map[string]string
+	TenantID       string
+	OwnerID        string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// OnChainPrivacyGroup is a privacy group found on the chain, whether or not it was created through Orchestrate
+type OnChainPrivacyGroup struct {
+	PrivacyGroupID string
+	Name           string
+	Description    string
+	Type           string
+	Members        []string
+}
@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type RelayerType string
+
+const (
+	// RelayerTypeForwarder relays ERC-2771 forward requests through a trusted forwarder contract
+	RelayerTypeForwarder RelayerType = "ERC2771"
+	// RelayerTypeEntryPoint relays ERC-4337 user operations through an entry point contract
+	RelayerTypeEntryPoint RelayerType = "ERC4337"
+)
+
+// RelayerLabel is the label set on jobs sent by a relayer, used to track the spending of tenants
+const RelayerLabel = "relayerUUID"
+
+type Relayer struct {
+	UUID            string
+	Name            string
+	ChainUUID       string
+	Type            RelayerType
+	Account         ethcommon.Address
+	ContractAddress ethcommon.Address
+	ContractName    string
+	ContractTag     string
+	DomainName      string
+	DomainVersion   string
+	TenantID        string
+	OwnerID         string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type ForwardRequest struct {
+	From  ethcommon.Address
+	To    ethcommon.Address
+	Value *hexutil.Big
+	Gas   uint64
+	Nonce uint64
+	Data  hexutil.Bytes
+}
+
+// UserOperation follows the ERC-4337 v0.6 entry point format
+type UserOperation struct {
+	Sender               ethcommon.Address
+	Nonce                *hexutil.Big
+	InitCode             hexutil.Bytes
+	CallData             hexutil.Bytes
+	CallGasLimit         *hexutil.Big
+	VerificationGasLimit *hexutil.Big
+	PreVerificationGas   *hexutil.Big
+	MaxFeePerGas         *hexutil.Big
+	MaxPriorityFeePerGas *hexutil.Big
+	PaymasterAndData     hexutil.Bytes
+	Signature            hexutil.Bytes
+}
+
+// MetaTransaction is a user signed payload submitted on chain by a relayer, either a forward request or a user operation
+type MetaTransaction struct {
+	ForwardRequest *ForwardRequest
+	UserOperation  *UserOperation
+	Signature      hexutil.Bytes
+}
+
+func (mtx *MetaTransaction) RelayerType() RelayerType {
+	if mtx.UserOperation != nil {
+		return RelayerTypeEntryPoint
+	}
+
+	return RelayerTypeForwarder
+}
+
+// RelaySpending is the total fee paid by a relayer on behalf of a tenant
+type RelaySpending struct {
+	RelayerUUID string
+	TenantID    string
+	TxCount     uint64
+	GasUsed     uint64
+	Fee         *hexutil.Big
+	UpdatedAt   time.Time
+}
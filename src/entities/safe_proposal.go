@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type SafeProposalStatus string
+type SafeOperation uint8
+
+const (
+	SafeProposalStatusPending   SafeProposalStatus = "PENDING"
+	SafeProposalStatusExecuting SafeProposalStatus = "EXECUTING"
+	SafeProposalStatusExecuted  SafeProposalStatus = "EXECUTED"
+	SafeProposalStatusFailed    SafeProposalStatus = "FAILED"
+)
+
+const (
+	SafeOperationCall         SafeOperation = 0
+	SafeOperationDelegateCall SafeOperation = 1
+)
+
+// SafeProposalLabel is the label set on the execTransaction job of a proposal
+const SafeProposalLabel = "safeProposalUUID"
+
+type SafeProposal struct {
+	UUID         string
+	ChainUUID    string
+	SafeAddress  ethcommon.Address
+	Sender       ethcommon.Address
+	Transaction  *SafeTransaction
+	SafeTxHash   ethcommon.Hash
+	Owners       []ethcommon.Address
+	Threshold    uint64
+	Signatures   []*SafeSignature
+	Status       SafeProposalStatus
+	ScheduleUUID string
+	TxHash       *ethcommon.Hash
+	Labels       map[string]string
+	TenantID     string
+	OwnerID      string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// SafeTransaction holds the parameters of a Safe transaction as hashed and executed by the Safe contract
+type SafeTransaction struct {
+	To             ethcommon.Address `json:"to"`
+	Value          *hexutil.Big      `json:"value,omitempty"`
+	Data           hexutil.Bytes     `json:"data,omitempty"`
+	Operation      SafeOperation     `json:"operation"`
+	SafeTxGas      uint64            `json:"safeTxGas"`
+	BaseGas        uint64            `json:"baseGas"`
+	GasPrice       *hexutil.Big      `json:"gasPrice,omitempty"`
+	GasToken       ethcommon.Address `json:"gasToken"`
+	RefundReceiver ethcommon.Address `json:"refundReceiver"`
+	Nonce          uint64            `json:"nonce"`
+}
+
+type SafeSignature struct {
+	Signer    ethcommon.Address `json:"signer"`
+	Signature hexutil.Bytes     `json:"signature"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+func (p *SafeProposal) IsOwner(address ethcommon.Address) bool {
+	for _, owner := range p.Owners {
+		if owner == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *SafeProposal) HasSigned(address ethcommon.Address) bool {
+	for _, sig := range p.Signatures {
+		if sig.Signer == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *SafeProposal) ThresholdReached() bool {
+	return uint64(len(p.Signatures)) >= p.Threshold
+}
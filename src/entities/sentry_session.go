@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// SentrySession is the retry session of a pending job run by the transaction sentry of the tx-listener,
+// persisted to be rescheduled when the tx-listener restarts
+type SentrySession struct {
+	JobUUID          string
+	ChainUUID        string
+	Retries          int
+	NChildren        int
+	LastChildJobUUID string
+	NextAttemptAt    time.Time
+	TenantID         string
+	OwnerID          string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,34 @@
+package testdata
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+)
+
+func FakeEventLog() *entities.EventLog {
+	return &entities.EventLog{
+		ChainUUID: uuid.Must(uuid.NewV4()).String(),
+		Address:   ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		Topics: []ethcommon.Hash{
+			ethcommon.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"),
+			ethcommon.HexToHash("0x0000000000000000000000001abae27a0cbfb02945720425d3b80c7e09728534"),
+			ethcommon.HexToHash("0x000000000000000000000000905b88eff8bda1543d4d6f4aa05afef143d27e18"),
+		},
+		Data:        hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000001"),
+		BlockNumber: 12000000,
+		BlockHash:   ethcommon.HexToHash("0x656c34545f90a730a19008c0e7a7cd4fb3895064b48d6d69761bd5abad681056"),
+		TxHash:      ethcommon.HexToHash("0x3b198bfd5d2907285af009e9ae84a0ecd63677110d89d7e030251acb87f6487e"),
+		LogIndex:    1,
+		Event:       "Transfer(address,address,uint256)",
+		DecodedData: map[string]string{
+			"from":  "0x1abae27a0cbfb02945720425d3b80c7e09728534",
+			"to":    "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18",
+			"value": "1",
+		},
+		CreatedAt: time.Now(),
+	}
+}
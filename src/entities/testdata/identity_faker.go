@@ -21,6 +21,7 @@ func FakeAccount() *entities.Account {
 		Address:             ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
 		PublicKey:           hexutil.MustDecode("0x" + utils.RandHexString(12)),
 		CompressedPublicKey: hexutil.MustDecode("0x" + utils.RandHexString(24)),
+		Status:              entities.AccountStatusActive,
 		CreatedAt:           time.Now(),
 		UpdatedAt:           time.Now(),
 	}
@@ -0,0 +1,23 @@
+package testdata
+
+import (
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+)
+
+func FakePrivacyGroup() *entities.PrivacyGroup {
+	return &entities.PrivacyGroup{
+		UUID:           uuid.Must(uuid.NewV4()).String(),
+		Name:           "privacy-group-" + uuid.Must(uuid.NewV4()).String()[:8],
+		ChainUUID:      uuid.Must(uuid.NewV4()).String(),
+		PrivacyGroupID: "ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bf=",
+		Description:    "my privacy group",
+		Members: []string{
+			"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+			"B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+		},
+		Labels:   map[string]string{"project": "foo"},
+		TenantID: "tenantOne",
+		OwnerID:  "username",
+	}
+}
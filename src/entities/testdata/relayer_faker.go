@@ -0,0 +1,94 @@
+package testdata
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+)
+
+const forwarderABI = `[
+{"name":"execute","type":"function","stateMutability":"payable","inputs":[{"name":"req","type":"tuple","components":[
+	{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},
+	{"name":"gas","type":"uint256"},{"name":"nonce","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"name":"signature","type":"bytes"}],"outputs":[{"name":"","type":"bool"},{"name":"","type":"bytes"}]},
+{"name":"verify","type":"function","stateMutability":"view","inputs":[{"name":"req","type":"tuple","components":[
+	{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"value","type":"uint256"},
+	{"name":"gas","type":"uint256"},{"name":"nonce","type":"uint256"},{"name":"data","type":"bytes"}]},
+	{"name":"signature","type":"bytes"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+const entryPointABI = `[
+{"name":"handleOps","type":"function","stateMutability":"nonpayable","inputs":[{"name":"ops","type":"tuple[]","components":[
+	{"name":"sender","type":"address"},{"name":"nonce","type":"uint256"},{"name":"initCode","type":"bytes"},
+	{"name":"callData","type":"bytes"},{"name":"callGasLimit","type":"uint256"},{"name":"verificationGasLimit","type":"uint256"},
+	{"name":"preVerificationGas","type":"uint256"},{"name":"maxFeePerGas","type":"uint256"},{"name":"maxPriorityFeePerGas","type":"uint256"},
+	{"name":"paymasterAndData","type":"bytes"},{"name":"signature","type":"bytes"}]},
+	{"name":"beneficiary","type":"address"}],"outputs":[]}
+]`
+
+func FakeRelayer() *entities.Relayer {
+	return &entities.Relayer{
+		UUID:            uuid.Must(uuid.NewV4()).String(),
+		Name:            "relayer-" + uuid.Must(uuid.NewV4()).String()[:8],
+		ChainUUID:       uuid.Must(uuid.NewV4()).String(),
+		Type:            entities.RelayerTypeForwarder,
+		Account:         ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"),
+		ContractAddress: ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		ContractName:    "MinimalForwarder",
+		ContractTag:     entities.DefaultContractTagValue,
+		DomainName:      "MinimalForwarder",
+		DomainVersion:   "0.0.1",
+		TenantID:        "tenantOne",
+		OwnerID:         "username",
+	}
+}
+
+func FakeForwardRequest() *entities.ForwardRequest {
+	return &entities.ForwardRequest{
+		From:  ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+		To:    ethcommon.HexToAddress("0xdbb881a51CD4023E4400CEF3ef73046743f08da3"),
+		Value: (*hexutil.Big)(big.NewInt(0)),
+		Gas:   100000,
+		Nonce: 0,
+		Data:  hexutil.MustDecode("0xa9059cbb"),
+	}
+}
+
+func FakeUserOperation() *entities.UserOperation {
+	return &entities.UserOperation{
+		Sender:               ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+		Nonce:                (*hexutil.Big)(big.NewInt(0)),
+		CallData:             hexutil.MustDecode("0xb61d27f6"),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(5000000)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(1500000)),
+		Signature:            hexutil.MustDecode("0x01"),
+	}
+}
+
+func FakeForwarderContract() *entities.Contract {
+	parsedABI, _ := abi.JSON(strings.NewReader(forwarderABI))
+	return &entities.Contract{
+		Name:   "MinimalForwarder",
+		Tag:    entities.DefaultContractTagValue,
+		RawABI: forwarderABI,
+		ABI:    parsedABI,
+	}
+}
+
+func FakeEntryPointContract() *entities.Contract {
+	parsedABI, _ := abi.JSON(strings.NewReader(entryPointABI))
+	return &entities.Contract{
+		Name:   "EntryPoint",
+		Tag:    entities.DefaultContractTagValue,
+		RawABI: entryPointABI,
+		ABI:    parsedABI,
+	}
+}
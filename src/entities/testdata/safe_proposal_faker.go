@@ -0,0 +1,35 @@
+package testdata
+
+import (
+	"math/big"
+
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gofrs/uuid"
+)
+
+func FakeSafeProposal() *entities.SafeProposal {
+	return &entities.SafeProposal{
+		UUID:        uuid.Must(uuid.NewV4()).String(),
+		ChainUUID:   uuid.Must(uuid.NewV4()).String(),
+		SafeAddress: ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610"),
+		Sender:      ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+		Transaction: &entities.SafeTransaction{
+			To:    ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"),
+			Value: (*hexutil.Big)(big.NewInt(1000)),
+			Nonce: 1,
+		},
+		SafeTxHash: ethcommon.HexToHash("0x6621fbe1e2848446e38d99bfda159cdd83f555ae0ed7a4f3e1c3c79f7d6d74f3"),
+		Owners: []ethcommon.Address{
+			ethcommon.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"),
+			ethcommon.HexToAddress("0xdbb881a51CD4023E4400CEF3ef73046743f08da3"),
+		},
+		Threshold:  2,
+		Signatures: []*entities.SafeSignature{},
+		Status:     entities.SafeProposalStatusPending,
+		Labels:     map[string]string{},
+		TenantID:   "tenantOne",
+		OwnerID:    "username",
+	}
+}
@@ -0,0 +1,23 @@
+package testdata
+
+import (
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/gofrs/uuid"
+)
+
+func FakeSentrySession() *entities.SentrySession {
+	return &entities.SentrySession{
+		JobUUID:          uuid.Must(uuid.NewV4()).String(),
+		ChainUUID:        uuid.Must(uuid.NewV4()).String(),
+		Retries:          1,
+		NChildren:        1,
+		LastChildJobUUID: uuid.Must(uuid.NewV4()).String(),
+		NextAttemptAt:    time.Now().Add(time.Minute),
+		TenantID:         "tenantID",
+		OwnerID:          "ownerID",
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+}
@@ -0,0 +1,29 @@
+package entities
+
+type TxBatchItemType string
+type TxBatchItemStatus string
+
+const (
+	TxBatchSend     TxBatchItemType = "send"
+	TxBatchDeploy   TxBatchItemType = "deploy"
+	TxBatchTransfer TxBatchItemType = "transfer"
+)
+
+const (
+	TxBatchItemCreated   TxBatchItemStatus = "created"
+	TxBatchItemDuplicate TxBatchItemStatus = "duplicate"
+	TxBatchItemInvalid   TxBatchItemStatus = "invalid"
+)
+
+// TxBatchItem is a transaction request of a batch, of a contract transaction, a deployment or a transfer
+type TxBatchItem struct {
+	Type      TxBatchItemType
+	TxRequest *TxRequest
+}
+
+// TxBatchResult is the outcome of a transaction request of a batch, holding the error of an invalid request
+type TxBatchResult struct {
+	Status    TxBatchItemStatus
+	TxRequest *TxRequest
+	Error     error
+}
@@ -2,6 +2,8 @@ package entities
 
 import (
 	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 type TxRequest struct {
@@ -22,4 +24,7 @@ type TxRequestParams struct {
 	MethodSignature string               `json:"methodSignature,omitempty"`
 	Args            []interface{}        `json:"args,omitempty"`
 	Protocol        PrivateTxManagerType `json:"protocol,omitempty" example:"EEA"`
+	Salt            *ethcommon.Hash      `json:"salt,omitempty"`
+	// PrivacyGroupName references a privacy group created through Orchestrate, resolved to its privacy group ID
+	PrivacyGroupName string `json:"privacyGroupName,omitempty"`
 }
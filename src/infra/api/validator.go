@@ -246,6 +246,85 @@ func isNotificationStatus(fl validator.FieldLevel) bool {
 	return true
 }
 
+func isAccountStatus(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.AccountStatusActive), string(entities.AccountStatusDisabled), string(entities.AccountStatusArchived):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSafeProposalStatus(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.SafeProposalStatusPending), string(entities.SafeProposalStatusExecuting),
+			string(entities.SafeProposalStatusExecuted), string(entities.SafeProposalStatusFailed):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSortField(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.SortByCreatedAt), string(entities.SortByUpdatedAt):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSortOrder(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.SortOrderAsc), string(entities.SortOrderDesc):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isRelayerType(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.RelayerTypeForwarder), string(entities.RelayerTypeEntryPoint):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isBlockTag(fl validator.FieldLevel) bool {
+	switch tag := fl.Field().String(); tag {
+	case "", entities.BlockTagLatest, entities.BlockTagPending, entities.BlockTagEarliest:
+		return true
+	default:
+		if _, err := hexutil.DecodeBig(tag); err == nil {
+			return true
+		}
+		_, ok := new(big.Int).SetString(tag, 10)
+		return ok
+	}
+}
+
 func isChannel(fl validator.FieldLevel) bool {
 	if fl.Field().String() != "" {
 		switch fl.Field().String() {
@@ -282,6 +361,12 @@ func init() {
 	_ = validate.RegisterValidation("isEventStreamStatus", isEventStreamStatus)
 	_ = validate.RegisterValidation("isChannel", isChannel)
 	_ = validate.RegisterValidation("isNotificationStatus", isNotificationStatus)
+	_ = validate.RegisterValidation("isAccountStatus", isAccountStatus)
+	_ = validate.RegisterValidation("isSafeProposalStatus", isSafeProposalStatus)
+	_ = validate.RegisterValidation("isRelayerType", isRelayerType)
+	_ = validate.RegisterValidation("isBlockTag", isBlockTag)
+	_ = validate.RegisterValidation("isSortField", isSortField)
+	_ = validate.RegisterValidation("isSortOrder", isSortOrder)
 }
 
 func GetValidator() *validator.Validate {
@@ -33,6 +33,8 @@ type EEATransactionSender interface {
 	PrivDistributeRawTransaction(ctx context.Context, endpoint string, raw hexutil.Bytes) (ethcommon.Hash, error)
 	// Creates a group of nodes, specified by their EEA public key.
 	PrivCreatePrivacyGroup(ctx context.Context, endpoint string, addresses []string) (string, error)
+	// Deletes the privacy group, returning its ID
+	PrivDeletePrivacyGroup(ctx context.Context, endpoint, privacyGroupID string) (string, error)
 }
 
 type QuorumTransactionSender interface {
@@ -80,6 +82,11 @@ type EEAChainLedgerReader interface {
 	PrivCodeAt(ctx context.Context, url string, account ethcommon.Address, privateGroupID string, blockNumber *big.Int) ([]byte, error)
 }
 
+type QuorumChainLedgerReader interface {
+	// QuorumPrivateTransactionReceipt returns the receipt of a private transaction executed on the private state of the node.
+	QuorumPrivateTransactionReceipt(ctx context.Context, url string, txHash ethcommon.Hash) (*proto.Receipt, error)
+}
+
 // ChainStateReader is a service to access a blockchain state information
 type ChainStateReader interface {
 	// BalanceAt returns wei balance of the given account.
@@ -120,7 +127,8 @@ type EEAChainStateReader interface {
 	// PrivNonce Returns the private transaction count for specified account and privacy group
 	PrivNonce(ctx context.Context, endpoint string, account ethcommon.Address, privacyGroupID string) (uint64, error)
 
-	PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]string, error)
+	// PrivFindPrivacyGroup Returns the privacy groups containing only the listed members
+	PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]*types.PrivacyGroup, error)
 
 	// EEAPrivPrecompiledContractAddr Returns the private precompiled contract address of Besu/EEA
 	EEAPrivPrecompiledContractAddr(ctx context.Context, endpoint string) (ethcommon.Address, error)
@@ -142,6 +150,18 @@ type ContractCaller interface {
 	PendingCallContract(ctx context.Context, url string, msg *eth.CallMsg) ([]byte, error)
 }
 
+type EEAContractCaller interface {
+	// PrivCallContract executes a message call against the private state of a privacy group.
+	// The block number can be nil, in which case the call is executed on the latest known block.
+	PrivCallContract(ctx context.Context, url, privacyGroupID string, msg *eth.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+type QuorumContractCaller interface {
+	// QuorumCallContract executes a message call against the private state of the node, selected by privateFrom on multi-tenant nodes.
+	// The block number can be nil, in which case the call is executed on the latest known block.
+	QuorumCallContract(ctx context.Context, url string, msg *eth.CallMsg, privateFrom string, blockNumber *big.Int) ([]byte, error)
+}
+
 // GasEstimator is a service that can provide transaction gas price estimation
 type GasEstimator interface {
 	// EstimateGas tries to estimate the gas needed to execute a specific transaction
@@ -174,8 +194,11 @@ type MultiClient interface {
 	EEATransactionSender
 	EEAChainLedgerReader
 	EEAChainStateReader
+	EEAContractCaller
 	QuorumTransactionSender
+	QuorumChainLedgerReader
 	QuorumChainStateReader
+	QuorumContractCaller
 	Call(ctx context.Context, endpoint string, processResult func(result json.RawMessage) error, method string, args ...interface{}) error
 }
 
@@ -198,6 +221,7 @@ type EEAClient interface {
 	EEATransactionSender
 	EEAChainLedgerReader
 	EEAChainStateReader
+	EEAContractCaller
 }
 
 type QuorumClient interface {
@@ -207,5 +231,7 @@ type QuorumClient interface {
 	ChainSyncReader
 	ChainLedgerReader
 	QuorumTransactionSender
+	QuorumChainLedgerReader
 	QuorumChainStateReader
+	QuorumContractCaller
 }
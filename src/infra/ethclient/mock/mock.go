@@ -140,6 +140,21 @@ func (mr *MockEEATransactionSenderMockRecorder) PrivCreatePrivacyGroup(ctx, endp
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCreatePrivacyGroup", reflect.TypeOf((*MockEEATransactionSender)(nil).PrivCreatePrivacyGroup), ctx, endpoint, addresses)
 }
 
+// PrivDeletePrivacyGroup mocks base method
+func (m *MockEEATransactionSender) PrivDeletePrivacyGroup(ctx context.Context, endpoint, privacyGroupID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivDeletePrivacyGroup", ctx, endpoint, privacyGroupID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivDeletePrivacyGroup indicates an expected call of PrivDeletePrivacyGroup
+func (mr *MockEEATransactionSenderMockRecorder) PrivDeletePrivacyGroup(ctx, endpoint, privacyGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivDeletePrivacyGroup", reflect.TypeOf((*MockEEATransactionSender)(nil).PrivDeletePrivacyGroup), ctx, endpoint, privacyGroupID)
+}
+
 // MockQuorumTransactionSender is a mock of QuorumTransactionSender interface
 type MockQuorumTransactionSender struct {
 	ctrl     *gomock.Controller
@@ -375,6 +390,44 @@ func (mr *MockEEAChainLedgerReaderMockRecorder) PrivCodeAt(ctx, url, account, pr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCodeAt", reflect.TypeOf((*MockEEAChainLedgerReader)(nil).PrivCodeAt), ctx, url, account, privateGroupID, blockNumber)
 }
 
+// MockQuorumChainLedgerReader is a mock of QuorumChainLedgerReader interface
+type MockQuorumChainLedgerReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuorumChainLedgerReaderMockRecorder
+}
+
+// MockQuorumChainLedgerReaderMockRecorder is the mock recorder for MockQuorumChainLedgerReader
+type MockQuorumChainLedgerReaderMockRecorder struct {
+	mock *MockQuorumChainLedgerReader
+}
+
+// NewMockQuorumChainLedgerReader creates a new mock instance
+func NewMockQuorumChainLedgerReader(ctrl *gomock.Controller) *MockQuorumChainLedgerReader {
+	mock := &MockQuorumChainLedgerReader{ctrl: ctrl}
+	mock.recorder = &MockQuorumChainLedgerReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockQuorumChainLedgerReader) EXPECT() *MockQuorumChainLedgerReaderMockRecorder {
+	return m.recorder
+}
+
+// QuorumPrivateTransactionReceipt mocks base method
+func (m *MockQuorumChainLedgerReader) QuorumPrivateTransactionReceipt(ctx context.Context, url string, txHash common.Hash) (*ethereum.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuorumPrivateTransactionReceipt", ctx, url, txHash)
+	ret0, _ := ret[0].(*ethereum.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuorumPrivateTransactionReceipt indicates an expected call of QuorumPrivateTransactionReceipt
+func (mr *MockQuorumChainLedgerReaderMockRecorder) QuorumPrivateTransactionReceipt(ctx, url, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuorumPrivateTransactionReceipt", reflect.TypeOf((*MockQuorumChainLedgerReader)(nil).QuorumPrivateTransactionReceipt), ctx, url, txHash)
+}
+
 // MockChainStateReader is a mock of ChainStateReader interface
 type MockChainStateReader struct {
 	ctrl     *gomock.Controller
@@ -587,10 +640,10 @@ func (mr *MockEEAChainStateReaderMockRecorder) PrivNonce(ctx, endpoint, account,
 }
 
 // PrivFindPrivacyGroup mocks base method
-func (m *MockEEAChainStateReader) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]string, error) {
+func (m *MockEEAChainStateReader) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]*types.PrivacyGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PrivFindPrivacyGroup", ctx, endpoint, members)
-	ret0, _ := ret[0].([]string)
+	ret0, _ := ret[0].([]*types.PrivacyGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -707,6 +760,82 @@ func (mr *MockContractCallerMockRecorder) PendingCallContract(ctx, url, msg inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingCallContract", reflect.TypeOf((*MockContractCaller)(nil).PendingCallContract), ctx, url, msg)
 }
 
+// MockEEAContractCaller is a mock of EEAContractCaller interface
+type MockEEAContractCaller struct {
+	ctrl     *gomock.Controller
+	recorder *MockEEAContractCallerMockRecorder
+}
+
+// MockEEAContractCallerMockRecorder is the mock recorder for MockEEAContractCaller
+type MockEEAContractCallerMockRecorder struct {
+	mock *MockEEAContractCaller
+}
+
+// NewMockEEAContractCaller creates a new mock instance
+func NewMockEEAContractCaller(ctrl *gomock.Controller) *MockEEAContractCaller {
+	mock := &MockEEAContractCaller{ctrl: ctrl}
+	mock.recorder = &MockEEAContractCallerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEEAContractCaller) EXPECT() *MockEEAContractCallerMockRecorder {
+	return m.recorder
+}
+
+// PrivCallContract mocks base method
+func (m *MockEEAContractCaller) PrivCallContract(ctx context.Context, url, privacyGroupID string, msg *ethereum0.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, privacyGroupID, msg, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockEEAContractCallerMockRecorder) PrivCallContract(ctx, url, privacyGroupID, msg, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockEEAContractCaller)(nil).PrivCallContract), ctx, url, privacyGroupID, msg, blockNumber)
+}
+
+// MockQuorumContractCaller is a mock of QuorumContractCaller interface
+type MockQuorumContractCaller struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuorumContractCallerMockRecorder
+}
+
+// MockQuorumContractCallerMockRecorder is the mock recorder for MockQuorumContractCaller
+type MockQuorumContractCallerMockRecorder struct {
+	mock *MockQuorumContractCaller
+}
+
+// NewMockQuorumContractCaller creates a new mock instance
+func NewMockQuorumContractCaller(ctrl *gomock.Controller) *MockQuorumContractCaller {
+	mock := &MockQuorumContractCaller{ctrl: ctrl}
+	mock.recorder = &MockQuorumContractCallerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockQuorumContractCaller) EXPECT() *MockQuorumContractCallerMockRecorder {
+	return m.recorder
+}
+
+// QuorumCallContract mocks base method
+func (m *MockQuorumContractCaller) QuorumCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privateFrom string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuorumCallContract", ctx, url, msg, privateFrom, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuorumCallContract indicates an expected call of QuorumCallContract
+func (mr *MockQuorumContractCallerMockRecorder) QuorumCallContract(ctx, url, msg, privateFrom, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuorumCallContract", reflect.TypeOf((*MockQuorumContractCaller)(nil).QuorumCallContract), ctx, url, msg, privateFrom, blockNumber)
+}
+
 // MockGasEstimator is a mock of GasEstimator interface
 type MockGasEstimator struct {
 	ctrl     *gomock.Controller
@@ -1295,6 +1424,21 @@ func (mr *MockMultiClientMockRecorder) PrivCreatePrivacyGroup(ctx, endpoint, add
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCreatePrivacyGroup", reflect.TypeOf((*MockMultiClient)(nil).PrivCreatePrivacyGroup), ctx, endpoint, addresses)
 }
 
+// PrivDeletePrivacyGroup mocks base method
+func (m *MockMultiClient) PrivDeletePrivacyGroup(ctx context.Context, endpoint, privacyGroupID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivDeletePrivacyGroup", ctx, endpoint, privacyGroupID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivDeletePrivacyGroup indicates an expected call of PrivDeletePrivacyGroup
+func (mr *MockMultiClientMockRecorder) PrivDeletePrivacyGroup(ctx, endpoint, privacyGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivDeletePrivacyGroup", reflect.TypeOf((*MockMultiClient)(nil).PrivDeletePrivacyGroup), ctx, endpoint, privacyGroupID)
+}
+
 // PrivateTransactionReceipt mocks base method
 func (m *MockMultiClient) PrivateTransactionReceipt(ctx context.Context, url string, txHash common.Hash) (*ethereum.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -1356,10 +1500,10 @@ func (mr *MockMultiClientMockRecorder) PrivNonce(ctx, endpoint, account, privacy
 }
 
 // PrivFindPrivacyGroup mocks base method
-func (m *MockMultiClient) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]string, error) {
+func (m *MockMultiClient) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]*types.PrivacyGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PrivFindPrivacyGroup", ctx, endpoint, members)
-	ret0, _ := ret[0].([]string)
+	ret0, _ := ret[0].([]*types.PrivacyGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1385,6 +1529,21 @@ func (mr *MockMultiClientMockRecorder) EEAPrivPrecompiledContractAddr(ctx, endpo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EEAPrivPrecompiledContractAddr", reflect.TypeOf((*MockMultiClient)(nil).EEAPrivPrecompiledContractAddr), ctx, endpoint)
 }
 
+// PrivCallContract mocks base method
+func (m *MockMultiClient) PrivCallContract(ctx context.Context, url, privacyGroupID string, msg *ethereum0.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, privacyGroupID, msg, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockMultiClientMockRecorder) PrivCallContract(ctx, url, privacyGroupID, msg, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockMultiClient)(nil).PrivCallContract), ctx, url, privacyGroupID, msg, blockNumber)
+}
+
 // SendQuorumRawPrivateTransaction mocks base method
 func (m *MockMultiClient) SendQuorumRawPrivateTransaction(ctx context.Context, url string, raw hexutil.Bytes, privateFor, mandatoryFor []string, privacyFlag int) (common.Hash, error) {
 	m.ctrl.T.Helper()
@@ -1415,6 +1574,21 @@ func (mr *MockMultiClientMockRecorder) StoreRaw(ctx, endpoint, data, privateFrom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRaw", reflect.TypeOf((*MockMultiClient)(nil).StoreRaw), ctx, endpoint, data, privateFrom)
 }
 
+// QuorumPrivateTransactionReceipt mocks base method
+func (m *MockMultiClient) QuorumPrivateTransactionReceipt(ctx context.Context, url string, txHash common.Hash) (*ethereum.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuorumPrivateTransactionReceipt", ctx, url, txHash)
+	ret0, _ := ret[0].(*ethereum.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuorumPrivateTransactionReceipt indicates an expected call of QuorumPrivateTransactionReceipt
+func (mr *MockMultiClientMockRecorder) QuorumPrivateTransactionReceipt(ctx, url, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuorumPrivateTransactionReceipt", reflect.TypeOf((*MockMultiClient)(nil).QuorumPrivateTransactionReceipt), ctx, url, txHash)
+}
+
 // GetStatus mocks base method
 func (m *MockMultiClient) GetStatus(ctx context.Context, endpoint string) (string, error) {
 	m.ctrl.T.Helper()
@@ -1430,6 +1604,21 @@ func (mr *MockMultiClientMockRecorder) GetStatus(ctx, endpoint interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockMultiClient)(nil).GetStatus), ctx, endpoint)
 }
 
+// QuorumCallContract mocks base method
+func (m *MockMultiClient) QuorumCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privateFrom string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuorumCallContract", ctx, url, msg, privateFrom, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuorumCallContract indicates an expected call of QuorumCallContract
+func (mr *MockMultiClientMockRecorder) QuorumCallContract(ctx, url, msg, privateFrom, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuorumCallContract", reflect.TypeOf((*MockMultiClient)(nil).QuorumCallContract), ctx, url, msg, privateFrom, blockNumber)
+}
+
 // Call mocks base method
 func (m *MockMultiClient) Call(ctx context.Context, endpoint string, processResult func(json.RawMessage) error, method string, args ...interface{}) error {
 	m.ctrl.T.Helper()
@@ -2040,6 +2229,21 @@ func (mr *MockEEAClientMockRecorder) PrivCreatePrivacyGroup(ctx, endpoint, addre
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCreatePrivacyGroup", reflect.TypeOf((*MockEEAClient)(nil).PrivCreatePrivacyGroup), ctx, endpoint, addresses)
 }
 
+// PrivDeletePrivacyGroup mocks base method
+func (m *MockEEAClient) PrivDeletePrivacyGroup(ctx context.Context, endpoint, privacyGroupID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivDeletePrivacyGroup", ctx, endpoint, privacyGroupID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivDeletePrivacyGroup indicates an expected call of PrivDeletePrivacyGroup
+func (mr *MockEEAClientMockRecorder) PrivDeletePrivacyGroup(ctx, endpoint, privacyGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivDeletePrivacyGroup", reflect.TypeOf((*MockEEAClient)(nil).PrivDeletePrivacyGroup), ctx, endpoint, privacyGroupID)
+}
+
 // PrivateTransactionReceipt mocks base method
 func (m *MockEEAClient) PrivateTransactionReceipt(ctx context.Context, url string, txHash common.Hash) (*ethereum.Receipt, error) {
 	m.ctrl.T.Helper()
@@ -2101,10 +2305,10 @@ func (mr *MockEEAClientMockRecorder) PrivNonce(ctx, endpoint, account, privacyGr
 }
 
 // PrivFindPrivacyGroup mocks base method
-func (m *MockEEAClient) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]string, error) {
+func (m *MockEEAClient) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]*types.PrivacyGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PrivFindPrivacyGroup", ctx, endpoint, members)
-	ret0, _ := ret[0].([]string)
+	ret0, _ := ret[0].([]*types.PrivacyGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -2130,6 +2334,21 @@ func (mr *MockEEAClientMockRecorder) EEAPrivPrecompiledContractAddr(ctx, endpoin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EEAPrivPrecompiledContractAddr", reflect.TypeOf((*MockEEAClient)(nil).EEAPrivPrecompiledContractAddr), ctx, endpoint)
 }
 
+// PrivCallContract mocks base method
+func (m *MockEEAClient) PrivCallContract(ctx context.Context, url, privacyGroupID string, msg *ethereum0.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrivCallContract", ctx, url, privacyGroupID, msg, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrivCallContract indicates an expected call of PrivCallContract
+func (mr *MockEEAClientMockRecorder) PrivCallContract(ctx, url, privacyGroupID, msg, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrivCallContract", reflect.TypeOf((*MockEEAClient)(nil).PrivCallContract), ctx, url, privacyGroupID, msg, blockNumber)
+}
+
 // MockQuorumClient is a mock of QuorumClient interface
 type MockQuorumClient struct {
 	ctrl     *gomock.Controller
@@ -2394,6 +2613,21 @@ func (mr *MockQuorumClientMockRecorder) StoreRaw(ctx, endpoint, data, privateFro
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRaw", reflect.TypeOf((*MockQuorumClient)(nil).StoreRaw), ctx, endpoint, data, privateFrom)
 }
 
+// QuorumPrivateTransactionReceipt mocks base method
+func (m *MockQuorumClient) QuorumPrivateTransactionReceipt(ctx context.Context, url string, txHash common.Hash) (*ethereum.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuorumPrivateTransactionReceipt", ctx, url, txHash)
+	ret0, _ := ret[0].(*ethereum.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuorumPrivateTransactionReceipt indicates an expected call of QuorumPrivateTransactionReceipt
+func (mr *MockQuorumClientMockRecorder) QuorumPrivateTransactionReceipt(ctx, url, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuorumPrivateTransactionReceipt", reflect.TypeOf((*MockQuorumClient)(nil).QuorumPrivateTransactionReceipt), ctx, url, txHash)
+}
+
 // GetStatus mocks base method
 func (m *MockQuorumClient) GetStatus(ctx context.Context, endpoint string) (string, error) {
 	m.ctrl.T.Helper()
@@ -2408,3 +2642,18 @@ func (mr *MockQuorumClientMockRecorder) GetStatus(ctx, endpoint interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockQuorumClient)(nil).GetStatus), ctx, endpoint)
 }
+
+// QuorumCallContract mocks base method
+func (m *MockQuorumClient) QuorumCallContract(ctx context.Context, url string, msg *ethereum0.CallMsg, privateFrom string, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuorumCallContract", ctx, url, msg, privateFrom, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuorumCallContract indicates an expected call of QuorumCallContract
+func (mr *MockQuorumClientMockRecorder) QuorumCallContract(ctx, url, msg, privateFrom, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuorumCallContract", reflect.TypeOf((*MockQuorumClient)(nil).QuorumCallContract), ctx, url, msg, privateFrom, blockNumber)
+}
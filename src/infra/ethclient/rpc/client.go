@@ -15,9 +15,12 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/infra/ethclient/utils"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ParseResultFunc func(result json.RawMessage) error
@@ -53,7 +56,13 @@ func NewClient(client *http.Client) *Client {
 	}
 }
 
-func (ec *Client) Call(ctx context.Context, endpoint string, processResult func(result json.RawMessage) error, method string, args ...interface{}) error {
+func (ec *Client) Call(ctx context.Context, endpoint string, processResult func(result json.RawMessage) error, method string, args ...interface{}) (err error) {
+	ctx, span := tracing.StartSpan(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.RPCSystemKey.String("jsonrpc"), semconv.RPCMethodKey.String(method)),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	bckoff := backoff.WithContext(ec.pool.Get().(backoff.BackOff), ctx)
 	defer ec.pool.Put(bckoff)
 
@@ -177,6 +186,7 @@ func (ec *Client) newJSONRpcRequestWithContext(ctx context.Context, endpoint, me
 	// Set headers for JSON-RPC request
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
+	tracing.InjectHTTPHeaders(ctx, req.Header)
 
 	return req, nil
 }
@@ -7,23 +7,30 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	proto "github.com/consensys/orchestrate/pkg/types/ethereum"
+	"github.com/consensys/orchestrate/src/infra/ethclient/types"
 	"github.com/consensys/orchestrate/src/infra/ethclient/utils"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // Transaction Receipt
 type privateReceipt struct {
-	ContractAddress string       `json:"contractAddress,omitempty"`
-	From            string       `json:"from,omitempty"`
-	Output          string       `json:"output,omitempty"`
-	CommitmentHash  string       `json:"commitmentHash,omitempty"`
-	TransactionHash string       `json:"transactionHash,omitempty"`
-	PrivateFrom     string       `json:"privateFrom,omitempty"`
-	PrivateFor      []string     `json:"privateFor,omitempty"`
-	PrivacyGroupID  string       `json:"privacyGroupId,omitempty"`
-	Status          string       `json:"status,omitempty"`
-	Logs            []*proto.Log `json:"logs,omitempty"`
+	ContractAddress  string       `json:"contractAddress,omitempty"`
+	From             string       `json:"from,omitempty"`
+	To               string       `json:"to,omitempty"`
+	BlockHash        string       `json:"blockHash,omitempty"`
+	BlockNumber      string       `json:"blockNumber,omitempty"`
+	TransactionIndex string       `json:"transactionIndex,omitempty"`
+	RevertReason     string       `json:"revertReason,omitempty"`
+	Output           string       `json:"output,omitempty"`
+	CommitmentHash   string       `json:"commitmentHash,omitempty"`
+	TransactionHash  string       `json:"transactionHash,omitempty"`
+	PrivateFrom      string       `json:"privateFrom,omitempty"`
+	PrivateFor       []string     `json:"privateFor,omitempty"`
+	PrivacyGroupID   string       `json:"privacyGroupId,omitempty"`
+	Status           string       `json:"status,omitempty"`
+	Logs             []*proto.Log `json:"logs,omitempty"`
 }
 
 // Distributes a signed, RLP encoded private transaction.
@@ -36,6 +43,8 @@ func (ec *Client) PrivDistributeRawTransaction(ctx context.Context, endpoint str
 	return txHash, nil
 }
 
+// PrivCreatePrivacyGroup creates a group of nodes, returning the privacy group ID
+// https://besu.hyperledger.org/en/stable/Reference/API-Methods/#priv_createprivacygroup
 func (ec *Client) PrivCreatePrivacyGroup(ctx context.Context, endpoint string, addresses []string) (string, error) {
 	var privGroupID string
 	err := ec.Call(ctx, endpoint, utils.ProcessResult(&privGroupID), "priv_createPrivacyGroup",
@@ -67,13 +76,25 @@ func (ec *Client) PrivNonce(ctx context.Context, endpoint string, account ethcom
 }
 
 // Returns a list of privacy groups containing only the listed members. For example, if the listed members are A and B, a privacy group containing A, B, and C is not returned.
-func (ec *Client) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]string, error) {
-	var groupIDs []string
-	err := ec.Call(ctx, endpoint, utils.ProcessResult(&members), "priv_findPrivacyGroup", members)
+// https://besu.hyperledger.org/en/stable/Reference/API-Methods/#priv_findprivacygroup
+func (ec *Client) PrivFindPrivacyGroup(ctx context.Context, endpoint string, members []string) ([]*types.PrivacyGroup, error) {
+	var groups []*types.PrivacyGroup
+	err := ec.Call(ctx, endpoint, utils.ProcessResult(&groups), "priv_findPrivacyGroup", members)
 	if err != nil {
 		return nil, errors.FromError(err).ExtendComponent(component)
 	}
-	return groupIDs, nil
+	return groups, nil
+}
+
+// PrivDeletePrivacyGroup deletes the privacy group, returning its ID
+// https://besu.hyperledger.org/en/stable/Reference/API-Methods/#priv_deleteprivacygroup
+func (ec *Client) PrivDeletePrivacyGroup(ctx context.Context, endpoint, privacyGroupID string) (string, error) {
+	var deletedGroupID string
+	err := ec.Call(ctx, endpoint, utils.ProcessResult(&deletedGroupID), "priv_deletePrivacyGroup", privacyGroupID)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+	return deletedGroupID, nil
 }
 
 // PrivCodeAt returns the contract code of the given account.
@@ -132,6 +153,18 @@ func (ec *Client) PrivateTransactionReceipt(ctx context.Context, endpoint string
 	return r, nil
 }
 
+// PrivCallContract executes a call against the private state of the given privacy group.
+// The block number can be nil, in which case the call is executed on the latest known block.
+// https://besu.hyperledger.org/en/stable/Reference/API-Methods/#priv_call
+func (ec *Client) PrivCallContract(ctx context.Context, endpoint, privacyGroupID string, msg *eth.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var hex hexutil.Bytes
+	err := ec.Call(ctx, endpoint, utils.ProcessResult(&hex), "priv_call", privacyGroupID, toCallArg(msg), toBlockNumArg(blockNumber))
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+	return hex, nil
+}
+
 func processPrivateReceiptResult(receipt **privateReceipt) ParseResultFunc {
 	return func(result json.RawMessage) error {
 		err := utils.ProcessResult(&receipt)(result)
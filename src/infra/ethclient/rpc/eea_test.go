@@ -8,9 +8,11 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/src/infra/ethclient/testutils"
+	"github.com/consensys/orchestrate/src/infra/ethclient/types"
 	proto "github.com/consensys/orchestrate/pkg/types/ethereum"
 	pkgUtils "github.com/consensys/orchestrate/pkg/utils"
 	"github.com/cenkalti/backoff/v4"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
@@ -105,3 +107,41 @@ func TestPrivateTransactionReceipt(t *testing.T) {
 	// 	assert.NotNil(t, receipt, "Public receipt should be there")
 	// })
 }
+
+func TestPrivFindPrivacyGroup(t *testing.T) {
+	ec := newEEAClient()
+
+	group := &types.PrivacyGroup{
+		PrivacyGroupID: "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=",
+		Type:           "PANTHEON",
+		Members:        []string{"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=", "B1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="},
+	}
+
+	ctx := testutils.NewContext(nil, 200, testutils.MakeRespBody([]*types.PrivacyGroup{group}, ""))
+	groups, err := ec.PrivFindPrivacyGroup(ctx, "test-endpoint", group.Members)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.PrivacyGroup{group}, groups)
+}
+
+func TestPrivDeletePrivacyGroup(t *testing.T) {
+	ec := newEEAClient()
+	privacyGroupID := "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
+
+	ctx := testutils.NewContext(nil, 200, testutils.MakeRespBody(privacyGroupID, ""))
+	deletedGroupID, err := ec.PrivDeletePrivacyGroup(ctx, "test-endpoint", privacyGroupID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, privacyGroupID, deletedGroupID)
+}
+
+func TestPrivCallContract(t *testing.T) {
+	ec := newEEAClient()
+
+	ctx := testutils.NewContext(nil, 200, testutils.MakeRespBody("0x0000000000000000000000000000000000000000000000000000000000000001", ""))
+	to := ethcommon.HexToAddress("0x1")
+	output, err := ec.PrivCallContract(ctx, "test-endpoint", "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=", &eth.CallMsg{To: &to}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, ethcommon.LeftPadBytes([]byte{1}, 32), output)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	backoffmock "github.com/consensys/orchestrate/pkg/backoff/mock"
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	pkgUtils "github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/infra/ethclient/testutils"
 	"github.com/consensys/orchestrate/src/infra/ethclient/types"
@@ -24,6 +25,13 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func newEthereumClient() *Client {
@@ -107,6 +115,52 @@ func TestCallWithRetry(t *testing.T) {
 	assert.True(t, bckoff.HasRetried(), "#4 Should have retried")
 }
 
+type headerRecorderRoundTripper struct {
+	testutils.MockRoundTripper
+	header http.Header
+}
+
+func (rt *headerRecorderRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.header = req.Header
+	return rt.MockRoundTripper.RoundTrip(req)
+}
+
+func TestCallTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), 1, "test"))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	rt := &headerRecorderRoundTripper{}
+	newBackOff := func() backoff.BackOff { return pkgUtils.NewBackOff(testutils.TestConfig) }
+	ec := NewClientWithBackOff(newBackOff, &http.Client{Transport: rt})
+
+	t.Run("should trace JSON-RPC call and propagate trace context to the node", func(t *testing.T) {
+		exporter.Reset()
+
+		ctx := testutils.NewContext(nil, 200, testutils.MakeRespBody("0x1", ""))
+		_, err := ec.SuggestGasPrice(ctx, "test-endpoint")
+		require.NoError(t, err)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "eth_gasPrice", spans[0].Name)
+		assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind)
+		assert.Contains(t, rt.header.Get("traceparent"), spans[0].SpanContext.SpanID().String())
+	})
+
+	t.Run("should mark span as failed if JSON-RPC call fails", func(t *testing.T) {
+		exporter.Reset()
+
+		ctx := testutils.NewContext(nil, 200, testutils.MakeRespBody(nil, "test-error"))
+		_, err := ec.SuggestGasPrice(ctx, "test-endpoint")
+		require.Error(t, err)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+	})
+}
+
 func TestBlockByHash(t *testing.T) {
 	ec := newEthereumClient()
 
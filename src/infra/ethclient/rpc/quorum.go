@@ -7,13 +7,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/consensys/orchestrate/pkg/errors"
+	proto "github.com/consensys/orchestrate/pkg/types/ethereum"
 	infra "github.com/consensys/orchestrate/src/infra/api"
 	"github.com/consensys/orchestrate/src/infra/ethclient/utils"
+	eth "github.com/ethereum/go-ethereum"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
@@ -47,6 +50,40 @@ func (ec *Client) SendQuorumRawPrivateTransaction(ctx context.Context, endpoint
 	return ethcommon.HexToHash(hash), nil
 }
 
+// QuorumPrivateTransactionReceipt returns the receipt of the private transaction with the given hash, executed on the
+// private state of the node. The transaction hash can be the one of a privacy marker transaction.
+// https://consensys.net/docs/goquorum/en/latest/reference/api-methods/#eth_getprivatetransactionreceipt
+func (ec *Client) QuorumPrivateTransactionReceipt(ctx context.Context, endpoint string, txHash ethcommon.Hash) (*proto.Receipt, error) {
+	var r *proto.Receipt
+	err := ec.Call(ctx, endpoint, utils.ProcessReceiptResult(&r), "eth_getPrivateTransactionReceipt", txHash)
+	if err == nil {
+		return r, nil
+	}
+	if errors.IsConnectionError(err) {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	// Private transactions sent without privacy marker have their private receipt returned by the participant nodes
+	return ec.TransactionReceipt(ctx, endpoint, txHash)
+}
+
+// QuorumCallContract executes a call against the private state of the node, selected by the given private key
+// of the Tessera enclave when the node is multi-tenant.
+// The block number can be nil, in which case the call is executed on the latest known block.
+func (ec *Client) QuorumCallContract(ctx context.Context, endpoint string, msg *eth.CallMsg, privateFrom string, blockNumber *big.Int) ([]byte, error) {
+	arg := toCallArg(msg).(map[string]interface{})
+	if privateFrom != "" {
+		arg["privateFrom"] = privateFrom
+	}
+
+	var hex hexutil.Bytes
+	err := ec.Call(ctx, endpoint, utils.ProcessResult(&hex), "eth_call", arg, toBlockNumArg(blockNumber))
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+	return hex, nil
+}
+
 func (ec *Client) StoreRaw(ctx context.Context, endpoint string, data hexutil.Bytes, privateFrom string) ([]byte, error) {
 	request := map[string]string{
 		"payload": base64.StdEncoding.EncodeToString(data),
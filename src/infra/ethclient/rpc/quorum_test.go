@@ -15,7 +15,10 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/infra/ethclient/testutils"
 	pkgUtils "github.com/consensys/orchestrate/pkg/utils"
+	eth "github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -113,3 +116,35 @@ func TestSendQuorumRawPrivateTransaction(t *testing.T) {
 	_, err := ec.SendQuorumRawPrivateTransaction(ctx, "test-endpoint", nil, nil, nil, 0)
 	assert.Error(t, err, "#1 SendQuorumRawPrivateTransaction should  error")
 }
+
+func TestQuorumPrivateTransactionReceipt(t *testing.T) {
+	ec := newQuorumClient()
+
+	// Test 1 with Error
+	ctx := testutils.NewContext(fmt.Errorf("test-error"), 0, nil)
+	_, err := ec.QuorumPrivateTransactionReceipt(ctx, "test-endpoint", ethcommon.HexToHash(""))
+	assert.True(t, errors.IsConnectionError(err), "#1 QuorumPrivateTransactionReceipt should fail with connection error")
+
+	// Test 2 with private receipt
+	ethReceipt := &ethtypes.Receipt{
+		Status:            1,
+		CumulativeGasUsed: 1000,
+		Logs:              []*ethtypes.Log{},
+		GasUsed:           111111,
+	}
+	ethReceipt.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{ethReceipt})
+	ctx = testutils.NewContext(nil, 200, testutils.MakeRespBody(testutils.NewReceiptResp(ethReceipt), ""))
+	receipt, err := ec.QuorumPrivateTransactionReceipt(ctx, "test-endpoint", ethcommon.HexToHash(""))
+	assert.NoError(t, err, "#2 QuorumPrivateTransactionReceipt should not error")
+	assert.Equal(t, ethReceipt.GasUsed, receipt.GasUsed, "#2 QuorumPrivateTransactionReceipt should have correct gas used")
+}
+
+func TestQuorumCallContract(t *testing.T) {
+	ec := newQuorumClient()
+
+	ctx := testutils.NewContext(nil, 200, testutils.MakeRespBody("0x01", ""))
+	to := ethcommon.HexToAddress("0x1")
+	output, err := ec.QuorumCallContract(ctx, "test-endpoint", &eth.CallMsg{To: &to}, "BULeR8JyUWhiuuCMU/HLA0Q5pzkYT+cHII3ZKBey3Bo=", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, output)
+}
@@ -0,0 +1,11 @@
+package types
+
+// PrivacyGroup is a Besu privacy group as returned by `priv_findPrivacyGroup`
+// https://besu.hyperledger.org/en/stable/Reference/API-Objects/#privacy-group-object
+type PrivacyGroup struct {
+	PrivacyGroupID string   `json:"privacyGroupId"`
+	Name           string   `json:"name,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	Type           string   `json:"type,omitempty"`
+	Members        []string `json:"members"`
+}
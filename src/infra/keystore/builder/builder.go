@@ -0,0 +1,34 @@
+package builder
+
+import (
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	"github.com/consensys/orchestrate/src/infra/keystore/file"
+	pgkeystore "github.com/consensys/orchestrate/src/infra/keystore/postgres"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+)
+
+// NewClient creates the local keystore of the configured type, Postgres keystores storing keys through the given client
+func NewClient(cfg *keystore.Config, postgresClient postgres.Client) (*keystore.Client, error) {
+	if cfg.MasterKey == "" {
+		return nil, errors.ConfigError("master key of the keystore is required")
+	}
+
+	switch cfg.Type {
+	case keystore.TypePostgres:
+		if postgresClient == nil {
+			return nil, errors.ConfigError("postgres is required by the keystore")
+		}
+
+		return keystore.New(pgkeystore.New(postgresClient), cfg.MasterKey), nil
+	case keystore.TypeFile:
+		store, err := file.New(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		return keystore.New(store, cfg.MasterKey), nil
+	default:
+		return nil, errors.ConfigError("invalid keystore type %q", cfg.Type)
+	}
+}
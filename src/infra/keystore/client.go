@@ -0,0 +1,331 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	nonclient "github.com/consensys/orchestrate/src/infra/quorum-key-manager/non-client"
+	"github.com/consensys/orchestrate/src/infra/signer"
+	qkmclient "github.com/consensys/quorum-key-manager/pkg/client"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	qkmutilstypes "github.com/consensys/quorum-key-manager/src/utils/api/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	ethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gofrs/uuid"
+)
+
+const component = "keystore"
+
+// Client is a key manager holding the keys of the accounts encrypted at rest with a master key, used instead of
+// the Quorum Key Manager. All stores are served by the same keystore and only Ethereum accounts are supported.
+type Client struct {
+	nonclient.NonClient
+	store     Store
+	masterKey string
+	scryptN   int
+	scryptP   int
+	keys      sync.Map
+	logger    *log.Logger
+}
+
+var _ qkmclient.KeyManagerClient = &Client{}
+
+func New(store Store, masterKey string) *Client {
+	return &Client{
+		store:     store,
+		masterKey: masterKey,
+		scryptN:   ethkeystore.LightScryptN,
+		scryptP:   ethkeystore.LightScryptP,
+		logger:    log.NewLogger().SetComponent(component),
+	}
+}
+
+type encryptedKeyJSONV3 struct {
+	Address string                 `json:"address"`
+	Crypto  ethkeystore.CryptoJSON `json:"crypto"`
+	ID      string                 `json:"id"`
+	Version int                    `json:"version"`
+}
+
+func (c *Client) CreateEthAccount(ctx context.Context, _ string, req *qkmtypes.CreateEthAccountRequest) (*qkmtypes.EthAccountResponse, error) {
+	privKey, err := crypto.GenerateKey()
+	if err != nil {
+		errMsg := "failed to generate private key"
+		c.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.CryptoOperationError(errMsg).ExtendComponent(component)
+	}
+
+	return c.insert(ctx, privKey, req.KeyID, req.Tags)
+}
+
+func (c *Client) ImportEthAccount(ctx context.Context, _ string, req *qkmtypes.ImportEthAccountRequest) (*qkmtypes.EthAccountResponse, error) {
+	privKey, err := crypto.ToECDSA(req.PrivateKey)
+	if err != nil {
+		return nil, errors.InvalidParameterError("invalid private key").ExtendComponent(component)
+	}
+
+	return c.insert(ctx, privKey, req.KeyID, req.Tags)
+}
+
+func (c *Client) GetEthAccount(ctx context.Context, _, address string) (*qkmtypes.EthAccountResponse, error) {
+	key, err := c.store.FindOneByAddress(ctx, ethcommon.HexToAddress(address).Hex())
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	privKey, err := c.privateKey(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatEthAccountResponse(key, privKey), nil
+}
+
+func (c *Client) ListEthAccounts(ctx context.Context, _ string, limit, page uint64) ([]string, error) {
+	addresses, err := c.store.ListAddresses(ctx)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	if limit == 0 {
+		return addresses, nil
+	}
+
+	start := limit * page
+	if start >= uint64(len(addresses)) {
+		return []string{}, nil
+	}
+	end := start + limit
+	if end > uint64(len(addresses)) {
+		end = uint64(len(addresses))
+	}
+
+	return addresses[start:end], nil
+}
+
+// SignMessage signs the EIP-191 hash of the message
+func (c *Client) SignMessage(ctx context.Context, _, address string, req *qkmtypes.SignMessageRequest) (string, error) {
+	signature, err := c.signHomestead(ctx, address, accounts.TextHash(req.Message))
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.Encode(signature), nil
+}
+
+// SignTypedData signs the EIP-712 hash of the typed data
+func (c *Client) SignTypedData(ctx context.Context, _, address string, req *qkmtypes.SignTypedDataRequest) (string, error) {
+	encodedData, err := signer.EncodeTypedData(req)
+	if err != nil {
+		return "", errors.InvalidParameterError("invalid typed data").AppendReason(err.Error()).ExtendComponent(component)
+	}
+
+	signature, err := c.signHomestead(ctx, address, crypto.Keccak256(encodedData))
+	if err != nil {
+		return "", err
+	}
+
+	return hexutil.Encode(signature), nil
+}
+
+func (c *Client) SignTransaction(ctx context.Context, _, address string, req *qkmtypes.SignETHTransactionRequest) (string, error) {
+	privKey, err := c.privateKey(ctx, address)
+	if err != nil {
+		return "", err
+	}
+
+	signedRaw, err := signTransaction(privKey, req)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signedRaw), nil
+}
+
+func (c *Client) SignQuorumPrivateTransaction(ctx context.Context, _, address string, req *qkmtypes.SignQuorumPrivateTransactionRequest) (string, error) {
+	privKey, err := c.privateKey(ctx, address)
+	if err != nil {
+		return "", err
+	}
+
+	signedRaw, err := signQuorumPrivateTransaction(privKey, req)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signedRaw), nil
+}
+
+func (c *Client) SignEEATransaction(ctx context.Context, _, address string, req *qkmtypes.SignEEATransactionRequest) (string, error) {
+	privKey, err := c.privateKey(ctx, address)
+	if err != nil {
+		return "", err
+	}
+
+	signedRaw, err := signEEATransaction(privKey, req)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	return hexutil.Encode(signedRaw), nil
+}
+
+func (c *Client) ECRecover(_ context.Context, req *qkmutilstypes.ECRecoverRequest) (string, error) {
+	address, err := recoverHomestead(req.Data, req.Signature)
+	if err != nil {
+		return "", errors.FromError(err).ExtendComponent(component)
+	}
+
+	return address.Hex(), nil
+}
+
+func (c *Client) VerifyMessage(_ context.Context, req *qkmutilstypes.VerifyRequest) error {
+	address, err := recoverHomestead(accounts.TextHash(req.Data), req.Signature)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(component)
+	}
+
+	if address != req.Address {
+		return errors.InvalidParameterError("signature does not match the address").ExtendComponent(component)
+	}
+
+	return nil
+}
+
+func (c *Client) VerifyTypedData(_ context.Context, req *qkmutilstypes.VerifyTypedDataRequest) error {
+	encodedData, err := signer.EncodeTypedData(&req.TypedData)
+	if err != nil {
+		return errors.InvalidParameterError("invalid typed data").AppendReason(err.Error()).ExtendComponent(component)
+	}
+
+	address, err := recoverHomestead(crypto.Keccak256(encodedData), req.Signature)
+	if err != nil {
+		return errors.FromError(err).ExtendComponent(component)
+	}
+
+	if address != req.Address {
+		return errors.InvalidParameterError("signature does not match the address").ExtendComponent(component)
+	}
+
+	return nil
+}
+
+func (c *Client) insert(ctx context.Context, privKey *ecdsa.PrivateKey, keyID string, tags map[string]string) (*qkmtypes.EthAccountResponse, error) {
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+	logger := c.logger.WithContext(ctx).WithField("address", address.Hex())
+
+	cryptoJSON, err := ethkeystore.EncryptDataV3(crypto.FromECDSA(privKey), []byte(c.masterKey), c.scryptN, c.scryptP)
+	if err != nil {
+		errMsg := "failed to encrypt private key"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.CryptoOperationError(errMsg).ExtendComponent(component)
+	}
+
+	keyJSON, err := json.Marshal(&encryptedKeyJSONV3{
+		Address: hex.EncodeToString(address.Bytes()),
+		Crypto:  cryptoJSON,
+		ID:      uuid.Must(uuid.NewV4()).String(),
+		Version: 3,
+	})
+	if err != nil {
+		errMsg := "failed to encode encrypted private key"
+		logger.WithError(err).Error(errMsg)
+		return nil, errors.EncodingError(errMsg).ExtendComponent(component)
+	}
+
+	key := &Key{
+		Address: address.Hex(),
+		KeyID:   keyID,
+		Tags:    tags,
+		KeyJSON: keyJSON,
+	}
+	err = c.store.Insert(ctx, key)
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	c.keys.Store(address, privKey)
+
+	logger.Debug("ethereum account stored successfully")
+	return formatEthAccountResponse(key, privKey), nil
+}
+
+// privateKey returns the decrypted key of the account, decrypted keys being kept in memory to avoid running the key
+// derivation function on every signature
+func (c *Client) privateKey(ctx context.Context, address string) (*ecdsa.PrivateKey, error) {
+	addr := ethcommon.HexToAddress(address)
+	if privKey, ok := c.keys.Load(addr); ok {
+		return privKey.(*ecdsa.PrivateKey), nil
+	}
+
+	key, err := c.store.FindOneByAddress(ctx, addr.Hex())
+	if err != nil {
+		return nil, errors.FromError(err).ExtendComponent(component)
+	}
+
+	decryptedKey, err := ethkeystore.DecryptKey(key.KeyJSON, c.masterKey)
+	if err != nil {
+		errMsg := "failed to decrypt private key"
+		c.logger.WithContext(ctx).WithError(err).WithField("address", addr.Hex()).Error(errMsg)
+		return nil, errors.CryptoOperationError(errMsg).ExtendComponent(component)
+	}
+
+	c.keys.Store(addr, decryptedKey.PrivateKey)
+
+	return decryptedKey.PrivateKey, nil
+}
+
+// signHomestead signs the hash with a recovery ID of 27 or 28, as expected for messages and typed data
+func (c *Client) signHomestead(ctx context.Context, address string, hash []byte) ([]byte, error) {
+	privKey, err := c.privateKey(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(hash, privKey)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to sign payload").AppendReason(err.Error()).ExtendComponent(component)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	return signature, nil
+}
+
+func recoverHomestead(hash, signature []byte) (ethcommon.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return ethcommon.Address{}, errors.InvalidParameterError("invalid signature length")
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return ethcommon.Address{}, errors.InvalidParameterError("invalid signature").AppendReason(err.Error())
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+func formatEthAccountResponse(key *Key, privKey *ecdsa.PrivateKey) *qkmtypes.EthAccountResponse {
+	return &qkmtypes.EthAccountResponse{
+		KeyID:               key.KeyID,
+		Tags:                key.Tags,
+		Address:             crypto.PubkeyToAddress(privKey.PublicKey),
+		PublicKey:           crypto.FromECDSAPub(&privKey.PublicKey),
+		CompressedPublicKey: crypto.CompressPubkey(&privKey.PublicKey),
+		CreatedAt:           key.CreatedAt,
+		UpdatedAt:           key.CreatedAt,
+	}
+}
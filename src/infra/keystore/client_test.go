@@ -0,0 +1,324 @@
+// +build unit
+
+package keystore_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	"github.com/consensys/orchestrate/src/infra/keystore/mocks"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	qkmutilstypes "github.com/consensys/quorum-key-manager/src/utils/api/types"
+	ethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	masterKey  = "master-key"
+	privKeyHex = "56202652fdffd802b7252a456dbd8f3ecc0352bbde76c23b40afe8aebd714e20"
+)
+
+func TestClient_Accounts(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockStore(ctrl)
+	privKey, _ := crypto.HexToECDSA(privKeyHex)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	t.Run("should import account with its key encrypted by the master key", func(t *testing.T) {
+		var storedKey *keystore.Key
+		store.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key *keystore.Key) error {
+			storedKey = key
+			return nil
+		})
+
+		resp, err := keystore.New(store, masterKey).ImportEthAccount(ctx, "", &qkmtypes.ImportEthAccountRequest{
+			KeyID:      "my-key",
+			PrivateKey: crypto.FromECDSA(privKey),
+			Tags:       map[string]string{"tenants": "tenantOne"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, address, resp.Address)
+		assert.Equal(t, hexutil.Bytes(crypto.FromECDSAPub(&privKey.PublicKey)), resp.PublicKey)
+		assert.Equal(t, hexutil.Bytes(crypto.CompressPubkey(&privKey.PublicKey)), resp.CompressedPublicKey)
+		assert.Equal(t, address.Hex(), storedKey.Address)
+		assert.Equal(t, "my-key", storedKey.KeyID)
+		assert.NotContains(t, string(storedKey.KeyJSON), privKeyHex)
+
+		decryptedKey, err := ethkeystore.DecryptKey(storedKey.KeyJSON, masterKey)
+		require.NoError(t, err)
+		assert.Equal(t, privKey.D, decryptedKey.PrivateKey.D)
+	})
+
+	t.Run("should create account", func(t *testing.T) {
+		store.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(nil)
+
+		resp, err := keystore.New(store, masterKey).CreateEthAccount(ctx, "", &qkmtypes.CreateEthAccountRequest{})
+
+		require.NoError(t, err)
+		assert.NotEqual(t, ethcommon.Address{}, resp.Address)
+	})
+
+	t.Run("should fail with InvalidParameterError if private key is invalid", func(t *testing.T) {
+		_, err := keystore.New(store, masterKey).ImportEthAccount(ctx, "", &qkmtypes.ImportEthAccountRequest{
+			PrivateKey: hexutil.MustDecode("0x1234"),
+		})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if account already exists", func(t *testing.T) {
+		store.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.AlreadyExistsError("error"))
+
+		_, err := keystore.New(store, masterKey).ImportEthAccount(ctx, "", &qkmtypes.ImportEthAccountRequest{
+			PrivateKey: crypto.FromECDSA(privKey),
+		})
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should decrypt key of account stored by another client", func(t *testing.T) {
+		key := encryptedTestKey(t, privKey, masterKey)
+		store.EXPECT().FindOneByAddress(gomock.Any(), address.Hex()).Return(key, nil).Times(2)
+
+		resp, err := keystore.New(store, masterKey).GetEthAccount(ctx, "", address.Hex())
+
+		require.NoError(t, err)
+		assert.Equal(t, address, resp.Address)
+	})
+
+	t.Run("should fail with CryptoOperationError if master key is wrong", func(t *testing.T) {
+		key := encryptedTestKey(t, privKey, masterKey)
+		store.EXPECT().FindOneByAddress(gomock.Any(), address.Hex()).Return(key, nil)
+
+		_, err := keystore.New(store, "wrong-key").SignMessage(ctx, "", address.Hex(), &qkmtypes.SignMessageRequest{
+			Message: []byte("message"),
+		})
+
+		assert.True(t, errors.IsCryptoOperationError(err))
+	})
+
+	t.Run("should fail with NotFoundError if account is not in the keystore", func(t *testing.T) {
+		store.EXPECT().FindOneByAddress(gomock.Any(), address.Hex()).Return(nil, errors.NotFoundError("error"))
+
+		_, err := keystore.New(store, masterKey).SignMessage(ctx, "", address.Hex(), &qkmtypes.SignMessageRequest{
+			Message: []byte("message"),
+		})
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should paginate accounts", func(t *testing.T) {
+		store.EXPECT().ListAddresses(gomock.Any()).Return([]string{"0x1", "0x2", "0x3"}, nil).Times(2)
+		c := keystore.New(store, masterKey)
+
+		addresses, err := c.ListEthAccounts(ctx, "", 2, 1)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0x3"}, addresses)
+
+		addresses, err = c.ListEthAccounts(ctx, "", 2, 2)
+		require.NoError(t, err)
+		assert.Empty(t, addresses)
+	})
+}
+
+func TestClient_Sign(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	privKey, _ := crypto.HexToECDSA(privKeyHex)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().FindOneByAddress(gomock.Any(), address.Hex()).Return(encryptedTestKey(t, privKey, masterKey), nil)
+	c := keystore.New(store, masterKey)
+	to := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	chainID := big.NewInt(1337)
+
+	t.Run("should sign message verified by the client", func(t *testing.T) {
+		message := []byte("my message")
+
+		signature, err := c.SignMessage(ctx, "", address.Hex(), &qkmtypes.SignMessageRequest{Message: message})
+		require.NoError(t, err)
+
+		err = c.VerifyMessage(ctx, &qkmutilstypes.VerifyRequest{
+			Data:      message,
+			Signature: hexutil.MustDecode(signature),
+			Address:   address,
+		})
+		assert.NoError(t, err)
+
+		err = c.VerifyMessage(ctx, &qkmutilstypes.VerifyRequest{
+			Data:      []byte("another message"),
+			Signature: hexutil.MustDecode(signature),
+			Address:   address,
+		})
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should sign typed data verified by the client", func(t *testing.T) {
+		typedData := &qkmtypes.SignTypedDataRequest{
+			DomainSeparator: qkmtypes.DomainSeparator{Name: "orchestrate", Version: "v1", ChainID: 1},
+			Types: map[string][]qkmtypes.Type{
+				"Mail": {{Name: "sender", Type: "address"}, {Name: "contents", Type: "string"}},
+			},
+			Message:     map[string]interface{}{"sender": to.Hex(), "contents": "hello"},
+			MessageType: "Mail",
+		}
+
+		signature, err := c.SignTypedData(ctx, "", address.Hex(), typedData)
+		require.NoError(t, err)
+
+		err = c.VerifyTypedData(ctx, &qkmutilstypes.VerifyTypedDataRequest{
+			TypedData: *typedData,
+			Signature: hexutil.MustDecode(signature),
+			Address:   address,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should sign legacy transaction", func(t *testing.T) {
+		signedRaw, err := c.SignTransaction(ctx, "", address.Hex(), &qkmtypes.SignETHTransactionRequest{
+			TransactionType: qkmtypes.LegacyTxType,
+			Nonce:           1,
+			To:              &to,
+			GasPrice:        hexutil.Big(*big.NewInt(1000)),
+			GasLimit:        21000,
+			ChainID:         hexutil.Big(*chainID),
+		})
+		require.NoError(t, err)
+
+		tx := &types.Transaction{}
+		require.NoError(t, tx.UnmarshalBinary(hexutil.MustDecode(signedRaw)))
+		sender, err := types.Sender(types.NewLondonSigner(chainID), tx)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+		assert.Equal(t, uint8(types.LegacyTxType), tx.Type())
+	})
+
+	t.Run("should sign dynamic fee transaction", func(t *testing.T) {
+		signedRaw, err := c.SignTransaction(ctx, "", address.Hex(), &qkmtypes.SignETHTransactionRequest{
+			Nonce:     1,
+			To:        &to,
+			GasFeeCap: (*hexutil.Big)(big.NewInt(1000)),
+			GasTipCap: (*hexutil.Big)(big.NewInt(10)),
+			GasLimit:  21000,
+			ChainID:   hexutil.Big(*chainID),
+		})
+		require.NoError(t, err)
+
+		tx := &types.Transaction{}
+		require.NoError(t, tx.UnmarshalBinary(hexutil.MustDecode(signedRaw)))
+		sender, err := types.Sender(types.NewLondonSigner(chainID), tx)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+		assert.Equal(t, uint8(types.DynamicFeeTxType), tx.Type())
+	})
+
+	t.Run("should fail with InvalidParameterError if fees of dynamic fee transaction are missing", func(t *testing.T) {
+		_, err := c.SignTransaction(ctx, "", address.Hex(), &qkmtypes.SignETHTransactionRequest{
+			TransactionType: qkmtypes.DynamicFeeTxType,
+			GasLimit:        21000,
+			ChainID:         hexutil.Big(*chainID),
+		})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should sign quorum private transaction", func(t *testing.T) {
+		signedRaw, err := c.SignQuorumPrivateTransaction(ctx, "", address.Hex(), &qkmtypes.SignQuorumPrivateTransactionRequest{
+			Nonce:    1,
+			To:       &to,
+			GasLimit: 21000,
+			Data:     hexutil.MustDecode("0x1234"),
+		})
+		require.NoError(t, err)
+
+		var fields []interface{}
+		require.NoError(t, rlp.DecodeBytes(hexutil.MustDecode(signedRaw), &fields))
+		require.Len(t, fields, 9)
+		v := new(big.Int).SetBytes(fields[6].([]byte)).Uint64()
+		assert.Contains(t, []uint64{37, 38}, v)
+
+		tx := types.NewTransaction(1, to, big.NewInt(0), 21000, big.NewInt(0), hexutil.MustDecode("0x1234"))
+		sig := append(append(fields[7].([]byte), fields[8].([]byte)...), byte(v-37))
+		pubKey, err := crypto.SigToPub(types.HomesteadSigner{}.Hash(tx).Bytes(), sig)
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+	})
+
+	t.Run("should sign EEA transaction", func(t *testing.T) {
+		privateFrom := base64.StdEncoding.EncodeToString([]byte("privateFrom"))
+		privateFor := base64.StdEncoding.EncodeToString([]byte("privateFor"))
+
+		signedRaw, err := c.SignEEATransaction(ctx, "", address.Hex(), &qkmtypes.SignEEATransactionRequest{
+			Nonce:       1,
+			To:          &to,
+			GasLimit:    21000,
+			ChainID:     hexutil.Big(*chainID),
+			PrivateFrom: privateFrom,
+			PrivateFor:  []string{privateFor},
+		})
+		require.NoError(t, err)
+
+		var fields []interface{}
+		require.NoError(t, rlp.DecodeBytes(hexutil.MustDecode(signedRaw), &fields))
+		require.Len(t, fields, 12)
+		v := new(big.Int).SetBytes(fields[6].([]byte)).Uint64()
+		recID := v - (chainID.Uint64()*2 + 35)
+		assert.True(t, recID <= 1)
+		assert.Equal(t, []byte("privateFrom"), fields[9])
+		assert.Equal(t, []interface{}{[]byte("privateFor")}, fields[10])
+		assert.Equal(t, []byte("restricted"), fields[11])
+
+		payload, _ := rlp.EncodeToBytes([]interface{}{
+			uint64(1), big.NewInt(0), uint64(21000), &to, big.NewInt(0), []byte{}, chainID, uint(0), uint(0),
+			[]byte("privateFrom"), [][]byte{[]byte("privateFor")}, "restricted",
+		})
+		sig := append(append(fields[7].([]byte), fields[8].([]byte)...), byte(recID))
+		pubKey, err := crypto.SigToPub(crypto.Keccak256(payload), sig)
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+	})
+
+	t.Run("should fail with InvalidParameterError if privateFrom is invalid", func(t *testing.T) {
+		_, err := c.SignEEATransaction(ctx, "", address.Hex(), &qkmtypes.SignEEATransactionRequest{
+			ChainID:     hexutil.Big(*chainID),
+			PrivateFrom: "invalid base64",
+		})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
+
+func encryptedTestKey(t *testing.T, privKey *ecdsa.PrivateKey, key string) *keystore.Key {
+	var storedKey *keystore.Key
+	ctrl := gomock.NewController(t)
+	store := mocks.NewMockStore(ctrl)
+	store.EXPECT().Insert(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, k *keystore.Key) error {
+		storedKey = k
+		return nil
+	})
+
+	_, err := keystore.New(store, key).ImportEthAccount(context.Background(), "", &qkmtypes.ImportEthAccountRequest{
+		PrivateKey: crypto.FromECDSA(privKey),
+	})
+	require.NoError(t, err)
+
+	return storedKey
+}
@@ -0,0 +1,12 @@
+package keystore
+
+const (
+	TypePostgres = "postgres"
+	TypeFile     = "file"
+)
+
+type Config struct {
+	Type      string
+	Path      string
+	MasterKey string
+}
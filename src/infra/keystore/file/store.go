@@ -0,0 +1,145 @@
+package file
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const keyFileTimeLayout = "2006-01-02T15-04-05.000000000Z"
+
+// Store keeps each key in a V3 keystore file of the directory, named as by geth so that the directory can be used as
+// a geth keystore. Key IDs and tags are not persisted.
+type Store struct {
+	dir string
+}
+
+var _ keystore.Store = &Store{}
+
+func New(dir string) (*Store, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.StorageError("failed to create keystore directory").AppendReason(err.Error())
+	}
+
+	return &Store{dir: dir}, nil
+}
+
+func (s *Store) Insert(_ context.Context, key *keystore.Key) error {
+	address := ethcommon.HexToAddress(key.Address)
+	if _, err := s.findFile(address); err == nil {
+		return errors.AlreadyExistsError("account already exists in keystore")
+	}
+
+	createdAt := time.Now().UTC()
+	fileName := fmt.Sprintf("UTC--%s--%s", createdAt.Format(keyFileTimeLayout), hex.EncodeToString(address.Bytes()))
+
+	// Keys are written to a temporary file first so that a partially written key is never read
+	tmpFile, err := ioutil.TempFile(s.dir, "."+fileName+".tmp")
+	if err != nil {
+		return errors.StorageError("failed to create key file").AppendReason(err.Error())
+	}
+
+	_, err = tmpFile.Write(key.KeyJSON)
+	if err == nil {
+		err = tmpFile.Close()
+	}
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), filepath.Join(s.dir, fileName))
+	}
+	if err != nil {
+		_ = os.Remove(tmpFile.Name())
+		return errors.StorageError("failed to write key file").AppendReason(err.Error())
+	}
+
+	key.CreatedAt = createdAt
+	return nil
+}
+
+func (s *Store) FindOneByAddress(_ context.Context, address string) (*keystore.Key, error) {
+	fileInfo, err := s.findFile(ethcommon.HexToAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	keyJSON, err := ioutil.ReadFile(filepath.Join(s.dir, fileInfo.Name()))
+	if err != nil {
+		return nil, errors.StorageError("failed to read key file").AppendReason(err.Error())
+	}
+
+	return &keystore.Key{
+		Address:   ethcommon.HexToAddress(address).Hex(),
+		KeyJSON:   keyJSON,
+		CreatedAt: fileInfo.ModTime().UTC(),
+	}, nil
+}
+
+func (s *Store) ListAddresses(_ context.Context) ([]string, error) {
+	fileInfos, err := s.keyFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []string{}
+	for _, fileInfo := range fileInfos {
+		addresses = append(addresses, fileAddress(fileInfo.Name()).Hex())
+	}
+
+	return addresses, nil
+}
+
+func (s *Store) findFile(address ethcommon.Address) (os.FileInfo, error) {
+	fileInfos, err := s.keyFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fileInfo := range fileInfos {
+		if fileAddress(fileInfo.Name()) == address {
+			return fileInfo, nil
+		}
+	}
+
+	return nil, errors.NotFoundError("account not found in keystore")
+}
+
+// keyFiles returns the key files of the directory, sorted by creation date as their name starts with it
+func (s *Store) keyFiles() ([]os.FileInfo, error) {
+	fileInfos, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.StorageError("failed to read keystore directory").AppendReason(err.Error())
+	}
+
+	keyFiles := []os.FileInfo{}
+	for _, fileInfo := range fileInfos {
+		if fileInfo.IsDir() || strings.HasPrefix(fileInfo.Name(), ".") || fileAddress(fileInfo.Name()) == (ethcommon.Address{}) {
+			continue
+		}
+		keyFiles = append(keyFiles, fileInfo)
+	}
+
+	sort.Slice(keyFiles, func(i, j int) bool {
+		return keyFiles[i].Name() < keyFiles[j].Name()
+	})
+
+	return keyFiles, nil
+}
+
+func fileAddress(fileName string) ethcommon.Address {
+	i := strings.LastIndex(fileName, "--")
+	if i < 0 || !ethcommon.IsHexAddress(fileName[i+2:]) {
+		return ethcommon.Address{}
+	}
+
+	return ethcommon.HexToAddress(fileName[i+2:])
+}
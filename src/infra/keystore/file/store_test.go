@@ -0,0 +1,70 @@
+// +build unit
+
+package file
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "keystore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := New(filepath.Join(dir, "keys"))
+	require.NoError(t, err)
+
+	firstAddress := "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+	secondAddress := "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"
+
+	t.Run("should insert keys in V3 keystore files", func(t *testing.T) {
+		err := store.Insert(ctx, &keystore.Key{Address: firstAddress, KeyJSON: []byte(`{"version":3}`)})
+		require.NoError(t, err)
+		err = store.Insert(ctx, &keystore.Key{Address: secondAddress, KeyJSON: []byte(`{"version":3}`)})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "keys", "UTC--*--7e654d251da770a068413677967f6d3ea2fea9e4"))
+		require.NoError(t, err)
+		assert.Len(t, files, 1)
+	})
+
+	t.Run("should fail with AlreadyExistsError if key already exists", func(t *testing.T) {
+		err := store.Insert(ctx, &keystore.Key{Address: firstAddress, KeyJSON: []byte(`{"version":3}`)})
+
+		assert.True(t, errors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("should find key by address", func(t *testing.T) {
+		key, err := store.FindOneByAddress(ctx, "0x7e654d251da770a068413677967f6d3ea2fea9e4")
+
+		require.NoError(t, err)
+		assert.Equal(t, firstAddress, key.Address)
+		assert.Equal(t, []byte(`{"version":3}`), key.KeyJSON)
+	})
+
+	t.Run("should fail with NotFoundError if key does not exist", func(t *testing.T) {
+		_, err := store.FindOneByAddress(ctx, "0x664895b5fE3ddf049d2Fb508cfA03923859763C6")
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should list addresses ignoring other files", func(t *testing.T) {
+		err := ioutil.WriteFile(filepath.Join(dir, "keys", "README"), []byte("readme"), 0600)
+		require.NoError(t, err)
+
+		addresses, err := store.ListAddresses(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{firstAddress, secondAddress}, addresses)
+	})
+}
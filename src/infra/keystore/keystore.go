@@ -0,0 +1,24 @@
+package keystore
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=keystore.go -destination=mocks/keystore.go -package=mocks
+
+// Key is the private key of an account, encrypted with the master key as a V3 keystore
+type Key struct {
+	Address   string
+	KeyID     string
+	Tags      map[string]string
+	KeyJSON   []byte
+	CreatedAt time.Time
+}
+
+// Store persists the encrypted keys of the local keystore
+type Store interface {
+	Insert(ctx context.Context, key *Key) error
+	FindOneByAddress(ctx context.Context, address string) (*Key, error)
+	ListAddresses(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keystore.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	keystore "github.com/consensys/orchestrate/src/infra/keystore"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockStore is a mock of Store interface
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method
+func (m *MockStore) Insert(ctx context.Context, key *keystore.Key) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert
+func (mr *MockStoreMockRecorder) Insert(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockStore)(nil).Insert), ctx, key)
+}
+
+// FindOneByAddress mocks base method
+func (m *MockStore) FindOneByAddress(ctx context.Context, address string) (*keystore.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOneByAddress", ctx, address)
+	ret0, _ := ret[0].(*keystore.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOneByAddress indicates an expected call of FindOneByAddress
+func (mr *MockStoreMockRecorder) FindOneByAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOneByAddress", reflect.TypeOf((*MockStore)(nil).FindOneByAddress), ctx, address)
+}
+
+// ListAddresses mocks base method
+func (m *MockStore) ListAddresses(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAddresses", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAddresses indicates an expected call of ListAddresses
+func (mr *MockStoreMockRecorder) ListAddresses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAddresses", reflect.TypeOf((*MockStore)(nil).ListAddresses), ctx)
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+)
+
+type keystoreKey struct {
+	tableName struct{} `pg:"keystore_keys"` // nolint:unused,structcheck // reason
+
+	Address   string
+	KeyID     string `pg:"alias:key_id"`
+	Tags      map[string]string
+	KeyJSON   string    `pg:"alias:key_json"`
+	CreatedAt time.Time `pg:"default:now()"`
+}
+
+// Store keeps the keys in Postgres, shared by the API and the tx-sender
+type Store struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+var _ keystore.Store = &Store{}
+
+func New(client postgres.Client) *Store {
+	return &Store{
+		client: client,
+		logger: log.NewLogger().SetComponent("keystore.postgres"),
+	}
+}
+
+func (s *Store) Insert(ctx context.Context, key *keystore.Key) error {
+	model := &keystoreKey{
+		Address:   key.Address,
+		KeyID:     key.KeyID,
+		Tags:      key.Tags,
+		KeyJSON:   string(key.KeyJSON),
+		CreatedAt: time.Now().UTC(),
+	}
+
+	err := s.client.ModelContext(ctx, model).Insert()
+	if err != nil {
+		if errors.IsConstraintViolatedError(err) {
+			return errors.AlreadyExistsError("account already exists in keystore")
+		}
+
+		errMsg := "failed to insert key"
+		s.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return errors.FromError(err).SetMessage(errMsg)
+	}
+
+	key.CreatedAt = model.CreatedAt
+	return nil
+}
+
+func (s *Store) FindOneByAddress(ctx context.Context, address string) (*keystore.Key, error) {
+	model := &keystoreKey{}
+
+	err := s.client.ModelContext(ctx, model).Where("address = ?", address).SelectOne()
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, errors.FromError(err).SetMessage("account not found in keystore")
+		}
+
+		errMsg := "failed to find key by address"
+		s.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return &keystore.Key{
+		Address:   model.Address,
+		KeyID:     model.KeyID,
+		Tags:      model.Tags,
+		KeyJSON:   []byte(model.KeyJSON),
+		CreatedAt: model.CreatedAt,
+	}, nil
+}
+
+func (s *Store) ListAddresses(ctx context.Context) ([]string, error) {
+	addresses := []string{}
+
+	err := s.client.ModelContext(ctx, (*keystoreKey)(nil)).
+		Column("address").
+		OrderExpr("created_at ASC, address ASC").
+		SelectColumn(&addresses)
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMsg := "failed to list keys"
+		s.logger.WithContext(ctx).WithError(err).Error(errMsg)
+		return nil, errors.FromError(err).SetMessage(errMsg)
+	}
+
+	return addresses, nil
+}
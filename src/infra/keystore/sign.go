@@ -0,0 +1,203 @@
+package keystore
+
+import (
+	"crypto/ecdsa"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	eeaRestrictedPrivateType = "restricted"
+	// GoQuorum private transactions are identified by a V value of 37 or 38 instead of 27 or 28
+	quorumPrivateTxVOffset = 10
+)
+
+// signTransaction signs legacy, access list and dynamic fee transactions, returning the signed raw transaction
+func signTransaction(privKey *ecdsa.PrivateKey, req *qkmtypes.SignETHTransactionRequest) ([]byte, error) {
+	var txData types.TxData
+	switch req.TransactionType {
+	case qkmtypes.LegacyTxType:
+		txData = &types.LegacyTx{
+			Nonce:    uint64(req.Nonce),
+			GasPrice: req.GasPrice.ToInt(),
+			Gas:      uint64(req.GasLimit),
+			To:       req.To,
+			Value:    req.Value.ToInt(),
+			Data:     req.Data,
+		}
+	case qkmtypes.AccessListTxType:
+		txData = &types.AccessListTx{
+			ChainID:    req.ChainID.ToInt(),
+			Nonce:      uint64(req.Nonce),
+			GasPrice:   req.GasPrice.ToInt(),
+			Gas:        uint64(req.GasLimit),
+			To:         req.To,
+			Value:      req.Value.ToInt(),
+			Data:       req.Data,
+			AccessList: req.AccessList,
+		}
+	case "", qkmtypes.DynamicFeeTxType:
+		if req.GasFeeCap == nil || req.GasTipCap == nil {
+			return nil, errors.InvalidParameterError("maxFeePerGas and maxPriorityFeePerGas are required for a %s transaction",
+				qkmtypes.DynamicFeeTxType)
+		}
+
+		txData = &types.DynamicFeeTx{
+			ChainID:    req.ChainID.ToInt(),
+			Nonce:      uint64(req.Nonce),
+			GasTipCap:  req.GasTipCap.ToInt(),
+			GasFeeCap:  req.GasFeeCap.ToInt(),
+			Gas:        uint64(req.GasLimit),
+			To:         req.To,
+			Value:      req.Value.ToInt(),
+			Data:       req.Data,
+			AccessList: req.AccessList,
+		}
+	default:
+		return nil, errors.InvalidParameterError("invalid transaction type %s", req.TransactionType)
+	}
+
+	signedTx, err := types.SignNewTx(privKey, types.NewLondonSigner(req.ChainID.ToInt()), txData)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to sign transaction").AppendReason(err.Error())
+	}
+
+	signedRaw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, errors.EncodingError("failed to RLP encode signed transaction").AppendReason(err.Error())
+	}
+
+	return signedRaw, nil
+}
+
+// signQuorumPrivateTransaction signs a GoQuorum private transaction, whose data is the hash of the payload stored in
+// the private transaction manager
+func signQuorumPrivateTransaction(privKey *ecdsa.PrivateKey, req *qkmtypes.SignQuorumPrivateTransactionRequest) ([]byte, error) {
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    uint64(req.Nonce),
+		GasPrice: req.GasPrice.ToInt(),
+		Gas:      uint64(req.GasLimit),
+		To:       req.To,
+		Value:    req.Value.ToInt(),
+		Data:     req.Data,
+	})
+
+	signedTx, err := types.SignTx(tx, types.HomesteadSigner{}, privKey)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to sign quorum private transaction").AppendReason(err.Error())
+	}
+	v, r, s := signedTx.RawSignatureValues()
+
+	signedRaw, err := rlp.EncodeToBytes([]interface{}{
+		tx.Nonce(),
+		tx.GasPrice(),
+		tx.Gas(),
+		tx.To(),
+		tx.Value(),
+		tx.Data(),
+		new(big.Int).Add(v, big.NewInt(quorumPrivateTxVOffset)),
+		r,
+		s,
+	})
+	if err != nil {
+		return nil, errors.EncodingError("failed to RLP encode signed quorum private transaction").AppendReason(err.Error())
+	}
+
+	return signedRaw, nil
+}
+
+// signEEATransaction signs an EEA private transaction, the private arguments being part of the signed payload
+func signEEATransaction(privKey *ecdsa.PrivateKey, req *qkmtypes.SignEEATransactionRequest) ([]byte, error) {
+	privateFrom, err := base64.StdEncoding.DecodeString(req.PrivateFrom)
+	if err != nil {
+		return nil, errors.InvalidParameterError("invalid 'privateFrom'")
+	}
+
+	privateRecipient, err := eeaPrivateRecipient(req)
+	if err != nil {
+		return nil, errors.InvalidParameterError("invalid 'privacyGroupId' or 'privateFor'")
+	}
+
+	chainID := req.ChainID.ToInt()
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    uint64(req.Nonce),
+		GasPrice: req.GasPrice.ToInt(),
+		Gas:      uint64(req.GasLimit),
+		To:       req.To,
+		Value:    req.Value.ToInt(),
+		Data:     req.Data,
+	})
+
+	payload, err := rlp.EncodeToBytes([]interface{}{
+		tx.Nonce(),
+		tx.GasPrice(),
+		tx.Gas(),
+		tx.To(),
+		tx.Value(),
+		tx.Data(),
+		chainID,
+		uint(0),
+		uint(0),
+		privateFrom,
+		privateRecipient,
+		eeaRestrictedPrivateType,
+	})
+	if err != nil {
+		return nil, errors.EncodingError("failed to RLP encode EEA transaction").AppendReason(err.Error())
+	}
+
+	signature, err := crypto.Sign(crypto.Keccak256(payload), privKey)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to sign EEA transaction").AppendReason(err.Error())
+	}
+
+	signedTx, err := tx.WithSignature(types.NewEIP155Signer(chainID), signature)
+	if err != nil {
+		return nil, errors.CryptoOperationError("failed to set EEA transaction signature").AppendReason(err.Error())
+	}
+	v, r, s := signedTx.RawSignatureValues()
+
+	signedRaw, err := rlp.EncodeToBytes([]interface{}{
+		tx.Nonce(),
+		tx.GasPrice(),
+		tx.Gas(),
+		tx.To(),
+		tx.Value(),
+		tx.Data(),
+		v,
+		r,
+		s,
+		privateFrom,
+		privateRecipient,
+		eeaRestrictedPrivateType,
+	})
+	if err != nil {
+		return nil, errors.EncodingError("failed to RLP encode signed EEA transaction").AppendReason(err.Error())
+	}
+
+	return signedRaw, nil
+}
+
+// eeaPrivateRecipient returns the privacy group ID if set, the list of recipients otherwise
+func eeaPrivateRecipient(req *qkmtypes.SignEEATransactionRequest) (interface{}, error) {
+	if req.PrivacyGroupID != "" {
+		return base64.StdEncoding.DecodeString(req.PrivacyGroupID)
+	}
+
+	privateFor := make([][]byte, len(req.PrivateFor))
+	for i, recipient := range req.PrivateFor {
+		decoded, err := base64.StdEncoding.DecodeString(recipient)
+		if err != nil {
+			return nil, err
+		}
+		privateFor[i] = decoded
+	}
+
+	return privateFor, nil
+}
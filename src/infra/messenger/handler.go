@@ -0,0 +1,28 @@
+package messenger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
+	"github.com/consensys/orchestrate/src/entities"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HandleMessage runs the handler of a consumed message within a span continuing the trace propagated by the producer
+func HandleMessage(ctx context.Context, handler MessageHandler, msg *entities.Message, topic string, headers map[string][]byte) error {
+	ctx, span := tracing.StartSpan(tracing.ExtractMessageHeaders(ctx, headers), fmt.Sprintf("%s process", topic),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination", topic),
+			attribute.String("messaging.message_type", string(msg.Type)),
+			attribute.Int64("messaging.kafka.offset", msg.Offset),
+		),
+	)
+
+	err := handler(ctx, msg)
+	tracing.EndSpan(span, err)
+	return err
+}
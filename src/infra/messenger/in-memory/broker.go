@@ -0,0 +1,121 @@
+package inmemory
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/kafka"
+)
+
+// Broker is an in-process replacement of Kafka used to run every service within a single binary.
+// Messages are kept in memory, in order, per topic and are lost when the process exits.
+type Broker struct {
+	topics map[string]*topic
+	mux    *sync.Mutex
+}
+
+var _ kafka.Producer = &Broker{}
+
+type message struct {
+	value   []byte
+	headers map[string][]byte
+	offset  int64
+}
+
+type topic struct {
+	messages []*message
+	offset   int64
+	notify   chan struct{}
+	mux      *sync.Mutex
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		topics: make(map[string]*topic),
+		mux:    &sync.Mutex{},
+	}
+}
+
+func (b *Broker) Send(body interface{}, topicName, _ string, headers map[string]interface{}) error {
+	if topicName == "" {
+		return errors.InvalidParameterError("topic not defined")
+	}
+
+	bValue, err := json.Marshal(body)
+	if err != nil {
+		return errors.EncodingError("failed to marshall message body")
+	}
+
+	msg := &message{
+		value:   bValue,
+		headers: make(map[string][]byte),
+	}
+	for headerKey, headerValue := range headers {
+		msg.headers[headerKey], _ = json.Marshal(headerValue)
+	}
+
+	b.topic(topicName).push(msg)
+
+	return nil
+}
+
+func (b *Broker) Close() error {
+	return nil
+}
+
+func (b *Broker) Checker() error {
+	return nil
+}
+
+func (b *Broker) topic(name string) *topic {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+
+	t, ok := b.topics[name]
+	if !ok {
+		t = &topic{
+			notify: make(chan struct{}, 1),
+			mux:    &sync.Mutex{},
+		}
+		b.topics[name] = t
+	}
+
+	return t
+}
+
+func (t *topic) push(msg *message) {
+	t.mux.Lock()
+	msg.offset = t.offset
+	t.offset++
+	t.messages = append(t.messages, msg)
+	t.mux.Unlock()
+
+	select {
+	case t.notify <- struct{}{}:
+	default:
+	}
+}
+
+// peek returns the oldest message of the topic without removing it
+func (t *topic) peek() *message {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if len(t.messages) == 0 {
+		return nil
+	}
+
+	return t.messages[0]
+}
+
+// pop removes the oldest message of the topic
+func (t *topic) pop() {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	if len(t.messages) > 0 {
+		t.messages[0] = nil
+		t.messages = t.messages[1:]
+	}
+}
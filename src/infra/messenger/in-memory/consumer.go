@@ -0,0 +1,150 @@
+package inmemory
+
+import (
+	"bytes"
+	"context"
+	encoding "encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/entities"
+	infra "github.com/consensys/orchestrate/src/infra/api"
+	"github.com/consensys/orchestrate/src/infra/messenger"
+	"github.com/hashicorp/go-multierror"
+)
+
+const consumerComponent = "messenger.in-memory.consumer"
+
+// Consumer reads messages published on a Broker. Every topic is expected to be read by a single consumer
+type Consumer struct {
+	broker  *Broker
+	handler map[entities.RequestMessageType]messenger.MessageHandler
+	topics  []string
+	cancel  context.CancelFunc
+	logger  *log.Logger
+	mux     *sync.Mutex
+}
+
+var _ messenger.Consumer = &Consumer{}
+
+func NewMessageConsumer(id string, broker *Broker, topics []string) *Consumer {
+	return &Consumer{
+		broker:  broker,
+		topics:  topics,
+		logger:  log.NewLogger().SetComponent(consumerComponent + "." + id),
+		handler: map[entities.RequestMessageType]messenger.MessageHandler{},
+		mux:     &sync.Mutex{},
+	}
+}
+
+func (cl *Consumer) Consume(ctx context.Context) error {
+	cl.mux.Lock()
+	ctx, cl.cancel = context.WithCancel(ctx)
+	cl.mux.Unlock()
+
+	cl.logger.WithContext(ctx).WithField("topics", cl.topics).Info("ready to consume messages")
+
+	gr := &multierror.Group{}
+	for _, topicName := range cl.topics {
+		topicName := topicName
+		t := cl.broker.topic(topicName)
+		logger := cl.logger.WithField("topic", topicName)
+		gr.Go(func() error {
+			return cl.consumeTopicLoop(ctx, topicName, t, logger)
+		})
+	}
+
+	return gr.Wait().ErrorOrNil()
+}
+
+func (cl *Consumer) AppendHandler(msgType entities.RequestMessageType, msgHandler messenger.MessageHandler) {
+	cl.handler[msgType] = msgHandler
+}
+
+func (cl *Consumer) Checker() error {
+	return nil
+}
+
+func (cl *Consumer) Close() error {
+	cl.mux.Lock()
+	defer cl.mux.Unlock()
+
+	if cl.cancel != nil {
+		cl.cancel()
+	}
+
+	return nil
+}
+
+func (cl *Consumer) consumeTopicLoop(ctx context.Context, topicName string, t *topic, logger *log.Logger) error {
+	logger = logger.WithContext(ctx)
+	logger.Debug("started consuming topic loop")
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.WithField("reason", ctx.Err().Error()).Info("gracefully stopping message consumption")
+			return nil
+		default:
+		}
+
+		msg := t.peek()
+		if msg == nil {
+			select {
+			case <-ctx.Done():
+			case <-t.notify:
+			}
+			continue
+		}
+
+		err := cl.processMessage(ctx, topicName, msg, logger)
+		if err != nil {
+			// The message is kept at the head of the topic so that it is consumed again on the next session
+			return err
+		}
+
+		t.pop()
+	}
+}
+
+func (cl *Consumer) processMessage(ctx context.Context, topicName string, msg *message, logger *log.Logger) error {
+	reqMsg := &entities.Message{}
+	err := infra.UnmarshalBody(bytes.NewReader(msg.value), reqMsg)
+	if err != nil {
+		logger.WithError(err).Error("failed to decode message request")
+		return nil
+	}
+	reqMsg.Offset = msg.offset
+	reqMsg.Commit = func() error {
+		return nil
+	}
+
+	handlerFunc, ok := cl.handler[reqMsg.Type]
+	if !ok {
+		logger.Error(fmt.Sprintf("missing handler for request type %s", reqMsg.Type))
+		return nil
+	}
+
+	if bUserInfo, ok := msg.headers[authutils.UserInfoHeader]; ok {
+		userInfo := &multitenancy.UserInfo{}
+		_ = encoding.Unmarshal(bUserInfo, userInfo)
+		ctx = multitenancy.WithUserInfo(ctx, userInfo)
+	}
+
+	err = messenger.HandleMessage(ctx, handlerFunc, reqMsg, topicName, msg.headers)
+	if err != nil {
+		logger.WithError(err).Error("message has been processed with errors")
+		// Invalid req format do not exit loop
+		if !errors.IsInvalidFormatError(err) {
+			return err
+		}
+		return nil
+	}
+
+	logger.WithField("offset", msg.offset).Debug("message has been processed successfully")
+	return nil
+}
@@ -0,0 +1,151 @@
+// +build unit
+
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	authutils "github.com/consensys/orchestrate/pkg/toolkit/app/auth/utils"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	testTopic                                   = "topic-test"
+	testMessageType entities.RequestMessageType = "test-message"
+)
+
+func TestConsumer(t *testing.T) {
+	broker := NewBroker()
+	userInfo := multitenancy.NewUserInfo("tenantOne", "username")
+	headers := map[string]interface{}{authutils.UserInfoHeader: userInfo}
+
+	t.Run("should consume messages in order with user info", func(t *testing.T) {
+		consumer := NewMessageConsumer("test", broker, []string{testTopic})
+		received := make(chan *entities.Message, 2)
+		tenants := make(chan string, 2)
+		consumer.AppendHandler(testMessageType, func(ctx context.Context, msg *entities.Message) error {
+			tenants <- multitenancy.UserInfoValue(ctx).TenantID
+			received <- msg
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- consumer.Consume(ctx) }()
+
+		require.NoError(t, broker.Send(&entities.Message{Type: testMessageType, Body: []byte(`"first"`)}, testTopic, "", headers))
+		require.NoError(t, broker.Send(&entities.Message{Type: testMessageType, Body: []byte(`"second"`)}, testTopic, "", headers))
+
+		for idx, expectedBody := range []string{`"first"`, `"second"`} {
+			select {
+			case msg := <-received:
+				assert.Equal(t, int64(idx), msg.Offset)
+				assert.Equal(t, expectedBody, string(msg.Body))
+				assert.NoError(t, msg.Commit())
+				assert.Equal(t, "tenantOne", <-tenants)
+			case <-time.After(time.Second):
+				t.Fatal("message not received")
+			}
+		}
+
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("should consume again a message that failed to be processed", func(t *testing.T) {
+		consumer := NewMessageConsumer("test", broker, []string{testTopic})
+		attempts := 0
+		consumer.AppendHandler(testMessageType, func(ctx context.Context, msg *entities.Message) error {
+			attempts++
+			if attempts == 1 {
+				return fmt.Errorf("error")
+			}
+			return nil
+		})
+
+		require.NoError(t, broker.Send(&entities.Message{Type: testMessageType}, testTopic, "", nil))
+
+		err := consumer.Consume(context.Background())
+		assert.Error(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- consumer.Consume(ctx) }()
+
+		assert.Eventually(t, func() bool { return broker.topic(testTopic).peek() == nil }, time.Second, 10*time.Millisecond)
+		cancel()
+		assert.NoError(t, <-done)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("should skip messages without handler", func(t *testing.T) {
+		consumer := NewMessageConsumer("test", broker, []string{testTopic})
+
+		require.NoError(t, broker.Send(&entities.Message{Type: "unknown"}, testTopic, "", nil))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- consumer.Consume(ctx) }()
+
+		assert.Eventually(t, func() bool { return broker.topic(testTopic).peek() == nil }, time.Second, 10*time.Millisecond)
+		cancel()
+		assert.NoError(t, <-done)
+	})
+
+	t.Run("should process message within a span continuing the trace of the producer", func(t *testing.T) {
+		exporter := tracetest.NewInMemoryExporter()
+		otel.SetTracerProvider(tracing.NewTracerProvider(sdktrace.NewSimpleSpanProcessor(exporter), 1, "test"))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		consumer := NewMessageConsumer("test", broker, []string{testTopic})
+		spanContexts := make(chan trace.SpanContext, 1)
+		consumer.AppendHandler(testMessageType, func(ctx context.Context, msg *entities.Message) error {
+			spanContexts <- trace.SpanContextFromContext(ctx)
+			return nil
+		})
+
+		producerCtx, producerSpan := tracing.StartSpan(context.Background(), "producer")
+		producerSpan.End()
+		tracingHeaders := map[string]interface{}{}
+		tracing.InjectMessageHeaders(producerCtx, tracingHeaders)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- consumer.Consume(ctx) }()
+
+		require.NoError(t, broker.Send(&entities.Message{Type: testMessageType}, testTopic, "", tracingHeaders))
+
+		select {
+		case spanCtx := <-spanContexts:
+			assert.Equal(t, producerSpan.SpanContext().TraceID(), spanCtx.TraceID())
+		case <-time.After(time.Second):
+			t.Fatal("message not received")
+		}
+
+		cancel()
+		assert.NoError(t, <-done)
+
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, testTopic+" process", spans[1].Name)
+		assert.Equal(t, trace.SpanKindConsumer, spans[1].SpanKind)
+		assert.Equal(t, producerSpan.SpanContext().SpanID(), spans[1].Parent.SpanID())
+	})
+
+	t.Run("should fail to send message without topic", func(t *testing.T) {
+		err := broker.Send(&entities.Message{Type: testMessageType}, "", "", nil)
+		assert.Error(t, err)
+	})
+}
@@ -127,15 +127,19 @@ func (cl *Consumer) consumeClaimLoop(ctx context.Context, session sarama.Consume
 				continue
 			}
 
+			headers := map[string][]byte{}
 			for _, h := range msg.Headers {
-				if string(h.Key) == authutils.UserInfoHeader {
-					userInfo := &multitenancy.UserInfo{}
-					_ = encoding.Unmarshal(h.Value, userInfo)
-					ctx = multitenancy.WithUserInfo(ctx, userInfo)
-				}
+				headers[string(h.Key)] = h.Value
+			}
+
+			msgCtx := ctx
+			if bUserInfo, ok := headers[authutils.UserInfoHeader]; ok {
+				userInfo := &multitenancy.UserInfo{}
+				_ = encoding.Unmarshal(bUserInfo, userInfo)
+				msgCtx = multitenancy.WithUserInfo(msgCtx, userInfo)
 			}
 
-			err = handlerFunc(ctx, reqMsg)
+			err = messenger.HandleMessage(msgCtx, handlerFunc, reqMsg, msg.Topic, headers)
 			if err != nil {
 				logger.WithError(err).Error("message has been processed with errors")
 				// Invalid req format do not exit loop
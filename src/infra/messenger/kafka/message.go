@@ -16,6 +16,7 @@ func NewMessage(msg *sarama.ConsumerMessage, session sarama.ConsumerGroupSession
 		return nil, err
 	}
 
+	reqMsg.Partition = msg.Partition
 	reqMsg.Offset = msg.Offset
 	reqMsg.Commit = func() error {
 		session.MarkMessage(msg, "")
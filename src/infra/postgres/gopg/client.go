@@ -21,7 +21,10 @@ func New(appName string, cfg *Config) (*Client, error) {
 		return nil, err
 	}
 
-	return &Client{db: pg.Connect(pgOptions)}, nil
+	db := pg.Connect(pgOptions)
+	db.AddQueryHook(tracingHook{})
+
+	return &Client{db: db}, nil
 }
 
 func (c *Client) ModelContext(ctx context.Context, models ...interface{}) postgres.Query {
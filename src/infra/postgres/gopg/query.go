@@ -106,6 +106,12 @@ func (q Query) For(s string, params ...interface{}) postgres.Query {
 	return &q
 }
 
+func (q Query) Limit(n int) postgres.Query {
+	q.pgQuery = q.pgQuery.Limit(n)
+
+	return &q
+}
+
 func (q *Query) Insert() error {
 	_, err := q.pgQuery.Insert()
 	if err != nil {
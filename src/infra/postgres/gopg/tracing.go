@@ -0,0 +1,58 @@
+package gopg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracingHook wraps every query in a span, the statement being recorded without its parameters
+type tracingHook struct{}
+
+var _ pg.QueryHook = tracingHook{}
+
+type spanStashKey struct{}
+
+func (tracingHook) BeforeQuery(ctx context.Context, evt *pg.QueryEvent) (context.Context, error) {
+	operation := "QUERY"
+	if cmd, ok := evt.Query.(orm.QueryCommand); ok {
+		operation = string(cmd.Operation())
+	}
+
+	ctx, span := tracing.StartSpan(ctx, fmt.Sprintf("postgres %s", operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationKey.String(operation)),
+	)
+
+	if query, err := evt.UnformattedQuery(); err == nil {
+		span.SetAttributes(semconv.DBStatementKey.String(string(query)))
+	}
+
+	if evt.Stash == nil {
+		evt.Stash = make(map[interface{}]interface{})
+	}
+	evt.Stash[spanStashKey{}] = span
+
+	return ctx, nil
+}
+
+func (tracingHook) AfterQuery(_ context.Context, evt *pg.QueryEvent) error {
+	span, ok := evt.Stash[spanStashKey{}].(trace.Span)
+	if !ok {
+		return nil
+	}
+
+	err := evt.Err
+	// Missing rows are reported as not found errors by the store, not as failed queries
+	if err == pg.ErrNoRows {
+		err = nil
+	}
+
+	tracing.EndSpan(span, err)
+	return nil
+}
@@ -387,6 +387,20 @@ func (mr *MockQueryMockRecorder) For(s interface{}, params ...interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "For", reflect.TypeOf((*MockQuery)(nil).For), varargs...)
 }
 
+// Limit mocks base method
+func (m *MockQuery) Limit(n int) postgres.Query {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Limit", n)
+	ret0, _ := ret[0].(postgres.Query)
+	return ret0
+}
+
+// Limit indicates an expected call of Limit
+func (mr *MockQueryMockRecorder) Limit(n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Limit", reflect.TypeOf((*MockQuery)(nil).Limit), n)
+}
+
 // Insert mocks base method
 func (m *MockQuery) Insert() error {
 	m.ctrl.T.Helper()
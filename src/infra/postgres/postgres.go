@@ -29,6 +29,7 @@ type Query interface {
 	Set(set string, params ...interface{}) Query
 	Returning(s string, params ...interface{}) Query
 	For(s string, params ...interface{}) Query
+	Limit(n int) Query
 	Insert() error
 	Update() error
 	UpdateNotZero() error
@@ -89,6 +89,26 @@ func (mr *MockClientMockRecorder) Incr(key interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Incr", reflect.TypeOf((*MockClient)(nil).Incr), key)
 }
 
+// EvalUint64 mocks base method
+func (m *MockClient) EvalUint64(script string, keys []string, args ...interface{}) (uint64, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{script, keys}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EvalUint64", varargs...)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvalUint64 indicates an expected call of EvalUint64
+func (mr *MockClientMockRecorder) EvalUint64(script, keys interface{}, args ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{script, keys}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvalUint64", reflect.TypeOf((*MockClient)(nil).EvalUint64), varargs...)
+}
+
 // Ping mocks base method
 func (m *MockClient) Ping() error {
 	m.ctrl.T.Helper()
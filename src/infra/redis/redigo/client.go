@@ -83,6 +83,30 @@ func (nm *Client) Incr(key string) error {
 	return nil
 }
 
+func (nm *Client) EvalUint64(script string, keys []string, args ...interface{}) (uint64, error) {
+	conn := nm.pool.Get()
+	defer closeConn(conn)
+
+	keysAndArgs := make([]interface{}, 0, len(keys)+len(args))
+	for _, key := range keys {
+		keysAndArgs = append(keysAndArgs, key)
+	}
+	keysAndArgs = append(keysAndArgs, args...)
+
+	// Script is sent by its SHA1 digest, and loaded on first use
+	reply, err := redigo.NewScript(len(keys), script).Do(conn, keysAndArgs...)
+	if err != nil {
+		return 0, parseRedisError(err)
+	}
+
+	value, err := redigo.Uint64(reply, nil)
+	if err != nil {
+		return 0, parseRedisError(err)
+	}
+
+	return value, nil
+}
+
 func (nm *Client) Ping() error {
 	conn := nm.pool.Get()
 	defer closeConn(conn)
@@ -7,5 +7,7 @@ type Client interface {
 	Set(key string, expiration int, value interface{}) error
 	Delete(key string) error
 	Incr(key string) error
+	// EvalUint64 runs a Lua script atomically, failing with a NotFoundError if the script returns no value
+	EvalUint64(script string, keys []string, args ...interface{}) (uint64, error)
 	Ping() error
 }
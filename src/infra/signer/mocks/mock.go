@@ -0,0 +1,224 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: signer.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	types "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	hexutil "github.com/ethereum/go-ethereum/common/hexutil"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockSigner is a mock of Signer interface
+type MockSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockSignerMockRecorder
+}
+
+// MockSignerMockRecorder is the mock recorder for MockSigner
+type MockSignerMockRecorder struct {
+	mock *MockSigner
+}
+
+// NewMockSigner creates a new mock instance
+func NewMockSigner(ctrl *gomock.Controller) *MockSigner {
+	mock := &MockSigner{ctrl: ctrl}
+	mock.recorder = &MockSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSigner) EXPECT() *MockSignerMockRecorder {
+	return m.recorder
+}
+
+// SignMessage mocks base method
+func (m *MockSigner) SignMessage(ctx context.Context, storeID, address string, req *types.SignMessageRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMessage", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignMessage indicates an expected call of SignMessage
+func (mr *MockSignerMockRecorder) SignMessage(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessage", reflect.TypeOf((*MockSigner)(nil).SignMessage), ctx, storeID, address, req)
+}
+
+// SignTypedData mocks base method
+func (m *MockSigner) SignTypedData(ctx context.Context, storeID, address string, req *types.SignTypedDataRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTypedData", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTypedData indicates an expected call of SignTypedData
+func (mr *MockSignerMockRecorder) SignTypedData(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTypedData", reflect.TypeOf((*MockSigner)(nil).SignTypedData), ctx, storeID, address, req)
+}
+
+// SignTransaction mocks base method
+func (m *MockSigner) SignTransaction(ctx context.Context, storeID, address string, req *types.SignETHTransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction
+func (mr *MockSignerMockRecorder) SignTransaction(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockSigner)(nil).SignTransaction), ctx, storeID, address, req)
+}
+
+// SignQuorumPrivateTransaction mocks base method
+func (m *MockSigner) SignQuorumPrivateTransaction(ctx context.Context, storeID, address string, req *types.SignQuorumPrivateTransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignQuorumPrivateTransaction", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignQuorumPrivateTransaction indicates an expected call of SignQuorumPrivateTransaction
+func (mr *MockSignerMockRecorder) SignQuorumPrivateTransaction(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignQuorumPrivateTransaction", reflect.TypeOf((*MockSigner)(nil).SignQuorumPrivateTransaction), ctx, storeID, address, req)
+}
+
+// SignEEATransaction mocks base method
+func (m *MockSigner) SignEEATransaction(ctx context.Context, storeID, address string, req *types.SignEEATransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignEEATransaction", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignEEATransaction indicates an expected call of SignEEATransaction
+func (mr *MockSignerMockRecorder) SignEEATransaction(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockSigner)(nil).SignEEATransaction), ctx, storeID, address, req)
+}
+
+// MockRemoteSigner is a mock of RemoteSigner interface
+type MockRemoteSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoteSignerMockRecorder
+}
+
+// MockRemoteSignerMockRecorder is the mock recorder for MockRemoteSigner
+type MockRemoteSignerMockRecorder struct {
+	mock *MockRemoteSigner
+}
+
+// NewMockRemoteSigner creates a new mock instance
+func NewMockRemoteSigner(ctrl *gomock.Controller) *MockRemoteSigner {
+	mock := &MockRemoteSigner{ctrl: ctrl}
+	mock.recorder = &MockRemoteSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRemoteSigner) EXPECT() *MockRemoteSignerMockRecorder {
+	return m.recorder
+}
+
+// SignMessage mocks base method
+func (m *MockRemoteSigner) SignMessage(ctx context.Context, storeID, address string, req *types.SignMessageRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignMessage", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignMessage indicates an expected call of SignMessage
+func (mr *MockRemoteSignerMockRecorder) SignMessage(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignMessage", reflect.TypeOf((*MockRemoteSigner)(nil).SignMessage), ctx, storeID, address, req)
+}
+
+// SignTypedData mocks base method
+func (m *MockRemoteSigner) SignTypedData(ctx context.Context, storeID, address string, req *types.SignTypedDataRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTypedData", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTypedData indicates an expected call of SignTypedData
+func (mr *MockRemoteSignerMockRecorder) SignTypedData(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTypedData", reflect.TypeOf((*MockRemoteSigner)(nil).SignTypedData), ctx, storeID, address, req)
+}
+
+// SignTransaction mocks base method
+func (m *MockRemoteSigner) SignTransaction(ctx context.Context, storeID, address string, req *types.SignETHTransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction
+func (mr *MockRemoteSignerMockRecorder) SignTransaction(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockRemoteSigner)(nil).SignTransaction), ctx, storeID, address, req)
+}
+
+// SignQuorumPrivateTransaction mocks base method
+func (m *MockRemoteSigner) SignQuorumPrivateTransaction(ctx context.Context, storeID, address string, req *types.SignQuorumPrivateTransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignQuorumPrivateTransaction", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignQuorumPrivateTransaction indicates an expected call of SignQuorumPrivateTransaction
+func (mr *MockRemoteSignerMockRecorder) SignQuorumPrivateTransaction(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignQuorumPrivateTransaction", reflect.TypeOf((*MockRemoteSigner)(nil).SignQuorumPrivateTransaction), ctx, storeID, address, req)
+}
+
+// SignEEATransaction mocks base method
+func (m *MockRemoteSigner) SignEEATransaction(ctx context.Context, storeID, address string, req *types.SignEEATransactionRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignEEATransaction", ctx, storeID, address, req)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignEEATransaction indicates an expected call of SignEEATransaction
+func (mr *MockRemoteSignerMockRecorder) SignEEATransaction(ctx, storeID, address, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockRemoteSigner)(nil).SignEEATransaction), ctx, storeID, address, req)
+}
+
+// GetPublicKey mocks base method
+func (m *MockRemoteSigner) GetPublicKey(ctx context.Context, address string) (hexutil.Bytes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicKey", ctx, address)
+	ret0, _ := ret[0].(hexutil.Bytes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicKey indicates an expected call of GetPublicKey
+func (mr *MockRemoteSignerMockRecorder) GetPublicKey(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicKey", reflect.TypeOf((*MockRemoteSigner)(nil).GetPublicKey), ctx, address)
+}
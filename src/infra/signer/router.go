@@ -0,0 +1,74 @@
+package signer
+
+import (
+	"context"
+
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+)
+
+// Router signs with the remote signer mounted on the store of the account, or with the default signer otherwise
+type Router struct {
+	defaultSigner  Signer
+	remoteSigners  map[string]RemoteSigner
+	tenantStoreIDs map[string]string
+}
+
+var _ Signer = &Router{}
+
+func NewRouter(defaultSigner Signer) *Router {
+	return &Router{
+		defaultSigner:  defaultSigner,
+		remoteSigners:  make(map[string]RemoteSigner),
+		tenantStoreIDs: make(map[string]string),
+	}
+}
+
+// Mount routes the accounts of the store to the remote signer, as well as the accounts created by the given tenants
+// without specifying a store
+func (r *Router) Mount(storeID string, remoteSigner RemoteSigner, tenants ...string) *Router {
+	r.remoteSigners[storeID] = remoteSigner
+	for _, tenantID := range tenants {
+		r.tenantStoreIDs[tenantID] = storeID
+	}
+
+	return r
+}
+
+// RemoteSigner returns the remote signer mounted on the store, if any
+func (r *Router) RemoteSigner(storeID string) (RemoteSigner, bool) {
+	remoteSigner, ok := r.remoteSigners[storeID]
+	return remoteSigner, ok
+}
+
+// TenantStoreID returns the store of the accounts created by the tenant without specifying a store
+func (r *Router) TenantStoreID(tenantID string) string {
+	return r.tenantStoreIDs[tenantID]
+}
+
+func (r *Router) SignMessage(ctx context.Context, storeID, address string, req *qkmtypes.SignMessageRequest) (string, error) {
+	return r.signer(storeID).SignMessage(ctx, storeID, address, req)
+}
+
+func (r *Router) SignTypedData(ctx context.Context, storeID, address string, req *qkmtypes.SignTypedDataRequest) (string, error) {
+	return r.signer(storeID).SignTypedData(ctx, storeID, address, req)
+}
+
+func (r *Router) SignTransaction(ctx context.Context, storeID, address string, req *qkmtypes.SignETHTransactionRequest) (string, error) {
+	return r.signer(storeID).SignTransaction(ctx, storeID, address, req)
+}
+
+func (r *Router) SignQuorumPrivateTransaction(ctx context.Context, storeID, address string, req *qkmtypes.SignQuorumPrivateTransactionRequest) (string, error) {
+	return r.signer(storeID).SignQuorumPrivateTransaction(ctx, storeID, address, req)
+}
+
+func (r *Router) SignEEATransaction(ctx context.Context, storeID, address string, req *qkmtypes.SignEEATransactionRequest) (string, error) {
+	return r.signer(storeID).SignEEATransaction(ctx, storeID, address, req)
+}
+
+func (r *Router) signer(storeID string) Signer {
+	if remoteSigner, ok := r.remoteSigners[storeID]; ok {
+		return remoteSigner
+	}
+
+	return r.defaultSigner
+}
@@ -0,0 +1,50 @@
+// +build unit
+
+package signer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/orchestrate/src/infra/signer"
+	"github.com/consensys/orchestrate/src/infra/signer/mocks"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defaultSigner := mocks.NewMockSigner(ctrl)
+	remoteSigner := mocks.NewMockRemoteSigner(ctrl)
+	address := "0x7E654d251Da770A068413677967F6d3Ea2FeA9E4"
+	req := &qkmtypes.SignETHTransactionRequest{}
+
+	router := signer.NewRouter(defaultSigner).Mount("remote-store", remoteSigner, "tenantOne")
+
+	t.Run("should sign with the remote signer mounted on the store", func(t *testing.T) {
+		remoteSigner.EXPECT().SignTransaction(gomock.Any(), "remote-store", address, req).Return("0xremote", nil)
+
+		raw, err := router.SignTransaction(ctx, "remote-store", address, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0xremote", raw)
+	})
+
+	t.Run("should sign with the default signer if no remote signer is mounted on the store", func(t *testing.T) {
+		defaultSigner.EXPECT().SignTransaction(gomock.Any(), "qkm-store", address, req).Return("0xdefault", nil)
+
+		raw, err := router.SignTransaction(ctx, "qkm-store", address, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0xdefault", raw)
+	})
+
+	t.Run("should return the store of the tenant", func(t *testing.T) {
+		assert.Equal(t, "remote-store", router.TenantStoreID("tenantOne"))
+		assert.Empty(t, router.TenantStoreID("tenantTwo"))
+	})
+}
@@ -0,0 +1,25 @@
+package signer
+
+import (
+	"context"
+
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+//go:generate mockgen -source=signer.go -destination=mocks/mock.go -package=mocks
+
+// Signer signs with the Ethereum accounts of a key store, the Quorum Key Manager client being the default implementation
+type Signer interface {
+	SignMessage(ctx context.Context, storeID, address string, req *qkmtypes.SignMessageRequest) (string, error)
+	SignTypedData(ctx context.Context, storeID, address string, req *qkmtypes.SignTypedDataRequest) (string, error)
+	SignTransaction(ctx context.Context, storeID, address string, req *qkmtypes.SignETHTransactionRequest) (string, error)
+	SignQuorumPrivateTransaction(ctx context.Context, storeID, address string, req *qkmtypes.SignQuorumPrivateTransactionRequest) (string, error)
+	SignEEATransaction(ctx context.Context, storeID, address string, req *qkmtypes.SignEEATransactionRequest) (string, error)
+}
+
+// RemoteSigner signs with accounts whose keys are provisioned out of Orchestrate
+type RemoteSigner interface {
+	Signer
+	GetPublicKey(ctx context.Context, address string) (hexutil.Bytes, error)
+}
@@ -0,0 +1,61 @@
+package signer
+
+import (
+	"fmt"
+
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const eip712DomainLabel = "EIP712Domain"
+
+// EncodeTypedData returns the EIP-712 encoded data of the request, whose keccak256 hash is signed
+func EncodeTypedData(req *qkmtypes.SignTypedDataRequest) ([]byte, error) {
+	typedData := &apitypes.TypedData{
+		Types: apitypes.Types{
+			eip712DomainLabel: []apitypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "version", Type: "string"},
+			},
+		},
+		PrimaryType: req.MessageType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              req.DomainSeparator.Name,
+			Version:           req.DomainSeparator.Version,
+			ChainId:           math.NewHexOrDecimal256(req.DomainSeparator.ChainID),
+			VerifyingContract: req.DomainSeparator.VerifyingContract,
+			Salt:              req.DomainSeparator.Salt,
+		},
+		Message: req.Message,
+	}
+
+	for typeName, reqTypes := range req.Types {
+		var typesDefinition []apitypes.Type
+		for _, reqType := range reqTypes {
+			typesDefinition = append(typesDefinition, apitypes.Type{Name: reqType.Name, Type: reqType.Type})
+		}
+		typedData.Types[typeName] = typesDefinition
+	}
+
+	if req.DomainSeparator.VerifyingContract != "" {
+		typedData.Types[eip712DomainLabel] = append(typedData.Types[eip712DomainLabel], apitypes.Type{Name: "verifyingContract", Type: "address"})
+	}
+
+	if req.DomainSeparator.Salt != "" {
+		typedData.Types[eip712DomainLabel] = append(typedData.Types[eip712DomainLabel], apitypes.Type{Name: "salt", Type: "string"})
+	}
+
+	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	domainSeparatorHash, err := typedData.HashStruct(eip712DomainLabel, typedData.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparatorHash), string(typedDataHash))), nil
+}
@@ -0,0 +1,239 @@
+package web3signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	httputils "github.com/consensys/orchestrate/pkg/toolkit/app/http"
+	clientutils "github.com/consensys/orchestrate/pkg/toolkit/app/http/client-utils"
+	"github.com/consensys/orchestrate/src/infra/signer"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth1/publicKeys"
+	signPath       = "/api/v1/eth1/sign/"
+)
+
+// Client signs through a Web3Signer, or any signer exposing the EthSigner JSON-RPC methods and the Web3Signer
+// eth1 REST API, keys being identified by their public key
+type Client struct {
+	url        string
+	httpClient *http.Client
+	rpcClient  *rpc.Client
+	// identifiers caches the public keys of the accounts by address
+	identifiers sync.Map
+}
+
+var _ signer.RemoteSigner = &Client{}
+
+type signTransactionArgs struct {
+	From                 ethcommon.Address  `json:"from"`
+	To                   *ethcommon.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64     `json:"gas"`
+	GasPrice             *hexutil.Big       `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big       `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big       `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big       `json:"value,omitempty"`
+	Nonce                hexutil.Uint64     `json:"nonce"`
+	Data                 hexutil.Bytes      `json:"data,omitempty"`
+	ChainID              *hexutil.Big       `json:"chainId,omitempty"`
+	AccessList           *types.AccessList  `json:"accessList,omitempty"`
+}
+
+type signRequest struct {
+	Data hexutil.Bytes `json:"data"`
+}
+
+func New(cfg *Config) (*Client, error) {
+	httpCfg := httputils.NewDefaultConfig()
+	httpCfg.InsecureSkipVerify = cfg.TLSSkipVerify
+	if cfg.APIKey != "" {
+		httpCfg.Authorization = "Basic " + cfg.APIKey
+	}
+
+	return NewClient(httputils.NewClient(httpCfg), cfg.URL)
+}
+
+func NewClient(httpClient *http.Client, url string) (*Client, error) {
+	rpcClient, err := rpc.DialHTTPWithClient(url, httpClient)
+	if err != nil {
+		return nil, errors.ServiceConnectionError("invalid web3signer url %s", url).AppendReason(err.Error())
+	}
+
+	return &Client{
+		url:        strings.TrimSuffix(url, "/"),
+		httpClient: httpClient,
+		rpcClient:  rpcClient,
+	}, nil
+}
+
+// SignMessage signs the EIP-191 message through eth_sign, the signer prefixing the message
+func (c *Client) SignMessage(ctx context.Context, _, address string, req *qkmtypes.SignMessageRequest) (string, error) {
+	var signature hexutil.Bytes
+	err := c.rpcClient.CallContext(ctx, &signature, "eth_sign", ethcommon.HexToAddress(address), req.Message)
+	if err != nil {
+		return "", errors.DependencyFailureError("failed to sign message with web3signer").AppendReason(err.Error())
+	}
+
+	return signature.String(), nil
+}
+
+// SignTypedData signs the EIP-712 encoded data, hashed by the signer before being signed
+func (c *Client) SignTypedData(ctx context.Context, _, address string, req *qkmtypes.SignTypedDataRequest) (string, error) {
+	encodedData, err := signer.EncodeTypedData(req)
+	if err != nil {
+		return "", errors.InvalidParameterError("invalid typed data").AppendReason(err.Error())
+	}
+
+	identifier, err := c.identifier(ctx, address)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := clientutils.PostRequest(ctx, c.httpClient, c.url+signPath+identifier, &signRequest{Data: encodedData})
+	if err != nil {
+		return "", errors.ServiceConnectionError("failed to reach web3signer").AppendReason(err.Error())
+	}
+	defer clientutils.CloseResponse(resp)
+
+	body, err := readResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(body)), nil
+}
+
+// SignTransaction signs through eth_signTransaction, returning the RLP encoded signed transaction
+func (c *Client) SignTransaction(ctx context.Context, _, address string, req *qkmtypes.SignETHTransactionRequest) (string, error) {
+	args := &signTransactionArgs{
+		From:    ethcommon.HexToAddress(address),
+		To:      req.To,
+		Gas:     req.GasLimit,
+		Value:   &req.Value,
+		Nonce:   req.Nonce,
+		Data:    req.Data,
+		ChainID: &req.ChainID,
+	}
+
+	switch req.TransactionType {
+	case qkmtypes.DynamicFeeTxType:
+		args.MaxFeePerGas = req.GasFeeCap
+		args.MaxPriorityFeePerGas = req.GasTipCap
+		args.AccessList = &req.AccessList
+	case qkmtypes.AccessListTxType:
+		args.GasPrice = &req.GasPrice
+		args.AccessList = &req.AccessList
+	default:
+		args.GasPrice = &req.GasPrice
+	}
+
+	var signedRaw hexutil.Bytes
+	err := c.rpcClient.CallContext(ctx, &signedRaw, "eth_signTransaction", args)
+	if err != nil {
+		return "", errors.DependencyFailureError("failed to sign transaction with web3signer").AppendReason(err.Error())
+	}
+
+	return signedRaw.String(), nil
+}
+
+func (c *Client) SignQuorumPrivateTransaction(_ context.Context, _, _ string, _ *qkmtypes.SignQuorumPrivateTransactionRequest) (string, error) {
+	return "", errors.FeatureNotSupportedError("private transactions cannot be signed with web3signer")
+}
+
+func (c *Client) SignEEATransaction(_ context.Context, _, _ string, _ *qkmtypes.SignEEATransactionRequest) (string, error) {
+	return "", errors.FeatureNotSupportedError("private transactions cannot be signed with web3signer")
+}
+
+// GetPublicKey returns the uncompressed public key of the account, if its key is loaded by the signer
+func (c *Client) GetPublicKey(ctx context.Context, address string) (hexutil.Bytes, error) {
+	identifier, err := c.identifier(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, _ := parsePublicKey(identifier)
+	return crypto.FromECDSAPub(pubKey), nil
+}
+
+func (c *Client) identifier(ctx context.Context, address string) (string, error) {
+	addr := ethcommon.HexToAddress(address)
+	if identifier, ok := c.identifiers.Load(addr); ok {
+		return identifier.(string), nil
+	}
+
+	resp, err := clientutils.GetRequest(ctx, c.httpClient, c.url+publicKeysPath)
+	if err != nil {
+		return "", errors.ServiceConnectionError("failed to reach web3signer").AppendReason(err.Error())
+	}
+	defer clientutils.CloseResponse(resp)
+
+	body, err := readResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	var publicKeys []string
+	err = json.Unmarshal(body, &publicKeys)
+	if err != nil {
+		return "", errors.EncodingError("failed to decode public keys of web3signer").AppendReason(err.Error())
+	}
+
+	for _, identifier := range publicKeys {
+		pubKey, der := parsePublicKey(identifier)
+		if der != nil {
+			continue
+		}
+
+		keyAddr := crypto.PubkeyToAddress(*pubKey)
+		c.identifiers.Store(keyAddr, identifier)
+		if keyAddr == addr {
+			return identifier, nil
+		}
+	}
+
+	return "", errors.NotFoundError("key of account %s not found in web3signer", addr.Hex())
+}
+
+func readResponse(resp *http.Response) ([]byte, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.ServiceConnectionError("failed to read web3signer response").AppendReason(err.Error())
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errors.NotFoundError("web3signer key not found")
+	case resp.StatusCode >= http.StatusBadRequest:
+		return nil, errors.DependencyFailureError("web3signer responded with status %d", resp.StatusCode).
+			AppendReason(string(body))
+	}
+
+	return body, nil
+}
+
+// parsePublicKey decodes the public keys listed by the signer, with or without their uncompressed prefix
+func parsePublicKey(identifier string) (*ecdsa.PublicKey, error) {
+	b, err := hexutil.Decode(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) == 64 {
+		b = append([]byte{0x04}, b...)
+	}
+
+	return crypto.UnmarshalPubkey(b)
+}
@@ -0,0 +1,167 @@
+// +build unit
+
+package web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/signer"
+	qkmtypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const privKeyHex = "56202652fdffd802b7252a456dbd8f3ecc0352bbde76c23b40afe8aebd714e20"
+
+type jsonRPCRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// newStubServer stands in for a Web3Signer holding a single key
+func newStubServer(t *testing.T) *httptest.Server {
+	privKey, _ := crypto.HexToECDSA(privKeyHex)
+	identifier := hexutil.Encode(crypto.FromECDSAPub(&privKey.PublicKey)[1:])
+
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == publicKeysPath:
+			_ = json.NewEncoder(rw).Encode([]string{identifier})
+		case strings.HasPrefix(req.URL.Path, signPath):
+			if strings.TrimPrefix(req.URL.Path, signPath) != identifier {
+				rw.WriteHeader(http.StatusNotFound)
+				return
+			}
+			signReq := &signRequest{}
+			_ = json.NewDecoder(req.Body).Decode(signReq)
+			sig, _ := crypto.Sign(crypto.Keccak256(signReq.Data), privKey)
+			_, _ = rw.Write([]byte(hexutil.Encode(sig)))
+		default:
+			body, _ := ioutil.ReadAll(req.Body)
+			rpcReq := &jsonRPCRequest{}
+			require.NoError(t, json.Unmarshal(body, rpcReq))
+
+			var result interface{}
+			switch rpcReq.Method {
+			case "eth_sign":
+				var msg hexutil.Bytes
+				_ = json.Unmarshal(rpcReq.Params[1], &msg)
+				sig, _ := crypto.Sign(crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n"), []byte{byte('0' + len(msg))}, msg), privKey)
+				result = hexutil.Encode(sig)
+			case "eth_signTransaction":
+				args := &signTransactionArgs{}
+				_ = json.Unmarshal(rpcReq.Params[0], args)
+				tx := types.NewTx(&types.LegacyTx{
+					Nonce:    uint64(args.Nonce),
+					To:       args.To,
+					Gas:      uint64(args.Gas),
+					GasPrice: args.GasPrice.ToInt(),
+					Value:    args.Value.ToInt(),
+					Data:     args.Data,
+				})
+				signedTx, _ := types.SignTx(tx, types.NewEIP155Signer(args.ChainID.ToInt()), privKey)
+				raw, _ := signedTx.MarshalBinary()
+				result = hexutil.Encode(raw)
+			}
+
+			_ = json.NewEncoder(rw).Encode(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      rpcReq.ID,
+				"result":  result,
+			})
+		}
+	}))
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	server := newStubServer(t)
+	defer server.Close()
+
+	privKey, _ := crypto.HexToECDSA(privKeyHex)
+	address := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	client, err := NewClient(server.Client(), server.URL)
+	require.NoError(t, err)
+
+	t.Run("should get public key of the account successfully", func(t *testing.T) {
+		pubKey, err := client.GetPublicKey(ctx, address.Hex())
+
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Bytes(crypto.FromECDSAPub(&privKey.PublicKey)), pubKey)
+	})
+
+	t.Run("should fail with NotFoundError if the signer does not hold the key of the account", func(t *testing.T) {
+		_, err := client.GetPublicKey(ctx, ethcommon.HexToAddress("0x1").Hex())
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should sign message successfully", func(t *testing.T) {
+		sig, err := client.SignMessage(ctx, "", address.Hex(), &qkmtypes.SignMessageRequest{Message: []byte("message")})
+		require.NoError(t, err)
+
+		pubKey, err := crypto.SigToPub(crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n7message")), hexutil.MustDecode(sig))
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+	})
+
+	t.Run("should sign typed data successfully", func(t *testing.T) {
+		req := &qkmtypes.SignTypedDataRequest{
+			DomainSeparator: qkmtypes.DomainSeparator{Name: "orchestrate", Version: "1", ChainID: 1},
+			Types:           map[string][]qkmtypes.Type{"Mail": {{Name: "content", Type: "string"}}},
+			Message:         map[string]interface{}{"content": "hello"},
+			MessageType:     "Mail",
+		}
+
+		sig, err := client.SignTypedData(ctx, "", address.Hex(), req)
+		require.NoError(t, err)
+
+		encodedData, err := signer.EncodeTypedData(req)
+		require.NoError(t, err)
+		pubKey, err := crypto.SigToPub(crypto.Keccak256(encodedData), hexutil.MustDecode(sig))
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+	})
+
+	t.Run("should sign transaction successfully", func(t *testing.T) {
+		to := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+		signedRaw, err := client.SignTransaction(ctx, "", address.Hex(), &qkmtypes.SignETHTransactionRequest{
+			Nonce:    1,
+			To:       &to,
+			Value:    hexutil.Big(*big.NewInt(10)),
+			GasPrice: hexutil.Big(*big.NewInt(1000)),
+			GasLimit: 21000,
+			ChainID:  hexutil.Big(*big.NewInt(1)),
+		})
+		require.NoError(t, err)
+
+		tx := &types.Transaction{}
+		require.NoError(t, tx.UnmarshalBinary(hexutil.MustDecode(signedRaw)))
+		sender, err := types.Sender(types.NewEIP155Signer(big.NewInt(1)), tx)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+		assert.Equal(t, uint64(1), tx.Nonce())
+	})
+
+	t.Run("should fail with FeatureNotSupportedError to sign private transactions", func(t *testing.T) {
+		_, err := client.SignEEATransaction(ctx, "", address.Hex(), &qkmtypes.SignEEATransactionRequest{})
+		assert.True(t, errors.IsFeatureNotSupportedError(err))
+
+		_, err = client.SignQuorumPrivateTransaction(ctx, "", address.Hex(), &qkmtypes.SignQuorumPrivateTransactionRequest{})
+		assert.True(t, errors.IsFeatureNotSupportedError(err))
+	})
+}
@@ -0,0 +1,9 @@
+package web3signer
+
+type Config struct {
+	URL           string
+	StoreID       string
+	Tenants       []string
+	APIKey        string
+	TLSSkipVerify bool
+}
@@ -0,0 +1,20 @@
+package web3signer
+
+import (
+	"github.com/consensys/orchestrate/src/infra/signer"
+)
+
+// NewRouter signs with the default signer, except for the accounts of the Web3Signer store when a URL is configured
+func NewRouter(defaultSigner signer.Signer, cfg *Config) (*signer.Router, error) {
+	router := signer.NewRouter(defaultSigner)
+	if cfg == nil || cfg.URL == "" {
+		return router, nil
+	}
+
+	client, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return router.Mount(cfg.StoreID, client, cfg.Tenants...), nil
+}
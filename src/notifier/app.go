@@ -43,6 +43,25 @@ func New(config *Config,
 
 	txRouter := service.NewTransactionHandler(sendUC, config.MaxRetries)
 	subRouter := service.NewSubscriptionHandler(sendUC, config.MaxRetries)
+	consumers, err := newMessageConsumers(config, txRouter, subRouter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Daemon{
+		consumers: consumers,
+		config:    config,
+		logger:    log.NewLogger().SetComponent(component),
+	}, nil
+}
+
+func newMessageConsumers(config *Config, txRouter *service.TransactionHandler, subRouter *service.SubscriptionHandler) ([]messenger.Consumer, error) {
+	if config.Broker != nil {
+		return []messenger.Consumer{
+			service.NewInMemoryMessageConsumer(config.Broker, []string{config.ConsumerTopic}, txRouter, subRouter),
+		}, nil
+	}
+
 	consumers := make([]messenger.Consumer, config.Kafka.NConsumers)
 	for idx := 0; idx < config.Kafka.NConsumers; idx++ {
 		var err error
@@ -52,11 +71,7 @@ func New(config *Config,
 		}
 	}
 
-	return &Daemon{
-		consumers: consumers,
-		config:    config,
-		logger:    log.NewLogger().SetComponent(component),
-	}, nil
+	return consumers, nil
 }
 
 func (d *Daemon) Run(ctx context.Context) error {
@@ -3,6 +3,7 @@ package notifier
 import (
 	"github.com/consensys/orchestrate/pkg/sdk/messenger"
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
 )
 
 type Config struct {
@@ -10,4 +11,6 @@ type Config struct {
 	Messenger     *messenger.Config
 	ConsumerTopic string
 	MaxRetries    int
+	// Broker replaces Kafka when set, to run all services within a single process
+	Broker *inmemory.Broker
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/infra/webhook"
 
 	"github.com/consensys/orchestrate/pkg/errors"
@@ -37,11 +38,13 @@ func NewSendUseCase(
 	}
 }
 
-func (uc *sendUseCase) Execute(ctx context.Context, eventStream *entities.EventStream, notif *entities.Notification) error {
+func (uc *sendUseCase) Execute(ctx context.Context, eventStream *entities.EventStream, notif *entities.Notification) (err error) {
+	ctx, span := tracing.StartSpan(ctx, sendComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	logger := uc.logger.WithContext(log.WithFields(ctx, log.Field("notification", notif.UUID), log.Field("event_stream", eventStream.UUID)))
 	userInfo := multitenancy.NewInternalAdminUser()
 
-	var err error
 	switch eventStream.Channel {
 	case entities.EventStreamChannelKafka:
 		err = uc.kafkaProducer.Send(types.NewNotificationResponse(notif), eventStream.Kafka.Topic, eventStream.ChainUUID, nil)
@@ -2,7 +2,9 @@ package service
 
 import (
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
-	messenger "github.com/consensys/orchestrate/src/infra/messenger/kafka"
+	"github.com/consensys/orchestrate/src/infra/messenger"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
+	messengerkafka "github.com/consensys/orchestrate/src/infra/messenger/kafka"
 )
 
 const (
@@ -13,13 +15,27 @@ func NewMessageConsumer(cfg *kafka.Config,
 	topics []string,
 	transactionHandler *TransactionHandler,
 	subscriptionHandler *SubscriptionHandler,
-) (*messenger.Consumer, error) {
-	consumer, err := messenger.NewMessageConsumer(messageListenerComponent, cfg, topics)
+) (*messengerkafka.Consumer, error) {
+	consumer, err := messengerkafka.NewMessageConsumer(messageListenerComponent, cfg, topics)
 	if err != nil {
 		return nil, err
 	}
 
+	appendHandlers(consumer, transactionHandler, subscriptionHandler)
+	return consumer, nil
+}
+
+func NewInMemoryMessageConsumer(broker *inmemory.Broker,
+	topics []string,
+	transactionHandler *TransactionHandler,
+	subscriptionHandler *SubscriptionHandler,
+) *inmemory.Consumer {
+	consumer := inmemory.NewMessageConsumer(messageListenerComponent, broker, topics)
+	appendHandlers(consumer, transactionHandler, subscriptionHandler)
+	return consumer
+}
+
+func appendHandlers(consumer messenger.Consumer, transactionHandler *TransactionHandler, subscriptionHandler *SubscriptionHandler) {
 	consumer.AppendHandler(TransactionMessageType, transactionHandler.HandleTransactionReq)
 	consumer.AppendHandler(ContractEventMessageType, subscriptionHandler.HandleContractEventReq)
-	return consumer, nil
 }
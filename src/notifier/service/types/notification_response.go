@@ -38,5 +38,9 @@ func NewNotificationResponse(notif *entities.Notification) *NotificationResponse
 		resp.Data = notif.Job // TODO(dario): Use TxResponse when formatted
 	}
 
+	if notif.SourceType == entities.NotificationSourceTypeSafeProposal {
+		resp.Data = notif.SafeProposal
+	}
+
 	return resp
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/consensys/orchestrate/src/infra/messenger"
 	"github.com/consensys/orchestrate/src/tx-listener/service"
 	"github.com/consensys/orchestrate/src/tx-listener/tx-listener/builder"
+	"github.com/consensys/orchestrate/src/tx-listener/tx-listener/sessions"
 	"github.com/hashicorp/go-multierror"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -24,10 +25,11 @@ import (
 )
 
 type Service struct {
-	cfg       *Config
-	consumers []messenger.Consumer
-	logger    *log.Logger
-	cancel    context.CancelFunc
+	cfg                 *Config
+	consumers           []messenger.Consumer
+	retryJobSessionMngr sessions.RetryJobSessionManager
+	logger              *log.Logger
+	cancel              context.CancelFunc
 }
 
 func NewTxListener(cfg *Config,
@@ -44,7 +46,7 @@ func NewTxListener(cfg *Config,
 	contractUCs := builder.NewContractUseCases(apiClient, ethClient, state, logger)
 	jobUCs := builder.NewJobUseCases(messengerAPI, apiClient, ethClient, contractUCs, state, logger)
 	subscriptionUCs := builder.NewSubscriptionUseCase(messengerAPI, apiClient, ethClient, state.SubscriptionState(), logger)
-	chainUCs := builder.NewChainUseCases(apiClient, ethClient, jobUCs, subscriptionUCs, state, logger)
+	chainUCs := builder.NewChainUseCases(messengerAPI, apiClient, ethClient, jobUCs, subscriptionUCs, state, logger)
 	sessionMngrs := builder.NewSessionManagers(messengerAPI, apiClient, ethClient, jobUCs, chainUCs, state, logger)
 
 	bckOff := backoff.NewConstantBackOff(cfg.RetryInterval) // @TODO Replace by config
@@ -52,22 +54,19 @@ func NewTxListener(cfg *Config,
 	jobRouter := service.NewJobHandler(jobUCs.PendingJobUseCase(), jobUCs.FailedJobUseCase(),
 		sessionMngrs.ChainSessionManager(), sessionMngrs.RetryJobSessionManager(), bckOff)
 	subscriptionRouter := service.NewSubscriptionHandler(subscriptionUCs, sessionMngrs.ChainSessionManager(), bckOff)
+	eventLogsRouter := service.NewEventLogsHandler(chainUCs.ChainEventLogsBackfillUseCase(), bckOff)
 
 	// Create service layer consumer
-	consumers := make([]messenger.Consumer, cfg.Kafka.NConsumers)
-	for idx := 0; idx < cfg.Kafka.NConsumers; idx++ {
-		var err error
-		consumers[idx], err = service.NewMessageConsumer(cfg.Kafka, []string{cfg.ConsumerTopic},
-			jobRouter, subscriptionRouter)
-		if err != nil {
-			return nil, err
-		}
+	consumers, err := newMessageConsumers(cfg, jobRouter, subscriptionRouter, eventLogsRouter)
+	if err != nil {
+		return nil, err
 	}
 
 	txListenerSrv := &Service{
-		cfg:       cfg,
-		consumers: consumers,
-		logger:    logger,
+		cfg:                 cfg,
+		consumers:           consumers,
+		retryJobSessionMngr: sessionMngrs.RetryJobSessionManager(),
+		logger:              logger,
 	}
 
 	appli, err := app.New(cfg.App, readinessOpt(apiClient, consumers[0], kafkaProducer), app.MetricsOpt(listenerMetrics))
@@ -89,6 +88,13 @@ func (s *Service) Run(ctx context.Context) error {
 	}
 
 	ctx, s.cancel = context.WithCancel(ctx)
+
+	// Pending jobs are also retried again once their messages are consumed, so the tx-listener starts anyway
+	err := s.retryJobSessionMngr.ResumeSessions(ctx)
+	if err != nil {
+		s.logger.WithError(err).Warn("failed to resume retry sessions")
+	}
+
 	gr := &multierror.Group{}
 	for idx, consumerGroup := range s.consumers {
 		cGroup := consumerGroup
@@ -130,6 +136,27 @@ func (s *Service) Close() error {
 	return gerr
 }
 
+func newMessageConsumers(cfg *Config, jobRouter *service.JobHandler, subscriptionRouter *service.SubscriptionHandler,
+	eventLogsRouter *service.EventLogsHandler) ([]messenger.Consumer, error) {
+	if cfg.Broker != nil {
+		return []messenger.Consumer{
+			service.NewInMemoryMessageConsumer(cfg.Broker, []string{cfg.ConsumerTopic}, jobRouter, subscriptionRouter, eventLogsRouter),
+		}, nil
+	}
+
+	consumers := make([]messenger.Consumer, cfg.Kafka.NConsumers)
+	for idx := 0; idx < cfg.Kafka.NConsumers; idx++ {
+		var err error
+		consumers[idx], err = service.NewMessageConsumer(cfg.Kafka, []string{cfg.ConsumerTopic},
+			jobRouter, subscriptionRouter, eventLogsRouter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return consumers, nil
+}
+
 func readinessOpt(client sdk.OrchestrateClient, kafkaConsumer messenger.Consumer, kafkaProducer kafka.Producer) app.Option {
 	return func(ap *app.App) error {
 		ap.AddReadinessCheck("api", client.Checker())
@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/sdk/messenger"
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
 
 	orchestrateclient "github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
@@ -20,4 +21,6 @@ type Config struct {
 	ConsumerTopic         string
 	Messenger             *messenger.Config
 	Kafka                 *kafka.Config
+	// Broker replaces Kafka when set, to run all services within a single process
+	Broker *inmemory.Broker
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/infra/ethclient/rpc"
+	kafkainfra "github.com/consensys/orchestrate/src/infra/kafka"
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
 	listenermetrics "github.com/consensys/orchestrate/src/tx-listener/tx-listener/metrics"
 	"github.com/spf13/viper"
@@ -22,9 +23,15 @@ func New(ctx context.Context, cfg *Config) (*app.App, error) {
 
 	apiClient := orchestrateclient.NewHTTPClient(http.NewClient(cfg.HTTPClient), cfg.API)
 
-	kafkaProdClient, err := kafka.NewProducer(cfg.Kafka)
-	if err != nil {
-		return nil, err
+	var kafkaProdClient kafkainfra.Producer
+	if cfg.Broker != nil {
+		kafkaProdClient = cfg.Broker
+	} else {
+		var err error
+		kafkaProdClient, err = kafka.NewProducer(cfg.Kafka)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var listenerMetrics listenermetrics.ListenerMetrics
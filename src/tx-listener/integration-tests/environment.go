@@ -191,6 +191,11 @@ func (env *IntegrationEnvironment) Start(ctx context.Context) error {
 
 	env.messengerClient = messenger.NewProducerClient(&messenger.Config{TopicTxListener: env.cfg.ConsumerTopic}, kafkaProd)
 
+	// No sentry session to resume on start
+	gock.New(apiURL).
+		Get("/sentry-sessions").
+		Reply(http2.StatusOK).JSON([]interface{}{})
+
 	// Start tx-sender app
 	err = env.app.Start(ctx)
 	if err != nil {
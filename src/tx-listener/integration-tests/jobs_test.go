@@ -113,6 +113,8 @@ func (s *txListenerJobTestSuite) TestRetryJob() {
 			AddMatcher(waitTimeoutMatcher(isResendCalled, job.InternalData.RetryInterval+extendedWaitingTime)).
 			Reply(http2.StatusAccepted)
 
+		mockSentrySession(job)
+
 		err := s.sendJobMessage(job)
 		require.NoError(t, err)
 
@@ -164,6 +166,8 @@ func (s *txListenerJobTestSuite) TestRetryJob() {
 			AddMatcher(waitTimeoutMatcher(isResendCalled, job.InternalData.RetryInterval+extendedWaitingTime)).
 			Reply(http2.StatusAccepted)
 
+		mockSentrySession(job)
+
 		err := s.sendJobMessage(job)
 
 		require.NoError(t, err)
@@ -203,6 +207,8 @@ func (s *txListenerJobTestSuite) TestRetryJob() {
 			AddMatcher(waitTimeoutMatcher(isStartJobCalled, job.InternalData.RetryInterval+extendedWaitingTime)).
 			Reply(http2.StatusAccepted)
 
+		mockSentrySession(job)
+
 		err := s.sendJobMessage(job)
 
 		require.NoError(t, err)
@@ -221,6 +227,32 @@ func (s *txListenerJobTestSuite) sendJobMessage(job *entities.Job) error {
 	return nil
 }
 
+func mockSentrySession(job *entities.Job) {
+	gock.New(apiURL).
+		Get("/sentry-sessions/" + job.UUID).
+		Times(1).
+		Reply(http2.StatusNotFound)
+
+	session := &api.SentrySessionResponse{JobUUID: job.UUID, ChainUUID: job.ChainUUID}
+	gock.New(apiURL).
+		Post("/sentry-sessions").
+		Reply(http2.StatusOK).JSON(session)
+
+	gock.New(apiURL).
+		Get("/sentry-sessions/" + job.UUID).
+		Persist().
+		Reply(http2.StatusOK).JSON(session)
+
+	gock.New(apiURL).
+		Patch("/sentry-sessions/" + job.UUID).
+		Persist().
+		Reply(http2.StatusOK).JSON(session)
+
+	gock.New(apiURL).
+		Delete("/sentry-sessions/" + job.UUID).
+		Reply(http2.StatusNoContent)
+}
+
 func searchTxMatcher(jobUUID string) gock.MatchFunc {
 	return func(rw *http2.Request, _ *gock.Request) (bool, error) {
 		qParentJobUUID := rw.URL.Query().Get("parent_job_uuid")
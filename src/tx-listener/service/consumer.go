@@ -2,7 +2,9 @@ package service
 
 import (
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
-	messenger "github.com/consensys/orchestrate/src/infra/messenger/kafka"
+	"github.com/consensys/orchestrate/src/infra/messenger"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
+	messengerkafka "github.com/consensys/orchestrate/src/infra/messenger/kafka"
 )
 
 const (
@@ -13,14 +15,33 @@ func NewMessageConsumer(cfg *kafka.Config,
 	topics []string,
 	jobHandler *JobHandler,
 	subscriptionHandler *SubscriptionHandler,
-) (*messenger.Consumer, error) {
-	consumer, err := messenger.NewMessageConsumer(messageListenerComponent, cfg, topics)
+	eventLogsHandler *EventLogsHandler,
+) (*messengerkafka.Consumer, error) {
+	consumer, err := messengerkafka.NewMessageConsumer(messageListenerComponent, cfg, topics)
 	if err != nil {
 		return nil, err
 	}
 
-	consumer.AppendHandler(PendingJobMessageType, jobHandler.HandlePendingJobMessage)
-	consumer.AppendHandler(SubscriptionMessageType, subscriptionHandler.HandleSubscriptionMessage)
+	appendHandlers(consumer, jobHandler, subscriptionHandler, eventLogsHandler)
 
 	return consumer, nil
 }
+
+func NewInMemoryMessageConsumer(broker *inmemory.Broker,
+	topics []string,
+	jobHandler *JobHandler,
+	subscriptionHandler *SubscriptionHandler,
+	eventLogsHandler *EventLogsHandler,
+) *inmemory.Consumer {
+	consumer := inmemory.NewMessageConsumer(messageListenerComponent, broker, topics)
+	appendHandlers(consumer, jobHandler, subscriptionHandler, eventLogsHandler)
+
+	return consumer
+}
+
+func appendHandlers(consumer messenger.Consumer, jobHandler *JobHandler, subscriptionHandler *SubscriptionHandler,
+	eventLogsHandler *EventLogsHandler) {
+	consumer.AppendHandler(PendingJobMessageType, jobHandler.HandlePendingJobMessage)
+	consumer.AppendHandler(SubscriptionMessageType, subscriptionHandler.HandleSubscriptionMessage)
+	consumer.AppendHandler(EventLogsBackfillMessageType, eventLogsHandler.HandleBackfillMessage)
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/api"
+	"github.com/consensys/orchestrate/src/tx-listener/service/types"
+	usecases "github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases"
+)
+
+var (
+	EventLogsBackfillMessageType entities.RequestMessageType = "event-logs-backfill"
+)
+
+type EventLogsHandler struct {
+	backfillUC   usecases.ChainEventLogsBackfill
+	retryBackOff backoff.BackOff
+	logger       *log.Logger
+}
+
+func NewEventLogsHandler(backfillUC usecases.ChainEventLogsBackfill, bck backoff.BackOff) *EventLogsHandler {
+	return &EventLogsHandler{
+		backfillUC:   backfillUC,
+		retryBackOff: bck,
+		logger:       log.NewLogger().SetComponent(messageListenerComponent),
+	}
+}
+
+func (mch *EventLogsHandler) HandleBackfillMessage(ctx context.Context, msg *entities.Message) error {
+	req := &types.EventLogsBackfillMessageRequest{}
+	err := api.UnmarshalBody(bytes.NewReader(msg.Body), req)
+	if err != nil || req.Backfill == nil {
+		return errors.InvalidFormatError("invalid event logs backfill request type")
+	}
+
+	return backoff.RetryNotify(
+		func() error {
+			err := mch.backfillUC.Execute(ctx, req.Backfill)
+			switch {
+			// Exits if not errors
+			case err == nil:
+				return nil
+			case err == context.DeadlineExceeded || err == context.Canceled:
+				return backoff.Permanent(ctx.Err())
+			case ctx.Err() != nil:
+				return backoff.Permanent(ctx.Err())
+			case errors.IsConnectionError(err):
+				return err
+			default: // Remaining error types (err != nil)
+				mch.logger.WithError(err).WithField("chain", req.Backfill.ChainUUID).Error("failed to backfill event logs")
+				return backoff.Permanent(err)
+			}
+		},
+		mch.retryBackOff,
+		func(err error, duration time.Duration) {
+			mch.logger.WithError(err).Warnf("error processing message, retrying in %v...", duration)
+		},
+	)
+}
@@ -0,0 +1,9 @@
+package types
+
+import (
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type EventLogsBackfillMessageRequest struct {
+	Backfill *entities.EventLogsBackfill `json:"backfill"`
+}
@@ -10,8 +10,9 @@ import (
 )
 
 type chainUCs struct {
-	chainBlockTxsUC    usecases.ChainBlockTxs
-	chainBlockEventsUC usecases.ChainBlockEvents
+	chainBlockTxsUC          usecases.ChainBlockTxs
+	chainBlockEventsUC       usecases.ChainBlockEvents
+	chainEventLogsBackfillUC usecases.ChainEventLogsBackfill
 }
 
 func (s *chainUCs) ChainBlockTxsUseCase() usecases.ChainBlockTxs {
@@ -22,7 +23,12 @@ func (s *chainUCs) ChainBlockEventsUseCase() usecases.ChainBlockEvents {
 	return s.chainBlockEventsUC
 }
 
-func NewChainUseCases(proxyClient sdk.ChainProxyClient,
+func (s *chainUCs) ChainEventLogsBackfillUseCase() usecases.ChainEventLogsBackfill {
+	return s.chainEventLogsBackfillUC
+}
+
+func NewChainUseCases(messengerAPI sdk.MessengerAPI,
+	proxyClient sdk.ChainProxyClient,
 	ethClient ethclient.Client,
 	jobUCs usecases.JobUseCases,
 	subscriptionUCs usecases.SubscriptionUseCases,
@@ -33,9 +39,11 @@ func NewChainUseCases(proxyClient sdk.ChainProxyClient,
 		state.PendingJobState(), logger)
 	chainBlockEvents := chains.NewChainBlockEventsUseCase(proxyClient, ethClient, subscriptionUCs.NotifySubscriptionEventsUseCase(), 
 		state.SubscriptionState(), logger)
+	chainEventLogsBackfill := chains.NewChainEventLogsBackfillUseCase(proxyClient, ethClient, messengerAPI, logger)
 	
 	return &chainUCs{
 		chainBlockTxsUC: chainBlockTxs,
 		chainBlockEventsUC: chainBlockEvents,
+		chainEventLogsBackfillUC: chainEventLogsBackfill,
 	}
 }
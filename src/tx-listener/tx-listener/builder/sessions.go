@@ -32,10 +32,10 @@ func NewSessionManagers(messengerAPI sdk.MessengerAPI,
 	state store.State,
 	logger *log.Logger,
 ) sessions.SessionManagers {
-	retryJobSessionMngr := tx_sentry.NewRetrySessionManager(messengerAPI, apiClient, jobUCs.RetryJobUseCase(), state.RetryJobSessionState(),
-		state.PendingJobState(), logger)
 	chainSessionMngr := chains.ChainSessionManager(apiClient, ethClient, chainUCs, state.PendingJobState(),
 		state.SubscriptionState(), state.ChainState(), logger)
+	retryJobSessionMngr := tx_sentry.NewRetrySessionManager(messengerAPI, apiClient, jobUCs.RetryJobUseCase(), chainSessionMngr,
+		state.RetryJobSessionState(), state.PendingJobState(), logger)
 
 	return &sessionMngrs{
 		chainSessionMngr:    chainSessionMngr,
@@ -86,6 +86,20 @@ func (mr *MockRetryJobSessionManagerMockRecorder) StartSession(ctx, job interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockRetryJobSessionManager)(nil).StartSession), ctx, job)
 }
 
+// ResumeSessions mocks base method
+func (m *MockRetryJobSessionManager) ResumeSessions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSessions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeSessions indicates an expected call of ResumeSessions
+func (mr *MockRetryJobSessionManagerMockRecorder) ResumeSessions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSessions", reflect.TypeOf((*MockRetryJobSessionManager)(nil).ResumeSessions), ctx)
+}
+
 // MockSessionManagers is a mock of SessionManagers interface
 type MockSessionManagers struct {
 	ctrl     *gomock.Controller
@@ -14,6 +14,7 @@ type ChainSessionManager interface {
 
 type RetryJobSessionManager interface {
 	StartSession(ctx context.Context, job *entities.Job) error
+	ResumeSessions(ctx context.Context) error
 }
 
 type SessionManagers interface {
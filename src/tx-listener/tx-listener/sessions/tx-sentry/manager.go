@@ -6,10 +6,12 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/service/formatters"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/tx-listener/store"
 	"github.com/consensys/orchestrate/src/tx-listener/tx-listener/sessions"
 	usecases "github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases"
+	"github.com/hashicorp/go-multierror"
 )
 
 const retryJobSessionMngrComponent = "tx-listener.use-case.tx-sentry.session-manager"
@@ -17,15 +19,17 @@ const retryJobSessionMngrComponent = "tx-listener.use-case.tx-sentry.session-man
 type RetryJobSessionMngr struct {
 	sendRetryJobUseCase usecases.RetryJob
 	messengerAPI        sdk.MessengerAPI
-	jobClient           sdk.JobClient
+	client              sdk.OrchestrateClient
+	chainSessionMngr    sessions.ChainSessionManager
 	retrySessionState   store.RetryJobSession
 	pendingJobState     store.PendingJob
 	logger              *log.Logger
 }
 
 func NewRetrySessionManager(messengerAPI sdk.MessengerAPI,
-	jobClient sdk.JobClient,
+	client sdk.OrchestrateClient,
 	sendRetryJobUseCase usecases.RetryJob,
+	chainSessionMngr sessions.ChainSessionManager,
 	retrySessionState store.RetryJobSession,
 	pendingJobState store.PendingJob,
 	logger *log.Logger,
@@ -33,7 +37,8 @@ func NewRetrySessionManager(messengerAPI sdk.MessengerAPI,
 	return &RetryJobSessionMngr{
 		messengerAPI:        messengerAPI,
 		sendRetryJobUseCase: sendRetryJobUseCase,
-		jobClient:           jobClient,
+		client:              client,
+		chainSessionMngr:    chainSessionMngr,
 		retrySessionState:   retrySessionState,
 		pendingJobState:     pendingJobState,
 		logger:              logger.SetComponent(retryJobSessionMngrComponent),
@@ -51,7 +56,7 @@ func (mngr *RetryJobSessionMngr) StartSession(ctx context.Context, job *entities
 		return errors.AlreadyExistsError(errMsg)
 	}
 
-	sess := NewRetryJobSession(mngr.messengerAPI, mngr.jobClient, mngr.sendRetryJobUseCase, mngr.pendingJobState, job, logger)
+	sess := NewRetryJobSession(mngr.messengerAPI, mngr.client, mngr.sendRetryJobUseCase, mngr.pendingJobState, job, logger)
 
 	err := mngr.retrySessionState.Add(ctx, job)
 	if err != nil {
@@ -73,7 +78,75 @@ func (mngr *RetryJobSessionMngr) StartSession(ctx context.Context, job *entities
 			errMsg := "failed to remove retry session"
 			logger.WithError(err).Error(errMsg)
 		}
+
+		// Sessions interrupted by the shutdown of the tx-listener are resumed on next startup
+		if ctx.Err() != nil {
+			return
+		}
+
+		err = mngr.client.DeleteSentrySession(context.Background(), job.UUID)
+		if err != nil && !errors.IsNotFoundError(err) {
+			errMsg := "failed to delete persisted retry session"
+			logger.WithError(err).Error(errMsg)
+		}
 	}(sess)
 
 	return nil
 }
+
+// ResumeSessions reschedules the retry sessions persisted before the tx-listener restarted.
+// A session failing to resume does not prevent the others from resuming, all failures being returned at the end
+func (mngr *RetryJobSessionMngr) ResumeSessions(ctx context.Context) error {
+	sessResps, err := mngr.client.SearchSentrySessions(ctx, &entities.SentrySessionFilters{})
+	if err != nil {
+		mngr.logger.WithError(err).Error("failed to fetch retry sessions")
+		return err
+	}
+
+	var result *multierror.Error
+	for _, sessResp := range sessResps {
+		logger := mngr.logger.WithField("job", sessResp.JobUUID).WithField("chain", sessResp.ChainUUID)
+
+		jobResp, err := mngr.client.GetJob(ctx, sessResp.JobUUID)
+		if err != nil {
+			logger.WithError(err).Error("failed to fetch job of retry session")
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		// Jobs mined or failed while the tx-listener was stopped are no longer retried
+		if jobResp.Status != entities.StatusPending && jobResp.Status != entities.StatusResending {
+			err = mngr.client.DeleteSentrySession(ctx, sessResp.JobUUID)
+			if err != nil && !errors.IsNotFoundError(err) {
+				logger.WithError(err).Error("failed to delete retry session")
+				result = multierror.Append(result, err)
+				continue
+			}
+
+			logger.WithField("status", jobResp.Status).Debug("retry session of completed job deleted")
+			continue
+		}
+
+		err = mngr.chainSessionMngr.StartSession(ctx, sessResp.ChainUUID)
+		if err != nil && !errors.IsAlreadyExistsError(err) {
+			logger.WithError(err).Error("failed to start chain session of retry session")
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		err = mngr.StartSession(ctx, formatters.JobResponseToEntity(jobResp))
+		if err != nil && !errors.IsAlreadyExistsError(err) {
+			logger.WithError(err).Error("failed to resume retry session")
+			result = multierror.Append(result, err)
+			continue
+		}
+	}
+
+	if result != nil {
+		mngr.logger.WithField("sessions", len(sessResps)).WithField("failed", result.Len()).Error("failed to resume some retry sessions")
+		return result.ErrorOrNil()
+	}
+
+	mngr.logger.WithField("sessions", len(sessResps)).Info("retry sessions resumed")
+	return nil
+}
@@ -0,0 +1,60 @@
+// +build unit
+
+package txsentry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	testdata2 "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/entities"
+	mocks2 "github.com/consensys/orchestrate/src/tx-listener/store/mocks"
+	mocks3 "github.com/consensys/orchestrate/src/tx-listener/tx-listener/sessions/mocks"
+	"github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryJobSessionMngr_ResumeSessions(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := mock.NewMockOrchestrateClient(ctrl)
+	chainSessionMngr := mocks3.NewMockChainSessionManager(ctrl)
+	mngr := NewRetrySessionManager(mock.NewMockMessengerAPI(ctrl), client, mocks.NewMockRetryJob(ctrl), chainSessionMngr,
+		mocks2.NewMockRetryJobSession(ctrl), mocks2.NewMockPendingJob(ctrl), log.NewLogger())
+
+	t.Run("should resume the other sessions if a session fails to resume", func(t *testing.T) {
+		failedSess := &types.SentrySessionResponse{JobUUID: "failedJobUUID", ChainUUID: "chainUUID"}
+		completedSess := &types.SentrySessionResponse{JobUUID: "completedJobUUID", ChainUUID: "chainUUID"}
+		completedJob := testdata2.FakeJobResponse()
+		completedJob.Status = entities.StatusMined
+		expectedErr := fmt.Errorf("error")
+
+		client.EXPECT().SearchSentrySessions(gomock.Any(), gomock.Any()).
+			Return([]*types.SentrySessionResponse{failedSess, completedSess}, nil)
+		client.EXPECT().GetJob(gomock.Any(), failedSess.JobUUID).Return(nil, expectedErr)
+		client.EXPECT().GetJob(gomock.Any(), completedSess.JobUUID).Return(completedJob, nil)
+		client.EXPECT().DeleteSentrySession(gomock.Any(), completedSess.JobUUID).Return(nil)
+
+		err := mngr.ResumeSessions(ctx)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), expectedErr.Error())
+	})
+
+	t.Run("should fail with same error if sessions cannot be fetched", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+
+		client.EXPECT().SearchSentrySessions(gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := mngr.ResumeSessions(ctx)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
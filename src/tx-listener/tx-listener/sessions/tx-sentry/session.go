@@ -19,7 +19,7 @@ const retryJobSessionComponent = "tx-listener.retry-job.session"
 
 type RetryJobSession struct {
 	sendRetryJobUseCase usecases.RetryJob
-	client              sdk.JobClient
+	client              sdk.OrchestrateClient
 	messenger           sdk.MessengerAPI
 	pendingJobState     store.PendingJob
 	logger              *log.Logger
@@ -33,9 +33,12 @@ type sessionData struct {
 	nChildren        int
 	retries          int
 	lastChildJobUUID string
+	nextAttemptAt    time.Time
+	// resumed is true until the job of a session resumed on startup is consumed again as a pending job
+	resumed bool
 }
 
-func NewRetryJobSession(messenger sdk.MessengerAPI, client sdk.JobClient, sendRetryJobUseCase usecases.RetryJob,
+func NewRetryJobSession(messenger sdk.MessengerAPI, client sdk.OrchestrateClient, sendRetryJobUseCase usecases.RetryJob,
 	pendingJobState store.PendingJob, job *entities.Job, logger *log.Logger) *RetryJobSession {
 	return &RetryJobSession{
 		sendRetryJobUseCase: sendRetryJobUseCase,
@@ -52,7 +55,7 @@ func (uc *RetryJobSession) Start(ctx context.Context) error {
 	uc.logger.Info("retry session started")
 	ctx, uc.cancelCtx = context.WithCancel(ctx)
 
-	ses, err := uc.retrieveJobSessionData(ctx, uc.job)
+	ses, err := uc.loadSessionData(ctx)
 	if err != nil {
 		uc.logger.WithError(err).Error("job listening session failed to start")
 		return err
@@ -87,13 +90,25 @@ func (uc *RetryJobSession) Stop() {
 }
 
 func (uc *RetryJobSession) runSession(ctx context.Context, sess *sessionData) error {
-	ticker := time.NewTicker(sess.parentJob.InternalData.RetryInterval)
+	timer := time.NewTimer(time.Until(sess.nextAttemptAt))
+	defer timer.Stop()
 	for {
 		select {
-		case <-ticker.C:
-			_, err := uc.pendingJobState.GetByTxHash(ctx, uc.job.ChainUUID, uc.job.Transaction.Hash)
+		case <-timer.C:
+			isPending, err := uc.isJobPending(ctx, sess)
+			if err != nil {
+				return err
+			}
+			if !isPending {
+				uc.Stop()
+				return nil
+			}
+
+			// Sessions cancelled through the API are no longer persisted
+			_, err = uc.client.GetSentrySession(ctx, uc.job.UUID)
 			if err != nil {
 				if errors.IsNotFoundError(err) {
+					uc.logger.Info("session has been cancelled")
 					uc.Stop()
 					return nil
 				}
@@ -125,6 +140,18 @@ func (uc *RetryJobSession) runSession(ctx context.Context, sess *sessionData) er
 				sess.nChildren++
 				sess.lastChildJobUUID = childJobUUID
 			}
+
+			sess.nextAttemptAt = time.Now().Add(sess.parentJob.InternalData.RetryInterval)
+			err = uc.persistSessionData(ctx, sess)
+			if err != nil {
+				if errors.IsNotFoundError(err) {
+					uc.logger.Info("session has been cancelled")
+					return nil
+				}
+				return err
+			}
+
+			timer.Reset(time.Until(sess.nextAttemptAt))
 		case <-ctx.Done():
 			uc.logger.WithField("reason", ctx.Err().Error()).Info("session gracefully stopped")
 			return nil
@@ -132,6 +159,29 @@ func (uc *RetryJobSession) runSession(ctx context.Context, sess *sessionData) er
 	}
 }
 
+// isJobPending checks whether the job is still waiting to be mined
+func (uc *RetryJobSession) isJobPending(ctx context.Context, sess *sessionData) (bool, error) {
+	_, err := uc.pendingJobState.GetByTxHash(ctx, uc.job.ChainUUID, uc.job.Transaction.Hash)
+	if err == nil {
+		sess.resumed = false
+		return true, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		return false, err
+	}
+	if !sess.resumed {
+		return false, nil
+	}
+
+	// Jobs of sessions resumed on startup are only tracked once their pending job message is consumed again
+	jobResp, err := uc.client.GetJob(ctx, uc.job.UUID)
+	if err != nil {
+		return false, err
+	}
+
+	return jobResp.Status == entities.StatusPending || jobResp.Status == entities.StatusResending, nil
+}
+
 func (uc *RetryJobSession) updateJobAnnotations() error {
 	uc.job.InternalData.HasBeenRetried = true
 	err := uc.messenger.JobUpdateMessage(context.Background(), &types.JobUpdateMessageRequest{
@@ -147,11 +197,69 @@ func (uc *RetryJobSession) updateJobAnnotations() error {
 	return nil
 }
 
+// loadSessionData resumes the persisted session of the job, or creates it if the job was never retried
+func (uc *RetryJobSession) loadSessionData(ctx context.Context) (*sessionData, error) {
+	sessResp, err := uc.client.GetSentrySession(ctx, uc.job.UUID)
+	if err == nil {
+		uc.logger.WithField("retries", sessResp.Retries).Info("retry session resumed")
+		return &sessionData{
+			parentJob:        uc.job,
+			nChildren:        sessResp.NChildren,
+			retries:          sessResp.Retries,
+			lastChildJobUUID: sessResp.LastChildJobUUID,
+			nextAttemptAt:    sessResp.NextAttemptAt,
+			resumed:          true,
+		}, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	ses, err := uc.retrieveJobSessionData(ctx, uc.job)
+	if err != nil {
+		return nil, err
+	}
+
+	ses.nextAttemptAt = time.Now().Add(uc.job.InternalData.RetryInterval)
+	_, err = uc.client.CreateSentrySession(ctx, &types.CreateSentrySessionRequest{
+		JobUUID:          uc.job.UUID,
+		Retries:          ses.retries,
+		NChildren:        ses.nChildren,
+		LastChildJobUUID: ses.lastChildJobUUID,
+		NextAttemptAt:    ses.nextAttemptAt,
+	})
+	if err != nil && !errors.IsAlreadyExistsError(err) {
+		uc.logger.WithError(err).Error("failed to persist retry session")
+		return nil, err
+	}
+
+	return ses, nil
+}
+
+func (uc *RetryJobSession) persistSessionData(ctx context.Context, sess *sessionData) error {
+	_, err := uc.client.UpdateSentrySession(ctx, uc.job.UUID, &types.UpdateSentrySessionRequest{
+		Retries:          sess.retries,
+		NChildren:        sess.nChildren,
+		LastChildJobUUID: sess.lastChildJobUUID,
+		NextAttemptAt:    sess.nextAttemptAt,
+	})
+	if err != nil {
+		uc.logger.WithError(err).Error("failed to persist retry session")
+		return err
+	}
+
+	return nil
+}
+
 func (uc *RetryJobSession) retrieveJobSessionData(ctx context.Context, job *entities.Job) (*sessionData, error) {
-	childrenJobs, err := uc.client.SearchJob(ctx, &entities.JobFilters{
+	var childrenJobs []*types.JobResponse
+	err := uc.client.SearchJobPages(ctx, &entities.JobFilters{
 		ChainUUID:     job.ChainUUID,
 		ParentJobUUID: job.UUID,
 		WithLogs:      true,
+	}, func(jobs []*types.JobResponse) error {
+		childrenJobs = append(childrenJobs, jobs...)
+		return nil
 	})
 
 	if err != nil {
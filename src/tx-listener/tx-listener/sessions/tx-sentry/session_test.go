@@ -11,7 +11,8 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
-	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	mocks2 "github.com/consensys/orchestrate/src/tx-listener/store/mocks"
 	"github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases/mocks"
@@ -29,7 +30,7 @@ func TestRetryJobSession_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	msgAPI := mock.NewMockMessengerAPI(ctrl)
-	jobAPIClient := mock.NewMockJobClient(ctrl)
+	jobAPIClient := mock.NewMockOrchestrateClient(ctrl)
 	retryJobUseCase := mocks.NewMockRetryJob(ctrl)
 	pendingJobState := mocks2.NewMockPendingJob(ctrl)
 	logger := log.NewLogger()
@@ -40,7 +41,19 @@ func TestRetryJobSession_Execute(t *testing.T) {
 		job.InternalData.RetryInterval = defaultRetryInterval
 		cStopErr := make(chan error, 1)
 
-		jobAPIClient.EXPECT().SearchJob(gomock.Any(), gomock.Any()).Return([]*api.JobResponse{}, nil)
+		gomock.InOrder(
+			jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Return(nil, errors.NotFoundError("")),
+			jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Times(2).Return(&types.SentrySessionResponse{}, nil),
+		)
+		jobAPIClient.EXPECT().SearchJobPages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		jobAPIClient.EXPECT().CreateSentrySession(gomock.Any(), gomock.Any()).Return(&types.SentrySessionResponse{}, nil)
+		jobAPIClient.EXPECT().UpdateSentrySession(gomock.Any(), job.UUID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, req *types.UpdateSentrySessionRequest) (*types.SentrySessionResponse, error) {
+				assert.Equal(t, 1, req.Retries)
+				assert.Equal(t, 1, req.NChildren)
+				assert.Equal(t, childJob.UUID, req.LastChildJobUUID)
+				return &types.SentrySessionResponse{}, nil
+			})
 		pendingJobState.EXPECT().GetByTxHash(gomock.Any(), job.ChainUUID, job.Transaction.Hash).
 			Times(2).Return(job, nil)
 		prevRetryCall := retryJobUseCase.EXPECT().Execute(gomock.Any(), job, job.UUID, 0).Return(childJob.UUID, nil)
@@ -71,7 +84,13 @@ func TestRetryJobSession_Execute(t *testing.T) {
 		job.InternalData.RetryInterval = defaultRetryInterval
 		cStopErr := make(chan error, 1)
 
-		jobAPIClient.EXPECT().SearchJob(gomock.Any(), gomock.Any()).Return([]*api.JobResponse{}, nil)
+		gomock.InOrder(
+			jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Return(nil, errors.NotFoundError("")),
+			jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Return(&types.SentrySessionResponse{}, nil),
+		)
+		jobAPIClient.EXPECT().SearchJobPages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		jobAPIClient.EXPECT().CreateSentrySession(gomock.Any(), gomock.Any()).Return(&types.SentrySessionResponse{}, nil)
+		jobAPIClient.EXPECT().UpdateSentrySession(gomock.Any(), job.UUID, gomock.Any()).Return(&types.SentrySessionResponse{}, nil)
 		prevPendingCall := pendingJobState.EXPECT().GetByTxHash(gomock.Any(), job.ChainUUID, job.Transaction.Hash).Return(job, nil)
 		pendingJobState.EXPECT().GetByTxHash(gomock.Any(), job.ChainUUID, job.Transaction.Hash).After(prevPendingCall).Return(nil, errors.NotFoundError(""))
 		retryJobUseCase.EXPECT().Execute(gomock.Any(), job, job.UUID, 0).Return(childJob.UUID, nil)
@@ -98,7 +117,12 @@ func TestRetryJobSession_Execute(t *testing.T) {
 		cStopErr := make(chan error, 1)
 
 		expectedErr := fmt.Errorf("failed to retry")
-		jobAPIClient.EXPECT().SearchJob(gomock.Any(), gomock.Any()).Return([]*api.JobResponse{}, nil)
+		gomock.InOrder(
+			jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Return(nil, errors.NotFoundError("")),
+			jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Return(&types.SentrySessionResponse{}, nil),
+		)
+		jobAPIClient.EXPECT().SearchJobPages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		jobAPIClient.EXPECT().CreateSentrySession(gomock.Any(), gomock.Any()).Return(&types.SentrySessionResponse{}, nil)
 		pendingJobState.EXPECT().GetByTxHash(gomock.Any(), job.ChainUUID, job.Transaction.Hash).Return(job, nil)
 		retryJobUseCase.EXPECT().Execute(gomock.Any(), job, job.UUID, 0).Return("", expectedErr)
 
@@ -116,4 +140,59 @@ func TestRetryJobSession_Execute(t *testing.T) {
 			assert.Error(t, err)
 		}
 	})
+
+	t.Run("should resume persisted session and stop once the job is no longer pending", func(t *testing.T) {
+		job := testdata.FakeJob()
+		childJob := testdata.FakeJob()
+		job.InternalData.RetryInterval = defaultRetryInterval
+		cStopErr := make(chan error, 1)
+
+		jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Return(&types.SentrySessionResponse{
+			JobUUID:          job.UUID,
+			Retries:          2,
+			NChildren:        1,
+			LastChildJobUUID: childJob.UUID,
+			NextAttemptAt:    time.Now(),
+		}, nil)
+		pendingJobState.EXPECT().GetByTxHash(gomock.Any(), job.ChainUUID, job.Transaction.Hash).Return(nil, errors.NotFoundError(""))
+		jobAPIClient.EXPECT().GetJob(gomock.Any(), job.UUID).Return(&types.JobResponse{Status: entities.StatusMined}, nil)
+
+		usecase := NewRetryJobSession(msgAPI, jobAPIClient, retryJobUseCase, pendingJobState, job, logger)
+		go func() {
+			err := usecase.Start(ctx)
+			cStopErr <- err
+		}()
+
+		select {
+		case <-time.Tick(extendedWaitingTime):
+			t.Error(errMsgExceedTime)
+		case err := <-cStopErr:
+			assert.NoError(t, err)
+		}
+	})
+
+	t.Run("should exit gracefully if session has been cancelled", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.InternalData.RetryInterval = defaultRetryInterval
+		cStopErr := make(chan error, 1)
+
+		jobAPIClient.EXPECT().GetSentrySession(gomock.Any(), job.UUID).Times(2).Return(nil, errors.NotFoundError(""))
+		jobAPIClient.EXPECT().SearchJobPages(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		jobAPIClient.EXPECT().CreateSentrySession(gomock.Any(), gomock.Any()).Return(&types.SentrySessionResponse{}, nil)
+		pendingJobState.EXPECT().GetByTxHash(gomock.Any(), job.ChainUUID, job.Transaction.Hash).Return(job, nil)
+
+		usecase := NewRetryJobSession(msgAPI, jobAPIClient, retryJobUseCase, pendingJobState, job, logger)
+		go func() {
+			err := usecase.Start(ctx)
+			cStopErr <- err
+		}()
+
+		time.Sleep(job.InternalData.RetryInterval + extendedWaitingTime)
+		select {
+		case <-time.Tick(extendedWaitingTime):
+			t.Error(errMsgExceedTime)
+		case err := <-cStopErr:
+			assert.NoError(t, err)
+		}
+	})
 }
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 
+	"github.com/consensys/orchestrate/src/entities"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
@@ -16,7 +17,12 @@ type ChainBlockEvents interface {
 	Execute(ctx context.Context, chainUUID string, blockNumber uint64) error
 }
 
+type ChainEventLogsBackfill interface {
+	Execute(ctx context.Context, backfill *entities.EventLogsBackfill) error
+}
+
 type ChainUseCases interface {
 	ChainBlockTxsUseCase() ChainBlockTxs
 	ChainBlockEventsUseCase() ChainBlockEvents
+	ChainEventLogsBackfillUseCase() ChainEventLogsBackfill
 }
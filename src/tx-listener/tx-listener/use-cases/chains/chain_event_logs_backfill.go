@@ -0,0 +1,99 @@
+package chains
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/orchestrate/pkg/sdk"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/infra/ethclient"
+	usecases "github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const chainEventLogsBackfillUseCaseComponent = "tx-listener.use-case.chain-event-logs-backfill"
+
+// backfillBlockRange is the number of blocks queried at once, nodes limiting the range of eth_getLogs
+const backfillBlockRange = 1000
+
+type chainEventLogsBackfillUC struct {
+	ethClient   ethclient.Client
+	proxyClient sdk.ChainProxyClient
+	messenger   sdk.MessengerAPI
+	logger      *log.Logger
+}
+
+func NewChainEventLogsBackfillUseCase(proxyClient sdk.ChainProxyClient,
+	ethClient ethclient.Client,
+	messenger sdk.MessengerAPI,
+	logger *log.Logger,
+) usecases.ChainEventLogsBackfill {
+	return &chainEventLogsBackfillUC{
+		proxyClient: proxyClient,
+		ethClient:   ethClient,
+		messenger:   messenger,
+		logger:      logger.SetComponent(chainEventLogsBackfillUseCaseComponent),
+	}
+}
+
+// Execute scans the block range by chunks and sends the event logs found to be indexed
+func (uc *chainEventLogsBackfillUC) Execute(ctx context.Context, backfill *entities.EventLogsBackfill) error {
+	logger := uc.logger.WithField("chain", backfill.ChainUUID).
+		WithField("from_block", backfill.FromBlock).
+		WithField("to_block", backfill.ToBlock)
+	logger.Debug("backfilling event logs")
+
+	proxyURL := uc.proxyClient.ChainProxyURL(backfill.ChainUUID)
+	for from := backfill.FromBlock; from <= backfill.ToBlock; from += backfillBlockRange {
+		to := from + backfillBlockRange - 1
+		if to > backfill.ToBlock {
+			to = backfill.ToBlock
+		}
+
+		eventLogs, err := uc.ethClient.FilterLogs(ctx, proxyURL, backfill.Addresses, new(big.Int).SetUint64(from),
+			new(big.Int).SetUint64(to))
+		if err != nil {
+			logger.WithError(err).WithField("block", from).Error("failed to query filtered logs")
+			return err
+		}
+
+		err = uc.sendEventLogs(ctx, backfill.ChainUUID, eventLogs)
+		if err != nil {
+			logger.WithError(err).WithField("block", from).Error("failed to send event logs")
+			return err
+		}
+	}
+
+	logger.Info("event logs backfilled successfully")
+	return nil
+}
+
+func (uc *chainEventLogsBackfillUC) sendEventLogs(ctx context.Context, chainUUID string, eventLogs []ethtypes.Log) error {
+	var addresses []ethcommon.Address
+	eventLogsPerAddress := make(map[ethcommon.Address][]ethtypes.Log)
+	for idx := range eventLogs {
+		addr := eventLogs[idx].Address
+		if _, ok := eventLogsPerAddress[addr]; !ok {
+			addresses = append(addresses, addr)
+		}
+		eventLogsPerAddress[addr] = append(eventLogsPerAddress[addr], eventLogs[idx])
+	}
+
+	for _, addr := range addresses {
+		err := uc.messenger.ContractEventLogsMessage(ctx, &types.EventLogsMessageRequest{
+			Address:   addr,
+			ChainUUID: chainUUID,
+			EventLogs: eventLogsPerAddress[addr],
+			Backfill:  true,
+		}, multitenancy.NewInternalAdminUser())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
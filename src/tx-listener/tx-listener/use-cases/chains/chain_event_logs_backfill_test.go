@@ -0,0 +1,80 @@
+// +build unit
+
+package chains
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	mock2 "github.com/consensys/orchestrate/src/infra/ethclient/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChainEventLogsBackfill_Execute(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	proxyClient := mock.NewMockChainProxyClient(ctrl)
+	ethClient := mock2.NewMockClient(ctrl)
+	messenger := mock.NewMockMessengerAPI(ctrl)
+	logger := log.NewLogger()
+
+	usecase := NewChainEventLogsBackfillUseCase(proxyClient, ethClient, messenger, logger)
+
+	chain := testdata.FakeChain()
+	proxyURL := "http://api/proxy/chains/" + chain.UUID
+	addrOne := ethcommon.HexToAddress("0x5Cc634233E4a454d47aACd9fC68801482Fb02610")
+	addrTwo := ethcommon.HexToAddress("0x1abae27a0cbfb02945720425d3b80c7e09728534")
+	backfill := &entities.EventLogsBackfill{
+		ChainUUID: chain.UUID,
+		Addresses: []ethcommon.Address{addrOne, addrTwo},
+		FromBlock: 500,
+		ToBlock:   1600,
+	}
+
+	proxyClient.EXPECT().ChainProxyURL(chain.UUID).Return(proxyURL).AnyTimes()
+
+	t.Run("should scan block range by chunks and send event logs per address", func(t *testing.T) {
+		logOne := ethtypes.Log{Address: addrOne, BlockNumber: 600}
+		logTwo := ethtypes.Log{Address: addrTwo, BlockNumber: 700}
+		logThree := ethtypes.Log{Address: addrOne, BlockNumber: 1550}
+
+		ethClient.EXPECT().FilterLogs(gomock.Any(), proxyURL, backfill.Addresses, big.NewInt(500), big.NewInt(1499)).
+			Return([]ethtypes.Log{logOne, logTwo}, nil)
+		ethClient.EXPECT().FilterLogs(gomock.Any(), proxyURL, backfill.Addresses, big.NewInt(1500), big.NewInt(1600)).
+			Return([]ethtypes.Log{logThree}, nil)
+		messenger.EXPECT().ContractEventLogsMessage(gomock.Any(), &types.EventLogsMessageRequest{
+			ChainUUID: chain.UUID, Address: addrOne, EventLogs: []ethtypes.Log{logOne}, Backfill: true,
+		}, gomock.Any()).Return(nil)
+		messenger.EXPECT().ContractEventLogsMessage(gomock.Any(), &types.EventLogsMessageRequest{
+			ChainUUID: chain.UUID, Address: addrTwo, EventLogs: []ethtypes.Log{logTwo}, Backfill: true,
+		}, gomock.Any()).Return(nil)
+		messenger.EXPECT().ContractEventLogsMessage(gomock.Any(), &types.EventLogsMessageRequest{
+			ChainUUID: chain.UUID, Address: addrOne, EventLogs: []ethtypes.Log{logThree}, Backfill: true,
+		}, gomock.Any()).Return(nil)
+
+		err := usecase.Execute(ctx, backfill)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with same error if logs cannot be queried", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		ethClient.EXPECT().FilterLogs(gomock.Any(), proxyURL, backfill.Addresses, gomock.Any(), gomock.Any()).Return(nil, expectedErr)
+
+		err := usecase.Execute(ctx, backfill)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
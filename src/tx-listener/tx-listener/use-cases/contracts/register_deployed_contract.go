@@ -5,15 +5,16 @@ import (
 	"math/big"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/ethereum/artifacts"
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	"github.com/consensys/orchestrate/src/tx-listener/store"
 	usecases "github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const registerDeployContractComponent = "tx-listener.use-case.tx-listener.register_deploy_contract"
@@ -67,9 +68,15 @@ func (uc *registerDeployedContractUseCase) Execute(ctx context.Context, job *ent
 		logger.WithError(err).Error("failed to get chain for contract registration")
 		return err
 	}
+	// Deployments are registered in the contract registry of the tenant who sent the deployment transaction
+	if multitenancy.UserInfoValue(ctx) != nil {
+		ctx = multitenancy.WithUserInfo(ctx, multitenancy.NewUserInfo(job.TenantID, job.OwnerID))
+	}
+
+	// Libraries embed their own address in their code, ignored by the codehash to match the one of their artifact
 	err = uc.client.SetContractAddressCodeHash(ctx, job.Receipt.ContractAddress, chain.ChainID.String(),
 		&api.SetContractCodeHashRequest{
-			CodeHash: crypto.Keccak256Hash(code).Bytes(),
+			CodeHash: artifacts.CodeHash(code, ethcommon.HexToAddress(job.Receipt.ContractAddress)).Bytes(),
 		})
 	if err != nil {
 		errMsg := "failed to register contract"
@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	testdata2 "github.com/consensys/orchestrate/pkg/types/ethereum/testdata"
 	"github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities/testdata"
@@ -54,6 +55,28 @@ func TestRegisterDeployedContract_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should register deployed contract in the registry of the tenant of the job", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.TenantID = "tenantOne"
+		job.OwnerID = "username"
+		job.Receipt = testdata2.FakeReceipt()
+		job.Receipt.ContractAddress = testdata.FakeAddress().String()
+		adminCtx := multitenancy.WithUserInfo(ctx, multitenancy.NewInternalAdminUser())
+
+		codeAt := []byte(testdata.FakeHash())
+		ethClient.EXPECT().CodeAt(gomock.Any(), proxyURL, ethcommon.HexToAddress(job.Receipt.ContractAddress), gomock.Any()).
+			Return(codeAt, nil)
+		chainState.EXPECT().Get(gomock.Any(), job.ChainUUID).Return(chain, nil)
+		apiClient.EXPECT().SetContractAddressCodeHash(gomock.Any(), job.Receipt.ContractAddress, chain.ChainID.String(), gomock.Any()).
+			DoAndReturn(func(rctx context.Context, _, _ string, _ *types.SetContractCodeHashRequest) error {
+				assert.Equal(t, multitenancy.NewUserInfo(job.TenantID, job.OwnerID), multitenancy.UserInfoValue(rctx))
+				return nil
+			})
+
+		err := usecase.Execute(adminCtx, job)
+		assert.NoError(t, err)
+	})
+
 	t.Run("should register private deployed contract successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
 		job.Receipt = testdata2.FakeReceipt()
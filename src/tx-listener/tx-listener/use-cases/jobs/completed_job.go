@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/tx-listener/store"
 	usecases "github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases"
@@ -28,7 +29,10 @@ func CompletedUseCase(pendingJobState store.PendingJob, messengerState store.Mes
 	return consumer
 }
 
-func (c *completedJob) Execute(ctx context.Context, job *entities.Job) error {
+func (c *completedJob) Execute(ctx context.Context, job *entities.Job) (err error) {
+	ctx, span := tracing.StartSpan(ctx, commitJobUseCaseComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	childrenUUIDs := c.pendingJobState.GetChildrenJobUUIDs(ctx, job.UUID)
 	for _, childrenUUID := range childrenUUIDs {
 		err := c.execute(ctx, childrenUUID)
@@ -7,6 +7,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/tx-listener/store"
@@ -33,12 +34,15 @@ func FailedJobUseCase(messengerCli sdk.MessengerAPI, commitMessage usecases.Comp
 }
 
 // Execute starts a job session
-func (uc *failedJobUseCase) Execute(ctx context.Context, job *entities.Job, errMsg string) error {
+func (uc *failedJobUseCase) Execute(ctx context.Context, job *entities.Job, errMsg string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, failedSessionJobComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	logger := uc.logger.WithField("job", job.UUID).WithField("reason", errMsg)
 	logger.Debug("failed job")
 
 	// Otherwise we failed on last job
-	err := uc.messenger.JobUpdateMessage(ctx, &types.JobUpdateMessageRequest{
+	err = uc.messenger.JobUpdateMessage(ctx, &types.JobUpdateMessageRequest{
 		JobUUID: job.UUID,
 		Status:  entities.StatusFailed,
 		Message: errMsg,
@@ -3,6 +3,7 @@ package jobs
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -11,6 +12,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/pkg/types/ethereum"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/api/service/types"
@@ -53,12 +55,15 @@ func MinedJobUseCase(messengerCli sdk.MessengerAPI,
 	}
 }
 
-func (uc *minedJobUC) Execute(ctx context.Context, job *entities.Job) error {
+func (uc *minedJobUC) Execute(ctx context.Context, job *entities.Job) (err error) {
+	ctx, span := tracing.StartSpan(ctx, minedJobUseCaseComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	logger := uc.logger.WithField("job", job.UUID).WithField("tx_hash", job.Transaction.Hash)
 	logger.Debug("updating job to mined")
 
 	// There is a racing issue between tx included in the block and receipt being available
-	err := backoff.RetryNotify(
+	err = backoff.RetryNotify(
 		func() error {
 			var err error
 			job.Receipt, err = uc.getTxReceipt(ctx, job, logger)
@@ -75,6 +80,33 @@ func (uc *minedJobUC) Execute(ctx context.Context, job *entities.Job) error {
 		return errors.DependencyFailureError(errMsg)
 	}
 
+	// Private receipts are attached to the job as a best effort, the node of the chain proxy possibly not being party
+	// of the private transaction
+	job.PrivateReceipt, err = uc.getPrivateTxReceipt(ctx, job)
+	if err != nil {
+		logger.WithError(err).Warn("failed to fetch private receipt")
+	}
+
+	// Contracts deployed through a CREATE2 factory are not reported by the receipt, only predicted on creation.
+	// The factory possibly not reverting when the deployment fails, the prediction is kept only if code was deployed
+	if job.InternalData != nil && job.InternalData.ContractAddress != nil && job.Receipt.Status == 1 {
+		var code []byte
+		code, err = uc.ethClient.CodeAt(ctx, uc.proxyClient.ChainProxyURL(job.ChainUUID), *job.InternalData.ContractAddress,
+			new(big.Int).SetUint64(job.Receipt.BlockNumber))
+		if err != nil {
+			errMsg := "failed to fetch code of contract deployed through CREATE2 factory"
+			logger.WithError(err).Error(errMsg)
+			return errors.DependencyFailureError(errMsg)
+		}
+
+		if len(code) > 0 {
+			job.Receipt.ContractAddress = job.InternalData.ContractAddress.Hex()
+		} else {
+			logger.WithField("contract_address", job.InternalData.ContractAddress.Hex()).
+				Warn("no contract deployed at address predicted for CREATE2 factory")
+		}
+	}
+
 	// If contract has been deployed
 	if job.Receipt.ContractAddress != "" && job.Receipt.ContractAddress != utils.ZeroAddressString {
 		err = uc.registerDeployedContract.Execute(ctx, job)
@@ -98,10 +130,11 @@ func (uc *minedJobUC) Execute(ctx context.Context, job *entities.Job) error {
 
 func (uc *minedJobUC) updateJobStatus(ctx context.Context, job *entities.Job, logger *log.Logger) error {
 	updateTxReq := &types.JobUpdateMessageRequest{
-		JobUUID: job.UUID,
-		Status:  entities.StatusMined,
-		Message: fmt.Sprintf("transaction mined in block %v", job.Receipt.BlockNumber),
-		Receipt: job.Receipt,
+		JobUUID:        job.UUID,
+		Status:         entities.StatusMined,
+		Message:        fmt.Sprintf("transaction mined in block %v", job.Receipt.BlockNumber),
+		Receipt:        job.Receipt,
+		PrivateReceipt: job.PrivateReceipt,
 	}
 
 	if job.Transaction.TransactionType == entities.DynamicFeeTxType {
@@ -141,6 +174,21 @@ func (uc *minedJobUC) getTxReceipt(ctx context.Context, job *entities.Job, logge
 	return receipt, nil
 }
 
+func (uc *minedJobUC) getPrivateTxReceipt(ctx context.Context, job *entities.Job) (*ethereum.Receipt, error) {
+	switch job.Type {
+	case entities.EEAMarkingTransaction:
+		// The receipt of EEA marking transactions is already merged with the private one, unless it was not available
+		if job.Receipt.PrivateFrom == "" {
+			return nil, errors.NotFoundError("private receipt not found")
+		}
+		return job.Receipt, nil
+	case entities.GoQuorumMarkingTransaction:
+		return uc.ethClient.QuorumPrivateTransactionReceipt(ctx, uc.proxyClient.ChainProxyURL(job.ChainUUID), *job.Transaction.Hash)
+	default:
+		return nil, nil
+	}
+}
+
 func (uc *minedJobUC) fetchReceipt(ctx context.Context, chainURL string, txHash ethcommon.Hash) (*ethereum.Receipt, error) {
 	receipt, err := uc.ethClient.TransactionReceipt(ctx, chainURL, txHash)
 	if err != nil {
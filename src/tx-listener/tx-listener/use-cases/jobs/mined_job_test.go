@@ -10,8 +10,11 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk/mock"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	testdata2 "github.com/consensys/orchestrate/pkg/types/ethereum/testdata"
+	"github.com/consensys/orchestrate/src/api/service/types"
 	testdata3 "github.com/consensys/orchestrate/src/api/service/types/testdata"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	mock2 "github.com/consensys/orchestrate/src/infra/ethclient/mock"
 	mocks3 "github.com/consensys/orchestrate/src/tx-listener/store/mocks"
@@ -62,6 +65,117 @@ func TestNotifyMinedJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("should register contract deployed through CREATE2 factory", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.ChainUUID = chain.UUID
+		contractAddress := testdata.FakeAddress()
+		job.InternalData.ContractAddress = contractAddress
+		receipt := testdata2.FakeReceipt()
+
+		ethClient.EXPECT().TransactionReceipt(gomock.Any(), proxyURL, *job.Transaction.Hash).Return(receipt, nil)
+		ethClient.EXPECT().CodeAt(gomock.Any(), proxyURL, *contractAddress, gomock.Any()).Return([]byte{0x60}, nil)
+
+		registerDeployedContract.EXPECT().Execute(gomock.Any(), job).Return(nil)
+
+		messengerAPI.EXPECT().JobUpdateMessage(gomock.Any(),
+			testdata3.MinedJobMessageRequestMatcher(job.UUID, receipt), gomock.Any()).
+			Return(nil)
+
+		completedJobUC.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, contractAddress.Hex(), job.Receipt.ContractAddress)
+	})
+
+	t.Run("should not register contract if CREATE2 factory deployed no code", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.ChainUUID = chain.UUID
+		contractAddress := testdata.FakeAddress()
+		job.InternalData.ContractAddress = contractAddress
+		receipt := testdata2.FakeReceipt()
+
+		ethClient.EXPECT().TransactionReceipt(gomock.Any(), proxyURL, *job.Transaction.Hash).Return(receipt, nil)
+		ethClient.EXPECT().CodeAt(gomock.Any(), proxyURL, *contractAddress, gomock.Any()).Return([]byte{}, nil)
+
+		messengerAPI.EXPECT().JobUpdateMessage(gomock.Any(),
+			testdata3.MinedJobMessageRequestMatcher(job.UUID, receipt), gomock.Any()).
+			Return(nil)
+
+		completedJobUC.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Empty(t, job.Receipt.ContractAddress)
+	})
+
+	t.Run("should attach private receipt to mined private job", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.ChainUUID = chain.UUID
+		job.Type = entities.GoQuorumMarkingTransaction
+		receipt := testdata2.FakeReceipt()
+		privateReceipt := testdata2.FakeReceipt()
+
+		ethClient.EXPECT().TransactionReceipt(gomock.Any(), proxyURL, *job.Transaction.Hash).Return(receipt, nil)
+		ethClient.EXPECT().QuorumPrivateTransactionReceipt(gomock.Any(), proxyURL, *job.Transaction.Hash).Return(privateReceipt, nil)
+
+		messengerAPI.EXPECT().JobUpdateMessage(gomock.Any(),
+			testdata3.MinedJobMessageRequestMatcher(job.UUID, receipt), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *types.JobUpdateMessageRequest, _ *multitenancy.UserInfo) error {
+				assert.Equal(t, privateReceipt, req.PrivateReceipt)
+				return nil
+			})
+
+		completedJobUC.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, privateReceipt, job.PrivateReceipt)
+	})
+
+	t.Run("should attach merged private receipt to mined EEA private job without fetching it again", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.ChainUUID = chain.UUID
+		job.Type = entities.EEAMarkingTransaction
+		receipt := testdata2.FakeReceipt()
+		receipt.PrivateFrom = "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="
+
+		ethClient.EXPECT().PrivateTransactionReceipt(gomock.Any(), proxyURL, *job.Transaction.Hash).Return(receipt, nil)
+
+		messengerAPI.EXPECT().JobUpdateMessage(gomock.Any(),
+			testdata3.MinedJobMessageRequestMatcher(job.UUID, receipt), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *types.JobUpdateMessageRequest, _ *multitenancy.UserInfo) error {
+				assert.Equal(t, receipt, req.PrivateReceipt)
+				return nil
+			})
+
+		completedJobUC.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, receipt, job.PrivateReceipt)
+	})
+
+	t.Run("should handle mined private job if private receipt cannot be fetched", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.ChainUUID = chain.UUID
+		job.Type = entities.GoQuorumMarkingTransaction
+		receipt := testdata2.FakeReceipt()
+
+		ethClient.EXPECT().TransactionReceipt(gomock.Any(), proxyURL, *job.Transaction.Hash).Return(receipt, nil)
+		ethClient.EXPECT().QuorumPrivateTransactionReceipt(gomock.Any(), proxyURL, *job.Transaction.Hash).Return(nil, expectedErr)
+
+		messengerAPI.EXPECT().JobUpdateMessage(gomock.Any(),
+			testdata3.MinedJobMessageRequestMatcher(job.UUID, receipt), gomock.Any()).
+			Return(nil)
+
+		completedJobUC.EXPECT().Execute(gomock.Any(), job).Return(nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Nil(t, job.PrivateReceipt)
+	})
+
 	t.Run("should fail to handle mined job if update status fails", func(t *testing.T) {
 		job := testdata.FakeJob()
 		job.ChainUUID = chain.UUID
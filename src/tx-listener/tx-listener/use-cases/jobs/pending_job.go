@@ -5,6 +5,7 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	"github.com/consensys/orchestrate/src/tx-listener/store"
@@ -39,7 +40,10 @@ func PendingJob(proxyClient sdk.ChainProxyClient,
 	}
 }
 
-func (uc *pendingJobMsg) Execute(ctx context.Context, job *entities.Job, msg *entities.Message) error {
+func (uc *pendingJobMsg) Execute(ctx context.Context, job *entities.Job, msg *entities.Message) (err error) {
+	ctx, span := tracing.StartSpan(ctx, pendingJobUseCaseComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	logger := uc.logger.WithField("job", job.UUID).
 		WithField("chain", job.ChainUUID).
 		WithField("txHash", job.Transaction.Hash.String()).
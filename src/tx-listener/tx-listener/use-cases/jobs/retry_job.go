@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	usecases "github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases"
@@ -29,7 +30,10 @@ func RetryJobUseCase(client sdk.OrchestrateClient, logger *log.Logger) usecases.
 }
 
 // Execute starts a job session
-func (uc *retryJobUseCase) Execute(ctx context.Context, job *entities.Job, childUUID string, nChildren int) (string, error) {
+func (uc *retryJobUseCase) Execute(ctx context.Context, job *entities.Job, childUUID string, nChildren int) (_ string, err error) {
+	ctx, span := tracing.StartSpan(ctx, retryJobUseCaseComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	logger := uc.logger.WithField("job", job.UUID).WithField("children", nChildren)
 	logger.Debug("retrying job")
 
@@ -48,7 +52,7 @@ func (uc *retryJobUseCase) Execute(ctx context.Context, job *entities.Job, child
 	}
 
 	// Otherwise we retry on last job
-	err := uc.client.ResendJobTx(ctx, childUUID)
+	err = uc.client.ResendJobTx(ctx, childUUID)
 	if err != nil {
 		logger.WithError(err).Error("failed to resend job")
 		return "", errors.FromError(err).ExtendComponent(retryJobUseCaseComponent)
@@ -132,14 +136,23 @@ func craftChildJobRequest(parentJob *entities.Job, gasPriceMultiplier float64) *
 		nextGasPriceF := new(big.Float).Mul(curGasPriceF, big.NewFloat(1+gasPriceMultiplier))
 		nextGasPrice := new(big.Int)
 		nextGasPriceF.Int(nextGasPrice)
+		capMaxFee(nextGasPrice, parentJob.InternalData.MaxFee)
 		newJobRequest.Transaction.GasPrice = (*hexutil.Big)(nextGasPrice)
 	case entities.DynamicFeeTxType:
 		curGasTipCapF := new(big.Float).SetInt(parentJob.Transaction.GasTipCap.ToInt())
 		nextGasTipCapF := new(big.Float).Mul(curGasTipCapF, big.NewFloat(1+gasPriceMultiplier))
 		nextGasTipCap := new(big.Int)
 		nextGasTipCapF.Int(nextGasTipCap)
+		capMaxFee(nextGasTipCap, parentJob.InternalData.MaxFee)
 		newJobRequest.Transaction.GasTipCap = (*hexutil.Big)(nextGasTipCap)
 	}
 
 	return newJobRequest
 }
+
+// capMaxFee bounds the bumped fee to the max fee of the chain
+func capMaxFee(fee, maxFee *big.Int) {
+	if maxFee != nil && fee.Cmp(maxFee) > 0 {
+		fee.Set(maxFee)
+	}
+}
@@ -4,6 +4,7 @@ package jobs
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/sdk/mock"
@@ -177,4 +178,28 @@ func TestCreateChildJob_Execute(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotEmpty(t, childJobUUID)
 	})
+
+	t.Run("should create a new child job by increasing the gasPrice and not exceed the max fee (legacyTx)", func(t *testing.T) {
+		parentJob := testdata.FakeJob()
+		childJob := testdata.FakeJob()
+		childJobResponse := apitestdata.FakeJobResponse()
+
+		parentJob.Transaction.Nonce = utils.ToPtr(uint64(1)).(*uint64)
+		parentJob.Transaction.TransactionType = entities.LegacyTxType
+		parentJob.Transaction.GasPrice = initialGasPrice
+		parentJob.InternalData.GasPriceIncrement = 0.06
+		parentJob.InternalData.GasPriceLimit = 0.12
+		parentJob.InternalData.MaxFee = big.NewInt(1030000000)
+
+		apiClient.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(timeoutCtx context.Context, req *types.CreateJobRequest) (*types.JobResponse, error) {
+				assert.Equal(t, "1030000000", req.Transaction.GasPrice.ToInt().String())
+				return childJobResponse, nil
+			})
+		apiClient.EXPECT().StartJob(gomock.Any(), childJobResponse.UUID).Return(nil)
+
+		childJobUUID, err := usecase.Execute(ctx, parentJob, childJob.UUID, 1)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, childJobUUID)
+	})
 }
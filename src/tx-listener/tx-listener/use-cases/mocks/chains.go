@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	entities "github.com/consensys/orchestrate/src/entities"
 	usecases "github.com/consensys/orchestrate/src/tx-listener/tx-listener/use-cases"
 	common "github.com/ethereum/go-ethereum/common"
 	gomock "github.com/golang/mock/gomock"
@@ -86,6 +87,43 @@ func (mr *MockChainBlockEventsMockRecorder) Execute(ctx, chainUUID, blockNumber
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockChainBlockEvents)(nil).Execute), ctx, chainUUID, blockNumber)
 }
 
+// MockChainEventLogsBackfill is a mock of ChainEventLogsBackfill interface
+type MockChainEventLogsBackfill struct {
+	ctrl     *gomock.Controller
+	recorder *MockChainEventLogsBackfillMockRecorder
+}
+
+// MockChainEventLogsBackfillMockRecorder is the mock recorder for MockChainEventLogsBackfill
+type MockChainEventLogsBackfillMockRecorder struct {
+	mock *MockChainEventLogsBackfill
+}
+
+// NewMockChainEventLogsBackfill creates a new mock instance
+func NewMockChainEventLogsBackfill(ctrl *gomock.Controller) *MockChainEventLogsBackfill {
+	mock := &MockChainEventLogsBackfill{ctrl: ctrl}
+	mock.recorder = &MockChainEventLogsBackfillMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChainEventLogsBackfill) EXPECT() *MockChainEventLogsBackfillMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method
+func (m *MockChainEventLogsBackfill) Execute(ctx context.Context, backfill *entities.EventLogsBackfill) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, backfill)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Execute indicates an expected call of Execute
+func (mr *MockChainEventLogsBackfillMockRecorder) Execute(ctx, backfill interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockChainEventLogsBackfill)(nil).Execute), ctx, backfill)
+}
+
 // MockChainUseCases is a mock of ChainUseCases interface
 type MockChainUseCases struct {
 	ctrl     *gomock.Controller
@@ -136,3 +174,17 @@ func (mr *MockChainUseCasesMockRecorder) ChainBlockEventsUseCase() *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainBlockEventsUseCase", reflect.TypeOf((*MockChainUseCases)(nil).ChainBlockEventsUseCase))
 }
+
+// ChainEventLogsBackfillUseCase mocks base method
+func (m *MockChainUseCases) ChainEventLogsBackfillUseCase() usecases.ChainEventLogsBackfill {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChainEventLogsBackfillUseCase")
+	ret0, _ := ret[0].(usecases.ChainEventLogsBackfill)
+	return ret0
+}
+
+// ChainEventLogsBackfillUseCase indicates an expected call of ChainEventLogsBackfillUseCase
+func (mr *MockChainUseCasesMockRecorder) ChainEventLogsBackfillUseCase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainEventLogsBackfillUseCase", reflect.TypeOf((*MockChainUseCases)(nil).ChainEventLogsBackfillUseCase))
+}
@@ -18,11 +18,15 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
+	"github.com/consensys/orchestrate/src/infra/postgres"
 	"github.com/consensys/orchestrate/src/infra/redis"
+	"github.com/consensys/orchestrate/src/infra/signer/web3signer"
 	"github.com/consensys/orchestrate/src/tx-sender/service"
 	"github.com/consensys/orchestrate/src/tx-sender/store/memory"
+	postgresnoncemngr "github.com/consensys/orchestrate/src/tx-sender/store/postgres"
 	redisnoncemngr "github.com/consensys/orchestrate/src/tx-sender/store/redis"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/builder"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/metrics"
 	keymanager "github.com/consensys/quorum-key-manager/pkg/client"
 	"github.com/hashicorp/go-multierror"
 )
@@ -35,6 +39,7 @@ type txSenderDaemon struct {
 	ec               ethclient.MultiClient
 	nonceManager     nonce.Manager
 	consumers        []messenger.Consumer
+	dispatcher       *service.JobDispatcher
 	config           *Config
 	logger           *log.Logger
 	cancel           context.CancelFunc
@@ -43,44 +48,58 @@ type txSenderDaemon struct {
 func NewTxSender(
 	config *Config,
 	keyManagerClient keymanager.KeyManagerClient,
+	accountClient sdk.AccountClient,
 	kafkaProducer kafka.Producer,
 	ec ethclient.MultiClient,
 	redisCli redis.Client,
+	postgresClient postgres.Client,
+	senderMetrics metrics.SenderMetrics,
 ) (*app.App, error) {
 	var nm nonce.Manager
-	if config.NonceManagerType == NonceManagerTypeInMemory {
+	switch config.NonceManagerType {
+	case NonceManagerTypeInMemory:
 		nm = manager.NewNonceManager(ec, memory.NewNonceSender(config.NonceManagerExpiration), memory.NewNonceRecoveryTracker(),
 			config.ProxyURL, config.NonceMaxRecovery)
-	} else if config.NonceManagerType == NonceManagerTypeRedis {
+	case NonceManagerTypeRedis:
 		nm = manager.NewNonceManager(ec, redisnoncemngr.NewNonceSender(redisCli, config.NonceManagerExpiration), redisnoncemngr.NewNonceRecoveryTracker(redisCli),
 			config.ProxyURL, config.NonceMaxRecovery)
+	case NonceManagerTypePostgres:
+		nm = manager.NewNonceManager(ec, postgresnoncemngr.NewNonceSender(postgresClient, config.NonceManagerExpiration), postgresnoncemngr.NewNonceRecoveryTracker(postgresClient),
+			config.ProxyURL, config.NonceMaxRecovery)
+	}
+
+	signerRouter, err := web3signer.NewRouter(keyManagerClient, config.Web3Signer)
+	if err != nil {
+		return nil, err
 	}
 
 	sdkMessengerCli := sdkMessenger.NewProducerClient(config.Messenger, kafkaProducer)
 	// Create business layer use cases
-	useCases := builder.NewUseCases(sdkMessengerCli, keyManagerClient, ec, nm, config.ProxyURL)
+	useCases := builder.NewUseCases(sdkMessengerCli, signerRouter, accountClient, ec, nm, config.ProxyURL)
 
-	jobRouter := service.NewJobHandler(useCases, sdkMessengerCli, config.BckOff)
-	consumers := make([]messenger.Consumer, config.Kafka.NConsumers)
-	for idx := 0; idx < config.Kafka.NConsumers; idx++ {
-		var err error
-		consumers[idx], err = service.NewMessageConsumer(config.Kafka, []string{config.ConsumerTopic}, jobRouter)
-		if err != nil {
-			return nil, err
-		}
+	dispatcher, err := service.NewJobDispatcher(config.Pipeline, senderMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	jobRouter := service.NewJobHandler(useCases, sdkMessengerCli, config.BckOff, dispatcher)
+	consumers, err := newMessageConsumers(config, jobRouter)
+	if err != nil {
+		return nil, err
 	}
 
 	txSenderDaemon := &txSenderDaemon{
 		keyManagerClient: keyManagerClient,
 		messengerAPI:     sdkMessengerCli,
 		consumers:        consumers,
+		dispatcher:       dispatcher,
 		config:           config,
 		ec:               ec,
 		nonceManager:     nm,
 		logger:           log.NewLogger().SetComponent(component),
 	}
 
-	appli, err := app.New(config.App, readinessOpt(kafkaProducer, redisCli, consumers[0]), app.MetricsOpt())
+	appli, err := app.New(config.App, readinessOpt(kafkaProducer, redisCli, postgresClient, consumers[0]), app.MetricsOpt(senderMetrics))
 	if err != nil {
 		return nil, err
 	}
@@ -136,16 +155,40 @@ func (d *txSenderDaemon) Close() error {
 		gerr = errors.CombineErrors(gerr, consumerGroup.Close())
 	}
 
+	d.dispatcher.Stop()
+
 	return gerr
 }
 
-func readinessOpt(producer kafka.Producer, redisCli redis.Client, consumer messenger.Consumer) app.Option {
+func newMessageConsumers(config *Config, jobRouter *service.JobHandler) ([]messenger.Consumer, error) {
+	if config.Broker != nil {
+		return []messenger.Consumer{
+			service.NewInMemoryMessageConsumer(config.Broker, []string{config.ConsumerTopic}, jobRouter),
+		}, nil
+	}
+
+	consumers := make([]messenger.Consumer, config.Kafka.NConsumers)
+	for idx := 0; idx < config.Kafka.NConsumers; idx++ {
+		var err error
+		consumers[idx], err = service.NewMessageConsumer(config.Kafka, []string{config.ConsumerTopic}, jobRouter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return consumers, nil
+}
+
+func readinessOpt(producer kafka.Producer, redisCli redis.Client, postgresClient postgres.Client, consumer messenger.Consumer) app.Option {
 	return func(ap *app.App) error {
 		ap.AddReadinessCheck("kafka.consumer", consumer.Checker)
 		ap.AddReadinessCheck("kafka.producer", producer.Checker)
 		if redisCli != nil {
 			ap.AddReadinessCheck("redis", redisCli.Ping)
 		}
+		if postgresClient != nil {
+			ap.AddReadinessCheck("database", func() error { return postgresClient.Exec("SELECT 1") })
+		}
 		return nil
 	}
 }
@@ -5,18 +5,24 @@ import (
 
 	"github.com/consensys/orchestrate/pkg/sdk/messenger"
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
 
 	quorumkeymanager "github.com/consensys/orchestrate/src/infra/quorum-key-manager/http"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	"github.com/consensys/orchestrate/src/infra/signer/web3signer"
 
+	"github.com/consensys/orchestrate/src/infra/postgres/gopg"
 	"github.com/consensys/orchestrate/src/infra/redis/redigo"
+	"github.com/consensys/orchestrate/src/tx-sender/service"
 
-	"github.com/cenkalti/backoff/v4"
+	"github.com/consensys/orchestrate/pkg/backoff"
 	"github.com/consensys/orchestrate/pkg/toolkit/app"
 )
 
 const (
 	NonceManagerTypeInMemory = "in-memory"
 	NonceManagerTypeRedis    = "redis"
+	NonceManagerTypePostgres = "postgres"
 )
 
 type Config struct {
@@ -30,6 +36,12 @@ type Config struct {
 	NonceManagerType       string
 	IsMultiTenancyEnabled  bool
 	RedisCfg               *redigo.Config
+	Postgres               *gopg.Config
 	NonceManagerExpiration time.Duration
 	QKM                    *quorumkeymanager.Config
+	Web3Signer             *web3signer.Config
+	Keystore               *keystore.Config
+	Pipeline               *service.PipelineConfig
+	// Broker replaces Kafka when set, to run all services within a single process
+	Broker *inmemory.Broker
 }
@@ -3,11 +3,17 @@ package txsender
 import (
 	"context"
 
+	kafkainfra "github.com/consensys/orchestrate/src/infra/kafka"
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
+	"github.com/consensys/orchestrate/src/infra/keystore"
+	keystorebuilder "github.com/consensys/orchestrate/src/infra/keystore/builder"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/consensys/orchestrate/src/infra/postgres/gopg"
 	qkmhttp "github.com/consensys/orchestrate/src/infra/quorum-key-manager/http"
 	nonclient "github.com/consensys/orchestrate/src/infra/quorum-key-manager/non-client"
 	"github.com/consensys/orchestrate/src/infra/redis"
 	"github.com/consensys/orchestrate/src/infra/redis/redigo"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/metrics"
 	"github.com/consensys/quorum-key-manager/pkg/client"
 
 	orchestrateClient "github.com/consensys/orchestrate/pkg/sdk/client"
@@ -23,12 +29,17 @@ func New(ctx context.Context, cfg *Config) (*app.App, error) {
 		return nil, err
 	}
 
-	qkmClient, err := getQKMClient(cfg)
+	postgresClient, err := getPostgresClient(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	kafkaProdClient, err := kafka.NewProducer(cfg.Kafka)
+	qkmClient, err := getQKMClient(cfg, postgresClient)
+	if err != nil {
+		return nil, err
+	}
+
+	kafkaProdClient, err := getProducer(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -39,12 +50,31 @@ func New(ctx context.Context, cfg *Config) (*app.App, error) {
 	return NewTxSender(
 		cfg,
 		qkmClient,
+		orchestrateClient.GlobalClient(),
 		kafkaProdClient,
 		ethclient.GlobalClient(),
 		redisClient,
+		postgresClient,
+		getSenderMetrics(cfg),
 	)
 }
 
+func getSenderMetrics(cfg *Config) metrics.SenderMetrics {
+	if cfg.App.Metrics.IsActive(metrics.ModuleName) {
+		return metrics.NewSenderMetrics()
+	}
+
+	return metrics.NewSenderNopMetrics()
+}
+
+func getProducer(cfg *Config) (kafkainfra.Producer, error) {
+	if cfg.Broker != nil {
+		return cfg.Broker, nil
+	}
+
+	return kafka.NewProducer(cfg.Kafka)
+}
+
 func getRedisClient(cfg *Config) (redis.Client, error) {
 	if cfg.NonceManagerType == NonceManagerTypeRedis {
 		return redigo.New(cfg.RedisCfg)
@@ -53,10 +83,26 @@ func getRedisClient(cfg *Config) (redis.Client, error) {
 	return nil, nil
 }
 
-func getQKMClient(cfg *Config) (client.KeyManagerClient, error) {
+func getPostgresClient(cfg *Config) (postgres.Client, error) {
+	if cfg.NonceManagerType == NonceManagerTypePostgres || isPostgresKeystore(cfg) {
+		return gopg.New("orchestrate.tx-sender", cfg.Postgres)
+	}
+
+	return nil, nil
+}
+
+func getQKMClient(cfg *Config, postgresClient postgres.Client) (client.KeyManagerClient, error) {
+	if cfg.Keystore != nil && cfg.Keystore.Type != "" {
+		return keystorebuilder.NewClient(cfg.Keystore, postgresClient)
+	}
+
 	if cfg.QKM.URL != "" {
 		return qkmhttp.New(cfg.QKM)
 	}
 
 	return nonclient.NewNonClient(), nil
 }
+
+func isPostgresKeystore(cfg *Config) bool {
+	return cfg.Keystore != nil && cfg.Keystore.Type == keystore.TypePostgres
+}
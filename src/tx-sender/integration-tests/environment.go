@@ -10,6 +10,7 @@ import (
 	"github.com/alicebob/miniredis"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/consensys/orchestrate/cmd/flags"
+	pkgbackoff "github.com/consensys/orchestrate/pkg/backoff"
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/sdk/messenger"
@@ -23,6 +24,7 @@ import (
 	txsender "github.com/consensys/orchestrate/src/tx-sender"
 	"github.com/consensys/orchestrate/src/tx-sender/store"
 	noncesender "github.com/consensys/orchestrate/src/tx-sender/store/redis"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/metrics"
 	"github.com/consensys/orchestrate/tests/pkg/docker"
 	"github.com/consensys/orchestrate/tests/pkg/docker/config"
 	kafkaDocker "github.com/consensys/orchestrate/tests/pkg/docker/container/kafka"
@@ -162,7 +164,7 @@ func (env *IntegrationEnvironment) Start(ctx context.Context) error {
 	}
 
 	// Create app
-	env.txSenderCfg.BckOff = testBackOff()
+	env.txSenderCfg.BckOff = pkgbackoff.ConstantBackOffWithMaxRetries(time.Second, maxRecoveryDefault)
 	env.txSender, err = newTxSender(env.txSenderCfg, env.redis, env.logger)
 	if err != nil {
 		env.logger.WithError(err).Error("could not initialize tx-sender")
@@ -249,7 +251,7 @@ func newTxSender(cfg *txsender.Config, redisCli redis.Client, logger log.Logger)
 
 	cfg.NonceMaxRecovery = maxRecoveryDefault
 
-	return txsender.NewTxSender(cfg, qkmClient, kafkaProd, ec, redisCli)
+	return txsender.NewTxSender(cfg, qkmClient, client.NewHTTPClient(httpClient, conf2), kafkaProd, ec, redisCli, nil, metrics.NewSenderNopMetrics())
 }
 
 func testBackOff() backoff.BackOff {
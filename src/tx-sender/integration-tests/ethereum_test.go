@@ -17,6 +17,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/toolkit/app/http"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/multitenancy"
 	"github.com/consensys/orchestrate/pkg/utils"
+	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	infra "github.com/consensys/orchestrate/src/infra/api"
@@ -62,6 +63,8 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
@@ -103,6 +106,8 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
@@ -161,6 +166,8 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
@@ -259,6 +266,8 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
@@ -295,6 +304,8 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusBadRequest)
@@ -326,6 +337,8 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
@@ -359,6 +372,8 @@ func (s *txSenderEthereumTestSuite) TestPublic() {
 		jobMsg := fakeMsgJob()
 
 		url := fmt.Sprintf("/stores/%s/ethereum/accounts/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).Post(url).Reply(http2.StatusUnauthorized)
 
 		wg.Go(func() error {
@@ -496,6 +511,8 @@ func (s *txSenderEthereumTestSuite) TestEEA() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-eea-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedRawTx)
@@ -598,6 +615,8 @@ func (s *txSenderEthereumTestSuite) TestTesseraMarking() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-quorum-private-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedTxRaw)
@@ -645,6 +664,8 @@ func (s *txSenderEthereumTestSuite) TestTesseraMarking() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-quorum-private-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedTxRaw)
@@ -736,6 +757,8 @@ func (s *txSenderEthereumTestSuite) TestTesseraMarking() {
 			},
 		})
 
+		mockAPIAccount(jobMsg.Transaction.From)
+
 		gock.New(keyManagerURL).
 			Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-quorum-private-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 			Reply(http2.StatusOK).BodyString(signedTxRaw)
@@ -849,6 +872,8 @@ func (s *txSenderEthereumTestSuite) TestXNonceManager() {
 				},
 			})
 
+			mockAPIAccount(jobMsg.Transaction.From)
+
 			gock.New(keyManagerURL).
 				Post(fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", qkmStoreName, jobMsg.Transaction.From.String())).
 				Reply(http2.StatusOK).BodyString(signedRawTx)
@@ -1171,6 +1196,15 @@ func (s *txSenderEthereumTestSuite) sendJobMessage(job *entities.Job) error {
 	return nil
 }
 
+func mockAPIAccount(address *ethcommon.Address) {
+	gock.New(apiURL).
+		Get(fmt.Sprintf("/accounts/%s", address.String())).
+		Reply(http2.StatusOK).JSON(&api.AccountResponse{
+		Address: address.String(),
+		Status:  string(entities.AccountStatusActive),
+	})
+}
+
 func waitTimeout(wg *multierror.Group, duration time.Duration) error {
 	c := make(chan bool, 1)
 	var err error
@@ -2,20 +2,33 @@ package service
 
 import (
 	kafka "github.com/consensys/orchestrate/src/infra/kafka/sarama"
-	messenger "github.com/consensys/orchestrate/src/infra/messenger/kafka"
+	"github.com/consensys/orchestrate/src/infra/messenger"
+	inmemory "github.com/consensys/orchestrate/src/infra/messenger/in-memory"
+	messengerkafka "github.com/consensys/orchestrate/src/infra/messenger/kafka"
 )
 
 const (
 	messageListenerComponent = "service.kafka-consumer"
 )
 
-func NewMessageConsumer(cfg *kafka.Config, topics []string, jobHandler *JobHandler) (*messenger.Consumer, error) {
-	consumer, err := messenger.NewMessageConsumer(messageListenerComponent, cfg, topics)
+func NewMessageConsumer(cfg *kafka.Config, topics []string, jobHandler *JobHandler) (*messengerkafka.Consumer, error) {
+	consumer, err := messengerkafka.NewMessageConsumer(messageListenerComponent, cfg, topics)
 	if err != nil {
 		return nil, err
 	}
 
-	consumer.AppendHandler(StartedJobMessageType, jobHandler.HandleStartedJob)
+	appendHandlers(consumer, jobHandler)
 
 	return consumer, nil
 }
+
+func NewInMemoryMessageConsumer(broker *inmemory.Broker, topics []string, jobHandler *JobHandler) *inmemory.Consumer {
+	consumer := inmemory.NewMessageConsumer(messageListenerComponent, broker, topics)
+	appendHandlers(consumer, jobHandler)
+
+	return consumer
+}
+
+func appendHandlers(consumer messenger.Consumer, jobHandler *JobHandler) {
+	consumer.AppendHandler(StartedJobMessageType, jobHandler.HandleStartedJob)
+}
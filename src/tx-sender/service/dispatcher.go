@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/workerpool"
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/metrics"
+)
+
+const (
+	dispatcherComponent = "service.job-dispatcher"
+	defaultQueueSize    = 100
+)
+
+// PipelineConfig bounds the processing of the jobs of every chain
+type PipelineConfig struct {
+	// Concurrency is the maximum number of jobs of a chain processed concurrently
+	Concurrency int
+	// Timeout is the maximum duration of the processing of a job, unlimited if zero
+	Timeout time.Duration
+	// QueueSize is the maximum number of jobs of a chain queued or being processed, dispatching blocking once reached
+	QueueSize int
+	// Chains overrides the concurrency and the timeout of some chains, formatted as <chainUUID>:<concurrency>[:<timeout>]
+	Chains []string
+}
+
+type chainPipelineConfig struct {
+	concurrency int
+	timeout     time.Duration
+}
+
+// JobProcessor processes a job, the timeout being the maximum duration of its processing
+type JobProcessor func(ctx context.Context, job *entities.Job, timeout time.Duration) error
+
+// JobDispatcher processes jobs on bounded worker pools, one per chain.
+// Jobs of the same account are processed in the order they are dispatched and
+// consumed messages are committed once all previous messages of the partition are processed
+type JobDispatcher struct {
+	defaultCfg *chainPipelineConfig
+	chainCfgs  map[string]*chainPipelineConfig
+	chains     map[string]*chainPipeline
+	queueSize  int
+	offsets    *offsetTracker
+	metrics    metrics.SenderMetrics
+	stopped    bool
+	stop       chan struct{}
+	mux        *sync.Mutex
+	logger     *log.Logger
+}
+
+type chainPipeline struct {
+	uuid     string
+	cfg      *chainPipelineConfig
+	pool     *workerpool.WorkerPool
+	accounts map[string]*workerpool.Deque
+	slots    chan struct{}
+	depth    int
+}
+
+type dispatchedJob struct {
+	ctx        context.Context
+	job        *entities.Job
+	msg        *trackedMessage
+	process    JobProcessor
+	receivedAt time.Time
+}
+
+func NewJobDispatcher(cfg *PipelineConfig, senderMetrics metrics.SenderMetrics) (*JobDispatcher, error) {
+	chainCfgs := make(map[string]*chainPipelineConfig)
+	for _, chainCfg := range cfg.Chains {
+		chainUUID, pipelineCfg, err := parseChainPipelineConfig(chainCfg, cfg.Timeout)
+		if err != nil {
+			return nil, errors.FromError(err).ExtendComponent(dispatcherComponent)
+		}
+
+		chainCfgs[chainUUID] = pipelineCfg
+	}
+
+	queueSize := cfg.QueueSize
+	if queueSize == 0 {
+		queueSize = defaultQueueSize
+	}
+	if queueSize < 0 {
+		return nil, errors.InvalidParameterError("invalid queue size %d, expected a positive integer", queueSize).
+			ExtendComponent(dispatcherComponent)
+	}
+
+	return &JobDispatcher{
+		defaultCfg: &chainPipelineConfig{concurrency: cfg.Concurrency, timeout: cfg.Timeout},
+		chainCfgs:  chainCfgs,
+		chains:     make(map[string]*chainPipeline),
+		queueSize:  queueSize,
+		offsets:    newOffsetTracker(),
+		metrics:    senderMetrics,
+		stop:       make(chan struct{}),
+		mux:        &sync.Mutex{},
+		logger:     log.NewLogger().SetComponent(dispatcherComponent),
+	}, nil
+}
+
+// Dispatch queues the job of the message behind the pending jobs of the same account.
+// It blocks while the queue of the chain is full so that consumption slows down to the processing rate
+func (d *JobDispatcher) Dispatch(ctx context.Context, msg *entities.Message, job *entities.Job, process JobProcessor) {
+	d.mux.Lock()
+	// Message is not committed so that the job is processed again by the next consumer session
+	if d.stopped {
+		d.mux.Unlock()
+		return
+	}
+	chain := d.chain(job.ChainUUID)
+	d.mux.Unlock()
+
+	select {
+	case chain.slots <- struct{}{}:
+	case <-ctx.Done():
+		return
+	case <-d.stop:
+		return
+	}
+
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if d.stopped {
+		<-chain.slots
+		return
+	}
+
+	dJob := &dispatchedJob{
+		ctx:        ctx,
+		job:        job,
+		msg:        d.offsets.track(msg),
+		process:    process,
+		receivedAt: time.Now(),
+	}
+
+	// Jobs without partition key, such as raw transactions, do not need to be ordered
+	accountKey := job.PartitionKey()
+	if accountKey == "" {
+		accountKey = job.UUID
+	}
+
+	queue, ok := chain.accounts[accountKey]
+	if !ok {
+		queue = &workerpool.Deque{}
+		chain.accounts[accountKey] = queue
+		chain.pool.Submit(func() {
+			d.processAccountQueue(chain, accountKey, queue)
+		})
+	}
+	queue.PushBack(dJob)
+
+	chain.depth++
+	d.metrics.JobsQueueDepth().With("chain_uuid", chain.uuid).Set(float64(chain.depth))
+}
+
+// Stop stops the worker pools, waiting for the jobs being processed
+func (d *JobDispatcher) Stop() {
+	d.mux.Lock()
+	if !d.stopped {
+		d.stopped = true
+		close(d.stop)
+	}
+	pools := make([]*workerpool.WorkerPool, 0, len(d.chains))
+	for _, chain := range d.chains {
+		pools = append(pools, chain.pool)
+	}
+	d.mux.Unlock()
+
+	for _, pool := range pools {
+		pool.Stop()
+	}
+}
+
+func (d *JobDispatcher) chain(chainUUID string) *chainPipeline {
+	chain, ok := d.chains[chainUUID]
+	if ok {
+		return chain
+	}
+
+	cfg, ok := d.chainCfgs[chainUUID]
+	if !ok {
+		cfg = d.defaultCfg
+	}
+
+	chain = &chainPipeline{
+		uuid:     chainUUID,
+		cfg:      cfg,
+		pool:     workerpool.New(cfg.concurrency),
+		accounts: make(map[string]*workerpool.Deque),
+		slots:    make(chan struct{}, d.queueSize),
+	}
+	d.chains[chainUUID] = chain
+
+	return chain
+}
+
+// processAccountQueue processes the jobs of an account sequentially until its queue is empty
+func (d *JobDispatcher) processAccountQueue(chain *chainPipeline, accountKey string, queue *workerpool.Deque) {
+	for {
+		d.mux.Lock()
+		if queue.Len() == 0 {
+			delete(chain.accounts, accountKey)
+			d.mux.Unlock()
+			return
+		}
+		dJob := queue.Front().(*dispatchedJob)
+		d.mux.Unlock()
+
+		d.processJob(chain, dJob)
+
+		d.mux.Lock()
+		queue.PopFront()
+		<-chain.slots
+		chain.depth--
+		d.metrics.JobsQueueDepth().With("chain_uuid", chain.uuid).Set(float64(chain.depth))
+		d.mux.Unlock()
+	}
+}
+
+func (d *JobDispatcher) processJob(chain *chainPipeline, dJob *dispatchedJob) {
+	logger := d.logger.WithField("job", dJob.job.UUID).WithField("chain", chain.uuid)
+
+	// Messages of a consumer session which has been closed are consumed again by the next session
+	if dJob.ctx.Err() != nil {
+		logger.WithError(dJob.ctx.Err()).Debug("job skipped, consumer session is closed")
+		return
+	}
+
+	err := dJob.process(dJob.ctx, dJob.job, chain.cfg.timeout)
+	d.metrics.JobProcessingLatency().With("chain_uuid", chain.uuid).Observe(time.Since(dJob.receivedAt).Seconds())
+	if err != nil {
+		// Message is not committed so that the job is processed again by the next consumer session
+		if dJob.ctx.Err() != nil {
+			logger.WithError(err).Warn("job interrupted, consumer session is closed")
+			return
+		}
+
+		// Job has been moved to a final status, it must not block the messages following it
+		logger.WithError(err).Error("failed to process job")
+	}
+
+	d.mux.Lock()
+	err = d.offsets.done(dJob.msg)
+	d.mux.Unlock()
+	if err != nil {
+		logger.WithError(err).Error("failed to commit job message")
+	}
+}
+
+func parseChainPipelineConfig(s string, defaultTimeout time.Duration) (string, *chainPipelineConfig, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+		return "", nil, errors.InvalidParameterError("invalid chain pipeline %q, expected <chainUUID>:<concurrency>[:<timeout>]", s)
+	}
+
+	concurrency, err := strconv.Atoi(parts[1])
+	if err != nil || concurrency < 1 {
+		return "", nil, errors.InvalidParameterError("invalid concurrency of chain pipeline %q, expected a positive integer", s)
+	}
+
+	cfg := &chainPipelineConfig{concurrency: concurrency, timeout: defaultTimeout}
+	if len(parts) == 3 {
+		cfg.timeout, err = time.ParseDuration(parts[2])
+		if err != nil {
+			return "", nil, errors.InvalidParameterError("invalid timeout of chain pipeline %q, expected a duration", s)
+		}
+	}
+
+	return parts[0], cfg, nil
+}
@@ -0,0 +1,353 @@
+// +build unit
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/src/entities"
+	"github.com/consensys/orchestrate/src/entities/testdata"
+	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = 2 * time.Second
+
+type committedOffsets struct {
+	offsets []int64
+	mux     sync.Mutex
+}
+
+func (c *committedOffsets) message(offset int64) *entities.Message {
+	return &entities.Message{
+		Offset: offset,
+		Commit: func() error {
+			c.mux.Lock()
+			defer c.mux.Unlock()
+			c.offsets = append(c.offsets, offset)
+			return nil
+		},
+	}
+}
+
+func (c *committedOffsets) get() []int64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return append([]int64{}, c.offsets...)
+}
+
+func TestJobDispatcher(t *testing.T) {
+	t.Run("should process jobs of the same account in order", func(t *testing.T) {
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		from := testdata.FakeAddress()
+		chainUUID := testdata.FakeJob().ChainUUID
+
+		var mux sync.Mutex
+		var processed []string
+		wg := &sync.WaitGroup{}
+		var jobUUIDs []string
+		for i := 0; i < 10; i++ {
+			job := testdata.FakeJob()
+			job.ChainUUID = chainUUID
+			job.Transaction.From = from
+			jobUUIDs = append(jobUUIDs, job.UUID)
+
+			wg.Add(1)
+			dispatcher.Dispatch(context.Background(), commits.message(int64(i)), job, func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+				defer wg.Done()
+				time.Sleep(time.Millisecond)
+				mux.Lock()
+				processed = append(processed, job.UUID)
+				mux.Unlock()
+				return nil
+			})
+		}
+
+		waitGroup(t, wg)
+		assert.Equal(t, jobUUIDs, processed)
+		assert.Eventually(t, func() bool {
+			c := commits.get()
+			return len(c) > 0 && c[len(c)-1] == 9
+		}, waitTimeout, time.Millisecond)
+	})
+
+	t.Run("should bound the number of jobs of a chain processed concurrently", func(t *testing.T) {
+		chainUUID := testdata.FakeJob().ChainUUID
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5, Chains: []string{chainUUID + ":2"}}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		var mux sync.Mutex
+		running, maxRunning := 0, 0
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 6; i++ {
+			job := testdata.FakeJob()
+			job.ChainUUID = chainUUID
+
+			wg.Add(1)
+			dispatcher.Dispatch(context.Background(), commits.message(int64(i)), job, func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+				defer wg.Done()
+				mux.Lock()
+				running++
+				if running > maxRunning {
+					maxRunning = running
+				}
+				mux.Unlock()
+
+				time.Sleep(20 * time.Millisecond)
+
+				mux.Lock()
+				running--
+				mux.Unlock()
+				return nil
+			})
+		}
+
+		waitGroup(t, wg)
+		assert.Equal(t, 2, maxRunning)
+	})
+
+	t.Run("should not block jobs of other chains", func(t *testing.T) {
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 1}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		blocked := make(chan struct{})
+		defer close(blocked)
+		dispatcher.Dispatch(context.Background(), commits.message(0), testdata.FakeJob(), func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+			<-blocked
+			return nil
+		})
+
+		done := make(chan struct{})
+		dispatcher.Dispatch(context.Background(), commits.message(1), testdata.FakeJob(), func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+			close(done)
+			return nil
+		})
+
+		select {
+		case <-done:
+		case <-time.After(waitTimeout):
+			t.Error("job of another chain was not processed")
+		}
+	})
+
+	t.Run("should commit messages once all previous messages are processed", func(t *testing.T) {
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		chainUUID := testdata.FakeJob().ChainUUID
+		blocked := make(chan struct{})
+		wg := &sync.WaitGroup{}
+		for i := 0; i < 3; i++ {
+			job := testdata.FakeJob()
+			job.ChainUUID = chainUUID
+
+			wg.Add(1)
+			first := i == 0
+			dispatcher.Dispatch(context.Background(), commits.message(int64(i)), job, func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+				defer wg.Done()
+				if first {
+					<-blocked
+				}
+				return nil
+			})
+		}
+
+		time.Sleep(50 * time.Millisecond)
+		assert.Empty(t, commits.get())
+
+		close(blocked)
+		waitGroup(t, wg)
+		assert.Eventually(t, func() bool {
+			c := commits.get()
+			return len(c) == 1 && c[0] == 2
+		}, waitTimeout, time.Millisecond)
+	})
+
+	t.Run("should commit message if job processing fails", func(t *testing.T) {
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		dispatcher.Dispatch(context.Background(), commits.message(0), testdata.FakeJob(), func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+			return fmt.Errorf("error")
+		})
+
+		assert.Eventually(t, func() bool {
+			c := commits.get()
+			return len(c) == 1 && c[0] == 0
+		}, waitTimeout, time.Millisecond)
+	})
+
+	t.Run("should not commit message if consumer session is closed during processing", func(t *testing.T) {
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		ctx, cancel := context.WithCancel(context.Background())
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+		dispatcher.Dispatch(ctx, commits.message(0), testdata.FakeJob(), func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+			defer wg.Done()
+			cancel()
+			return ctx.Err()
+		})
+
+		waitGroup(t, wg)
+		time.Sleep(20 * time.Millisecond)
+		assert.Empty(t, commits.get())
+	})
+
+	t.Run("should block dispatch while the queue of the chain is full", func(t *testing.T) {
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5, QueueSize: 2}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		chainUUID := testdata.FakeJob().ChainUUID
+		blocked := make(chan struct{})
+		for i := 0; i < 2; i++ {
+			job := testdata.FakeJob()
+			job.ChainUUID = chainUUID
+			dispatcher.Dispatch(context.Background(), commits.message(int64(i)), job, func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+				<-blocked
+				return nil
+			})
+		}
+
+		dispatched := make(chan struct{})
+		go func() {
+			job := testdata.FakeJob()
+			job.ChainUUID = chainUUID
+			dispatcher.Dispatch(context.Background(), commits.message(2), job, func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+				return nil
+			})
+			close(dispatched)
+		}()
+
+		select {
+		case <-dispatched:
+			t.Fatal("dispatch should block while the queue is full")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(blocked)
+		select {
+		case <-dispatched:
+		case <-time.After(waitTimeout):
+			t.Fatal("dispatch was not unblocked")
+		}
+	})
+
+	t.Run("should unblock dispatch when consumer session is closed", func(t *testing.T) {
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5, QueueSize: 1}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		chainUUID := testdata.FakeJob().ChainUUID
+		blocked := make(chan struct{})
+		defer close(blocked)
+		job := testdata.FakeJob()
+		job.ChainUUID = chainUUID
+		dispatcher.Dispatch(context.Background(), commits.message(0), job, func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+			<-blocked
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		dispatched := make(chan struct{})
+		go func() {
+			job := testdata.FakeJob()
+			job.ChainUUID = chainUUID
+			dispatcher.Dispatch(ctx, commits.message(1), job, func(ctx context.Context, job *entities.Job, _ time.Duration) error {
+				return nil
+			})
+			close(dispatched)
+		}()
+
+		cancel()
+		select {
+		case <-dispatched:
+		case <-time.After(waitTimeout):
+			t.Fatal("dispatch was not unblocked")
+		}
+	})
+
+	t.Run("should process jobs with the timeout of their chain", func(t *testing.T) {
+		chainUUID := testdata.FakeJob().ChainUUID
+		dispatcher, err := NewJobDispatcher(&PipelineConfig{
+			Concurrency: 5,
+			Timeout:     time.Minute,
+			Chains:      []string{chainUUID + ":2:30s"},
+		}, metrics.NewSenderNopMetrics())
+		require.NoError(t, err)
+		defer dispatcher.Stop()
+
+		commits := &committedOffsets{}
+		timeouts := make(chan time.Duration, 2)
+		job := testdata.FakeJob()
+		job.ChainUUID = chainUUID
+		dispatcher.Dispatch(context.Background(), commits.message(0), job, func(ctx context.Context, job *entities.Job, timeout time.Duration) error {
+			timeouts <- timeout
+			return nil
+		})
+		dispatcher.Dispatch(context.Background(), commits.message(1), testdata.FakeJob(), func(ctx context.Context, job *entities.Job, timeout time.Duration) error {
+			timeouts <- timeout
+			return nil
+		})
+
+		var received []time.Duration
+		for i := 0; i < 2; i++ {
+			select {
+			case timeout := <-timeouts:
+				received = append(received, timeout)
+			case <-time.After(waitTimeout):
+				t.Fatal("job was not processed")
+			}
+		}
+		assert.ElementsMatch(t, []time.Duration{30 * time.Second, time.Minute}, received)
+	})
+
+	t.Run("should fail with invalid chain pipelines", func(t *testing.T) {
+		for _, chainPipeline := range []string{"chainUUID", "chainUUID:0", "chainUUID:two", "chainUUID:2:soon", ":2", "chainUUID:2:30s:1"} {
+			_, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5, Chains: []string{chainPipeline}}, metrics.NewSenderNopMetrics())
+			assert.Error(t, err, chainPipeline)
+		}
+	})
+
+	t.Run("should fail with invalid queue size", func(t *testing.T) {
+		_, err := NewJobDispatcher(&PipelineConfig{Concurrency: 5, QueueSize: -1}, metrics.NewSenderNopMetrics())
+		assert.Error(t, err)
+	})
+}
+
+func waitGroup(t *testing.T, wg *sync.WaitGroup) {
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(waitTimeout):
+		t.Fatal("jobs were not processed")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	pkgbackoff "github.com/consensys/orchestrate/pkg/backoff"
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
 	"github.com/consensys/orchestrate/src/entities"
@@ -20,16 +21,18 @@ var StartedJobMessageType entities.RequestMessageType = "started-job"
 
 type JobHandler struct {
 	useCases     usecases.UseCases
-	retryBackOff backoff.BackOff
+	retryBackOff pkgbackoff.BackOff
 	messengerAPI sdk.MessengerAPI
+	dispatcher   *JobDispatcher
 	logger       *log.Logger
 }
 
-func NewJobHandler(useCases usecases.UseCases, messengerAPI sdk.MessengerAPI, bck backoff.BackOff) *JobHandler {
+func NewJobHandler(useCases usecases.UseCases, messengerAPI sdk.MessengerAPI, bck pkgbackoff.BackOff, dispatcher *JobDispatcher) *JobHandler {
 	return &JobHandler{
 		useCases:     useCases,
 		retryBackOff: bck,
 		messengerAPI: messengerAPI,
+		dispatcher:   dispatcher,
 		logger:       log.NewLogger().SetComponent(messageListenerComponent),
 	}
 }
@@ -42,14 +45,29 @@ func (mch *JobHandler) HandleStartedJob(ctx context.Context, msg *entities.Messa
 		return errors.InvalidFormatError("invalid start job request type")
 	}
 
-	logger := mch.logger.WithField("job", req.Job.UUID).WithField("schedule", req.Job.ScheduleUUID)
-	err = backoff.RetryNotify(
+	mch.dispatcher.Dispatch(ctx, msg, req.Job, mch.processJob)
+	return nil
+}
+
+func (mch *JobHandler) processJob(ctx context.Context, job *entities.Job, timeout time.Duration) error {
+	// Status updates use the parent context so that jobs which time out can be flagged as failed
+	execCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		execCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	logger := mch.logger.WithField("job", job.UUID).WithField("schedule", job.ScheduleUUID)
+	err := backoff.RetryNotify(
 		func() error {
-			err = mch.executeSendJob(ctx, req.Job)
+			err := mch.executeSendJob(execCtx, job)
 			switch {
 			// Exits if not errors
 			case err == nil:
 				return nil
+			case execCtx.Err() != nil && ctx.Err() == nil:
+				return backoff.Permanent(errors.InvalidStateError("job processing exceeded timeout of %s", timeout))
 			case err == context.DeadlineExceeded || err == context.Canceled:
 				return backoff.Permanent(err)
 			case ctx.Err() != nil:
@@ -60,22 +78,22 @@ func (mch *JobHandler) HandleStartedJob(ctx context.Context, msg *entities.Messa
 
 			var serr error
 			switch {
-			case req.Job.InternalData.ParentJobUUID != "":
-				if req.Job.InternalData.ParentJobUUID == req.Job.UUID {
+			case job.InternalData.ParentJobUUID != "":
+				if job.InternalData.ParentJobUUID == job.UUID {
 					logger.WithError(err).Warn("ignoring errors on resending jobs...")
 					return nil
 				}
-				serr = utils.UpdateJobStatus(ctx, mch.messengerAPI, req.Job,
+				serr = utils.UpdateJobStatus(ctx, mch.messengerAPI, job,
 					entities.StatusFailed, err.Error(), nil)
 			// Retry over same message
 			case errors.IsKnownTransactionError(err) || errors.IsNonceTooLowError(err):
 				return err
 			case errors.IsInvalidNonceWarning(err):
-				resetJobTx(req.Job)
-				serr = utils.UpdateJobStatus(ctx, mch.messengerAPI, req.Job,
+				resetJobTx(job)
+				serr = utils.UpdateJobStatus(ctx, mch.messengerAPI, job,
 					entities.StatusRecovering, err.Error(), nil)
 			default:
-				serr = utils.UpdateJobStatus(ctx, mch.messengerAPI, req.Job,
+				serr = utils.UpdateJobStatus(ctx, mch.messengerAPI, job,
 					entities.StatusFailed, err.Error(), nil)
 			}
 
@@ -90,14 +108,14 @@ func (mch *JobHandler) HandleStartedJob(ctx context.Context, msg *entities.Messa
 				return nil
 			}
 		},
-		mch.retryBackOff,
+		mch.retryBackOff.NewBackOff(),
 		func(err error, duration time.Duration) {
 			logger.WithError(err).Warnf("error processing job, retrying in %v...", duration)
 		},
 	)
 
 	if err != nil {
-		serr := utils.UpdateJobStatus(ctx, mch.messengerAPI, req.Job, entities.StatusFailed, err.Error(), nil)
+		serr := utils.UpdateJobStatus(ctx, mch.messengerAPI, job, entities.StatusFailed, err.Error(), nil)
 		if serr != nil {
 			return serr
 		}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"github.com/consensys/orchestrate/pkg/toolkit/workerpool"
+	"github.com/consensys/orchestrate/src/entities"
+)
+
+type trackedMessage struct {
+	msg  *entities.Message
+	done bool
+}
+
+// offsetTracker commits the messages of a partition in order, once they and all the previous ones are processed
+type offsetTracker struct {
+	partitions map[int32]*workerpool.Deque
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{
+		partitions: make(map[int32]*workerpool.Deque),
+	}
+}
+
+func (t *offsetTracker) track(msg *entities.Message) *trackedMessage {
+	pending, ok := t.partitions[msg.Partition]
+	if !ok {
+		pending = &workerpool.Deque{}
+		t.partitions[msg.Partition] = pending
+	}
+
+	// Messages are consumed again from the last committed offset when a new consumer session starts
+	if pending.Len() > 0 && msg.Offset <= pending.Back().(*trackedMessage).msg.Offset {
+		pending.Clear()
+	}
+
+	tMsg := &trackedMessage{msg: msg}
+	pending.PushBack(tMsg)
+
+	return tMsg
+}
+
+func (t *offsetTracker) done(tMsg *trackedMessage) error {
+	tMsg.done = true
+
+	pending := t.partitions[tMsg.msg.Partition]
+	var lastDone *trackedMessage
+	for pending.Len() > 0 && pending.Front().(*trackedMessage).done {
+		lastDone = pending.PopFront().(*trackedMessage)
+	}
+
+	if lastDone == nil {
+		return nil
+	}
+
+	return lastDone.msg.Commit()
+}
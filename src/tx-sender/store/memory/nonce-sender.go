@@ -1,93 +1,155 @@
 package memory
 
 import (
-	"reflect"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/consensys/orchestrate/src/tx-sender/store"
-	"github.com/dgraph-io/ristretto"
 )
 
-// NonceManager is a NonceManager that works with in memory cache
+// nonceSender is a NonceSender holding the nonces of the accounts in memory
 //
-// Important note:
-// NonceManager makes the assumption that distinct goroutines access
-// nonces for non overlapping set of keys (so there is never competitive access
-// to a nonce for a given key)
-// Accessing the same key from 2 different goroutines could result
-// in discrepancies in nonce updates
+// Nonces of an account are allocated under a lock, so distinct goroutines can
+// reserve nonces of the same account concurrently
 type nonceSender struct {
-	cache *ristretto.Cache
-	ttl   time.Duration
+	mux      *sync.Mutex
+	accounts map[string]*accountNonces
+	ttl      time.Duration
 }
 
-// NewNonceSender creates a new mock NonceManager
-func NewNonceSender(ttl time.Duration) store.NonceSender {
-	cache, _ := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1e7,     // number of keys to track frequency of (100k).
-		MaxCost:     1 << 32, // maximum cost of cache (100MB).
-		BufferItems: 64,      // number of keys per Get buffer.
-	})
+type accountNonces struct {
+	next      *uint64
+	lastSent  *uint64
+	released  []uint64
+	reserved  map[uint64]time.Time
+	expiresAt time.Time
+}
 
+// NewNonceSender creates a new in memory NonceSender
+func NewNonceSender(ttl time.Duration) store.NonceSender {
 	return &nonceSender{
-		cache: cache,
-		ttl:   ttl,
+		mux:      &sync.Mutex{},
+		accounts: make(map[string]*accountNonces),
+		ttl:      ttl,
 	}
 }
 
-const lastSentSuf = "last-sent"
+func (nm *nonceSender) ReserveNonce(key string) (uint64, error) {
+	nm.mux.Lock()
+	defer nm.mux.Unlock()
+
+	acc := nm.load(key)
+	nm.releaseExpired(acc)
+
+	var nonce uint64
+	switch {
+	case len(acc.released) > 0:
+		nonce = acc.released[0]
+		acc.released = acc.released[1:]
+	case acc.next != nil:
+		nonce = *acc.next
+		*acc.next++
+	default:
+		return 0, errors.NotFoundError("next nonce not found")
+	}
 
-func (nm *nonceSender) GetLastSent(key string) (uint64, error) {
-	return nm.loadUint64(computeKey(key, lastSentSuf))
+	acc.reserved[nonce] = time.Now().Add(nm.ttl)
+	return nonce, nil
 }
 
-// SetLastSent set last sent nonce
-func (nm *nonceSender) SetLastSent(key string, value uint64) error {
-	nm.set(computeKey(key, lastSentSuf), value)
+func (nm *nonceSender) InitNextNonce(key string, value uint64) error {
+	nm.mux.Lock()
+	defer nm.mux.Unlock()
+
+	acc := nm.load(key)
+	if acc.next == nil {
+		acc.next = &value
+	}
+
 	return nil
 }
 
-func (nm *nonceSender) IncrLastSent(key string) (err error) {
-	return nm.incrUint64(computeKey(key, lastSentSuf))
-}
+func (nm *nonceSender) CommitNonce(key string, value uint64) error {
+	nm.mux.Lock()
+	defer nm.mux.Unlock()
+
+	acc := nm.load(key)
+	delete(acc.reserved, value)
+	if acc.lastSent == nil || *acc.lastSent < value {
+		acc.lastSent = &value
+	}
 
-func (nm *nonceSender) DeleteLastSent(key string) (err error) {
-	nm.delete(computeKey(key, lastSentSuf))
 	return nil
 }
 
-func (nm *nonceSender) loadUint64(key string) (uint64, error) {
-	v, ok := nm.cache.Get(key)
-	if !ok {
-		return 0, nil
-	}
+func (nm *nonceSender) ReleaseNonce(key string, value uint64) error {
+	nm.mux.Lock()
+	defer nm.mux.Unlock()
 
-	rv, ok := v.(uint64)
-	if !ok {
-		return 0, errors.DataCorruptedError("loaded value is not uint64")
+	acc := nm.load(key)
+	if _, ok := acc.reserved[value]; !ok {
+		return nil
 	}
 
-	return rv, nil
+	delete(acc.reserved, value)
+	acc.release(value)
+
+	return nil
 }
 
-func (nm *nonceSender) set(key string, value interface{}) {
-	size := int64(reflect.TypeOf(value).Size())
-	nm.cache.SetWithTTL(key, value, size, nm.ttl)
+func (nm *nonceSender) GetLastSent(key string) (uint64, error) {
+	nm.mux.Lock()
+	defer nm.mux.Unlock()
+
+	acc := nm.load(key)
+	if acc.lastSent == nil {
+		return 0, errors.NotFoundError("last sent nonce not found")
+	}
+
+	return *acc.lastSent, nil
 }
 
-func (nm *nonceSender) delete(key string) {
-	nm.cache.Del(key)
+func (nm *nonceSender) DeleteNonces(key string, value uint64) (bool, error) {
+	nm.mux.Lock()
+	defer nm.mux.Unlock()
+
+	acc := nm.load(key)
+	delete(acc.reserved, value)
+	for _, expiresAt := range acc.reserved {
+		if time.Now().Before(expiresAt) {
+			return false, nil
+		}
+	}
+
+	delete(nm.accounts, key)
+	return true, nil
 }
 
-func (nm *nonceSender) incrUint64(key string) error {
-	v, err := nm.loadUint64(key)
-	if err != nil {
-		return err
+// load returns the nonces of the account, resetting them once expired
+func (nm *nonceSender) load(key string) *accountNonces {
+	acc, ok := nm.accounts[key]
+	if !ok || time.Now().After(acc.expiresAt) {
+		acc = &accountNonces{reserved: make(map[uint64]time.Time)}
+		nm.accounts[key] = acc
 	}
 
-	// Stores incremented nonce
-	nm.set(key, v+1)
+	acc.expiresAt = time.Now().Add(nm.ttl)
+	return acc
+}
 
-	return nil
+// releaseExpired releases the reservations neither committed nor released before expiring
+func (nm *nonceSender) releaseExpired(acc *accountNonces) {
+	for nonce, expiresAt := range acc.reserved {
+		if time.Now().After(expiresAt) {
+			delete(acc.reserved, nonce)
+			acc.release(nonce)
+		}
+	}
+}
+
+func (acc *accountNonces) release(nonce uint64) {
+	acc.released = append(acc.released, nonce)
+	sort.Slice(acc.released, func(i, j int) bool { return acc.released[i] < acc.released[j] })
 }
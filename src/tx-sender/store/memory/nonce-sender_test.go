@@ -3,10 +3,13 @@
 package memory
 
 import (
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/consensys/orchestrate/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNonceSenderMemory(t *testing.T) {
@@ -14,39 +17,125 @@ func TestNonceSenderMemory(t *testing.T) {
 
 	testKey := "nonce-sender-memory"
 
-	n, err := ns.GetLastSent(testKey)
+	_, err := ns.ReserveNonce(testKey)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	_, err = ns.GetLastSent(testKey)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	err = ns.InitNextNonce(testKey, 10)
 	assert.NoError(t, err)
-	assert.Equal(t, uint64(0), n)
 
-	err = ns.SetLastSent(testKey, 10)
+	// Next nonce is only initialized once
+	err = ns.InitNextNonce(testKey, 5)
 	assert.NoError(t, err)
-	time.Sleep(time.Millisecond * 100)
 
-	n, err = ns.GetLastSent(testKey)
+	n, err := ns.ReserveNonce(testKey)
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(10), n)
 
-	err = ns.IncrLastSent(testKey)
-	time.Sleep(time.Millisecond * 100)
+	n, err = ns.ReserveNonce(testKey)
 	assert.NoError(t, err)
-
-	n, _ = ns.GetLastSent(testKey)
 	assert.Equal(t, uint64(11), n)
 
-	err = ns.DeleteLastSent(testKey)
-	time.Sleep(time.Millisecond * 100)
+	err = ns.CommitNonce(testKey, 11)
 	assert.NoError(t, err)
 
 	n, err = ns.GetLastSent(testKey)
 	assert.NoError(t, err)
-	assert.Equal(t, uint64(0), n)
+	assert.Equal(t, uint64(11), n)
 
-	err = ns.SetLastSent(testKey, 10)
-	time.Sleep(time.Millisecond * 100)
+	// Released nonce is reused before allocating new ones
+	err = ns.ReleaseNonce(testKey, 10)
 	assert.NoError(t, err)
-	time.Sleep(time.Second)
 
-	n, err = ns.GetLastSent(testKey)
+	n, err = ns.ReserveNonce(testKey)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), n)
+
+	n, err = ns.ReserveNonce(testKey)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(12), n)
+
+	// Nonces not reserved are not released
+	err = ns.ReleaseNonce(testKey, 11)
+	assert.NoError(t, err)
+
+	n, err = ns.ReserveNonce(testKey)
 	assert.NoError(t, err)
+	assert.Equal(t, uint64(13), n)
+
+	// Nonces are kept while other nonces are reserved
+	deleted, err := ns.DeleteNonces(testKey, 13)
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+
+	err = ns.CommitNonce(testKey, 10)
+	assert.NoError(t, err)
+
+	deleted, err = ns.DeleteNonces(testKey, 12)
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+
+	_, err = ns.ReserveNonce(testKey)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	_, err = ns.GetLastSent(testKey)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	err = ns.InitNextNonce(testKey, 10)
+	assert.NoError(t, err)
+	time.Sleep(time.Second + 100*time.Millisecond)
+
+	_, err = ns.ReserveNonce(testKey)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
+func TestNonceSenderMemory_ExpiredReservations(t *testing.T) {
+	ns := NewNonceSender(100 * time.Millisecond)
+
+	testKey := "nonce-sender-memory-expired"
+	err := ns.InitNextNonce(testKey, 0)
+	require.NoError(t, err)
+
+	n, err := ns.ReserveNonce(testKey)
+	require.NoError(t, err)
 	assert.Equal(t, uint64(0), n)
+
+	// Accessing the account keeps it alive while its reservation expires
+	time.Sleep(60 * time.Millisecond)
+	_, _ = ns.GetLastSent(testKey)
+	time.Sleep(60 * time.Millisecond)
+
+	// Expired reservation is released to the next reservation
+	n, err = ns.ReserveNonce(testKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), n)
+}
+
+func TestNonceSenderMemory_ConcurrentReservations(t *testing.T) {
+	ns := NewNonceSender(time.Minute)
+
+	testKey := "nonce-sender-memory-concurrent"
+	err := ns.InitNextNonce(testKey, 0)
+	require.NoError(t, err)
+
+	var mux sync.Mutex
+	nonces := make(map[uint64]bool)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := ns.ReserveNonce(testKey)
+			assert.NoError(t, err)
+
+			mux.Lock()
+			nonces[n] = true
+			mux.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, nonces, 50)
 }
@@ -32,61 +32,91 @@ func (m *MockNonceSender) EXPECT() *MockNonceSenderMockRecorder {
 	return m.recorder
 }
 
-// GetLastSent mocks base method
-func (m *MockNonceSender) GetLastSent(key string) (uint64, error) {
+// ReserveNonce mocks base method
+func (m *MockNonceSender) ReserveNonce(key string) (uint64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLastSent", key)
+	ret := m.ctrl.Call(m, "ReserveNonce", key)
 	ret0, _ := ret[0].(uint64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLastSent indicates an expected call of GetLastSent
-func (mr *MockNonceSenderMockRecorder) GetLastSent(key interface{}) *gomock.Call {
+// ReserveNonce indicates an expected call of ReserveNonce
+func (mr *MockNonceSenderMockRecorder) ReserveNonce(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSent", reflect.TypeOf((*MockNonceSender)(nil).GetLastSent), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveNonce", reflect.TypeOf((*MockNonceSender)(nil).ReserveNonce), key)
 }
 
-// IncrLastSent mocks base method
-func (m *MockNonceSender) IncrLastSent(key string) error {
+// InitNextNonce mocks base method
+func (m *MockNonceSender) InitNextNonce(key string, value uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IncrLastSent", key)
+	ret := m.ctrl.Call(m, "InitNextNonce", key, value)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// IncrLastSent indicates an expected call of IncrLastSent
-func (mr *MockNonceSenderMockRecorder) IncrLastSent(key interface{}) *gomock.Call {
+// InitNextNonce indicates an expected call of InitNextNonce
+func (mr *MockNonceSenderMockRecorder) InitNextNonce(key, value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrLastSent", reflect.TypeOf((*MockNonceSender)(nil).IncrLastSent), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitNextNonce", reflect.TypeOf((*MockNonceSender)(nil).InitNextNonce), key, value)
 }
 
-// DeleteLastSent mocks base method
-func (m *MockNonceSender) DeleteLastSent(key string) error {
+// CommitNonce mocks base method
+func (m *MockNonceSender) CommitNonce(key string, value uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLastSent", key)
+	ret := m.ctrl.Call(m, "CommitNonce", key, value)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteLastSent indicates an expected call of DeleteLastSent
-func (mr *MockNonceSenderMockRecorder) DeleteLastSent(key interface{}) *gomock.Call {
+// CommitNonce indicates an expected call of CommitNonce
+func (mr *MockNonceSenderMockRecorder) CommitNonce(key, value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastSent", reflect.TypeOf((*MockNonceSender)(nil).DeleteLastSent), key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitNonce", reflect.TypeOf((*MockNonceSender)(nil).CommitNonce), key, value)
 }
 
-// SetLastSent mocks base method
-func (m *MockNonceSender) SetLastSent(key string, value uint64) error {
+// ReleaseNonce mocks base method
+func (m *MockNonceSender) ReleaseNonce(key string, value uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetLastSent", key, value)
+	ret := m.ctrl.Call(m, "ReleaseNonce", key, value)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetLastSent indicates an expected call of SetLastSent
-func (mr *MockNonceSenderMockRecorder) SetLastSent(key, value interface{}) *gomock.Call {
+// ReleaseNonce indicates an expected call of ReleaseNonce
+func (mr *MockNonceSenderMockRecorder) ReleaseNonce(key, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseNonce", reflect.TypeOf((*MockNonceSender)(nil).ReleaseNonce), key, value)
+}
+
+// GetLastSent mocks base method
+func (m *MockNonceSender) GetLastSent(key string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastSent", key)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastSent indicates an expected call of GetLastSent
+func (mr *MockNonceSenderMockRecorder) GetLastSent(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSent", reflect.TypeOf((*MockNonceSender)(nil).GetLastSent), key)
+}
+
+// DeleteNonces mocks base method
+func (m *MockNonceSender) DeleteNonces(key string, value uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNonces", key, value)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNonces indicates an expected call of DeleteNonces
+func (mr *MockNonceSenderMockRecorder) DeleteNonces(key, value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastSent", reflect.TypeOf((*MockNonceSender)(nil).SetLastSent), key, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNonces", reflect.TypeOf((*MockNonceSender)(nil).DeleteNonces), key, value)
 }
 
 // MockRecoveryTracker is a mock of RecoveryTracker interface
@@ -0,0 +1,220 @@
+// +build integration
+
+package postgres_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/utils"
+	"github.com/consensys/orchestrate/src/api/store/postgres/migrations"
+	"github.com/consensys/orchestrate/src/infra/postgres/gopg"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+	pgstore "github.com/consensys/orchestrate/src/tx-sender/store/postgres"
+	"github.com/consensys/orchestrate/tests/pkg/docker"
+	"github.com/consensys/orchestrate/tests/pkg/docker/config"
+	postgresDocker "github.com/consensys/orchestrate/tests/pkg/docker/container/postgres"
+	"github.com/go-pg/pg/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+const postgresContainerID = "postgres-tx-sender-store"
+const networkName = "tx-sender-store"
+
+type postgresStoreTestSuite struct {
+	suite.Suite
+	client *docker.Client
+	pgCfg  *gopg.Config
+	ns     store.NonceSender
+	rt     store.RecoveryTracker
+}
+
+func TestPostgresStore(t *testing.T) {
+	suite.Run(t, new(postgresStoreTestSuite))
+}
+
+func (s *postgresStoreTestSuite) SetupSuite() {
+	ctx := context.Background()
+	pgHostPort := strconv.Itoa(utils.RandIntRange(10000, 15235))
+
+	s.pgCfg = &gopg.Config{
+		Host:        "localhost",
+		Port:        pgHostPort,
+		User:        "postgres",
+		Password:    "postgres",
+		Database:    "postgres",
+		PoolSize:    10,
+		PoolTimeout: 30 * time.Second,
+		DialTimeout: 30 * time.Second,
+		SSLMode:     "disable",
+	}
+
+	composition := &config.Composition{
+		Containers: map[string]*config.Container{
+			postgresContainerID: {Postgres: postgresDocker.NewDefault().SetHostPort(pgHostPort)},
+		},
+	}
+
+	var err error
+	s.client, err = docker.NewClient(composition)
+	require.NoError(s.T(), err)
+
+	err = s.client.CreateNetwork(ctx, networkName)
+	require.NoError(s.T(), err)
+
+	err = s.client.Up(ctx, postgresContainerID, networkName)
+	require.NoError(s.T(), err)
+
+	err = s.client.WaitTillIsReady(ctx, postgresContainerID, 10*time.Second)
+	require.NoError(s.T(), err)
+
+	pgOptions, err := s.pgCfg.ToPGOptionsV9()
+	require.NoError(s.T(), err)
+
+	pgDB := pg.Connect(pgOptions)
+	_, _, err = migrations.Run(pgDB, "init")
+	require.NoError(s.T(), err)
+	_, _, err = migrations.Run(pgDB, "up")
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), pgDB.Close())
+
+	pgClient, err := gopg.New("orchestrate.integration-tests.tx-sender-store", s.pgCfg)
+	require.NoError(s.T(), err)
+
+	s.ns = pgstore.NewNonceSender(pgClient, time.Minute)
+	s.rt = pgstore.NewNonceRecoveryTracker(pgClient)
+}
+
+func (s *postgresStoreTestSuite) TearDownSuite() {
+	ctx := context.Background()
+	_ = s.client.Down(ctx, postgresContainerID)
+	_ = s.client.RemoveNetwork(ctx, networkName)
+}
+
+func (s *postgresStoreTestSuite) TestNonceSender() {
+	testKey := "nonce-sender-postgres"
+
+	_, err := s.ns.ReserveNonce(testKey)
+	assert.True(s.T(), errors.IsNotFoundError(err))
+
+	_, err = s.ns.GetLastSent(testKey)
+	assert.True(s.T(), errors.IsNotFoundError(err))
+
+	err = s.ns.InitNextNonce(testKey, 10)
+	assert.NoError(s.T(), err)
+
+	// Next nonce is only initialized once
+	err = s.ns.InitNextNonce(testKey, 5)
+	assert.NoError(s.T(), err)
+
+	n, err := s.ns.ReserveNonce(testKey)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), uint64(10), n)
+
+	n, err = s.ns.ReserveNonce(testKey)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), uint64(11), n)
+
+	err = s.ns.CommitNonce(testKey, 11)
+	assert.NoError(s.T(), err)
+
+	n, err = s.ns.GetLastSent(testKey)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), uint64(11), n)
+
+	// Last sent nonce is not decreased by a lower commit
+	err = s.ns.CommitNonce(testKey, 9)
+	assert.NoError(s.T(), err)
+
+	n, err = s.ns.GetLastSent(testKey)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), uint64(11), n)
+
+	// Released nonce is reused before allocating new ones
+	err = s.ns.ReleaseNonce(testKey, 10)
+	assert.NoError(s.T(), err)
+
+	n, err = s.ns.ReserveNonce(testKey)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), uint64(10), n)
+
+	// Nonces not reserved are not released
+	err = s.ns.ReleaseNonce(testKey, 11)
+	assert.NoError(s.T(), err)
+
+	n, err = s.ns.ReserveNonce(testKey)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), uint64(12), n)
+
+	// Nonces are kept while other nonces are reserved
+	deleted, err := s.ns.DeleteNonces(testKey, 12)
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), deleted)
+
+	err = s.ns.CommitNonce(testKey, 10)
+	assert.NoError(s.T(), err)
+
+	deleted, err = s.ns.DeleteNonces(testKey, 12)
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), deleted)
+
+	_, err = s.ns.ReserveNonce(testKey)
+	assert.True(s.T(), errors.IsNotFoundError(err))
+
+	_, err = s.ns.GetLastSent(testKey)
+	assert.True(s.T(), errors.IsNotFoundError(err))
+}
+
+func (s *postgresStoreTestSuite) TestNonceSender_ConcurrentReservations() {
+	testKey := "nonce-sender-postgres-concurrent"
+	err := s.ns.InitNextNonce(testKey, 0)
+	require.NoError(s.T(), err)
+
+	// Replicas share the nonces through distinct connections
+	pgClient, err := gopg.New("orchestrate.integration-tests.tx-sender-store-replica", s.pgCfg)
+	require.NoError(s.T(), err)
+	replica := pgstore.NewNonceSender(pgClient, time.Minute)
+
+	var mux sync.Mutex
+	nonces := make(map[uint64]bool)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		ns := s.ns
+		if i%2 == 0 {
+			ns = replica
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := ns.ReserveNonce(testKey)
+			assert.NoError(s.T(), err)
+
+			mux.Lock()
+			nonces[n] = true
+			mux.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(s.T(), nonces, 50)
+}
+
+func (s *postgresStoreTestSuite) TestRecoveryTracker() {
+	testKey := "recovery-tracker-postgres"
+
+	assert.Equal(s.T(), uint64(0), s.rt.Recovering(testKey))
+
+	s.rt.Recover(testKey)
+	s.rt.Recover(testKey)
+	assert.Equal(s.T(), uint64(2), s.rt.Recovering(testKey))
+
+	s.rt.Recovered(testKey)
+	assert.Equal(s.T(), uint64(0), s.rt.Recovering(testKey))
+}
@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+	"github.com/go-pg/pg/v10"
+)
+
+const (
+	// Locks the nonces of the account so that reservations of the same account are serialized
+	lockNextQuery = `SELECT next FROM account_nonces WHERE key = ? AND next IS NOT NULL FOR UPDATE`
+
+	lockAccountQuery = `SELECT key FROM account_nonces WHERE key = ? FOR UPDATE`
+
+	// Releases the reservations neither committed nor released before expiring
+	releaseExpiredQuery = `
+WITH expired AS (
+	UPDATE account_nonce_allocations SET released = true
+	WHERE key = ?0 AND NOT released AND reserved_at < now() - ?1 * interval '1 millisecond'
+	RETURNING nonce
+)
+SELECT count(*) FROM expired`
+
+	reserveReleasedQuery = `
+UPDATE account_nonce_allocations SET released = false, reserved_at = now()
+WHERE key = ?0 AND nonce = (SELECT min(nonce) FROM account_nonce_allocations WHERE key = ?0 AND released)
+RETURNING nonce`
+
+	allocateNextQuery = `
+WITH allocated AS (
+	INSERT INTO account_nonce_allocations (key, nonce) VALUES (?0, ?1)
+	ON CONFLICT (key, nonce) DO UPDATE SET released = false, reserved_at = now()
+)
+UPDATE account_nonces SET next = ?1 + 1, updated_at = now() WHERE key = ?0
+RETURNING ?1`
+
+	initNextQuery = `
+INSERT INTO account_nonces (key, next) VALUES (?0, ?1)
+ON CONFLICT (key) DO UPDATE SET next = EXCLUDED.next, updated_at = now() WHERE account_nonces.next IS NULL
+RETURNING next`
+
+	commitQuery = `
+WITH committed AS (
+	DELETE FROM account_nonce_allocations WHERE key = ?0 AND nonce = ?1 AND NOT released
+)
+INSERT INTO account_nonces (key, last_sent) VALUES (?0, ?1)
+ON CONFLICT (key) DO UPDATE SET last_sent = GREATEST(account_nonces.last_sent, EXCLUDED.last_sent), updated_at = now()
+RETURNING last_sent`
+
+	releaseQuery = `
+UPDATE account_nonce_allocations SET released = true WHERE key = ? AND nonce = ? AND NOT released
+RETURNING nonce`
+
+	lastSentQuery = `SELECT last_sent FROM account_nonces WHERE key = ? AND last_sent IS NOT NULL`
+
+	// Counts the other reservations which have not expired, the discarded one being still visible to the statement
+	discardQuery = `
+WITH discarded AS (
+	DELETE FROM account_nonce_allocations WHERE key = ?0 AND nonce = ?1 AND NOT released
+)
+SELECT count(*) FROM account_nonce_allocations
+WHERE key = ?0 AND nonce <> ?1 AND NOT released AND reserved_at >= now() - ?2 * interval '1 millisecond'`
+
+	deleteQuery = `
+WITH deleted AS (
+	DELETE FROM account_nonce_allocations WHERE key = ?0
+)
+DELETE FROM account_nonces WHERE key = ?0
+RETURNING key`
+)
+
+type nonceSender struct {
+	client     postgres.Client
+	expiration int64
+}
+
+// NewNonceSender creates a new NonceSender storing the nonces of the accounts in Postgres, shared by all tx-sender replicas
+func NewNonceSender(client postgres.Client, expiration time.Duration) store.NonceSender {
+	return &nonceSender{
+		client:     client,
+		expiration: expiration.Milliseconds(),
+	}
+}
+
+func (ns *nonceSender) ReserveNonce(key string) (uint64, error) {
+	ctx := context.Background()
+
+	var nonce uint64
+	err := ns.client.RunInTransaction(ctx, func(tx postgres.Client) error {
+		var next uint64
+		err := tx.QueryOneContext(ctx, pg.Scan(&next), lockNextQuery, key)
+		if err != nil {
+			return err
+		}
+
+		var expired uint64
+		err = tx.QueryOneContext(ctx, pg.Scan(&expired), releaseExpiredQuery, key, ns.expiration)
+		if err != nil {
+			return err
+		}
+
+		// Released nonces are reused before allocating new ones
+		err = tx.QueryOneContext(ctx, pg.Scan(&nonce), reserveReleasedQuery, key)
+		if err == nil || !errors.IsNotFoundError(err) {
+			return err
+		}
+
+		return tx.QueryOneContext(ctx, pg.Scan(&nonce), allocateNextQuery, key, next)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return nonce, nil
+}
+
+func (ns *nonceSender) InitNextNonce(key string, value uint64) error {
+	var next uint64
+	err := ns.client.QueryOneContext(context.Background(), pg.Scan(&next), initNextQuery, key, value)
+	// No row is returned if the next nonce is already set
+	if err != nil && !errors.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (ns *nonceSender) CommitNonce(key string, value uint64) error {
+	var lastSent uint64
+	return ns.client.QueryOneContext(context.Background(), pg.Scan(&lastSent), commitQuery, key, value)
+}
+
+func (ns *nonceSender) ReleaseNonce(key string, value uint64) error {
+	var nonce uint64
+	err := ns.client.QueryOneContext(context.Background(), pg.Scan(&nonce), releaseQuery, key, value)
+	// No row is returned if the nonce is not reserved
+	if err != nil && !errors.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (ns *nonceSender) GetLastSent(key string) (uint64, error) {
+	var lastSent uint64
+	err := ns.client.QueryOneContext(context.Background(), pg.Scan(&lastSent), lastSentQuery, key)
+	if err != nil {
+		return 0, err
+	}
+
+	return lastSent, nil
+}
+
+func (ns *nonceSender) DeleteNonces(key string, value uint64) (bool, error) {
+	ctx := context.Background()
+
+	deleted := false
+	err := ns.client.RunInTransaction(ctx, func(tx postgres.Client) error {
+		var lockedKey string
+		err := tx.QueryOneContext(ctx, pg.Scan(&lockedKey), lockAccountQuery, key)
+		if err != nil {
+			// Nonces of the account are already deleted
+			if errors.IsNotFoundError(err) {
+				deleted = true
+				return nil
+			}
+			return err
+		}
+
+		var reserved uint64
+		err = tx.QueryOneContext(ctx, pg.Scan(&reserved), discardQuery, key, value, ns.expiration)
+		if err != nil || reserved > 0 {
+			return err
+		}
+
+		var deletedKey string
+		err = tx.QueryOneContext(ctx, pg.Scan(&deletedKey), deleteQuery, key)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return err
+		}
+
+		deleted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,111 @@
+// +build unit
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/consensys/orchestrate/src/infra/postgres/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonceSender(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testKey := "nonce-sender-postgres"
+	mockClient := mocks.NewMockClient(ctrl)
+	mockClient.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, persist func(client postgres.Client) error) error {
+			return persist(mockClient)
+		}).AnyTimes()
+
+	ns := NewNonceSender(mockClient, time.Minute)
+
+	t.Run("should reserve released nonce successfully", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), lockNextQuery, testKey).Return(nil)
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), releaseExpiredQuery, testKey, int64(60000)).Return(nil)
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), reserveReleasedQuery, testKey).Return(nil)
+
+		_, err := ns.ReserveNonce(testKey)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should allocate next nonce if no nonce is released", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), lockNextQuery, testKey).Return(nil)
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), releaseExpiredQuery, testKey, int64(60000)).Return(nil)
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), reserveReleasedQuery, testKey).Return(errors.NotFoundError("error"))
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), allocateNextQuery, testKey, uint64(0)).Return(nil)
+
+		_, err := ns.ReserveNonce(testKey)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail with NotFoundError if next nonce is not initialized", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), lockNextQuery, testKey).Return(errors.NotFoundError("error"))
+
+		_, err := ns.ReserveNonce(testKey)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should init next nonce successfully if already set", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), initNextQuery, testKey, uint64(10)).Return(errors.NotFoundError("error"))
+
+		err := ns.InitNextNonce(testKey, 10)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should commit nonce successfully", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), commitQuery, testKey, uint64(10)).Return(nil)
+
+		err := ns.CommitNonce(testKey, 10)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should ignore release of nonce not reserved", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), releaseQuery, testKey, uint64(10)).Return(errors.NotFoundError("error"))
+
+		err := ns.ReleaseNonce(testKey, 10)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail to release nonce if postgres fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), releaseQuery, testKey, uint64(10)).Return(expectedErr)
+
+		err := ns.ReleaseNonce(testKey, 10)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with NotFoundError if no nonce was sent", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), lastSentQuery, testKey).Return(errors.NotFoundError("error"))
+
+		_, err := ns.GetLastSent(testKey)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should delete nonces successfully", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), lockAccountQuery, testKey).Return(nil)
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), discardQuery, testKey, uint64(10), int64(60000)).Return(nil)
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), deleteQuery, testKey).Return(errors.NotFoundError("error"))
+
+		deleted, err := ns.DeleteNonces(testKey, 10)
+		assert.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("should not delete nonces if postgres fails", func(t *testing.T) {
+		expectedErr := errors.PostgresConnectionError("error")
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), lockAccountQuery, testKey).Return(nil)
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), discardQuery, testKey, uint64(10), int64(60000)).Return(expectedErr)
+
+		deleted, err := ns.DeleteNonces(testKey, 10)
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, deleted)
+	})
+}
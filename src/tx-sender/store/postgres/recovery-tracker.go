@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/infra/postgres"
+	"github.com/consensys/orchestrate/src/tx-sender/store"
+	"github.com/go-pg/pg/v10"
+)
+
+const (
+	recoveringQuery = `SELECT count FROM nonce_recoveries WHERE key = ?`
+
+	recoverQuery = `
+INSERT INTO nonce_recoveries (key, count) VALUES (?, 1)
+ON CONFLICT (key) DO UPDATE SET count = nonce_recoveries.count + 1
+RETURNING count`
+
+	recoveredQuery = `DELETE FROM nonce_recoveries WHERE key = ? RETURNING key`
+)
+
+type nonceRecoveryTracker struct {
+	client postgres.Client
+	logger *log.Logger
+}
+
+func NewNonceRecoveryTracker(client postgres.Client) store.RecoveryTracker {
+	return &nonceRecoveryTracker{
+		client: client,
+		logger: log.NewLogger().SetComponent("nonce-recovery-tracker"),
+	}
+}
+
+func (t *nonceRecoveryTracker) Recovering(key string) (count uint64) {
+	err := t.client.QueryOneContext(context.Background(), pg.Scan(&count), recoveringQuery, key)
+	if err != nil && !errors.IsNotFoundError(err) {
+		t.logger.WithError(err).WithField("key", key).Error("failed to load nonce recovery count")
+	}
+
+	return count
+}
+
+func (t *nonceRecoveryTracker) Recover(key string) {
+	var count uint64
+	err := t.client.QueryOneContext(context.Background(), pg.Scan(&count), recoverQuery, key)
+	if err != nil {
+		t.logger.WithError(err).WithField("key", key).Error("failed to increment nonce recovery count")
+	}
+}
+
+func (t *nonceRecoveryTracker) Recovered(key string) {
+	var deletedKey string
+	err := t.client.QueryOneContext(context.Background(), pg.Scan(&deletedKey), recoveredQuery, key)
+	if err != nil && !errors.IsNotFoundError(err) {
+		t.logger.WithError(err).WithField("key", key).Error("failed to delete nonce recovery count")
+	}
+}
@@ -0,0 +1,42 @@
+// +build unit
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/src/infra/postgres/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoveryTracker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testKey := "recovery-tracker-postgres"
+
+	mockClient := mocks.NewMockClient(ctrl)
+
+	rt := NewNonceRecoveryTracker(mockClient)
+
+	t.Run("should call recovering nonce successfully", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), recoveringQuery, testKey).Return(errors.NotFoundError("error"))
+
+		n := rt.Recovering(testKey)
+		assert.Equal(t, uint64(0), n)
+	})
+
+	t.Run("should call recover nonce successfully", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), recoverQuery, testKey).Return(nil)
+
+		rt.Recover(testKey)
+	})
+
+	t.Run("should call recovered nonce successfully", func(t *testing.T) {
+		mockClient.EXPECT().QueryOneContext(gomock.Any(), gomock.Any(), recoveredQuery, testKey).Return(nil)
+
+		rt.Recovered(testKey)
+	})
+}
@@ -6,14 +6,91 @@ import (
 	"github.com/consensys/orchestrate/src/infra/redis"
 )
 
-const lastSentSuf = "last-sent"
+const (
+	lastSentSuf = "last-sent"
+	nextSuf     = "next"
+	releasedSuf = "released"
+	reservedSuf = "reserved"
+)
+
+// reserveScript releases the expired reservations, pops the lowest released nonce, or allocates the next one, and adds
+// it to the reserved nonces scored by the expiry of its reservation
+// KEYS: next, released, reserved - ARGV: expiration, now
+const reserveScript = `
+local expired = redis.call('ZRANGEBYSCORE', KEYS[3], '-inf', ARGV[2])
+if #expired > 0 then
+	for _, n in ipairs(expired) do
+		redis.call('ZADD', KEYS[2], n, n)
+	end
+	redis.call('ZREMRANGEBYSCORE', KEYS[3], '-inf', ARGV[2])
+	redis.call('PEXPIRE', KEYS[2], ARGV[1])
+end
+
+local nonce
+local released = redis.call('ZRANGE', KEYS[2], 0, 0)
+if #released > 0 then
+	nonce = tonumber(released[1])
+	redis.call('ZREM', KEYS[2], released[1])
+else
+	local next = redis.call('GET', KEYS[1])
+	if not next then
+		return false
+	end
+	nonce = tonumber(next)
+	redis.call('SET', KEYS[1], nonce + 1, 'PX', ARGV[1])
+end
+redis.call('ZADD', KEYS[3], tonumber(ARGV[2]) + tonumber(ARGV[1]), nonce)
+redis.call('PEXPIRE', KEYS[3], ARGV[1])
+return nonce
+`
+
+// initScript sets the next nonce unless it already exists
+// KEYS: next - ARGV: value, expiration
+const initScript = `
+redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2])
+return 1
+`
+
+// commitScript removes the nonce from the reserved nonces and sets it as last sent if greater
+// KEYS: reserved, last sent - ARGV: value, expiration
+const commitScript = `
+redis.call('ZREM', KEYS[1], ARGV[1])
+local lastSent = redis.call('GET', KEYS[2])
+if not lastSent or tonumber(lastSent) < tonumber(ARGV[1]) then
+	redis.call('SET', KEYS[2], ARGV[1], 'PX', ARGV[2])
+end
+return 1
+`
+
+// releaseScript moves the nonce from the reserved nonces to the released nonces
+// KEYS: released, reserved - ARGV: value, expiration
+const releaseScript = `
+if redis.call('ZREM', KEYS[2], ARGV[1]) == 0 then
+	return 0
+end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return 1
+`
+
+// deleteScript removes the nonce from the reserved nonces, then deletes all the nonces of the account unless other
+// reservations have not expired
+// KEYS: next, released, reserved, last sent - ARGV: value, now
+const deleteScript = `
+redis.call('ZREM', KEYS[3], ARGV[1])
+if redis.call('ZCOUNT', KEYS[3], '(' .. ARGV[2], '+inf') > 0 then
+	return 0
+end
+redis.call('DEL', KEYS[1], KEYS[2], KEYS[3], KEYS[4])
+return 1
+`
 
 type NonceSender struct {
 	redis      redis.Client
 	expiration int
 }
 
-// NewNonceSender creates a new mock NonceManager
+// NewNonceSender creates a new NonceSender allocating nonces atomically with Lua scripts
 func NewNonceSender(client redis.Client, expiration time.Duration) *NonceSender {
 	return &NonceSender{
 		redis:      client,
@@ -21,18 +98,38 @@ func NewNonceSender(client redis.Client, expiration time.Duration) *NonceSender
 	}
 }
 
-func (ns *NonceSender) GetLastSent(key string) (uint64, error) {
-	return ns.redis.LoadUint64(computeKey(key, lastSentSuf))
+func (ns *NonceSender) ReserveNonce(key string) (uint64, error) {
+	return ns.redis.EvalUint64(reserveScript,
+		[]string{computeKey(key, nextSuf), computeKey(key, releasedSuf), computeKey(key, reservedSuf)}, ns.expiration, now())
 }
 
-func (ns *NonceSender) SetLastSent(key string, value uint64) error {
-	return ns.redis.Set(computeKey(key, lastSentSuf), ns.expiration, value)
+func (ns *NonceSender) InitNextNonce(key string, value uint64) error {
+	_, err := ns.redis.EvalUint64(initScript, []string{computeKey(key, nextSuf)}, value, ns.expiration)
+	return err
 }
 
-func (ns *NonceSender) IncrLastSent(key string) error {
-	return ns.redis.Incr(computeKey(key, lastSentSuf))
+func (ns *NonceSender) CommitNonce(key string, value uint64) error {
+	_, err := ns.redis.EvalUint64(commitScript,
+		[]string{computeKey(key, reservedSuf), computeKey(key, lastSentSuf)}, value, ns.expiration)
+	return err
 }
 
-func (ns *NonceSender) DeleteLastSent(key string) error {
-	return ns.redis.Delete(computeKey(key, lastSentSuf))
+func (ns *NonceSender) ReleaseNonce(key string, value uint64) error {
+	_, err := ns.redis.EvalUint64(releaseScript,
+		[]string{computeKey(key, releasedSuf), computeKey(key, reservedSuf)}, value, ns.expiration)
+	return err
+}
+
+func (ns *NonceSender) GetLastSent(key string) (uint64, error) {
+	return ns.redis.LoadUint64(computeKey(key, lastSentSuf))
+}
+
+func (ns *NonceSender) DeleteNonces(key string, value uint64) (bool, error) {
+	deleted, err := ns.redis.EvalUint64(deleteScript, []string{computeKey(key, nextSuf), computeKey(key, releasedSuf),
+		computeKey(key, reservedSuf), computeKey(key, lastSentSuf)}, value, now())
+	if err != nil {
+		return false, err
+	}
+
+	return deleted == 1, nil
 }
@@ -23,10 +23,37 @@ func TestNonceSender(t *testing.T) {
 
 	ns := NewNonceSender(mockRedisClient, expiration)
 
-	t.Run("should set nonce successfully ", func(t *testing.T) {
-		mockRedisClient.EXPECT().Set(expectedKey, 100, uint64(10)).Return(nil)
+	t.Run("should reserve nonce successfully", func(t *testing.T) {
+		expectedValue := uint64(10)
+		mockRedisClient.EXPECT().EvalUint64(reserveScript,
+			[]string{computeKey(testKey, nextSuf), computeKey(testKey, releasedSuf), computeKey(testKey, reservedSuf)}, 100, gomock.Any()).
+			Return(expectedValue, nil)
+
+		n, err := ns.ReserveNonce(testKey)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedValue, n)
+	})
+
+	t.Run("should init next nonce successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().EvalUint64(initScript, []string{computeKey(testKey, nextSuf)}, uint64(10), 100).Return(uint64(1), nil)
+
+		err := ns.InitNextNonce(testKey, 10)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should commit nonce successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().EvalUint64(commitScript, []string{computeKey(testKey, reservedSuf), expectedKey}, uint64(10), 100).
+			Return(uint64(1), nil)
+
+		err := ns.CommitNonce(testKey, 10)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should release nonce successfully", func(t *testing.T) {
+		mockRedisClient.EXPECT().EvalUint64(releaseScript,
+			[]string{computeKey(testKey, releasedSuf), computeKey(testKey, reservedSuf)}, uint64(10), 100).Return(uint64(1), nil)
 
-		err := ns.SetLastSent(testKey, 10)
+		err := ns.ReleaseNonce(testKey, 10)
 		assert.NoError(t, err)
 	})
 
@@ -39,17 +66,21 @@ func TestNonceSender(t *testing.T) {
 		assert.Equal(t, expectedValue, n)
 	})
 
-	t.Run("should increment nonce successfully ", func(t *testing.T) {
-		mockRedisClient.EXPECT().Incr(expectedKey).Return(nil)
+	t.Run("should delete nonces successfully ", func(t *testing.T) {
+		mockRedisClient.EXPECT().EvalUint64(deleteScript, []string{computeKey(testKey, nextSuf), computeKey(testKey, releasedSuf),
+			computeKey(testKey, reservedSuf), expectedKey}, uint64(10), gomock.Any()).Return(uint64(1), nil)
 
-		err := ns.IncrLastSent(testKey)
+		deleted, err := ns.DeleteNonces(testKey, 10)
 		assert.NoError(t, err)
+		assert.True(t, deleted)
 	})
 
-	t.Run("should delete nonce successfully ", func(t *testing.T) {
-		mockRedisClient.EXPECT().Delete(expectedKey).Return(nil)
+	t.Run("should keep nonces if other nonces are reserved", func(t *testing.T) {
+		mockRedisClient.EXPECT().EvalUint64(deleteScript, []string{computeKey(testKey, nextSuf), computeKey(testKey, releasedSuf),
+			computeKey(testKey, reservedSuf), expectedKey}, uint64(10), gomock.Any()).Return(uint64(0), nil)
 
-		err := ns.DeleteLastSent(testKey)
+		deleted, err := ns.DeleteNonces(testKey, 10)
 		assert.NoError(t, err)
+		assert.False(t, deleted)
 	})
 }
@@ -2,8 +2,14 @@ package redis
 
 import (
 	"fmt"
+	"time"
 )
 
 func computeKey(key, suffix string) string {
 	return fmt.Sprintf("%v-%v", key, suffix)
 }
+
+// now returns the current time in milliseconds, the unit of the expiry of reservations
+func now() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
@@ -2,18 +2,30 @@ package store
 
 //go:generate mockgen -source=store.go -destination=mock/store.go -package=mock
 
+// NonceSender allocates the nonces of an account to transactions processed concurrently.
+// A nonce is reserved before signing, then either committed once the transaction is sent or released to be reused by
+// the next reservation. Reservations neither committed nor released before expiring are released by the next reservation
 type NonceSender interface {
-	// GetLastSent retrieves last sent nonce
-	GetLastSent(key string) (uint64, error)
+	// ReserveNonce atomically reserves the lowest released nonce, or allocates the next one.
+	// Fails with a NotFoundError if the next nonce has not been initialized
+	ReserveNonce(key string) (uint64, error)
+
+	// InitNextNonce sets the next nonce to allocate, unless already set by a concurrent reservation
+	InitNextNonce(key string, value uint64) error
 
-	// IncrLastSent increment last sent nonce
-	IncrLastSent(key string) error
+	// CommitNonce marks a reserved nonce as sent
+	CommitNonce(key string, value uint64) error
 
-	// DeleteLastSent last sent nonce
-	DeleteLastSent(key string) error
+	// ReleaseNonce makes a reserved nonce available to the next reservation. Nonces that are not reserved are ignored
+	ReleaseNonce(key string, value uint64) error
+
+	// GetLastSent retrieves last sent nonce
+	GetLastSent(key string) (uint64, error)
 
-	// SetLastSent sets last sent nonce
-	SetLastSent(key string, value uint64) error
+	// DeleteNonces discards the reservation of a nonce rejected by the chain, then deletes the next, released and last
+	// sent nonces so that they are fetched again from the chain. Nonces are kept while other nonces are reserved, as
+	// fetching them again would allocate the reserved nonces twice, in which case false is returned
+	DeleteNonces(key string, value uint64) (bool, error)
 }
 
 type RecoveryTracker interface {
@@ -3,12 +3,12 @@ package builder
 import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
+	signerinfra "github.com/consensys/orchestrate/src/infra/signer"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce"
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases/crafter"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases/sender"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases/signer"
-	keymanager "github.com/consensys/quorum-key-manager/pkg/client"
 )
 
 type useCases struct {
@@ -20,14 +20,15 @@ type useCases struct {
 }
 
 func NewUseCases(messengerAPI sdk.MessengerAPI,
-	keyManagerClient keymanager.KeyManagerClient,
+	signerClient signerinfra.Signer,
+	accountClient sdk.AccountClient,
 	ec ethclient.MultiClient,
 	nonceManager nonce.Manager,
 	chainRegistryURL string,
 ) usecases.UseCases {
-	signETHTransactionUC := signer.NewSignETHTransactionUseCase(keyManagerClient)
-	signEEATransactionUC := signer.NewSignEEAPrivateTransactionUseCase(keyManagerClient)
-	signQuorumTransactionUC := signer.NewSignGoQuorumPrivateTransactionUseCase(keyManagerClient)
+	signETHTransactionUC := signer.NewSignETHTransactionUseCase(signerClient, accountClient)
+	signEEATransactionUC := signer.NewSignEEAPrivateTransactionUseCase(signerClient, accountClient)
+	signQuorumTransactionUC := signer.NewSignGoQuorumPrivateTransactionUseCase(signerClient, accountClient)
 
 	crafterUC := crafter.NewCraftTransactionUseCase(ec, chainRegistryURL, nonceManager)
 
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	kitmetrics "github.com/go-kit/kit/metrics"
+)
+
+type metrics struct {
+	jobsQueueDepth       kitmetrics.Gauge
+	jobProcessingLatency kitmetrics.Histogram
+}
+
+func buildMetrics(
+	jobsQueueDepth kitmetrics.Gauge,
+	jobProcessingLatency kitmetrics.Histogram,
+) *metrics {
+	return &metrics{
+		jobsQueueDepth:       jobsQueueDepth,
+		jobProcessingLatency: jobProcessingLatency,
+	}
+}
+
+func (r *metrics) JobsQueueDepth() kitmetrics.Gauge {
+	return r.jobsQueueDepth
+}
+
+func (r *metrics) JobProcessingLatency() kitmetrics.Histogram {
+	return r.jobProcessingLatency
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	metrics1 "github.com/consensys/orchestrate/pkg/toolkit/app/metrics"
+	pkgmetrics "github.com/consensys/orchestrate/pkg/toolkit/app/metrics/multi"
+	"github.com/go-kit/kit/metrics/discard"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	Subsystem                   = "transaction_sender"
+	JobsQueueDepth              = "jobs_queue_depth"
+	JobProcessingLatencySeconds = "job_processing_latency_seconds"
+)
+
+type tpcMetrics struct {
+	prometheus.Collector
+	*metrics
+}
+
+func NewSenderMetrics() SenderMetrics {
+	multi := pkgmetrics.NewMulti()
+
+	jobsQueueDepthGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      JobsQueueDepth,
+			Help:      "Number of jobs waiting to be processed or being processed",
+		},
+		[]string{"chain_uuid"},
+	)
+	multi.Collectors = append(multi.Collectors, jobsQueueDepthGauge)
+
+	jobProcessingLatencyHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metrics1.Namespace,
+			Subsystem: Subsystem,
+			Name:      JobProcessingLatencySeconds,
+			Help:      "Histogram of job processing latency, from consumption to completion (second)",
+			Buckets:   []float64{.05, .1, .5, 1, 5, 10, 30},
+		},
+		[]string{"chain_uuid"},
+	)
+	multi.Collectors = append(multi.Collectors, jobProcessingLatencyHistogram)
+
+	return &tpcMetrics{
+		Collector: multi,
+		metrics: buildMetrics(
+			kitprometheus.NewGauge(jobsQueueDepthGauge),
+			kitprometheus.NewHistogram(jobProcessingLatencyHistogram),
+		),
+	}
+}
+
+func NewSenderNopMetrics() SenderMetrics {
+	return &tpcMetrics{
+		Collector: pkgmetrics.NewMulti(),
+		metrics: buildMetrics(
+			discard.NewGauge(),
+			discard.NewHistogram(),
+		),
+	}
+}
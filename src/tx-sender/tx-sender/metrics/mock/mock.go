@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: exported.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	metrics "github.com/go-kit/kit/metrics"
+	gomock "github.com/golang/mock/gomock"
+	prometheus "github.com/prometheus/client_golang/prometheus"
+	reflect "reflect"
+)
+
+// MockSenderMetrics is a mock of SenderMetrics interface
+type MockSenderMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockSenderMetricsMockRecorder
+}
+
+// MockSenderMetricsMockRecorder is the mock recorder for MockSenderMetrics
+type MockSenderMetricsMockRecorder struct {
+	mock *MockSenderMetrics
+}
+
+// NewMockSenderMetrics creates a new mock instance
+func NewMockSenderMetrics(ctrl *gomock.Controller) *MockSenderMetrics {
+	mock := &MockSenderMetrics{ctrl: ctrl}
+	mock.recorder = &MockSenderMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSenderMetrics) EXPECT() *MockSenderMetricsMockRecorder {
+	return m.recorder
+}
+
+// JobsQueueDepth mocks base method
+func (m *MockSenderMetrics) JobsQueueDepth() metrics.Gauge {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobsQueueDepth")
+	ret0, _ := ret[0].(metrics.Gauge)
+	return ret0
+}
+
+// JobsQueueDepth indicates an expected call of JobsQueueDepth
+func (mr *MockSenderMetricsMockRecorder) JobsQueueDepth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobsQueueDepth", reflect.TypeOf((*MockSenderMetrics)(nil).JobsQueueDepth))
+}
+
+// JobProcessingLatency mocks base method
+func (m *MockSenderMetrics) JobProcessingLatency() metrics.Histogram {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobProcessingLatency")
+	ret0, _ := ret[0].(metrics.Histogram)
+	return ret0
+}
+
+// JobProcessingLatency indicates an expected call of JobProcessingLatency
+func (mr *MockSenderMetricsMockRecorder) JobProcessingLatency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobProcessingLatency", reflect.TypeOf((*MockSenderMetrics)(nil).JobProcessingLatency))
+}
+
+// Describe mocks base method
+func (m *MockSenderMetrics) Describe(arg0 chan<- *prometheus.Desc) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Describe", arg0)
+}
+
+// Describe indicates an expected call of Describe
+func (mr *MockSenderMetricsMockRecorder) Describe(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockSenderMetrics)(nil).Describe), arg0)
+}
+
+// Collect mocks base method
+func (m *MockSenderMetrics) Collect(arg0 chan<- prometheus.Metric) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Collect", arg0)
+}
+
+// Collect indicates an expected call of Collect
+func (mr *MockSenderMetricsMockRecorder) Collect(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockSenderMetrics)(nil).Collect), arg0)
+}
@@ -36,6 +36,8 @@ func NewNonceManager(ec ethclient.MultiClient, nm store.NonceSender, tracker sto
 	}
 }
 
+// GetNonce reserves a nonce for the job, so that transactions of the same account can be crafted concurrently.
+// The reserved nonce must be either committed with IncrementNonce or released with CleanNonce
 func (nc *Manager) GetNonce(ctx context.Context, job *entities.Job) (uint64, error) {
 	logger := nc.logger.WithContext(ctx).WithField("job", job.UUID)
 
@@ -45,9 +47,8 @@ func (nc *Manager) GetNonce(ctx context.Context, job *entities.Job) (uint64, err
 		return 0, nil
 	}
 
-	lastSent, err := nc.nonce.GetLastSent(nonceKey)
-	switch {
-	case err != nil && errors.IsNotFoundError(err):
+	n, err := nc.nonce.ReserveNonce(nonceKey)
+	if err != nil && errors.IsNotFoundError(err) {
 		pendingNonce, der := nc.fetchNonceFromChain(ctx, job)
 		if der != nil {
 			logger.WithError(der).Error(fetchNonceErr)
@@ -55,16 +56,27 @@ func (nc *Manager) GetNonce(ctx context.Context, job *entities.Job) (uint64, err
 		}
 
 		logger.WithField("pending_nonce", pendingNonce).WithField("account", job.Transaction.From.Hex()).Debug("fetched pending nonce from node")
-		return pendingNonce, nil
-	case err != nil:
-		errMsg := "cannot retrieve last sent nonce"
-		logger.WithError(err).Error(errMsg)
+
+		// Next nonce might have been initialized by a concurrent reservation, in which case it is kept
+		if err = nc.nonce.InitNextNonce(nonceKey, pendingNonce); err != nil {
+			logger.WithError(err).Error("cannot initialize next nonce")
+			return 0, err
+		}
+
+		n, err = nc.nonce.ReserveNonce(nonceKey)
+	}
+	if err != nil {
+		logger.WithError(err).Error("cannot reserve nonce")
 		return 0, err
-	default:
-		return lastSent + 1, nil
 	}
+
+	logger.WithField("nonce", n).Debug("reserved account nonce")
+	return n, nil
 }
 
+// CleanNonce releases the nonce reserved by the job if it was not sent.
+// On invalid nonce errors, the nonces of the account are deleted to be fetched again from the chain, unless other jobs
+// hold reserved nonces
 func (nc *Manager) CleanNonce(ctx context.Context, job *entities.Job, jobErr error) error {
 	logger := nc.logger.WithContext(ctx).WithField("job", job.UUID)
 
@@ -73,14 +85,30 @@ func (nc *Manager) CleanNonce(ctx context.Context, job *entities.Job, jobErr err
 		return nil
 	}
 
+	nonceKey := job.PartitionKey()
+	txNonce := uint64(0)
+	if job.Transaction.Nonce != nil {
+		txNonce = *job.Transaction.Nonce
+	}
+
 	// TODO: update EthClient to process and standardize nonce too low errors
 	if !strings.Contains(strings.ToLower(jobErr.Error()), "nonce too low") &&
 		!strings.Contains(strings.ToLower(jobErr.Error()), "incorrect nonce") &&
 		!strings.Contains(strings.ToLower(jobErr.Error()), "replacement transaction") {
+		// Jobs are retried with the same nonce on connection errors, otherwise the nonce is reused by other jobs
+		if job.Transaction.Nonce == nil || nonceKey == "" || errors.IsConnectionError(jobErr) || errors.IsKnownTransactionError(jobErr) {
+			return nil
+		}
+
+		logger.WithField("nonce", txNonce).Debug("releasing account nonce")
+		if err := nc.nonce.ReleaseNonce(nonceKey, txNonce); err != nil {
+			logger.WithError(err).Error("cannot release nonce")
+			return err
+		}
+
 		return nil
 	}
 
-	nonceKey := job.PartitionKey()
 	logger.Warn("chain responded with invalid nonce error")
 	if nc.recovery.Recovering(job.UUID) >= nc.maxRecovery {
 		err := errors.InvalidNonceError("reached max nonce recovery max")
@@ -88,12 +116,7 @@ func (nc *Manager) CleanNonce(ctx context.Context, job *entities.Job, jobErr err
 		return err
 	}
 
-	txNonce := uint64(0)
-	if job.Transaction.Nonce != nil {
-		txNonce = *job.Transaction.Nonce
-	}
-
-	// Clean nonce value only if it was used to set the txNonce
+	// Clean nonces only if the chain is ahead of the sent nonces, a lower nonce being outdated
 	lastSentNonce, err := nc.nonce.GetLastSent(nonceKey)
 	if err != nil && !errors.IsNotFoundError(err) {
 		errMsg := "cannot retrieve nonce from cache for cleanup"
@@ -101,12 +124,20 @@ func (nc *Manager) CleanNonce(ctx context.Context, job *entities.Job, jobErr err
 		return err
 	}
 
-	if err == nil && txNonce == lastSentNonce+1 {
+	if errors.IsNotFoundError(err) || txNonce > lastSentNonce {
 		logger.WithField("last_sent", lastSentNonce).Debug("cleaning account nonce")
-		if err := nc.nonce.DeleteLastSent(nonceKey); err != nil {
-			logger.WithError(err).Error("cannot clean Manager LastSent")
+		deleted, err := nc.nonce.DeleteNonces(nonceKey, txNonce)
+		if err != nil {
+			logger.WithError(err).Error("cannot clean Manager nonces")
 			return err
 		}
+		if !deleted {
+			logger.Debug("account nonces kept, other nonces are reserved")
+		}
+	} else if err := nc.nonce.CommitNonce(nonceKey, txNonce); err != nil {
+		// Nonce is already used by the chain, its reservation must not be released once expired
+		logger.WithError(err).Error("cannot discard nonce reservation")
+		return err
 	}
 
 	// In case of failing because "nonce too low" we reset tx nonce
@@ -116,6 +147,7 @@ func (nc *Manager) CleanNonce(ctx context.Context, job *entities.Job, jobErr err
 	return errors.InvalidNonceWarning(jobErr.Error())
 }
 
+// IncrementNonce commits the nonce of the sent job
 func (nc *Manager) IncrementNonce(ctx context.Context, job *entities.Job) error {
 	logger := nc.logger.WithContext(ctx).WithField("job", job.UUID)
 
@@ -125,22 +157,13 @@ func (nc *Manager) IncrementNonce(ctx context.Context, job *entities.Job) error
 		txNonce = *job.Transaction.Nonce
 	}
 
-	// Set nonce value only if txNonce was using previous value
-	lastSentNonce, err := nc.nonce.GetLastSent(nonceKey)
-	if err != nil && !errors.IsNotFoundError(err) {
-		logger.WithError(err).Error("cannot retrieve nonce from cache for increment")
+	err := nc.nonce.CommitNonce(nonceKey, txNonce)
+	if err != nil {
+		logger.WithError(err).Error("could not store last sent nonce")
 		return err
 	}
 
-	if errors.IsNotFoundError(err) || txNonce == lastSentNonce+1 {
-		err := nc.nonce.SetLastSent(nonceKey, txNonce)
-		if err != nil {
-			logger.WithError(err).Error("could not store last sent nonce")
-			return err
-		}
-	}
-
-	logger.WithField("last_sent", *job.Transaction.Nonce).Debug("increment account nonce value")
+	logger.WithField("last_sent", txNonce).Debug("increment account nonce value")
 	nc.recovery.Recovered(job.UUID)
 	return nil
 }
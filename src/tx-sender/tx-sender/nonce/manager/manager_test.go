@@ -33,22 +33,24 @@ func TestNonceManager(t *testing.T) {
 		job := testdata.FakeJob()
 		expectedNonce := uint64(1)
 
-		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(uint64(0), errors.NotFoundError("error"))
+		ns.EXPECT().ReserveNonce(job.PartitionKey()).Return(uint64(0), errors.NotFoundError("error"))
 
 		url := client.GetProxyURL(chainRegistryURL, job.ChainUUID)
 		ec.EXPECT().PendingNonceAt(ctx, url, *job.Transaction.From).Return(expectedNonce, nil)
+		ns.EXPECT().InitNextNonce(job.PartitionKey(), expectedNonce).Return(nil)
+		ns.EXPECT().ReserveNonce(job.PartitionKey()).Return(expectedNonce, nil)
 
 		nonce, err := manager.GetNonce(ctx, job)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedNonce, nonce)
 	})
 
-	t.Run("should retrieve nonce from NonceSender successfully", func(t *testing.T) {
+	t.Run("should reserve nonce from NonceSender successfully", func(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
 		expectedNonce := uint64(2)
 
-		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(expectedNonce-1, nil)
+		ns.EXPECT().ReserveNonce(job.PartitionKey()).Return(expectedNonce, nil)
 
 		nonce, err := manager.GetNonce(ctx, job)
 		assert.NoError(t, err)
@@ -59,52 +61,49 @@ func TestNonceManager(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
 
-		expectedErr := errors.InvalidNonceWarning("invalid error")
-		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(uint64(0), expectedErr)
+		expectedErr := errors.RedisConnectionError("error")
+		ns.EXPECT().ReserveNonce(job.PartitionKey()).Return(uint64(0), expectedErr)
 
 		_, err := manager.GetNonce(ctx, job)
 		assert.Equal(t, err, expectedErr)
 	})
 
-	t.Run("should increment nonce successfully", func(t *testing.T) {
+	t.Run("should return error if NonceSender fails to initialize next nonce", func(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
-		expectedNonce := uint64(1)
-		job.Transaction.Nonce = utils.ToPtr(expectedNonce).(*uint64)
 
-		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(uint64(0), nil)
-		ns.EXPECT().SetLastSent(job.PartitionKey(), expectedNonce).Return(nil)
-		rt.EXPECT().Recovered(job.UUID)
+		expectedErr := errors.RedisConnectionError("error")
+		ns.EXPECT().ReserveNonce(job.PartitionKey()).Return(uint64(0), errors.NotFoundError("error"))
+		ec.EXPECT().PendingNonceAt(ctx, gomock.Any(), *job.Transaction.From).Return(uint64(1), nil)
+		ns.EXPECT().InitNextNonce(job.PartitionKey(), uint64(1)).Return(expectedErr)
 
-		err := manager.IncrementNonce(ctx, job)
-		assert.NoError(t, err)
+		_, err := manager.GetNonce(ctx, job)
+		assert.Equal(t, err, expectedErr)
 	})
 
-	t.Run("should increment nonce consecutively successfully", func(t *testing.T) {
+	t.Run("should increment nonce successfully", func(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
 		expectedNonce := uint64(1)
 		job.Transaction.Nonce = utils.ToPtr(expectedNonce).(*uint64)
 
-		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(uint64(0), nil)
-		ns.EXPECT().SetLastSent(job.PartitionKey(), expectedNonce).Return(nil)
+		ns.EXPECT().CommitNonce(job.PartitionKey(), expectedNonce).Return(nil)
 		rt.EXPECT().Recovered(job.UUID)
 
 		err := manager.IncrementNonce(ctx, job)
 		assert.NoError(t, err)
 	})
 
-	t.Run("should not increment nonce when no consecutively", func(t *testing.T) {
+	t.Run("should return error if NonceSender fails to commit nonce", func(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
-		expectedNonce := uint64(2)
-		job.Transaction.Nonce = utils.ToPtr(expectedNonce).(*uint64)
+		job.Transaction.Nonce = utils.ToPtr(uint64(1)).(*uint64)
 
-		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(uint64(0), nil)
-		rt.EXPECT().Recovered(job.UUID)
+		expectedErr := errors.RedisConnectionError("error")
+		ns.EXPECT().CommitNonce(job.PartitionKey(), uint64(1)).Return(expectedErr)
 
 		err := manager.IncrementNonce(ctx, job)
-		assert.NoError(t, err)
+		assert.Equal(t, err, expectedErr)
 	})
 
 	t.Run("should clean nonce if nonce too low error", func(t *testing.T) {
@@ -115,7 +114,23 @@ func TestNonceManager(t *testing.T) {
 
 		jobErr := errors.InvalidNonceWarning("nonce too low")
 		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(uint64(1), nil)
-		ns.EXPECT().DeleteLastSent(job.PartitionKey()).Return(nil)
+		ns.EXPECT().DeleteNonces(job.PartitionKey(), expectedNonce+1).Return(true, nil)
+		rt.EXPECT().Recovering(job.UUID).Return(uint64(0))
+		rt.EXPECT().Recover(job.UUID)
+
+		err := manager.CleanNonce(ctx, job, jobErr)
+		assert.True(t, errors.IsInvalidNonceWarning(err))
+		assert.Empty(t, job.Transaction.Nonce)
+	})
+
+	t.Run("should recover from nonce too low error if nonces are kept for other reservations", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		job.Transaction.Nonce = utils.ToPtr(uint64(2)).(*uint64)
+
+		jobErr := errors.InvalidNonceWarning("nonce too low")
+		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(uint64(1), nil)
+		ns.EXPECT().DeleteNonces(job.PartitionKey(), uint64(2)).Return(false, nil)
 		rt.EXPECT().Recovering(job.UUID).Return(uint64(0))
 		rt.EXPECT().Recover(job.UUID)
 
@@ -132,6 +147,7 @@ func TestNonceManager(t *testing.T) {
 
 		jobErr := errors.InvalidNonceWarning("nonce too low")
 		ns.EXPECT().GetLastSent(job.PartitionKey()).Return(expectedNonce, nil)
+		ns.EXPECT().CommitNonce(job.PartitionKey(), expectedNonce).Return(nil)
 		rt.EXPECT().Recovering(job.UUID).Return(uint64(0))
 		rt.EXPECT().Recover(job.UUID)
 
@@ -140,16 +156,27 @@ func TestNonceManager(t *testing.T) {
 		assert.Empty(t, job.Transaction.Nonce)
 	})
 
-	t.Run("should do nothing if if nonce too low error", func(t *testing.T) {
+	t.Run("should release nonce if not nonce too low error", func(t *testing.T) {
 		ctx := context.Background()
 		job := testdata.FakeJob()
 		expectedNonce := uint64(1)
 		job.Transaction.Nonce = utils.ToPtr(expectedNonce).(*uint64)
 
 		jobErr := errors.InvalidNonceWarning("internal error")
+		ns.EXPECT().ReleaseNonce(job.PartitionKey(), expectedNonce).Return(nil)
 
 		err := manager.CleanNonce(ctx, job, jobErr)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, job.Transaction.Nonce)
 	})
+
+	t.Run("should not release nonce if connection error", func(t *testing.T) {
+		ctx := context.Background()
+		job := testdata.FakeJob()
+		job.Transaction.Nonce = utils.ToPtr(uint64(1)).(*uint64)
+
+		err := manager.CleanNonce(ctx, job, errors.ServiceConnectionError("error"))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, job.Transaction.Nonce)
+	})
 }
@@ -191,6 +191,11 @@ func (uc *craftTxUseCase) craftGasPrice(ctx context.Context, job *entities.Job)
 		txGasPrice = gasPrice
 	}
 
+	if maxFee := job.InternalData.MaxFee; maxFee != nil && txGasPrice.Cmp(maxFee) > 0 {
+		logger.WithField("max_fee", maxFee.String()).Debug("gas price capped to the max fee of the chain")
+		txGasPrice = new(big.Int).Set(maxFee)
+	}
+
 	job.Transaction.GasPrice = utils.ToPtr(hexutil.Big(*txGasPrice)).(*hexutil.Big)
 
 	job.Transaction.TransactionType = entities.LegacyTxType
@@ -261,6 +266,14 @@ func (uc *craftTxUseCase) craftDynamicFeePrice(ctx context.Context, job *entitie
 	}
 
 	gasFeeCap := new(big.Int).Add(nextBlockBaseFeePerGas, priorityFee)
+	if maxFee := job.InternalData.MaxFee; maxFee != nil && gasFeeCap.Cmp(maxFee) > 0 {
+		logger.WithField("max_fee", maxFee.String()).Debug("gas fee cap capped to the max fee of the chain")
+		gasFeeCap = new(big.Int).Set(maxFee)
+		if priorityFee.Cmp(maxFee) > 0 {
+			priorityFee = new(big.Int).Set(maxFee)
+			job.Transaction.GasTipCap = utils.ToPtr(hexutil.Big(*priorityFee)).(*hexutil.Big)
+		}
+	}
 	job.Transaction.GasFeeCap = utils.ToPtr(hexutil.Big(*gasFeeCap)).(*hexutil.Big)
 	job.Transaction.TransactionType = entities.DynamicFeeTxType
 
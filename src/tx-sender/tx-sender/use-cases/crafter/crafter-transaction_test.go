@@ -75,6 +75,34 @@ func TestCrafterTransaction_Execute(t *testing.T) {
 		assert.Equal(t, uint64(1), *job.Transaction.Nonce)
 	})
 
+	t.Run("should cap the gas price to the max fee of the job (LegacyTx)", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.GasPrice = nil
+		job.Transaction.TransactionType = entities.LegacyTxType
+		job.InternalData.MaxFee = big.NewInt(800)
+
+		proxyURL := client.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		ec.EXPECT().SuggestGasPrice(gomock.Any(), proxyURL).Return(big.NewInt(1000), nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "800", job.Transaction.GasPrice.ToInt().String())
+	})
+
+	t.Run("should cap the gas fee cap to the max fee of the job (DynamicFeeTx)", func(t *testing.T) {
+		job := testdata.FakeJob()
+		job.Transaction.GasPrice = nil
+		job.InternalData.MaxFee = new(big.Int).Mul(nextBaseFee, big.NewInt(2))
+
+		proxyURL := client.GetProxyURL(chainRegistryURL, job.ChainUUID)
+		ec.EXPECT().FeeHistory(gomock.Any(), proxyURL, 1, "latest").Return(testdata.FakeFeeHistory(nextBaseFee), nil)
+		err := usecase.Execute(ctx, job)
+
+		assert.NoError(t, err)
+		assert.Equal(t, job.InternalData.MaxFee.String(), job.Transaction.GasFeeCap.ToInt().String())
+		assert.Equal(t, mediumPriority.String(), job.Transaction.GasTipCap.ToInt().String())
+	})
+
 	t.Run("should execute use case for OneTimeKey successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
 		job.Transaction.Nonce = nil
@@ -7,6 +7,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce"
@@ -45,7 +46,10 @@ func NewSendEEAPrivateTxUseCase(signTx usecases.SignEEATransactionUseCase,
 	}
 }
 
-func (uc *sendEEAPrivateTxUseCase) Execute(ctx context.Context, job *entities.Job) error {
+func (uc *sendEEAPrivateTxUseCase) Execute(ctx context.Context, job *entities.Job) (err error) {
+	ctx, span := tracing.StartSpan(ctx, sendEEAPrivateTxComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.With(log.WithFields(
 		ctx,
 		log.Field("job", job.UUID),
@@ -57,13 +61,16 @@ func (uc *sendEEAPrivateTxUseCase) Execute(ctx context.Context, job *entities.Jo
 
 	logger.Debug("processing EEA private transaction job")
 
-	err := uc.crafter.Execute(ctx, job)
+	err = uc.crafter.Execute(ctx, job)
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(sendEEAPrivateTxComponent)
 	}
 
 	job.Transaction.Raw, _, err = uc.signTx.Execute(ctx, job)
 	if err != nil {
+		if err2 := uc.nonceManager.CleanNonce(ctx, job, err); err2 != nil {
+			return errors.FromError(err2).ExtendComponent(sendEEAPrivateTxComponent)
+		}
 		return errors.FromError(err).ExtendComponent(sendEEAPrivateTxComponent)
 	}
 
@@ -74,6 +74,7 @@ func TestSendEEAPrivate_Execute(t *testing.T) {
 	
 		expectedErr := errors.InternalError("internal error")
 		signTx.EXPECT().Execute(gomock.Any(), job).Return(raw, &txHash, expectedErr)
+		nonceManager.EXPECT().CleanNonce(gomock.Any(), job, expectedErr).Return(nil)
 	
 		err := usecase.Execute(ctx, job)
 		assert.Equal(t, err, expectedErr)
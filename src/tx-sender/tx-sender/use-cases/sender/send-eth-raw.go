@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
@@ -34,7 +35,10 @@ func NewSendETHRawTxUseCase(ec ethclient.TransactionSender, messengerAPI sdk.Mes
 	}
 }
 
-func (uc *sendETHRawTxUseCase) Execute(ctx context.Context, job *entities.Job) error {
+func (uc *sendETHRawTxUseCase) Execute(ctx context.Context, job *entities.Job) (err error) {
+	ctx, span := tracing.StartSpan(ctx, sendETHRawTxComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.With(log.WithFields(
 		ctx,
 		log.Field("job", job.UUID),
@@ -45,7 +49,6 @@ func (uc *sendETHRawTxUseCase) Execute(ctx context.Context, job *entities.Job) e
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("processing ethereum raw transaction job")
 
-	var err error
 	if job.InternalData.ParentJobUUID == job.UUID || job.Status == entities.StatusPending || job.Status == entities.StatusResending {
 		err = utils2.UpdateJobStatus(ctx, uc.messengerAPI, job, entities.StatusResending, "", nil)
 	} else {
@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce"
@@ -46,7 +47,10 @@ func NewSendEthTxUseCase(signTx usecases.SignETHTransactionUseCase,
 	}
 }
 
-func (uc *sendETHTxUseCase) Execute(ctx context.Context, job *entities.Job) error {
+func (uc *sendETHTxUseCase) Execute(ctx context.Context, job *entities.Job) (err error) {
+	ctx, span := tracing.StartSpan(ctx, sendETHTxComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.With(log.WithFields(
 		ctx,
 		log.Field("job", job.UUID),
@@ -58,7 +62,7 @@ func (uc *sendETHTxUseCase) Execute(ctx context.Context, job *entities.Job) erro
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("processing ethereum transaction job")
 
-	err := uc.crafter.Execute(ctx, job)
+	err = uc.crafter.Execute(ctx, job)
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(sendETHTxComponent)
 	}
@@ -72,6 +76,9 @@ func (uc *sendETHTxUseCase) Execute(ctx context.Context, job *entities.Job) erro
 	} else {
 		job.Transaction.Raw, job.Transaction.Hash, err = uc.signTx.Execute(ctx, job)
 		if err != nil {
+			if err2 := uc.nonceChecker.CleanNonce(ctx, job, err); err2 != nil {
+				return errors.FromError(err2).ExtendComponent(sendETHTxComponent)
+			}
 			return errors.FromError(err).ExtendComponent(sendETHTxComponent)
 		}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
 	"github.com/consensys/orchestrate/src/tx-sender/tx-sender/nonce"
@@ -46,7 +47,10 @@ func NewSendGoQuorumMarkingTxUseCase(signTx usecases.SignGoQuorumPrivateTransact
 	}
 }
 
-func (uc *sendGoQuorumMarkingTxUseCase) Execute(ctx context.Context, job *entities.Job) error {
+func (uc *sendGoQuorumMarkingTxUseCase) Execute(ctx context.Context, job *entities.Job) (err error) {
+	ctx, span := tracing.StartSpan(ctx, sendGoQuorumMarkingTxComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.With(log.WithFields(
 		ctx,
 		log.Field("job", job.UUID),
@@ -57,7 +61,7 @@ func (uc *sendGoQuorumMarkingTxUseCase) Execute(ctx context.Context, job *entiti
 	logger := uc.logger.WithContext(ctx)
 	logger.Debug("processing tessera marking transaction job")
 
-	err := uc.crafter.Execute(ctx, job)
+	err = uc.crafter.Execute(ctx, job)
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(sendGoQuorumMarkingTxComponent)
 	}
@@ -71,6 +75,9 @@ func (uc *sendGoQuorumMarkingTxUseCase) Execute(ctx context.Context, job *entiti
 	} else {
 		job.Transaction.Raw, job.Transaction.Hash, err = uc.signTx.Execute(ctx, job)
 		if err != nil {
+			if err2 := uc.nonceChecker.CleanNonce(ctx, job, err); err2 != nil {
+				return errors.FromError(err2).ExtendComponent(sendGoQuorumMarkingTxComponent)
+			}
 			return errors.FromError(err).ExtendComponent(sendGoQuorumMarkingTxComponent)
 		}
 
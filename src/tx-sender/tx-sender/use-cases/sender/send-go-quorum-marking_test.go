@@ -123,6 +123,7 @@ func TestSendGoQuorumMarking_Execute(t *testing.T) {
 
 		expectedErr := errors.InternalError("internal error")
 		signTx.EXPECT().Execute(gomock.Any(), job).Return(raw, &txHash, expectedErr)
+		nonceChecker.EXPECT().CleanNonce(gomock.Any(), job, expectedErr).Return(nil)
 
 		err := usecase.Execute(ctx, job)
 		assert.Equal(t, err, expectedErr)
@@ -7,6 +7,7 @@ import (
 	"github.com/consensys/orchestrate/pkg/sdk"
 	"github.com/consensys/orchestrate/pkg/sdk/client"
 	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/tracing"
 	"github.com/consensys/orchestrate/pkg/utils"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/infra/ethclient"
@@ -36,7 +37,10 @@ func NewSendGoQuorumPrivateTxUseCase(ec ethclient.QuorumTransactionSender, craft
 	}
 }
 
-func (uc *sendGoQuorumPrivateTxUseCase) Execute(ctx context.Context, job *entities.Job) error {
+func (uc *sendGoQuorumPrivateTxUseCase) Execute(ctx context.Context, job *entities.Job) (err error) {
+	ctx, span := tracing.StartSpan(ctx, sendGoQuorumPrivateTxComponent)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	ctx = log.With(log.WithFields(
 		ctx,
 		log.Field("job", job.UUID),
@@ -46,7 +50,7 @@ func (uc *sendGoQuorumPrivateTxUseCase) Execute(ctx context.Context, job *entiti
 	logger.Debug("processing tessera private transaction job")
 
 	job.Transaction.Nonce = utils.ToPtr(uint64(0)).(*uint64)
-	err := uc.crafter.Execute(ctx, job)
+	err = uc.crafter.Execute(ctx, job)
 	if err != nil {
 		return errors.FromError(err).ExtendComponent(sendGoQuorumMarkingTxComponent)
 	}
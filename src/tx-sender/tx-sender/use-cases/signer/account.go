@@ -0,0 +1,41 @@
+package signer
+
+import (
+	"context"
+
+	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk"
+	"github.com/consensys/orchestrate/pkg/toolkit/app/log"
+	"github.com/consensys/orchestrate/src/entities"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// checkAccountCanSign prevents disabled and archived accounts from signing before calling the key manager.
+// Accounts unknown to Orchestrate are only managed by the key manager and are not restricted
+func checkAccountCanSign(ctx context.Context, accountClient sdk.AccountClient, job *entities.Job, logger *log.Logger) error {
+	acc, err := accountClient.GetAccount(ctx, *job.Transaction.From)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			logger.WithField("from", job.Transaction.From.Hex()).Debug("account not registered, skipping status check")
+			return nil
+		}
+
+		errMsg := "failed to fetch account"
+		logger.WithError(err).Error(errMsg)
+		return errors.DependencyFailureError(errMsg).AppendReason(err.Error())
+	}
+
+	account := &entities.Account{Status: entities.AccountStatus(acc.Status)}
+	if acc.RotatedTo != "" {
+		rotatedTo := ethcommon.HexToAddress(acc.RotatedTo)
+		account.RotatedTo = &rotatedTo
+	}
+
+	if !account.CanSign(job.Transaction.To) {
+		errMsg := "account is not allowed to sign transactions"
+		logger.WithField("from", job.Transaction.From.Hex()).WithField("status", acc.Status).Error(errMsg)
+		return errors.InvalidStateError(errMsg)
+	}
+
+	return nil
+}
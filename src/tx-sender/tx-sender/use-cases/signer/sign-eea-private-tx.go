@@ -15,7 +15,8 @@ import (
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/orchestrate/pkg/sdk"
+	signerinfra "github.com/consensys/orchestrate/src/infra/signer"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 )
@@ -23,14 +24,16 @@ import (
 const signEEATransactionComponent = "use-cases.sign-eea-transaction"
 
 type signEEAPrivateTransactionUseCase struct {
-	keyManagerClient client.KeyManagerClient
-	logger           *log.Logger
+	signer        signerinfra.Signer
+	accountClient sdk.AccountClient
+	logger        *log.Logger
 }
 
-func NewSignEEAPrivateTransactionUseCase(keyManagerClient client.KeyManagerClient) usecases.SignEEATransactionUseCase {
+func NewSignEEAPrivateTransactionUseCase(signer signerinfra.Signer, accountClient sdk.AccountClient) usecases.SignEEATransactionUseCase {
 	return &signEEAPrivateTransactionUseCase{
-		keyManagerClient: keyManagerClient,
-		logger:           log.NewLogger().SetComponent(signEEATransactionComponent),
+		signer:        signer,
+		accountClient: accountClient,
+		logger:        log.NewLogger().SetComponent(signEEATransactionComponent),
 	}
 }
 
@@ -90,6 +93,11 @@ func (uc *signEEAPrivateTransactionUseCase) signWithAccount(ctx context.Context,
 	privateArgs *privateETHTransactionParams, tx *types.Transaction, chainID *big.Int) (hexutil.Bytes, error) {
 	logger := uc.logger.WithContext(ctx)
 
+	err := checkAccountCanSign(ctx, uc.accountClient, job, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &qkmtypes.SignEEATransactionRequest{
 		Nonce:          hexutil.Uint64(tx.Nonce()),
 		To:             tx.To(),
@@ -103,7 +111,7 @@ func (uc *signEEAPrivateTransactionUseCase) signWithAccount(ctx context.Context,
 		PrivacyGroupID: privateArgs.PrivacyGroupID,
 	}
 
-	signedRawStr, err := uc.keyManagerClient.SignEEATransaction(ctx, job.InternalData.StoreID, job.Transaction.From.Hex(), req)
+	signedRawStr, err := uc.signer.SignEEATransaction(ctx, job.InternalData.StoreID, job.Transaction.From.Hex(), req)
 
 	if err != nil {
 		errMsg := "failed to sign eea transaction using key manager"
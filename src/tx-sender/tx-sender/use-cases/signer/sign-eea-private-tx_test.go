@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/pkg/utils"
 	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
@@ -22,9 +25,12 @@ func TestSignEEATransaction_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockKeyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockAccountClient := mock.NewMockAccountClient(ctrl)
+	mockAccountClient.EXPECT().GetAccount(gomock.Any(), gomock.Any()).
+		Return(&api.AccountResponse{Status: string(entities.AccountStatusActive)}, nil).AnyTimes()
 	ctx := context.Background()
 
-	usecase := NewSignEEAPrivateTransactionUseCase(mockKeyManagerClient)
+	usecase := NewSignEEAPrivateTransactionUseCase(mockKeyManagerClient, mockAccountClient)
 
 	signedRaw := utils.StringToHexBytes("0xf8d501822710825208944fed1fc4144c223ae3c1553be203cdfcbd38c58182c35080820713a09a0a890215ea6e79d06f9665297996ab967db117f36c2090d6d6ead5a2d32d52a065bc4bc766b5a833cb58b3319e44e952487559b9b939cb5268c0409398214c8ba0035695b4cc4b0941e60551d7a19cf30603db5bfc23e5ac43a56f57f25f75486af842a0035695b4cc4b0941e60551d7a19cf30603db5bfc23e5ac43a56f57f25f75486aa0075695b4cc4b0941e60551d7a19cf30603db5bfc23e5ac43a56f57f25f75486a8a72657374726963746564")
 	
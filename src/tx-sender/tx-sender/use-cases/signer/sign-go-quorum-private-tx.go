@@ -15,7 +15,8 @@ import (
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/orchestrate/pkg/sdk"
+	signerinfra "github.com/consensys/orchestrate/src/infra/signer"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -23,14 +24,16 @@ const signQuorumPrivateTransactionComponent = "use-cases.sign-quorum-private-tra
 
 // signQuorumPrivateTransactionUseCase is a use case to sign a quorum private transaction
 type signGoQuorumPrivateTransactionUseCase struct {
-	keyManagerClient client.KeyManagerClient
-	logger           *log.Logger
+	signer        signerinfra.Signer
+	accountClient sdk.AccountClient
+	logger        *log.Logger
 }
 
-func NewSignGoQuorumPrivateTransactionUseCase(keyManagerClient client.KeyManagerClient) usecases.SignGoQuorumPrivateTransactionUseCase {
+func NewSignGoQuorumPrivateTransactionUseCase(signer signerinfra.Signer, accountClient sdk.AccountClient) usecases.SignGoQuorumPrivateTransactionUseCase {
 	return &signGoQuorumPrivateTransactionUseCase{
-		keyManagerClient: keyManagerClient,
-		logger:           log.NewLogger().SetComponent(signQuorumPrivateTransactionComponent),
+		signer:        signer,
+		accountClient: accountClient,
+		logger:        log.NewLogger().SetComponent(signQuorumPrivateTransactionComponent),
 	}
 }
 
@@ -92,7 +95,12 @@ func (uc *signGoQuorumPrivateTransactionUseCase) signWithAccount(ctx context.Con
 	signedRaw hexutil.Bytes, txHash *ethcommon.Hash, err error) {
 	logger := uc.logger.WithContext(ctx)
 
-	signedRawStr, err := uc.keyManagerClient.SignQuorumPrivateTransaction(ctx, job.InternalData.StoreID, job.Transaction.From.Hex(), &qkmtypes.SignQuorumPrivateTransactionRequest{
+	err = checkAccountCanSign(ctx, uc.accountClient, job, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signedRawStr, err := uc.signer.SignQuorumPrivateTransaction(ctx, job.InternalData.StoreID, job.Transaction.From.Hex(), &qkmtypes.SignQuorumPrivateTransactionRequest{
 		Nonce:    hexutil.Uint64(tx.Nonce()),
 		To:       tx.To(),
 		Value:    hexutil.Big(*tx.Value()),
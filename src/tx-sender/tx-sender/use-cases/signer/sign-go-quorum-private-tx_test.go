@@ -7,6 +7,9 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	api "github.com/consensys/orchestrate/src/api/service/types"
+	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/pkg/utils"
 	qkmmock "github.com/consensys/quorum-key-manager/pkg/client/mock"
@@ -21,9 +24,12 @@ func TestSignQuorumPrivateTransaction_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockKeyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockAccountClient := mock.NewMockAccountClient(ctrl)
+	mockAccountClient.EXPECT().GetAccount(gomock.Any(), gomock.Any()).
+		Return(&api.AccountResponse{Status: string(entities.AccountStatusActive)}, nil).AnyTimes()
 	ctx := context.Background()
 
-	usecase := NewSignGoQuorumPrivateTransactionUseCase(mockKeyManagerClient)
+	usecase := NewSignGoQuorumPrivateTransactionUseCase(mockKeyManagerClient, mockAccountClient)
 
 	signedRaw := utils.StringToHexBytes("0xf851018227108252088082c35080820713a09a0a890215ea6e79d06f9665297996ab967db117f36c2090d6d6ead5a2d32d52a065bc4bc766b5a833cb58b3319e44e952487559b9b939cb5268c0409398214c8b")
 
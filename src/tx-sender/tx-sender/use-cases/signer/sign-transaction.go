@@ -15,7 +15,8 @@ import (
 	usecases "github.com/consensys/orchestrate/src/tx-sender/tx-sender/use-cases"
 
 	"github.com/consensys/orchestrate/pkg/errors"
-	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/orchestrate/pkg/sdk"
+	signerinfra "github.com/consensys/orchestrate/src/infra/signer"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 )
@@ -24,15 +25,17 @@ const signTransactionComponent = "use-cases.sign-eth-transaction"
 
 // signETHTransactionUseCase is a use case to sign a public Ethereum transaction
 type signETHTransactionUseCase struct {
-	keyManagerClient client.KeyManagerClient
-	logger           *log.Logger
+	signer        signerinfra.Signer
+	accountClient sdk.AccountClient
+	logger        *log.Logger
 }
 
 // NewSignETHTransactionUseCase creates a new SignTransactionUseCase
-func NewSignETHTransactionUseCase(keyManagerClient client.KeyManagerClient) usecases.SignETHTransactionUseCase {
+func NewSignETHTransactionUseCase(signer signerinfra.Signer, accountClient sdk.AccountClient) usecases.SignETHTransactionUseCase {
 	return &signETHTransactionUseCase{
-		keyManagerClient: keyManagerClient,
-		logger:           log.NewLogger().SetComponent(signTransactionComponent),
+		signer:        signer,
+		accountClient: accountClient,
+		logger:        log.NewLogger().SetComponent(signTransactionComponent),
 	}
 }
 
@@ -90,7 +93,12 @@ func (uc *signETHTransactionUseCase) signWithAccount(ctx context.Context, job *e
 	chainID *big.Int) (signedRaw hexutil.Bytes, txHash *ethcommon.Hash, err error) {
 	logger := uc.logger.WithContext(ctx)
 
-	signedRawStr, err := uc.keyManagerClient.SignTransaction(ctx, job.InternalData.StoreID, job.Transaction.From.Hex(), &qkmtypes.SignETHTransactionRequest{
+	err = checkAccountCanSign(ctx, uc.accountClient, job, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signedRawStr, err := uc.signer.SignTransaction(ctx, job.InternalData.StoreID, job.Transaction.From.Hex(), &qkmtypes.SignETHTransactionRequest{
 		Nonce:           hexutil.Uint64(tx.Nonce()),
 		To:              tx.To(),
 		Data:            tx.Data(),
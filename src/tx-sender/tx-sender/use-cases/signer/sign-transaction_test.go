@@ -7,6 +7,8 @@ import (
 	"testing"
 
 	"github.com/consensys/orchestrate/pkg/errors"
+	"github.com/consensys/orchestrate/pkg/sdk/mock"
+	api "github.com/consensys/orchestrate/src/api/service/types"
 	"github.com/consensys/orchestrate/src/entities"
 	"github.com/consensys/orchestrate/src/entities/testdata"
 	"github.com/consensys/orchestrate/pkg/utils"
@@ -22,14 +24,17 @@ func TestSignTransaction_Execute(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockKeyManagerClient := qkmmock.NewMockKeyManagerClient(ctrl)
+	mockAccountClient := mock.NewMockAccountClient(ctrl)
 	ctx := context.Background()
 
-	usecase := NewSignETHTransactionUseCase(mockKeyManagerClient)
+	usecase := NewSignETHTransactionUseCase(mockKeyManagerClient, mockAccountClient)
+	activeAccount := &api.AccountResponse{Status: string(entities.AccountStatusActive)}
 
 	signedRaw := utils.StringToHexBytes("0xf86501822710825208944fed1fc4144c223ae3c1553be203cdfcbd38c58182c35080820713a09a0a890215ea6e79d06f9665297996ab967db117f36c2090d6d6ead5a2d32d52a065bc4bc766b5a833cb58b3319e44e952487559b9b939cb5268c0409398214c8b")
 	
 	t.Run("should execute use case successfully", func(t *testing.T) {
 		job := testdata.FakeJob()
+		mockAccountClient.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).Return(activeAccount, nil)
 		mockKeyManagerClient.EXPECT().SignTransaction(gomock.Any(), job.InternalData.StoreID, job.Transaction.From.String(), 
 			gomock.AssignableToTypeOf(&types.SignETHTransactionRequest{})).Return(signedRaw.String(), nil)
 
@@ -43,6 +48,7 @@ func TestSignTransaction_Execute(t *testing.T) {
 	t.Run("should execute use case successfully for deployment transactions", func(t *testing.T) {
 		job := testdata.FakeJob()
 		job.Transaction.To = nil
+		mockAccountClient.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).Return(activeAccount, nil)
 		mockKeyManagerClient.EXPECT().SignTransaction(gomock.Any(), job.InternalData.StoreID, job.Transaction.From.String(), 
 			gomock.AssignableToTypeOf(&types.SignETHTransactionRequest{})).Return(signedRaw.String(), nil)
 
@@ -65,8 +71,57 @@ func TestSignTransaction_Execute(t *testing.T) {
 		assert.NotEmpty(t, txHash)
 	})
 
+	t.Run("should execute use case successfully for archived accounts transferring funds to the rotated account", func(t *testing.T) {
+		job := testdata.FakeJob()
+		mockAccountClient.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).Return(&api.AccountResponse{
+			Status:    string(entities.AccountStatusArchived),
+			RotatedTo: job.Transaction.To.Hex(),
+		}, nil)
+		mockKeyManagerClient.EXPECT().SignTransaction(gomock.Any(), job.InternalData.StoreID, job.Transaction.From.String(),
+			gomock.AssignableToTypeOf(&types.SignETHTransactionRequest{})).Return(signedRaw.String(), nil)
+
+		raw, _, err := usecase.Execute(ctx, job)
+
+		require.NoError(t, err)
+		assert.Equal(t, signedRaw.String(), raw.String())
+	})
+
+	t.Run("should fail with InvalidStateError if account is disabled", func(t *testing.T) {
+		job := testdata.FakeJob()
+		mockAccountClient.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).
+			Return(&api.AccountResponse{Status: string(entities.AccountStatusDisabled)}, nil)
+
+		raw, txHash, err := usecase.Execute(ctx, job)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+		assert.Empty(t, raw)
+		assert.Empty(t, txHash)
+	})
+
+	t.Run("should fail with InvalidStateError if archived account sends to another address", func(t *testing.T) {
+		job := testdata.FakeJob()
+		mockAccountClient.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).Return(&api.AccountResponse{
+			Status:    string(entities.AccountStatusArchived),
+			RotatedTo: job.Transaction.From.Hex(),
+		}, nil)
+
+		_, _, err := usecase.Execute(ctx, job)
+
+		assert.True(t, errors.IsInvalidStateError(err))
+	})
+
+	t.Run("should fail with DependencyFailureError if GetAccount fails", func(t *testing.T) {
+		job := testdata.FakeJob()
+		mockAccountClient.EXPECT().GetAccount(gomock.Any(), *job.Transaction.From).Return(nil, errors.ServiceConnectionError("error"))
+
+		_, _, err := usecase.Execute(ctx, job)
+
+		assert.True(t, errors.IsDependencyFailureError(err))
+	})
+
 	t.Run("should fail with same error if ETHSignTransaction fails", func(t *testing.T) {
 		expectedErr := errors.InvalidFormatError("error")
+		mockAccountClient.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(activeAccount, nil)
 		mockKeyManagerClient.EXPECT().SignTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return("", expectedErr)
 